@@ -0,0 +1,143 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"data-service/config"
+	"data-service/log"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/flight"
+	"github.com/apache/arrow/go/v15/arrow/flight/flightsql"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// DorisFlightSQLClient 通过 Doris 的 Arrow Flight SQL 接口执行查询
+// 查询发给 FE，FE 返回的各个结果分片可能位于不同的 BE 上，客户端按分片的地址连接 BE 拉取 Arrow 批次，
+// BE 连接复用 FE 握手得到的令牌。
+type DorisFlightSQLClient struct {
+	addr   string
+	client *flightsql.Client
+	// auth 握手后得到的认证头，每次调用都附带
+	auth metadata.MD
+
+	mu        sync.Mutex
+	endpoints map[string]*flightsql.Client
+}
+
+// NewDorisFlightSQLClient 连接 FE 的 Arrow Flight SQL 端口并以用户名密码握手
+func NewDorisFlightSQLClient(ctx context.Context, addr, user, password string) (*DorisFlightSQLClient, error) {
+	client, err := flightsql.NewClientCtx(ctx, addr, nil, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to flight sql endpoint %s: %v", addr, err)
+	}
+	authCtx, err := client.Client.AuthenticateBasicToken(ctx, user, password)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to authenticate to flight sql endpoint %s: %v", addr, err)
+	}
+	auth, _ := metadata.FromOutgoingContext(authCtx)
+	return &DorisFlightSQLClient{
+		addr:      addr,
+		client:    client,
+		auth:      auth,
+		endpoints: map[string]*flightsql.Client{},
+	}, nil
+}
+
+// NewDorisFlightSQLClientFromConfig 按配置中的 Doris FE 地址、Arrow Flight SQL 端口与账号创建客户端
+func NewDorisFlightSQLClientFromConfig(ctx context.Context) (*DorisFlightSQLClient, error) {
+	conf := config.GetConfigMap().DorisConfig
+	if conf.FlightSQLPort <= 0 {
+		return nil, fmt.Errorf("doris arrow_flight_sql_port is not configured")
+	}
+	return NewDorisFlightSQLClient(ctx, fmt.Sprintf("%s:%d", conf.Address, conf.FlightSQLPort), conf.User, conf.Password)
+}
+
+// Query 执行查询，按结果分片的顺序把每个 Arrow 批次交给 fn，返回读取的总行数
+// 批次只在 fn 执行期间有效；fn 返回错误时停止读取并返回该错误。
+func (c *DorisFlightSQLClient) Query(ctx context.Context, query string, fn func(arrow.Record) error) (int64, error) {
+	ctx = metadata.NewOutgoingContext(ctx, c.auth)
+	info, err := c.client.Execute(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute flight sql query: %v", err)
+	}
+
+	var rows int64
+	for i, endpoint := range info.Endpoint {
+		client, err := c.endpointClient(ctx, endpoint)
+		if err != nil {
+			return rows, err
+		}
+		n, err := readEndpoint(ctx, client, endpoint.Ticket, fn)
+		rows += n
+		if err != nil {
+			return rows, fmt.Errorf("failed to read flight sql endpoint %d/%d: %w", i+1, len(info.Endpoint), err)
+		}
+	}
+	log.Logger.Infof("Flight sql query returned %d rows from %d endpoints", rows, len(info.Endpoint))
+	return rows, nil
+}
+
+// Close 关闭与 FE 和各 BE 的连接
+func (c *DorisFlightSQLClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr, client := range c.endpoints {
+		if err := client.Close(); err != nil {
+			log.Logger.Warnf("Failed to close flight sql connection to %s: %v", addr, err)
+		}
+	}
+	c.endpoints = map[string]*flightsql.Client{}
+	return c.client.Close()
+}
+
+// endpointClient 返回读取结果分片所用的连接，分片没有地址或地址就是 FE 时复用 FE 的连接
+func (c *DorisFlightSQLClient) endpointClient(ctx context.Context, endpoint *flight.FlightEndpoint) (*flightsql.Client, error) {
+	if len(endpoint.Location) == 0 {
+		return c.client, nil
+	}
+	location, err := url.Parse(endpoint.Location[0].Uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flight endpoint location %s: %v", endpoint.Location[0].Uri, err)
+	}
+	addr := location.Host
+	if addr == "" || addr == c.addr {
+		return c.client, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if client, ok := c.endpoints[addr]; ok {
+		return client, nil
+	}
+	client, err := flightsql.NewClientCtx(ctx, addr, nil, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to flight sql endpoint %s: %v", addr, err)
+	}
+	c.endpoints[addr] = client
+	return client, nil
+}
+
+func readEndpoint(ctx context.Context, client *flightsql.Client, ticket *flight.Ticket, fn func(arrow.Record) error) (int64, error) {
+	reader, err := client.DoGet(ctx, ticket)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Release()
+
+	var rows int64
+	for reader.Next() {
+		record := reader.Record()
+		if err := fn(record); err != nil {
+			return rows, err
+		}
+		rows += record.NumRows()
+	}
+	return rows, reader.Err()
+}
@@ -0,0 +1,196 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/flight"
+	"github.com/apache/arrow/go/v15/arrow/flight/flightsql"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const fakeFlightToken = "doris-token"
+
+// fakeFlightAuth 用户名密码为 root/secret，握手后发放固定令牌
+type fakeFlightAuth struct{}
+
+func (fakeFlightAuth) Validate(username, password string) (string, error) {
+	if username == "root" && password == "secret" {
+		return fakeFlightToken, nil
+	}
+	return "", status.Error(codes.Unauthenticated, "invalid user or password")
+}
+
+func (fakeFlightAuth) IsValid(token string) (interface{}, error) {
+	if token == fakeFlightToken {
+		return "root", nil
+	}
+	return nil, status.Error(codes.Unauthenticated, "invalid token")
+}
+
+var fakeFlightSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+}, nil)
+
+// fakeFlightSQL 本地模拟的 Doris Arrow Flight SQL 服务
+// FE 返回两个结果分片：第一个没有地址，由 FE 自己返回；第二个的地址指向 BE。
+// 每个分片返回 batches 个批次，每批 3 行，id 从分片序号乘 100 开始。
+type fakeFlightSQL struct {
+	flightsql.BaseServer
+	server  flight.Server
+	backend *fakeFlightSQL
+	batches int
+
+	mu      sync.Mutex
+	queries []string
+	// failAfter 大于 0 时分片返回该数量的批次后出错
+	failAfter int
+}
+
+func newFakeFlightSQL(t *testing.T, backend *fakeFlightSQL) *fakeFlightSQL {
+	f := &fakeFlightSQL{backend: backend, batches: 2}
+	f.server = flight.NewServerWithMiddleware([]flight.ServerMiddleware{flight.CreateServerBasicAuthMiddleware(fakeFlightAuth{})})
+	f.server.RegisterFlightService(flightsql.NewFlightServer(f))
+	require.NoError(t, f.server.Init("localhost:0"))
+	go f.server.Serve()
+	t.Cleanup(f.server.Shutdown)
+	return f
+}
+
+func (f *fakeFlightSQL) addr() string {
+	return f.server.Addr().String()
+}
+
+func (f *fakeFlightSQL) GetFlightInfoStatement(_ context.Context, cmd flightsql.StatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	f.mu.Lock()
+	f.queries = append(f.queries, cmd.GetQuery())
+	f.mu.Unlock()
+
+	info := &flight.FlightInfo{FlightDescriptor: desc, Schema: flight.SerializeSchema(fakeFlightSchema, memory.DefaultAllocator)}
+	for i := 0; i < 2; i++ {
+		ticket, err := flightsql.CreateStatementQueryTicket([]byte(fmt.Sprint(i)))
+		if err != nil {
+			return nil, err
+		}
+		endpoint := &flight.FlightEndpoint{Ticket: &flight.Ticket{Ticket: ticket}}
+		if i == 1 && f.backend != nil {
+			endpoint.Location = []*flight.Location{{Uri: "grpc+tcp://" + f.backend.addr()}}
+		}
+		info.Endpoint = append(info.Endpoint, endpoint)
+	}
+	return info, nil
+}
+
+func (f *fakeFlightSQL) DoGetStatement(_ context.Context, ticket flightsql.StatementQueryTicket) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	var part int64
+	fmt.Sscan(string(ticket.GetStatementHandle()), &part)
+
+	chunks := make(chan flight.StreamChunk)
+	go func() {
+		defer close(chunks)
+		for batch := 0; batch < f.batches; batch++ {
+			if f.failAfter > 0 && batch == f.failAfter {
+				chunks <- flight.StreamChunk{Err: status.Error(codes.Internal, "backend crashed")}
+				return
+			}
+			chunks <- flight.StreamChunk{Data: newFakeFlightRecord(part*100 + int64(batch*3))}
+		}
+	}()
+	return fakeFlightSchema, chunks, nil
+}
+
+func newFakeFlightRecord(start int64) arrow.Record {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, fakeFlightSchema)
+	defer builder.Release()
+	for i := int64(0); i < 3; i++ {
+		builder.Field(0).(*array.Int64Builder).Append(start + i)
+		if i == 1 {
+			builder.Field(1).AppendNull()
+		} else {
+			builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("name_%d", start+i))
+		}
+	}
+	return builder.NewRecord()
+}
+
+func collectIDs(t *testing.T, client *DorisFlightSQLClient, query string) ([]int64, int64, error) {
+	var ids []int64
+	rows, err := client.Query(context.Background(), query, func(record arrow.Record) error {
+		assert.True(t, record.Schema().Equal(fakeFlightSchema))
+		ids = append(ids, record.Column(0).(*array.Int64).Int64Values()...)
+		return nil
+	})
+	return ids, rows, err
+}
+
+func TestDorisFlightSQLQueryReadsAllEndpoints(t *testing.T) {
+	backend := newFakeFlightSQL(t, nil)
+	frontend := newFakeFlightSQL(t, backend)
+
+	client, err := NewDorisFlightSQLClient(context.Background(), frontend.addr(), "root", "secret")
+	require.NoError(t, err)
+	defer client.Close()
+
+	ids, rows, err := collectIDs(t, client, "SELECT `id`, `name` FROM `db`.`orders`")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), rows)
+	// 第一个分片来自 FE，第二个分片来自 BE，按分片顺序返回
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 100, 101, 102, 103, 104, 105}, ids)
+	assert.Equal(t, []string{"SELECT `id`, `name` FROM `db`.`orders`"}, frontend.queries)
+	assert.Empty(t, backend.queries)
+
+	// 同一个 BE 的连接被复用
+	_, _, err = collectIDs(t, client, "SELECT 1")
+	require.NoError(t, err)
+	assert.Len(t, client.endpoints, 1)
+}
+
+func TestDorisFlightSQLAuthentication(t *testing.T) {
+	frontend := newFakeFlightSQL(t, nil)
+
+	_, err := NewDorisFlightSQLClient(context.Background(), frontend.addr(), "root", "wrong")
+	assert.ErrorContains(t, err, "failed to authenticate")
+}
+
+func TestDorisFlightSQLEndpointFailure(t *testing.T) {
+	backend := newFakeFlightSQL(t, nil)
+	backend.failAfter = 1
+	frontend := newFakeFlightSQL(t, backend)
+
+	client, err := NewDorisFlightSQLClient(context.Background(), frontend.addr(), "root", "secret")
+	require.NoError(t, err)
+	defer client.Close()
+
+	ids, rows, err := collectIDs(t, client, "SELECT 1")
+	assert.ErrorContains(t, err, "endpoint 2/2")
+	assert.ErrorContains(t, err, "backend crashed")
+	// 出错前读到的行数照常返回
+	assert.Equal(t, int64(9), rows)
+	assert.Len(t, ids, 9)
+}
+
+func TestDorisFlightSQLCallbackError(t *testing.T) {
+	frontend := newFakeFlightSQL(t, nil)
+
+	client, err := NewDorisFlightSQLClient(context.Background(), frontend.addr(), "root", "secret")
+	require.NoError(t, err)
+	defer client.Close()
+
+	calls := 0
+	_, err = client.Query(context.Background(), "SELECT 1", func(arrow.Record) error {
+		calls++
+		return fmt.Errorf("client went away")
+	})
+	assert.ErrorContains(t, err, "endpoint 1/2")
+	assert.ErrorContains(t, err, "client went away")
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,445 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/config"
+	"data-service/log"
+
+	"github.com/google/uuid"
+)
+
+// Stream Load 支持的数据格式
+const (
+	StreamLoadFormatCSV     = "csv"
+	StreamLoadFormatArrow   = "arrow"
+	StreamLoadFormatParquet = "parquet"
+)
+
+const (
+	defaultStreamLoadRetries    = 3
+	defaultStreamLoadRetryDelay = 500 * time.Millisecond
+	maxStreamLoadRetryDelay     = 5 * time.Second
+	// maxStreamLoadRedirects FE 重定向到 BE 的最多跳数
+	maxStreamLoadRedirects = 3
+)
+
+// StreamLoadClient Doris Stream Load 客户端
+// 请求先发给 FE，FE 以 307 重定向到 BE。请求带 Expect: 100-continue，收到重定向时请求体还未发送，
+// 因此请求体可以是只能读一遍的流，不需要先落盘。
+type StreamLoadClient struct {
+	// BaseURL FE 的 HTTP 地址，如 http://127.0.0.1:8030
+	BaseURL    string
+	User       string
+	Password   string
+	HTTPClient *http.Client
+	// MaxRetries 网络错误和 HTTP 5xx 的重试次数，请求体已被读取且无法回到开头时不重试
+	MaxRetries int
+	RetryDelay time.Duration
+	// Parallelism 调用方切分输入时的分片数
+	Parallelism int
+}
+
+// StreamLoadRequest 一次 Stream Load 的目标与参数
+type StreamLoadRequest struct {
+	DbName    string
+	TableName string
+	// Label 导入标签，同一标签只会成功导入一次，为空时随机生成
+	Label  string
+	Format string
+	// Columns 数据中各列对应的目标列，为空时按表结构
+	Columns         []string
+	ColumnSeparator string
+	// Headers 其余 Stream Load 参数
+	Headers map[string]string
+	// TwoPhaseCommit 为 true 时导入完成后只预提交，由 CommitTransaction 或 AbortTransaction 结束事务
+	TwoPhaseCommit bool
+}
+
+// StreamLoadResult Stream Load 返回的导入结果
+type StreamLoadResult struct {
+	TxnID                int64  `json:"TxnId"`
+	Label                string `json:"Label"`
+	TwoPhaseCommit       string `json:"TwoPhaseCommit"`
+	Status               string `json:"Status"`
+	ExistingJobStatus    string `json:"ExistingJobStatus"`
+	Message              string `json:"Message"`
+	NumberTotalRows      int64  `json:"NumberTotalRows"`
+	NumberLoadedRows     int64  `json:"NumberLoadedRows"`
+	NumberFilteredRows   int64  `json:"NumberFilteredRows"`
+	NumberUnselectedRows int64  `json:"NumberUnselectedRows"`
+	LoadBytes            int64  `json:"LoadBytes"`
+	LoadTimeMs           int64  `json:"LoadTimeMs"`
+	ErrorURL             string `json:"ErrorURL"`
+}
+
+// AlreadyLoaded 标签已被之前成功的导入使用，本次没有写入数据
+func (r *StreamLoadResult) AlreadyLoaded() bool {
+	return r.Status == "Label Already Exists" && r.ExistingJobStatus == "FINISHED"
+}
+
+// Err 导入失败时返回错误
+// Publish Timeout 表示事务已提交、数据稍后可见，视为成功；标签已被成功的导入使用时也视为成功，保证相同标签的导入幂等。
+// 有被过滤的行，或有数据但一行都没有导入时视为失败，错误中带上 Doris 给出的错误详情地址。
+func (r *StreamLoadResult) Err() error {
+	switch {
+	case strings.EqualFold(r.Status, "Success") || strings.EqualFold(r.Status, "OK"):
+	case r.Status == "Publish Timeout":
+		log.Logger.Warnf("Stream load %s published with timeout, data will be visible later: %s", r.Label, r.Message)
+	case r.AlreadyLoaded():
+		log.Logger.Infof("Stream load label %s was already loaded, skipping", r.Label)
+		return nil
+	default:
+		return fmt.Errorf("stream load %s failed: status=%s, existingJobStatus=%s, message=%s%s",
+			r.Label, r.Status, r.ExistingJobStatus, r.Message, r.errorURLSuffix())
+	}
+	if r.NumberFilteredRows > 0 || (r.NumberTotalRows > 0 && r.NumberLoadedRows == 0) {
+		return fmt.Errorf("stream load %s failed: totalRows=%d, loadedRows=%d, filteredRows=%d, message=%s%s",
+			r.Label, r.NumberTotalRows, r.NumberLoadedRows, r.NumberFilteredRows, r.Message, r.errorURLSuffix())
+	}
+	return nil
+}
+
+func (r *StreamLoadResult) errorURLSuffix() string {
+	if r.ErrorURL == "" {
+		return ""
+	}
+	return ", errorURL=" + r.ErrorURL
+}
+
+// streamLoadHTTPError FE 或 BE 返回的非 200 响应
+type streamLoadHTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *streamLoadHTTPError) Error() string {
+	return fmt.Sprintf("stream load returned HTTP %d: %s", e.StatusCode, e.Body)
+}
+
+// NewStreamLoadClient 创建 Stream Load 客户端
+func NewStreamLoadClient(baseURL, user, password string) *StreamLoadClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 等待 FE 响应后再发送请求体，FE 重定向时请求体保持未读
+	transport.ExpectContinueTimeout = 10 * time.Second
+	return &StreamLoadClient{
+		BaseURL:  strings.TrimRight(baseURL, "/"),
+		User:     user,
+		Password: password,
+		HTTPClient: &http.Client{
+			Transport: transport,
+			// 重定向由客户端自己处理，标准库跳转到其他主机时会去掉认证头，也无法重发流式请求体
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		MaxRetries:  defaultStreamLoadRetries,
+		RetryDelay:  defaultStreamLoadRetryDelay,
+		Parallelism: 1,
+	}
+}
+
+// NewStreamLoadClientFromConfig 按配置中的 Doris FE 地址与账号创建 Stream Load 客户端
+func NewStreamLoadClientFromConfig() *StreamLoadClient {
+	conf := config.GetConfigMap().DorisConfig
+	client := NewStreamLoadClient(fmt.Sprintf("http://%s:%d", conf.Address, conf.Port), conf.User, conf.Password)
+	if conf.ImportMaxRetry > 0 {
+		client.MaxRetries = conf.ImportMaxRetry
+	}
+	if conf.StreamLoadParallelism > 1 {
+		client.Parallelism = conf.StreamLoadParallelism
+	}
+	return client
+}
+
+// NewStreamLoadLabel 生成随机的导入标签
+func NewStreamLoadLabel(prefix string) string {
+	return fmt.Sprintf("%s_%d_%s", prefix, time.Now().UnixNano(), strings.ReplaceAll(uuid.New().String(), "-", "")[:8])
+}
+
+// Load 将 body 作为一次 Stream Load 导入
+// 网络错误和 HTTP 5xx 时以相同标签重试：请求体还未被读取，或 body 实现了 io.Seeker 可以回到开头时才重试，
+// 之前的尝试实际已成功时 Doris 返回标签已存在，视为成功。
+func (c *StreamLoadClient) Load(ctx context.Context, req StreamLoadRequest, body io.Reader) (*StreamLoadResult, error) {
+	if req.Label == "" {
+		req.Label = NewStreamLoadLabel("load")
+	}
+	url := fmt.Sprintf("%s/api/%s/%s/_stream_load", c.BaseURL, req.DbName, req.TableName)
+	headers := req.headers()
+
+	seeker, _ := body.(io.Seeker)
+	var start int64
+	if seeker != nil {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			seeker = nil
+		}
+		start = offset
+	}
+	counted := &countingReader{r: body}
+
+	var lastErr error
+	delay := c.RetryDelay
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if counted.n > 0 {
+				if seeker == nil {
+					break
+				}
+				if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+					break
+				}
+				counted.n = 0
+			}
+			log.Logger.Warnf("Retrying stream load %s (attempt=%d) after %s: %v", req.Label, attempt, delay, lastErr)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			delay = min(delay*2, maxStreamLoadRetryDelay)
+		}
+
+		respBody, err := c.put(ctx, url, headers, counted)
+		if err != nil {
+			lastErr = err
+			if !isRetryableStreamLoadError(ctx, err) {
+				break
+			}
+			continue
+		}
+		var result StreamLoadResult
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse stream load result %s: %v", string(respBody), err)
+		}
+		if result.Label == "" {
+			result.Label = req.Label
+		}
+		log.Logger.Infof("Stream load %s into %s.%s: status=%s, txnId=%d, loadedRows=%d, filteredRows=%d, loadBytes=%d, loadTimeMs=%d",
+			result.Label, req.DbName, req.TableName, result.Status, result.TxnID, result.NumberLoadedRows,
+			result.NumberFilteredRows, result.LoadBytes, result.LoadTimeMs)
+		return &result, result.Err()
+	}
+	return nil, fmt.Errorf("stream load %s into %s.%s failed: %v", req.Label, req.DbName, req.TableName, lastErr)
+}
+
+// LoadParallel 将每个分片作为一次 Stream Load 并发导入，只有一个分片时等同于 Load
+// 多个分片时各分片以 <Label>_<序号> 为标签两阶段提交，全部预提交成功后才逐个提交，任一分片失败时回滚已预提交的分片。
+// 实现了 io.Closer 的分片在其导入结束后被关闭，以便切分输入的一方及时停止。
+func (c *StreamLoadClient) LoadParallel(ctx context.Context, req StreamLoadRequest, parts []io.Reader) (*StreamLoadResult, error) {
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no data to stream load")
+	}
+	if req.Label == "" {
+		req.Label = NewStreamLoadLabel("load")
+	}
+	if len(parts) == 1 {
+		defer closePart(parts[0])
+		return c.Load(ctx, req, parts[0])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make([]*StreamLoadResult, len(parts))
+	errs := make([]error, len(parts))
+	var wg sync.WaitGroup
+	for i, part := range parts {
+		partReq := req
+		partReq.Label = fmt.Sprintf("%s_%d", req.Label, i)
+		partReq.TwoPhaseCommit = true
+		wg.Add(1)
+		go func(i int, part io.Reader) {
+			defer wg.Done()
+			defer closePart(part)
+			results[i], errs[i] = c.Load(ctx, partReq, part)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, part)
+	}
+	wg.Wait()
+
+	// 取第一个不是由取消引起的错误
+	var loadErr error
+	for _, err := range errs {
+		if err != nil && (loadErr == nil || errors.Is(loadErr, context.Canceled)) {
+			loadErr = err
+		}
+	}
+	if loadErr != nil {
+		c.abortPrepared(req.DbName, results)
+		return nil, loadErr
+	}
+
+	total := &StreamLoadResult{Label: req.Label, Status: "Success"}
+	for i, result := range results {
+		if result.TxnID > 0 && !result.AlreadyLoaded() {
+			if err := c.CommitTransaction(ctx, req.DbName, result.TxnID); err != nil {
+				c.abortPrepared(req.DbName, results[i+1:])
+				return nil, fmt.Errorf("failed to commit stream load %s, %d of %d parts committed: %v",
+					result.Label, i, len(results), err)
+			}
+		}
+		total.NumberTotalRows += result.NumberTotalRows
+		total.NumberLoadedRows += result.NumberLoadedRows
+		total.NumberFilteredRows += result.NumberFilteredRows
+		total.NumberUnselectedRows += result.NumberUnselectedRows
+		total.LoadBytes += result.LoadBytes
+		total.LoadTimeMs = max(total.LoadTimeMs, result.LoadTimeMs)
+	}
+	log.Logger.Infof("Stream load %s into %s.%s committed %d parts, loadedRows=%d",
+		req.Label, req.DbName, req.TableName, len(parts), total.NumberLoadedRows)
+	return total, nil
+}
+
+// CommitTransaction 提交两阶段提交中已预提交的导入事务
+func (c *StreamLoadClient) CommitTransaction(ctx context.Context, dbName string, txnID int64) error {
+	return c.finishTransaction(ctx, dbName, txnID, "commit")
+}
+
+// AbortTransaction 回滚两阶段提交中已预提交的导入事务
+func (c *StreamLoadClient) AbortTransaction(ctx context.Context, dbName string, txnID int64) error {
+	return c.finishTransaction(ctx, dbName, txnID, "abort")
+}
+
+func (c *StreamLoadClient) finishTransaction(ctx context.Context, dbName string, txnID int64, operation string) error {
+	url := fmt.Sprintf("%s/api/%s/_stream_load_2pc", c.BaseURL, dbName)
+	headers := map[string]string{"txn_id": fmt.Sprint(txnID), "txn_operation": operation}
+	respBody, err := c.put(ctx, url, headers, nil)
+	if err != nil {
+		return fmt.Errorf("failed to %s transaction %d: %v", operation, txnID, err)
+	}
+	var result struct {
+		Status string `json:"status"`
+		Msg    string `json:"msg"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("failed to parse %s result of transaction %d: %v", operation, txnID, err)
+	}
+	if !strings.EqualFold(result.Status, "Success") {
+		return fmt.Errorf("failed to %s transaction %d: status=%s, msg=%s", operation, txnID, result.Status, result.Msg)
+	}
+	log.Logger.Infof("Stream load transaction %d %s: %s", txnID, operation, result.Msg)
+	return nil
+}
+
+// abortPrepared 回滚已预提交的分片，失败时只记录日志，未提交的事务到期后由 Doris 回滚
+func (c *StreamLoadClient) abortPrepared(dbName string, results []*StreamLoadResult) {
+	for _, result := range results {
+		if result == nil || result.TxnID <= 0 || !strings.EqualFold(result.Status, "Success") {
+			continue
+		}
+		if err := c.AbortTransaction(context.Background(), dbName, result.TxnID); err != nil {
+			log.Logger.Warnf("Failed to abort stream load %s: %v", result.Label, err)
+		}
+	}
+}
+
+// put 发送 PUT 请求并跟随 FE 到 BE 的重定向，返回 200 响应的内容
+// 请求体已经发出一部分后才收到重定向时无法继续，返回错误
+func (c *StreamLoadClient) put(ctx context.Context, url string, headers map[string]string, body *countingReader) ([]byte, error) {
+	for redirects := 0; ; redirects++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = body
+		}
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPut, url, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		httpReq.SetBasicAuth(c.User, c.Password)
+		if body != nil {
+			httpReq.Header.Set("Expect", "100-continue")
+		}
+		for key, value := range headers {
+			httpReq.Header.Set(key, value)
+		}
+
+		resp, err := c.HTTPClient.Do(httpReq)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return respBody, nil
+		case http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+			location, err := resp.Location()
+			if err != nil {
+				return nil, fmt.Errorf("invalid stream load redirect: %v", err)
+			}
+			if redirects >= maxStreamLoadRedirects {
+				return nil, fmt.Errorf("too many stream load redirects, last to %s", location)
+			}
+			if body != nil && body.n > 0 {
+				return nil, fmt.Errorf("request body was sent before redirect to %s", location)
+			}
+			url = location.String()
+		default:
+			return nil, &streamLoadHTTPError{StatusCode: resp.StatusCode, Body: string(respBody)}
+		}
+	}
+}
+
+func (r StreamLoadRequest) headers() map[string]string {
+	headers := map[string]string{"label": r.Label}
+	if r.Format != "" {
+		headers["format"] = r.Format
+	}
+	if len(r.Columns) > 0 {
+		headers["columns"] = strings.Join(r.Columns, ",")
+	}
+	if r.ColumnSeparator != "" {
+		headers["column_separator"] = r.ColumnSeparator
+	}
+	if r.TwoPhaseCommit {
+		headers["two_phase_commit"] = "true"
+	}
+	for key, value := range r.Headers {
+		headers[key] = value
+	}
+	return headers
+}
+
+// isRetryableStreamLoadError 网络错误与 HTTP 5xx 可以重试，调用方取消时不重试
+func isRetryableStreamLoadError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var httpErr *streamLoadHTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+func closePart(part io.Reader) {
+	if closer, ok := part.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// countingReader 记录已读取的字节数，用于判断请求体是否已经发出
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,404 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"data-service/log"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	viper.Set("LoggerConfig.Level", "debug")
+	log.InitLogger()
+	m.Run()
+}
+
+// fakeDoris 本地模拟的 Stream Load 服务：FE 把导入请求重定向到 BE，BE 读取数据后按 respond 返回结果
+type fakeDoris struct {
+	fe *httptest.Server
+	be *httptest.Server
+
+	mu       sync.Mutex
+	attempts map[string]int
+	bodies   map[string]string
+	headers  map[string]http.Header
+	nextTxn  int64
+	prepared []int64
+	txnOps   map[string][]int64
+	// respond 返回 BE 的 HTTP 状态码与导入结果，为 nil 时全部成功
+	respond func(label string, attempt int, body string) (int, map[string]interface{})
+}
+
+func newFakeDoris(t *testing.T) *fakeDoris {
+	f := &fakeDoris{
+		attempts: map[string]int{},
+		bodies:   map[string]string{},
+		headers:  map[string]http.Header{},
+		txnOps:   map[string][]int64{},
+	}
+	f.be = httptest.NewServer(http.HandlerFunc(f.serveBackend))
+	f.fe = httptest.NewServer(http.HandlerFunc(f.serveFrontend))
+	t.Cleanup(func() {
+		f.fe.Close()
+		f.be.Close()
+	})
+	return f
+}
+
+func (f *fakeDoris) client() *StreamLoadClient {
+	client := NewStreamLoadClient(f.fe.URL, "root", "secret")
+	client.RetryDelay = time.Millisecond
+	return client
+}
+
+func (f *fakeDoris) serveFrontend(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/_stream_load_2pc") {
+		txnID := r.Header.Get("txn_id")
+		f.mu.Lock()
+		var id int64
+		fmt.Sscan(txnID, &id)
+		f.txnOps[r.Header.Get("txn_operation")] = append(f.txnOps[r.Header.Get("txn_operation")], id)
+		f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": "Success", "msg": "transaction [" + txnID + "] done"})
+		return
+	}
+	// FE 不读取请求体，直接重定向到 BE
+	http.Redirect(w, r, f.be.URL+r.URL.Path, http.StatusTemporaryRedirect)
+}
+
+func (f *fakeDoris) serveBackend(w http.ResponseWriter, r *http.Request) {
+	if user, password, ok := r.BasicAuth(); !ok || user != "root" || password != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	label := r.Header.Get("label")
+	body := string(data)
+
+	f.mu.Lock()
+	f.attempts[label]++
+	attempt := f.attempts[label]
+	f.headers[label] = r.Header.Clone()
+	f.mu.Unlock()
+
+	status, result := http.StatusOK, map[string]interface{}{"Status": "Success", "Message": "OK"}
+	if f.respond != nil {
+		status, result = f.respond(label, attempt, body)
+	}
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+		return
+	}
+
+	f.mu.Lock()
+	if result["Status"] == "Success" {
+		f.bodies[label] = body
+		f.nextTxn++
+		result["TxnId"] = f.nextTxn
+		if r.Header.Get("two_phase_commit") == "true" {
+			f.prepared = append(f.prepared, f.nextTxn)
+		}
+	}
+	f.mu.Unlock()
+	result["Label"] = label
+	if _, ok := result["NumberTotalRows"]; !ok {
+		rows := strings.Count(body, "\n")
+		result["NumberTotalRows"] = rows
+		result["NumberLoadedRows"] = rows
+	}
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func TestStreamLoadFollowsRedirect(t *testing.T) {
+	doris := newFakeDoris(t)
+
+	result, err := doris.client().Load(context.Background(), StreamLoadRequest{
+		DbName: "db", TableName: "orders", Label: "orders_1", Format: StreamLoadFormatCSV,
+		Columns: []string{"id", "name"}, ColumnSeparator: ",",
+	}, strings.NewReader("1,a\n2,b\n"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.NumberLoadedRows)
+	assert.Equal(t, "orders_1", result.Label)
+
+	assert.Equal(t, "1,a\n2,b\n", doris.bodies["orders_1"])
+	headers := doris.headers["orders_1"]
+	assert.Equal(t, "csv", headers.Get("format"))
+	assert.Equal(t, "id,name", headers.Get("columns"))
+	assert.Equal(t, ",", headers.Get("column_separator"))
+	assert.Empty(t, headers.Get("two_phase_commit"))
+}
+
+func TestStreamLoadGeneratesLabel(t *testing.T) {
+	doris := newFakeDoris(t)
+
+	result, err := doris.client().Load(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders"},
+		strings.NewReader("1\n"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result.Label, "load_"))
+	assert.Contains(t, doris.bodies, result.Label)
+}
+
+func TestStreamLoadRetriesSeekableBody(t *testing.T) {
+	doris := newFakeDoris(t)
+	doris.respond = func(label string, attempt int, body string) (int, map[string]interface{}) {
+		if attempt < 3 {
+			return http.StatusServiceUnavailable, nil
+		}
+		return http.StatusOK, map[string]interface{}{"Status": "Success"}
+	}
+
+	result, err := doris.client().Load(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders", Label: "retry"},
+		bytes.NewReader([]byte("1\n2\n3\n")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.NumberLoadedRows)
+	assert.Equal(t, 3, doris.attempts["retry"])
+	// 重试时使用相同的标签并重新发送完整数据
+	assert.Equal(t, "1\n2\n3\n", doris.bodies["retry"])
+}
+
+func TestStreamLoadDoesNotRetryConsumedStream(t *testing.T) {
+	doris := newFakeDoris(t)
+	doris.respond = func(label string, attempt int, body string) (int, map[string]interface{}) {
+		return http.StatusInternalServerError, nil
+	}
+
+	// io.MultiReader 不能回到开头
+	_, err := doris.client().Load(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders", Label: "once"},
+		io.MultiReader(strings.NewReader("1\n")))
+	assert.ErrorContains(t, err, "HTTP 500")
+	assert.Equal(t, 1, doris.attempts["once"])
+}
+
+func TestStreamLoadLabelAlreadyExists(t *testing.T) {
+	doris := newFakeDoris(t)
+	doris.respond = func(label string, attempt int, body string) (int, map[string]interface{}) {
+		return http.StatusOK, map[string]interface{}{"Status": "Label Already Exists", "ExistingJobStatus": "FINISHED",
+			"NumberTotalRows": 0, "Message": "label already used"}
+	}
+
+	result, err := doris.client().Load(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders", Label: "done"},
+		strings.NewReader("1\n"))
+	require.NoError(t, err)
+	assert.True(t, result.AlreadyLoaded())
+}
+
+func TestStreamLoadFilteredRows(t *testing.T) {
+	doris := newFakeDoris(t)
+	doris.respond = func(label string, attempt int, body string) (int, map[string]interface{}) {
+		return http.StatusOK, map[string]interface{}{"Status": "Success", "NumberTotalRows": 3, "NumberLoadedRows": 2,
+			"NumberFilteredRows": 1, "ErrorURL": "http://be:8040/api/_load_error_log?file=x"}
+	}
+
+	result, err := doris.client().Load(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders", Label: "filtered"},
+		strings.NewReader("1\n2\nx\n"))
+	assert.ErrorContains(t, err, "filteredRows=1")
+	assert.ErrorContains(t, err, "errorURL=http://be:8040/api/_load_error_log?file=x")
+	require.NotNil(t, result)
+	assert.Equal(t, int64(1), result.NumberFilteredRows)
+}
+
+func TestStreamLoadParallelCommitsAllParts(t *testing.T) {
+	doris := newFakeDoris(t)
+
+	var lines []string
+	for i := 0; i < 100; i++ {
+		lines = append(lines, fmt.Sprintf("%d,name_%d", i, i))
+	}
+	input := strings.Join(lines, "\n") + "\n"
+
+	result, err := doris.client().LoadParallel(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders", Label: "parallel"},
+		SplitLines(strings.NewReader(input), 3, 64))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), result.NumberLoadedRows)
+
+	// 每个分片都以两阶段提交导入并被提交，数据不重不漏
+	var received []string
+	for i := 0; i < 3; i++ {
+		label := fmt.Sprintf("parallel_%d", i)
+		assert.Equal(t, "true", doris.headers[label].Get("two_phase_commit"))
+		received = append(received, strings.Split(strings.TrimSuffix(doris.bodies[label], "\n"), "\n")...)
+	}
+	sort.Strings(received)
+	sort.Strings(lines)
+	assert.Equal(t, lines, received)
+	assert.ElementsMatch(t, doris.prepared, doris.txnOps["commit"])
+	assert.Empty(t, doris.txnOps["abort"])
+}
+
+func TestStreamLoadParallelAbortsOnFailure(t *testing.T) {
+	doris := newFakeDoris(t)
+	doris.respond = func(label string, attempt int, body string) (int, map[string]interface{}) {
+		if label == "broken_1" {
+			return http.StatusOK, map[string]interface{}{"Status": "Fail", "Message": "too many filtered rows"}
+		}
+		return http.StatusOK, map[string]interface{}{"Status": "Success"}
+	}
+
+	parts := []io.Reader{strings.NewReader("1\n"), strings.NewReader("2\n"), strings.NewReader("3\n")}
+	_, err := doris.client().LoadParallel(context.Background(), StreamLoadRequest{DbName: "db", TableName: "orders", Label: "broken"}, parts)
+	assert.ErrorContains(t, err, "too many filtered rows")
+
+	// 没有分片被提交，回滚的只有预提交成功的分片
+	assert.Empty(t, doris.txnOps["commit"])
+	assert.Subset(t, doris.prepared, doris.txnOps["abort"])
+}
+
+func TestStreamLoadResultErr(t *testing.T) {
+	tests := []struct {
+		name   string
+		result StreamLoadResult
+		errMsg string
+	}{
+		{name: "success", result: StreamLoadResult{Status: "Success", NumberTotalRows: 10, NumberLoadedRows: 10}},
+		{name: "two phase commit prepared", result: StreamLoadResult{Status: "OK", NumberTotalRows: 10, NumberLoadedRows: 10}},
+		{name: "publish timeout", result: StreamLoadResult{Status: "Publish Timeout", NumberTotalRows: 1, NumberLoadedRows: 1}},
+		{name: "empty data", result: StreamLoadResult{Status: "Success"}},
+		{name: "label already loaded", result: StreamLoadResult{Status: "Label Already Exists", ExistingJobStatus: "FINISHED"}},
+		{
+			name:   "label used by a running load",
+			result: StreamLoadResult{Status: "Label Already Exists", ExistingJobStatus: "RUNNING"},
+			errMsg: "status=Label Already Exists, existingJobStatus=RUNNING",
+		},
+		{
+			name:   "failed",
+			result: StreamLoadResult{Status: "Fail", Message: "[DATA_QUALITY_ERROR]", ErrorURL: "http://be/err"},
+			errMsg: "status=Fail, existingJobStatus=, message=[DATA_QUALITY_ERROR], errorURL=http://be/err",
+		},
+		{
+			name:   "all rows filtered",
+			result: StreamLoadResult{Status: "Success", NumberTotalRows: 5000, NumberFilteredRows: 5000},
+			errMsg: "totalRows=5000, loadedRows=0, filteredRows=5000",
+		},
+		{
+			name:   "no rows loaded",
+			result: StreamLoadResult{Status: "Success", NumberTotalRows: 5000},
+			errMsg: "totalRows=5000, loadedRows=0, filteredRows=0",
+		},
+		{
+			name:   "partially filtered",
+			result: StreamLoadResult{Status: "Success", NumberTotalRows: 1000, NumberLoadedRows: 800, NumberFilteredRows: 200},
+			errMsg: "totalRows=1000, loadedRows=800, filteredRows=200",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.result.Err()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestSplitLinesKeepsWholeLines(t *testing.T) {
+	input := "a,1\nbb,2\nccc,3\ndddd,4\neeeee,5\n"
+	parts := SplitLines(strings.NewReader(input), 2, 4)
+	require.Len(t, parts, 2)
+
+	var wg sync.WaitGroup
+	outputs := make([]string, len(parts))
+	for i, part := range parts {
+		wg.Add(1)
+		go func(i int, part io.Reader) {
+			defer wg.Done()
+			data, err := io.ReadAll(part)
+			assert.NoError(t, err)
+			outputs[i] = string(data)
+		}(i, part)
+	}
+	wg.Wait()
+
+	var lines []string
+	for _, output := range outputs {
+		if output == "" {
+			continue
+		}
+		assert.True(t, strings.HasSuffix(output, "\n"))
+		lines = append(lines, strings.Split(strings.TrimSuffix(output, "\n"), "\n")...)
+	}
+	sort.Strings(lines)
+	assert.Equal(t, []string{"a,1", "bb,2", "ccc,3", "dddd,4", "eeeee,5"}, lines)
+
+	// 只有一个分片时原样返回
+	single := strings.NewReader(input)
+	assert.Equal(t, []io.Reader{single}, SplitLines(single, 1, 0))
+}
+
+func TestSplitRecordsRoundTrip(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	var buf bytes.Buffer
+	writer := ipc.NewWriter(&buf, ipc.WithSchema(schema))
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	for batch := 0; batch < 5; batch++ {
+		for i := 0; i < 10; i++ {
+			builder.Field(0).(*array.Int64Builder).Append(int64(batch*10 + i))
+		}
+		record := builder.NewRecord()
+		require.NoError(t, writer.Write(record))
+		record.Release()
+	}
+	builder.Release()
+	require.NoError(t, writer.Close())
+
+	source, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer source.Release()
+
+	parts := SplitRecords(source, 3)
+	require.Len(t, parts, 3)
+
+	var mu sync.Mutex
+	var ids []int64
+	var wg sync.WaitGroup
+	for _, part := range parts {
+		wg.Add(1)
+		go func(part io.Reader) {
+			defer wg.Done()
+			// 每个分片都是独立完整的 Arrow IPC 流
+			reader, err := ipc.NewReader(part)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer reader.Release()
+			assert.True(t, reader.Schema().Equal(schema))
+			for reader.Next() {
+				column := reader.Record().Column(0).(*array.Int64)
+				mu.Lock()
+				ids = append(ids, column.Int64Values()...)
+				mu.Unlock()
+			}
+			assert.NoError(t, reader.Err())
+		}(part)
+	}
+	wg.Wait()
+
+	require.Len(t, ids, 50)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for i, id := range ids {
+		assert.Equal(t, int64(i), id)
+	}
+}
@@ -0,0 +1,150 @@
+package clients
+
+import (
+	"bufio"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+)
+
+// defaultSplitChunkSize 按行切分时每次交给一个分片的最少字节数
+const defaultSplitChunkSize = 1 << 20
+
+// RecordSource Arrow 批次的来源，ipc.Reader 与 ipc.FileReader 都满足
+// Read 返回的批次在下一次 Read 前有效，读完时返回 io.EOF
+type RecordSource interface {
+	Schema() *arrow.Schema
+	Read() (arrow.Record, error)
+}
+
+// SplitLines 把按行分隔的文本（如 CSV）切成 parts 个并发读取的分片，分片之间不保证顺序
+// 输入按不少于 chunkSize 字节的整行块分发，读得快的分片拿到更多的块；字段中含有换行的数据不能切分。
+// 读取输入出错时所有分片都以该错误结束。
+func SplitLines(r io.Reader, parts, chunkSize int) []io.Reader {
+	if parts <= 1 {
+		return []io.Reader{r}
+	}
+	if chunkSize <= 0 {
+		chunkSize = defaultSplitChunkSize
+	}
+	reader := bufio.NewReaderSize(r, chunkSize)
+	produce := func(emit func([]byte) bool) error {
+		for {
+			chunk := make([]byte, chunkSize)
+			n, err := io.ReadFull(reader, chunk)
+			chunk = chunk[:n]
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				if n > 0 {
+					emit(chunk)
+				}
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// 补齐到行尾，保证每块都是完整的行
+			if chunk[n-1] != '\n' {
+				rest, err := reader.ReadBytes('\n')
+				if err != nil && err != io.EOF {
+					return err
+				}
+				chunk = append(chunk, rest...)
+			}
+			if !emit(chunk) {
+				return nil
+			}
+		}
+	}
+	consume := func(w io.Writer, chunks <-chan []byte) error {
+		for chunk := range chunks {
+			if _, err := w.Write(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return splitStream(parts, produce, consume)
+}
+
+// SplitRecords 把 Arrow 批次分发到 parts 个 Arrow IPC 流中并发读取，分片之间不保证顺序
+// 读取批次出错时所有分片都以该错误结束
+func SplitRecords(source RecordSource, parts int) []io.Reader {
+	if parts < 1 {
+		parts = 1
+	}
+	produce := func(emit func(arrow.Record) bool) error {
+		for {
+			record, err := source.Read()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// 批次在下一次 Read 后失效，交给分片前先持有
+			record.Retain()
+			if !emit(record) {
+				record.Release()
+				return nil
+			}
+		}
+	}
+	consume := func(w io.Writer, records <-chan arrow.Record) error {
+		writer := ipc.NewWriter(w, ipc.WithSchema(source.Schema()))
+		for record := range records {
+			err := writer.Write(record)
+			record.Release()
+			if err != nil {
+				return err
+			}
+		}
+		return writer.Close()
+	}
+	return splitStream(parts, produce, consume)
+}
+
+// splitStream 由 produce 逐个读取输入并通过 emit 分发，parts 个 consume 并发取走并写入各自的管道
+// consume 出错时它的分片以该错误结束，其余分片继续取；所有分片都停止后 emit 返回 false。
+// produce 返回的错误会结束所有仍在读取的分片。
+func splitStream[T any](parts int, produce func(emit func(T) bool) error,
+	consume func(w io.Writer, items <-chan T) error) []io.Reader {
+	items := make(chan T)
+	done := make(chan struct{})
+	readers := make([]io.Reader, parts)
+
+	// items 关闭前写入，consume 读完 items 后才读取
+	var produceErr error
+	var wg sync.WaitGroup
+	for i := range readers {
+		pr, pw := io.Pipe()
+		readers[i] = pr
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := consume(pw, items); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			pw.CloseWithError(produceErr)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	go func() {
+		produceErr = produce(func(item T) bool {
+			select {
+			case items <- item:
+				return true
+			case <-done:
+				return false
+			}
+		})
+		close(items)
+	}()
+	return readers
+}
@@ -11,6 +11,7 @@ type ColumnInfo struct {
 	DataType string // 数据类型
 	Nullable bool   // 是否可为空
 	Default  string // 默认值
+	Comment  string // 列注释
 }
 
 // FilterColumnsByNames 根据指定的列名过滤列信息
@@ -68,6 +68,9 @@ const (
 	// KingBase证书目录
 	KINGBASE_CERT_DIR = "kingbase"
 
+	// 达梦 JDBC 客户端证书目录（需预先部署在 Doris BE 上）
+	DAMENG_SSL_FILES_PATH = "/opt/apache-doris/driver/dm_ssl"
+
 	// TLS keystore/client p12 password
 	TLS_KEYSTORE_PASSWORD = "doris123"
 )
@@ -91,4 +94,6 @@ func LoadCommonConfig(conf *config.DataServiceConf) {
 	} else {
 		STREAM_DATA_SIZE = conf.Dbms.StreamDataSize
 	}
+
+	SetResumeTokenSecret(conf.StreamConfig.ResumeTokenSecret)
 }
@@ -0,0 +1,54 @@
+package common
+
+// DorisTableModel Doris 表的数据模型
+type DorisTableModel string
+
+const (
+	// DorisTableModelDuplicate 明细模型，键列只用于排序
+	DorisTableModelDuplicate DorisTableModel = "DUPLICATE"
+	// DorisTableModelUnique 主键模型，相同键的行写入时覆盖旧行
+	DorisTableModelUnique DorisTableModel = "UNIQUE"
+	// DorisTableModelAggregate 聚合模型，相同键的行按值列的聚合方式合并
+	DorisTableModelAggregate DorisTableModel = "AGGREGATE"
+)
+
+// DorisPartitionType Doris 表的分区方式
+type DorisPartitionType string
+
+const (
+	DorisPartitionRange DorisPartitionType = "RANGE"
+	DorisPartitionList  DorisPartitionType = "LIST"
+)
+
+// DorisPartition 一个分区的定义
+type DorisPartition struct {
+	Name string
+	// Values RANGE 分区为上界（不含），MAXVALUE 表示没有上界；LIST 分区为枚举的取值
+	Values []string
+}
+
+// DorisTableLayout 内部表的数据模型、分区、分桶与副本数
+type DorisTableLayout struct {
+	Model DorisTableModel
+	// KeyColumns 键列，DUPLICATE 模型下为排序列；UNIQUE 模型为空时附加自增列作为键
+	KeyColumns []string
+	// AutoIncrementColumn 自增列，由服务生成，不来自源表
+	AutoIncrementColumn string
+	// Aggregations AGGREGATE 模型中值列的聚合方式，未指定的值列使用 REPLACE
+	Aggregations map[string]string
+
+	// DistributionColumns 分桶列，为空时 UNIQUE、AGGREGATE 模型按键列分桶，DUPLICATE 模型随机分桶
+	DistributionColumns []string
+	// RandomDistribution 随机分桶
+	RandomDistribution bool
+	// Buckets 分桶数，0 表示 BUCKETS AUTO
+	Buckets int
+
+	// PartitionType 为空时不分区
+	PartitionType   DorisPartitionType
+	PartitionColumn string
+	Partitions      []DorisPartition
+
+	// ReplicationNum 副本数
+	ReplicationNum int
+}
@@ -0,0 +1,29 @@
+package common
+
+// IncrementalImportRequest 按水位列增量导入数据资产的请求
+type IncrementalImportRequest struct {
+	AssetName       string
+	ChainInfoId     string
+	Alias           string
+	JobInstanceId   string
+	TargetTableName string
+	TargetDbName    string
+	Columns         []string
+	// WatermarkColumn 水位列，更新时间戳或单调递增的 id
+	WatermarkColumn string
+	// FromWatermark 上次导入保存的水位，为空时重建内部表并全量导入
+	FromWatermark string
+	// Layout 内部表的建表方式，UNIQUE 模型下变化的行按键覆盖旧行；没有键时只追加新行
+	Layout *DorisTableLayout
+}
+
+// IncrementalImportResult 增量导入的结果
+type IncrementalImportResult struct {
+	TableName string
+	// Watermark 导入后的水位，没有新数据时沿用导入前的水位
+	Watermark string
+	// Incremental 本次是否只导入了 FromWatermark 之后的数据
+	Incremental bool
+	// Rows 本次导入的行数
+	Rows int64
+}
@@ -0,0 +1,150 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/24
+	@note: 读取流的续传令牌：记录已发送的位置，断流后客户端携带令牌重新请求即可从该位置继续；
+	       令牌带服务端 HMAC 签名，客户端无法伪造或篡改其中的任务 ID 等字段
+
+*
+*/
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// resumeTokenVersion 令牌格式版本，格式变化时递增，旧令牌会被拒绝
+const resumeTokenVersion = 1
+
+// 数据库直读时令牌记录的续传位置
+const (
+	ResumeByKey    = "key"    // 按键值续传：WHERE key > 上一批最后一行的键值
+	ResumeByOffset = "offset" // 按行偏移续传：跳过已发送的行数，要求查询有确定的排序
+	ResumeByPart   = "part"   // 按导出分片续传：从第 Part 个 parquet 分片的第 PartRows 行继续
+)
+
+// ResumeKey 键值的类型，决定续传时绑定参数的类型
+const (
+	ResumeKeyInt    = "int"
+	ResumeKeyUint   = "uint"
+	ResumeKeyFloat  = "float"
+	ResumeKeyString = "string"
+)
+
+// ErrInvalidResumeToken 令牌无法解析、签名或版本不符、与请求不匹配
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// resumeTokenKey 令牌签名密钥，未配置时使用进程内随机密钥，此时令牌只能在签发的实例上续传
+var resumeTokenKey = newResumeTokenKey()
+
+func newResumeTokenKey() []byte {
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("failed to generate resume token key: %v", err))
+	}
+	return key
+}
+
+// SetResumeTokenSecret 设置令牌签名密钥，多实例部署时各实例需配置相同的密钥；secret 为空时保留随机密钥
+func SetResumeTokenSecret(secret string) {
+	if secret != "" {
+		resumeTokenKey = []byte(secret)
+	}
+}
+
+// signResumeToken 在编码后的令牌内容后追加 HMAC 签名，格式为 payload.signature
+func signResumeToken(payload string) string {
+	mac := hmac.New(sha256.New, resumeTokenKey)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ResumeToken 续传令牌，随每个 Arrow 批次返回，表示客户端收到该批次后的读取位置
+type ResumeToken struct {
+	Version int    `json:"v"`
+	Kind    string `json:"k"`
+	// Fingerprint 原始请求的摘要，防止令牌被用于条件不同的请求
+	Fingerprint string `json:"f"`
+	// Rows 已发送的总行数
+	Rows int64 `json:"r"`
+
+	// 数据库直读：排序键及上一批最后一行的键值
+	KeyColumn string `json:"kc,omitempty"`
+	KeyType   string `json:"kt,omitempty"`
+	LastKey   string `json:"lk,omitempty"`
+
+	// Doris 导出：任务 ID、分片序号及该分片内已发送的行数
+	JobInstanceId string `json:"j,omitempty"`
+	Part          int    `json:"p,omitempty"`
+	PartRows      int64  `json:"pr,omitempty"`
+}
+
+// Encode 将令牌编码为带签名的 URL 安全字符串
+func (t *ResumeToken) Encode() (string, error) {
+	token := *t
+	token.Version = resumeTokenVersion
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode resume token: %v", err)
+	}
+	return signResumeToken(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+// DecodeResumeToken 校验签名后解析令牌，并校验其属于 fingerprint 对应的请求，token 为空时返回 nil
+func DecodeResumeToken(token, fingerprint string) (*ResumeToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sep := strings.LastIndexByte(token, '.')
+	if sep < 0 || !hmac.Equal([]byte(signResumeToken(token[:sep])), []byte(token)) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidResumeToken)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token[:sep])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResumeToken, err)
+	}
+	var t ResumeToken
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResumeToken, err)
+	}
+	if t.Version != resumeTokenVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidResumeToken, t.Version)
+	}
+	switch t.Kind {
+	case ResumeByKey, ResumeByOffset, ResumeByPart:
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %q", ErrInvalidResumeToken, t.Kind)
+	}
+	if t.Fingerprint != fingerprint {
+		return nil, fmt.Errorf("%w: token does not belong to this request", ErrInvalidResumeToken)
+	}
+	if t.Rows < 0 || t.Part < 0 || t.PartRows < 0 {
+		return nil, fmt.Errorf("%w: negative position", ErrInvalidResumeToken)
+	}
+	return &t, nil
+}
+
+// ResumeFingerprint 计算请求的摘要，resume_token 字段与 ignoredFields 中的字段（如每次随机生成的 requestId）不参与计算
+func ResumeFingerprint(request proto.Message, ignoredFields ...string) string {
+	msg := proto.Clone(request).ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for _, name := range append([]string{"resume_token"}, ignoredFields...) {
+		if fd := fields.ByTextName(name); fd != nil {
+			msg.Clear(fd)
+		}
+	}
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	pb "data-service/generated/datasource"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumeTokenRoundTrip(t *testing.T) {
+	token := &ResumeToken{Kind: ResumeByKey, Fingerprint: "fp", Rows: 42, KeyColumn: "id", KeyType: ResumeKeyInt, LastKey: "9007199254740993"}
+	encoded, err := token.Encode()
+	assert.NoError(t, err)
+
+	decoded, err := DecodeResumeToken(encoded, "fp")
+	assert.NoError(t, err)
+	assert.Equal(t, &ResumeToken{Version: 1, Kind: ResumeByKey, Fingerprint: "fp", Rows: 42,
+		KeyColumn: "id", KeyType: ResumeKeyInt, LastKey: "9007199254740993"}, decoded)
+
+	// 空令牌表示从头读取
+	decoded, err = DecodeResumeToken("", "fp")
+	assert.NoError(t, err)
+	assert.Nil(t, decoded)
+}
+
+func TestDecodeResumeTokenRejects(t *testing.T) {
+	encoded, err := (&ResumeToken{Kind: ResumeByPart, Fingerprint: "fp", Part: 2}).Encode()
+	assert.NoError(t, err)
+
+	signed := func(payload string) string {
+		return signResumeToken(base64.RawURLEncoding.EncodeToString([]byte(payload)))
+	}
+	// 客户端自行构造或修改的令牌签名不符
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"v":1,"k":"part","f":"fp","j":"../../etc"}`))
+	tampered := forged + encoded[strings.LastIndexByte(encoded, '.'):]
+
+	for name, tc := range map[string]struct {
+		token       string
+		fingerprint string
+	}{
+		"other request": {encoded, "other"},
+		"unsigned":      {forged, "fp"},
+		"tampered":      {tampered, "fp"},
+		"not base64":    {signResumeToken("***"), "fp"},
+		"not json":      {signed("x"), "fp"},
+		"old version":   {signed(`{"v":0,"k":"part","f":"fp"}`), "fp"},
+		"unknown kind":  {signed(`{"v":1,"k":"page","f":"fp"}`), "fp"},
+		"negative rows": {signed(`{"v":1,"k":"offset","f":"fp","r":-1}`), "fp"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeResumeToken(tc.token, tc.fingerprint)
+			assert.True(t, errors.Is(err, ErrInvalidResumeToken), err)
+		})
+	}
+}
+
+func TestResumeFingerprint(t *testing.T) {
+	request := &pb.StreamReadRequest{AssetName: "asset", RequestId: "r1", DbFields: []string{"id"}}
+	fingerprint := ResumeFingerprint(request, "requestId")
+
+	// 续传令牌与忽略的字段不影响摘要，且不修改原请求
+	resumed := &pb.StreamReadRequest{AssetName: "asset", RequestId: "r2", DbFields: []string{"id"}, ResumeToken: "token"}
+	assert.Equal(t, fingerprint, ResumeFingerprint(resumed, "requestId"))
+	assert.Equal(t, "token", resumed.ResumeToken)
+
+	changed := &pb.StreamReadRequest{AssetName: "asset", RequestId: "r1", DbFields: []string{"id", "name"}}
+	assert.NotEqual(t, fingerprint, ResumeFingerprint(changed, "requestId"))
+}
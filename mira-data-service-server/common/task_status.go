@@ -8,6 +8,7 @@ const (
 	TaskStatusRunning   TaskStatus = "running"   // 执行中
 	TaskStatusCompleted TaskStatus = "completed" // 已完成
 	TaskStatusFailed    TaskStatus = "failed"    // 失败
+	TaskStatusCancelled TaskStatus = "cancelled" // 已取消
 )
 
 // String 实现Stringer接口
@@ -18,7 +19,7 @@ func (s TaskStatus) String() string {
 // IsValid 检查状态是否有效
 func (s TaskStatus) IsValid() bool {
 	switch s {
-	case TaskStatusPending, TaskStatusRunning, TaskStatusCompleted, TaskStatusFailed:
+	case TaskStatusPending, TaskStatusRunning, TaskStatusCompleted, TaskStatusFailed, TaskStatusCancelled:
 		return true
 	default:
 		return false
@@ -32,5 +33,11 @@ func GetTaskStatusList() []TaskStatus {
 		TaskStatusRunning,
 		TaskStatusCompleted,
 		TaskStatusFailed,
+		TaskStatusCancelled,
 	}
 }
+
+// IsFinished 任务是否已结束，结束后状态不再变化
+func (s TaskStatus) IsFinished() bool {
+	return s == TaskStatusCompleted || s == TaskStatusFailed || s == TaskStatusCancelled
+}
@@ -119,6 +119,10 @@ type DbmsConfig struct {
 	BatchDataSize     int     `yaml:"batch_data_size"`     // 一次insert数据库的数据量
 	UseEstimationOnly bool    `yaml:"use_estimation_only"` // 是否使用估算方式获取表大小
 	TableSizeFactor   float64 `yaml:"table_size_factor"`   // 表大小估算系数
+	CallTimeout       int     `yaml:"call_timeout"`        // 元数据查询等单次调用的超时时间（秒），0 表示不限制
+	PoolIdleTimeout   int     `yaml:"pool_idle_timeout"`   // 连接池空闲回收时间（分钟），0 表示使用默认值
+	SchemaEvolution   string  `yaml:"schema_evolution"`    // 写入已存在表时的结构演进策略：reject（默认）、add_columns、evolve
+	ReconcileMode     string  `yaml:"reconcile_mode"`      // 请求未指定时的额外对账强度：none（默认）、count、checksum、row_hash，未过滤的流式读取始终比对行数
 }
 
 // RedisConfig Redis配置结构
@@ -144,8 +148,18 @@ type DorisConfig struct {
 	MaxIdleTime  int `yaml:"max_idle_time"`  // 空闲连接最大空闲时间（分钟）
 	QueryTimeout int `yaml:"query_timeout"`  // 查询超时时间（秒）
 	// 导入相关配置
-	ImportBatchSize int `yaml:"import_batch_size"` // 分批导入每批的条数
-	ImportMaxRetry  int `yaml:"import_max_retry"`  // 导入失败重试次数
+	ImportBatchSize        int `yaml:"import_batch_size"`        // 分批导入每批的条数
+	ImportMaxRetry         int `yaml:"import_max_retry"`         // 导入失败重试次数
+	StreamLoadParallelism  int `yaml:"stream_load_parallelism"`  // Stream Load 并发分片数，大于 1 时分片并发导入并两阶段提交
+	CatalogIdleTimeout     int `yaml:"catalog_idle_timeout"`     // 数据源 JDBC Catalog 无人使用后的删除时间（分钟），0 表示使用默认值
+	CatalogRefreshInterval int `yaml:"catalog_refresh_interval"` // 复用 JDBC Catalog 前刷新元数据的间隔（秒），0 表示使用默认值
+	// 建表相关配置，表键 attributes 中指定时以表键为准
+	TableModel     string `yaml:"table_model"`     // 未指定表键时内部表的数据模型：unique（默认）以自增列作为 Unique Key，duplicate 为随机分桶的明细表
+	TableBuckets   int    `yaml:"table_buckets"`   // 内部表默认分桶数，0 表示 BUCKETS AUTO
+	ReplicationNum int    `yaml:"replication_num"` // 内部表默认副本数，0 表示 1
+	// 读取相关配置
+	ReadMode      string `yaml:"read_mode"`             // 读取 Doris 数据的方式：oss（默认）导出 parquet 到对象存储后读取，flight_sql 通过 Arrow Flight SQL 直接读取
+	FlightSQLPort int    `yaml:"arrow_flight_sql_port"` // FE 的 Arrow Flight SQL 端口
 	// s3导出配置
 	S3ExportMaxFileSize       string `yaml:"s3_export_max_file_size"`      // 单个导出文件大小
 	S3ExportRequestTimeout    int    `yaml:"s3_export_request_timeout"`    // 请求超时时间（秒）
@@ -216,8 +230,9 @@ type CleanupConfig struct {
 }
 
 type StreamConfig struct {
-	BatchLines       int `yaml:"batch_lines"`
-	ParquetBatchSize int `yaml:"parquet_batch_size"`
+	BatchLines        int    `yaml:"batch_lines"`
+	ParquetBatchSize  int    `yaml:"parquet_batch_size"`
+	ResumeTokenSecret string `yaml:"resume_token_secret"` // 续传令牌签名密钥，多实例部署时需配置相同的值，未配置时令牌只在签发实例有效
 }
 
 type CommonConfig struct {
@@ -0,0 +1,397 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/6/16
+	@note: 原生批量导入（MySQL LOAD DATA LOCAL INFILE / PostgreSQL 系 COPY FROM STDIN）
+
+*
+*/
+package database
+
+import (
+	"bytes"
+	"context"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// ErrBulkLoadUnsupported 服务端拒绝批量导入且尚未读取任何数据，调用方可以改用 INSERT 写入
+var ErrBulkLoadUnsupported = errors.New("bulk load not supported by server")
+
+// RowSource 逐行提供待导入的数据，数据读完时返回 io.EOF
+type RowSource interface {
+	Next() ([]interface{}, error)
+}
+
+// BulkLoadRequest 一次批量导入的目标表、列和数据
+type BulkLoadRequest struct {
+	TableName string
+	Columns   []string
+	Rows      RowSource
+	// BeforeLoad 在导入前于同一事务内执行的语句，如覆盖模式的清表
+	BeforeLoad []string
+}
+
+// BulkLoader 使用数据库原生的批量导入协议写入数据，返回写入行数
+type BulkLoader interface {
+	BulkLoad(ctx context.Context, db *sql.DB, req BulkLoadRequest) (int64, error)
+}
+
+// BulkLoaderFor 根据数据源类型获取批量导入实现，不支持时返回 nil
+func BulkLoaderFor(dbType pb.DataSourceType) BulkLoader {
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		pb.DataSourceType_DATA_SOURCE_TYPE_TIDB,
+		pb.DataSourceType_DATA_SOURCE_TYPE_TDSQL:
+		return &mysqlBulkLoader{dialect: mysqlDialect}
+	case pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL:
+		return &pgxCopyLoader{dialect: postgresDialect}
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		// gokb 与 openGauss 驱动沿用 lib/pq 的 COPY 协议：在事务内 Prepare COPY 语句后逐行 Exec
+		return &stmtCopyLoader{dialect: DialectFor(dbType)}
+	default:
+		return nil
+	}
+}
+
+// mysqlBulkLoader 通过 LOAD DATA LOCAL INFILE 导入，数据以驱动注册的 Reader 流式发送，不落盘
+//
+// 注意 LOCAL 导入时唯一键冲突的行会被跳过并记为警告，而不是像 INSERT 一样报错。
+type mysqlBulkLoader struct {
+	dialect Dialect
+}
+
+func (l *mysqlBulkLoader) BulkLoad(ctx context.Context, db *sql.DB, req BulkLoadRequest) (int64, error) {
+	source := &trackedRowSource{source: req.Rows}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := execBeforeLoad(ctx, tx, req.BeforeLoad); err != nil {
+		return 0, err
+	}
+
+	handlerName := "bulk_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	mysql.RegisterReaderHandler(handlerName, func() io.Reader {
+		return newTextLoadReader(source, len(req.Columns), false)
+	})
+	defer mysql.DeregisterReaderHandler(handlerName)
+
+	loadSQL := fmt.Sprintf("LOAD DATA LOCAL INFILE 'Reader::%s' INTO TABLE %s CHARACTER SET utf8mb4 "+
+		"FIELDS TERMINATED BY '\\t' ESCAPED BY '\\\\' LINES TERMINATED BY '\\n' (%s)",
+		handlerName, l.dialect.QuoteTableName(req.TableName), quoteColumnList(l.dialect, req.Columns))
+	log.Logger.Debugf("Executing bulk load: %s", loadSQL)
+	result, err := tx.ExecContext(ctx, loadSQL)
+	if err != nil {
+		return 0, classifyBulkLoadError(err, source)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit bulk load: %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// pgxCopyLoader 通过 pgx 底层连接执行 COPY FROM STDIN，数据按 COPY 文本格式流式发送
+type pgxCopyLoader struct {
+	dialect Dialect
+}
+
+func (l *pgxCopyLoader) BulkLoad(ctx context.Context, db *sql.DB, req BulkLoadRequest) (int64, error) {
+	source := &trackedRowSource{source: req.Rows}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get connection: %v", err)
+	}
+	defer conn.Close()
+
+	var loaded int64
+	err = conn.Raw(func(driverConn interface{}) error {
+		stdConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("%w: unexpected driver connection %T", ErrBulkLoadUnsupported, driverConn)
+		}
+		tx, err := stdConn.Conn().Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %v", err)
+		}
+		defer tx.Rollback(ctx)
+
+		for _, statement := range req.BeforeLoad {
+			if _, err := tx.Exec(ctx, statement); err != nil {
+				return fmt.Errorf("failed to execute %s: %v", statement, err)
+			}
+		}
+		tag, err := tx.Conn().PgConn().CopyFrom(ctx, newTextLoadReader(source, len(req.Columns), true), copyFromStdinSQL(l.dialect, req))
+		if err != nil {
+			return classifyBulkLoadError(err, source)
+		}
+		loaded = tag.RowsAffected()
+		return tx.Commit(ctx)
+	})
+	return loaded, err
+}
+
+// stmtCopyLoader 使用 lib/pq 风格驱动的 COPY 语句：Prepare 后每次 Exec 发送一行，无参数 Exec 结束导入
+type stmtCopyLoader struct {
+	dialect Dialect
+}
+
+func (l *stmtCopyLoader) BulkLoad(ctx context.Context, db *sql.DB, req BulkLoadRequest) (int64, error) {
+	source := &trackedRowSource{source: req.Rows}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := execBeforeLoad(ctx, tx, req.BeforeLoad); err != nil {
+		return 0, err
+	}
+	stmt, err := tx.PrepareContext(ctx, copyFromStdinSQL(l.dialect, req))
+	if err != nil {
+		return 0, classifyBulkLoadError(err, source)
+	}
+	defer stmt.Close()
+
+	var loaded int64
+	for {
+		row, err := source.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return 0, fmt.Errorf("failed to send copy row: %v", err)
+		}
+		loaded++
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return 0, fmt.Errorf("failed to finish copy: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit bulk load: %v", err)
+	}
+	return loaded, nil
+}
+
+func copyFromStdinSQL(dialect Dialect, req BulkLoadRequest) string {
+	return fmt.Sprintf("COPY %s (%s) FROM STDIN", dialect.QuoteTableName(req.TableName), quoteColumnList(dialect, req.Columns))
+}
+
+func execBeforeLoad(ctx context.Context, tx *sql.Tx, statements []string) error {
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to execute %s: %v", statement, err)
+		}
+	}
+	return nil
+}
+
+// classifyBulkLoadError 服务端拒绝批量导入且数据源未被读取时包装为 ErrBulkLoadUnsupported
+func classifyBulkLoadError(err error, source *trackedRowSource) error {
+	if source.consumed || !isBulkLoadRejected(err) {
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrBulkLoadUnsupported, err)
+}
+
+// isBulkLoadRejected 判断错误是否为服务端禁用或不支持批量导入
+func isBulkLoadRejected(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1148, // ER_NOT_ALLOWED_COMMAND: local_infile 未开启
+			3948, // ER_CLIENT_LOCAL_FILES_DISABLED
+			1235: // ER_NOT_SUPPORTED_YET
+			return true
+		}
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// feature_not_supported / insufficient_privilege
+		return pgErr.Code == "0A000" || pgErr.Code == "42501"
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "not supported") || strings.Contains(msg, "permission denied")
+}
+
+// trackedRowSource 记录数据源是否已被读取，已读取的数据无法再回退到 INSERT
+type trackedRowSource struct {
+	source   RowSource
+	consumed bool
+}
+
+func (t *trackedRowSource) Next() ([]interface{}, error) {
+	t.consumed = true
+	return t.source.Next()
+}
+
+// arrowRowSource 从 Arrow IPC 流中逐行读取数据
+type arrowRowSource struct {
+	reader  *ipc.Reader
+	numCols int
+	pending []interface{}
+}
+
+// NewArrowRowSource 以 Arrow IPC 读取器构建逐行数据源
+func NewArrowRowSource(reader *ipc.Reader, numCols int) RowSource {
+	return &arrowRowSource{reader: reader, numCols: numCols}
+}
+
+func (a *arrowRowSource) Next() ([]interface{}, error) {
+	for len(a.pending) == 0 {
+		if !a.reader.Next() {
+			if err := a.reader.Err(); err != nil && err != io.EOF {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		record := a.reader.Record()
+		if record == nil || record.NumRows() == 0 {
+			continue
+		}
+		values, err := (&ArrowRowExtractor{}).ExtractRowData(record)
+		if err != nil {
+			return nil, err
+		}
+		a.pending = values
+	}
+	row := a.pending[:a.numCols:a.numCols]
+	a.pending = a.pending[a.numCols:]
+	return row, nil
+}
+
+// stringRowSource 逐行读取已解析好的字符串行
+type stringRowSource struct {
+	rows  [][]string
+	index int
+}
+
+// NewStringRowSource 以字符串行构建逐行数据源
+func NewStringRowSource(rows [][]string) RowSource {
+	return &stringRowSource{rows: rows}
+}
+
+func (s *stringRowSource) Next() ([]interface{}, error) {
+	if s.index >= len(s.rows) {
+		return nil, io.EOF
+	}
+	row := make([]interface{}, len(s.rows[s.index]))
+	for i, value := range s.rows[s.index] {
+		row[i] = value
+	}
+	s.index++
+	return row, nil
+}
+
+// textLoadReader 将数据行即时编码为制表符分隔的文本，MySQL LOAD DATA 与 PostgreSQL COPY 文本格式共用
+type textLoadReader struct {
+	source   RowSource
+	numCols  int
+	hexBytes bool
+	buf      bytes.Buffer
+	err      error
+}
+
+// newTextLoadReader hexBytes 为 true 时二进制值按 bytea 的 \x 十六进制格式输出
+func newTextLoadReader(source RowSource, numCols int, hexBytes bool) *textLoadReader {
+	return &textLoadReader{source: source, numCols: numCols, hexBytes: hexBytes}
+}
+
+func (r *textLoadReader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 && r.err == nil {
+		row, err := r.source.Next()
+		if err != nil {
+			r.err = err
+			break
+		}
+		if len(row) != r.numCols {
+			r.err = fmt.Errorf("row has %d fields, expected %d", len(row), r.numCols)
+			break
+		}
+		for i, value := range row {
+			if i > 0 {
+				r.buf.WriteByte('\t')
+			}
+			r.writeValue(value)
+		}
+		r.buf.WriteByte('\n')
+	}
+	if r.buf.Len() > 0 {
+		return r.buf.Read(p)
+	}
+	return 0, r.err
+}
+
+func (r *textLoadReader) writeValue(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		r.buf.WriteString(`\N`)
+	case string:
+		writeEscapedText(&r.buf, v)
+	case []byte:
+		if r.hexBytes {
+			r.buf.WriteString(`\\x`)
+			r.buf.WriteString(fmt.Sprintf("%x", v))
+		} else {
+			writeEscapedText(&r.buf, string(v))
+		}
+	case bool:
+		if v {
+			r.buf.WriteByte('1')
+		} else {
+			r.buf.WriteByte('0')
+		}
+	case float32:
+		r.buf.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case float64:
+		r.buf.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			r.buf.WriteString(v.Format("2006-01-02"))
+		} else {
+			r.buf.WriteString(v.Format("2006-01-02 15:04:05.999999"))
+		}
+	default:
+		writeEscapedText(&r.buf, fmt.Sprint(v))
+	}
+}
+
+// writeEscapedText 转义反斜杠、制表符和换行符，避免值被当作分隔符
+func writeEscapedText(buf *bytes.Buffer, value string) {
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextLoadReaderEncoding(t *testing.T) {
+	rows := [][]interface{}{
+		{int64(1), "a\tb\nc\\d", nil},
+		{uint8(2), []byte{0x01, 0xff}, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{3.5, true, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+
+	data, err := io.ReadAll(newTextLoadReader(&sliceRowSource{rows: rows}, 3, true))
+	assert.NoError(t, err)
+	assert.Equal(t, "1\ta\\tb\\nc\\\\d\t\\N\n"+
+		"2\t\\\\x01ff\t2024-01-02\n"+
+		"3.5\t1\t2024-01-02 03:04:05\n", string(data))
+
+	// MySQL 的二进制值按原始字节转义输出
+	data, err = io.ReadAll(newTextLoadReader(&sliceRowSource{rows: [][]interface{}{{[]byte("x\ty")}}}, 1, false))
+	assert.NoError(t, err)
+	assert.Equal(t, "x\\ty\n", string(data))
+
+	// 列数不一致时返回错误
+	_, err = io.ReadAll(newTextLoadReader(&sliceRowSource{rows: [][]interface{}{{1}}}, 2, false))
+	assert.ErrorContains(t, err, "row has 1 fields, expected 2")
+}
+
+func TestBulkLoaderFor(t *testing.T) {
+	assert.IsType(t, &mysqlBulkLoader{}, BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_TIDB))
+	assert.IsType(t, &pgxCopyLoader{}, BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL))
+	assert.IsType(t, &stmtCopyLoader{}, BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE))
+	assert.Nil(t, BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS))
+	assert.Nil(t, BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG))
+}
+
+func TestMysqlBulkLoad(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `t`").WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec("LOAD DATA LOCAL INFILE 'Reader::bulk_[0-9a-f]+' INTO TABLE `t` .* \\(`id`, `name`\\)").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	loaded, err := BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL).BulkLoad(context.Background(), db, BulkLoadRequest{
+		TableName:  "t",
+		Columns:    []string{"id", "name"},
+		Rows:       NewStringRowSource([][]string{{"1", "a"}, {"2", "b"}}),
+		BeforeLoad: []string{"DELETE FROM `t`"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), loaded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMysqlBulkLoadRejected(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("LOAD DATA LOCAL INFILE").
+		WillReturnError(&mysql.MySQLError{Number: 3948, Message: "Loading local data is disabled"})
+	mock.ExpectRollback()
+
+	_, err = BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL).BulkLoad(context.Background(), db, BulkLoadRequest{
+		TableName: "t",
+		Columns:   []string{"id"},
+		Rows:      NewStringRowSource([][]string{{"1"}}),
+	})
+	assert.True(t, errors.Is(err, ErrBulkLoadUnsupported))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStmtCopyLoad(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	prepare := mock.ExpectPrepare(`COPY "t" \("id", "name"\) FROM STDIN`)
+	prepare.ExpectExec().WithArgs("1", "a").WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare.ExpectExec().WithArgs("2", "b").WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare.ExpectExec().WithoutArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	loaded, err := BulkLoaderFor(ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE).BulkLoad(context.Background(), db, BulkLoadRequest{
+		TableName: "t",
+		Columns:   []string{"id", "name"},
+		Rows:      NewStringRowSource([][]string{{"1", "a"}, {"2", "b"}}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), loaded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInsertArrowDataFallsBackToInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("LOAD DATA LOCAL INFILE").
+		WillReturnError(&mysql.MySQLError{Number: 1148, Message: "The used command is not allowed with this MySQL version"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `t` \\(`id`\\) VALUES \\(\\?\\), \\(\\?\\)").WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	schema, reader := newInt64Reader(t, "id", []int64{1, 2})
+	defer reader.Release()
+	err = InsertArrowDataInBatches(context.Background(), db, "t", schema, reader, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, WriteOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// sliceRowSource 按顺序返回给定的行
+type sliceRowSource struct {
+	rows [][]interface{}
+}
+
+func (s *sliceRowSource) Next() ([]interface{}, error) {
+	if len(s.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := s.rows[0]
+	s.rows = s.rows[1:]
+	return row, nil
+}
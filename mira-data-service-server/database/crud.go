@@ -32,13 +32,48 @@ import (
 	"github.com/google/uuid"
 )
 
-func InsertArrowDataInBatches(db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader, dbType pb.DataSourceType) error {
+// InsertArrowDataInBatches 按写入模式将 Arrow 数据写入目标表，覆盖模式的清表与写入在同一事务内
+func InsertArrowDataInBatches(ctx context.Context, db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader,
+	dbType pb.DataSourceType, opts WriteOptions) error {
+	columns := make([]string, len(schema.Fields()))
+	for i, field := range schema.Fields() {
+		columns[i] = field.Name
+	}
+	if err := opts.Validate(dbType, columns); err != nil {
+		return err
+	}
+	if err := EnsureWriteKey(ctx, db, dbType, tableName, opts); err != nil {
+		return err
+	}
+
+	// 追加和覆盖模式优先使用原生批量导入，服务端不允许时回退到 INSERT
+	if loader := BulkLoaderFor(dbType); loader != nil && !opts.needsKey() {
+		req := BulkLoadRequest{TableName: tableName, Columns: columns, Rows: NewArrowRowSource(ipcReader, len(columns))}
+		if opts.Mode == pb.WriteMode_WRITE_MODE_OVERWRITE {
+			req.BeforeLoad = []string{DialectFor(dbType).ClearTableSQL(tableName)}
+		}
+		loaded, err := loader.BulkLoad(ctx, db, req)
+		if err == nil {
+			log.Logger.Infof("Data bulk loaded successfully into %s, total row count: %d", tableName, loaded)
+			return nil
+		}
+		if !errors.Is(err, ErrBulkLoadUnsupported) {
+			return fmt.Errorf("bulk load failed: %v", err)
+		}
+		log.Logger.Warnf("Bulk load rejected for table %s, falling back to INSERT: %v", tableName, err)
+	}
+
 	for i := 0; i < common.MAX_RETRY_COUNT; i++ {
-		err := performBatchInsert(db, tableName, schema, ipcReader, dbType)
+		err := performBatchInsert(ctx, db, tableName, schema, ipcReader, dbType, opts)
 		if err == nil {
 			return nil // 插入成功
 		}
 
+		// 请求已取消或超时，不再重试
+		if ctx.Err() != nil {
+			return fmt.Errorf("batch insert aborted: %v", ctx.Err())
+		}
+
 		// 如果是死锁错误则重试
 		if isWriteConflictError(err) {
 			log.Logger.Infof("Retrying batch insert due to write conflict error: %v", err)
@@ -53,8 +88,8 @@ func InsertArrowDataInBatches(db *sql.DB, tableName string, schema *arrow.Schema
 }
 
 // 处理批量插入数据
-func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema *arrow.Schema,
-	tableName string, dbType pb.DataSourceType) ([]interface{}, int64, int64, error) {
+func processBatch(ctx context.Context, tx *sql.Tx, args []interface{}, currentRowCount int64, schema *arrow.Schema,
+	tableName string, dbType pb.DataSourceType, opts WriteOptions) ([]interface{}, int64, int64, error) {
 	var totalProcessedRows int64 = 0
 	sqlGenerator := &SQLGenerator{}
 
@@ -65,7 +100,7 @@ func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema
 		batchToInsert := args[:completeRows]
 		remainingArgs := args[completeRows:]
 
-		insertSQL, err := sqlGenerator.GenerateInsertSQL(tableName, batchToInsert, schema, dbType)
+		insertSQL, err := sqlGenerator.GenerateWriteSQL(tableName, batchToInsert, schema, dbType, opts)
 		log.Logger.Debugf("Get InsertSQL: %s", insertSQL)
 		log.Logger.Debugf("Get InsertBatch: %+v", batchToInsert)
 		if err != nil {
@@ -73,7 +108,7 @@ func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema
 			return nil, 0, 0, fmt.Errorf("failed to generate insert SQL: %v", err)
 		}
 
-		if _, err := tx.Exec(insertSQL, batchToInsert...); err != nil {
+		if _, err := tx.ExecContext(ctx, insertSQL, batchToInsert...); err != nil {
 			log.Logger.Errorf("Failed to execute batch insert to table %s: %v", tableName, err)
 			return nil, 0, 0, fmt.Errorf("failed to execute batch insert: %v", err)
 		}
@@ -86,7 +121,7 @@ func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema
 
 	// 处理剩余的参数
 	if len(args) > 0 {
-		insertSQL, err := sqlGenerator.GenerateInsertSQL(tableName, args, schema, dbType)
+		insertSQL, err := sqlGenerator.GenerateWriteSQL(tableName, args, schema, dbType, opts)
 		log.Logger.Debugf("Get InsertSQL: %s", insertSQL)
 		log.Logger.Debugf("Get InsertBatch: %+v", args)
 		if err != nil {
@@ -94,7 +129,7 @@ func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema
 			return nil, 0, 0, fmt.Errorf("failed to generate insert SQL: %v", err)
 		}
 
-		if _, err := tx.Exec(insertSQL, args...); err != nil {
+		if _, err := tx.ExecContext(ctx, insertSQL, args...); err != nil {
 			log.Logger.Errorf("Failed to execute batch insert to table %s: %v", tableName, err)
 			return nil, 0, 0, fmt.Errorf("failed to execute batch insert: %v", err)
 		}
@@ -108,14 +143,24 @@ func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema
 }
 
 // 执行批量插入的逻辑
-func performBatchInsert(db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader, dbType pb.DataSourceType) error {
-	tx, err := db.Begin()
+func performBatchInsert(ctx context.Context, db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader,
+	dbType pb.DataSourceType, opts WriteOptions) error {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		log.Logger.Errorf("Failed to begin transaction: %v", err)
 		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
 	defer tx.Rollback() // 确保事务失败时回滚
 
+	// 覆盖模式先清空目标表
+	if opts.Mode == pb.WriteMode_WRITE_MODE_OVERWRITE {
+		clearSQL := DialectFor(dbType).ClearTableSQL(tableName)
+		log.Logger.Infof("Clearing table before overwrite: %s", clearSQL)
+		if _, err := tx.ExecContext(ctx, clearSQL); err != nil {
+			return fmt.Errorf("failed to clear table %s: %v", tableName, err)
+		}
+	}
+
 	argsBatch := []interface{}{}
 	rowCount := int64(0)
 	totalRowCount := int64(0)
@@ -142,7 +187,7 @@ func performBatchInsert(db *sql.DB, tableName string, schema *arrow.Schema, ipcR
 		// 当达到批次大小时处理数据
 		if rowCount >= int64(common.BATCH_DATA_SIZE) {
 			var processedCount int64
-			argsBatch, rowCount, processedCount, err = processBatch(tx, argsBatch, rowCount, schema, tableName, dbType)
+			argsBatch, rowCount, processedCount, err = processBatch(ctx, tx, argsBatch, rowCount, schema, tableName, dbType, opts)
 			if err != nil {
 				return err
 			}
@@ -153,7 +198,7 @@ func performBatchInsert(db *sql.DB, tableName string, schema *arrow.Schema, ipcR
 	// 处理最后一批数据
 	if rowCount > 0 || len(argsBatch) > 0 {
 		var processedCount int64
-		_, _, processedCount, err = processBatch(tx, argsBatch, rowCount, schema, tableName, dbType)
+		_, _, processedCount, err = processBatch(ctx, tx, argsBatch, rowCount, schema, tableName, dbType, opts)
 		if err != nil {
 			return err
 		}
@@ -289,6 +334,7 @@ func StartAutoProfile() {
 
 func Init() error {
 	StartAutoProfile()
+	StartPoolJanitor()
 	conf := config.GetConfigMap()
 	dbType := utils.ConvertDBType(conf.Dbms.Type)
 	connInfo := &pb.ConnectionInfo{Host: conf.Dbms.Host,
@@ -308,7 +354,7 @@ func Init() error {
 		return fmt.Errorf("failed to connect to database: %v", err)
 	}
 	// 检查数据库是否存在，如不存在则创建
-	if err := dbStrategy.EnsureDatabaseExists(connInfo.DbName); err != nil {
+	if err := dbStrategy.EnsureDatabaseExists(context.Background(), connInfo.DbName); err != nil {
 		log.Logger.Errorf("Failed to ensure database exists: %v", err)
 		return fmt.Errorf("failed to ensure database exists: %v", err)
 	}
@@ -528,7 +574,9 @@ func AddIndexToFilterName(db *sql.DB, dbType pb.DataSourceType, dbName, tableNam
 
 	// 没有索引则创建
 	indexName := generateIndexName()
-	sqlStatement := fmt.Sprintf("CREATE INDEX %s ON %s (%s);", indexName, tableName, columnName)
+	dialect := DialectFor(dbType)
+	sqlStatement := fmt.Sprintf("CREATE INDEX %s ON %s (%s);", dialect.QuoteIdentifier(indexName),
+		dialect.QuoteTableName(tableName), dialect.QuoteIdentifier(columnName))
 	log.Logger.Infof("Attempting to create index: %s", sqlStatement)
 	_, err = db.Exec(sqlStatement)
 	_, err = db.Exec(sqlStatement)
@@ -0,0 +1,620 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/10/27
+	@note: 连接达梦 DM8（Oracle 兼容语法）
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/common"
+	"data-service/config"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+	"database/sql"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	_ "gitee.com/chunanyong/dm"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+)
+
+var (
+	damengMutex sync.Mutex
+)
+
+// DamengStrategy 访问达梦 DM8 数据源
+// 达梦中一个实例即一个库，ConnectionInfo.DbName 对应模式（schema）；SQL 按 Oracle 方言生成：
+// 标识符使用双引号、占位符使用 ?、分页使用 ROWNUM
+type DamengStrategy struct {
+	info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
+}
+
+func NewDamengStrategy(info *ds.ConnectionInfo) *DamengStrategy {
+	return &DamengStrategy{
+		info: info,
+	}
+}
+
+func (d *DamengStrategy) ConnectToDB() error {
+	return d.ConnectToDBWithPass(d.info)
+}
+
+func (d *DamengStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
+	key := PoolFingerprint("dameng", info)
+
+	damengMutex.Lock()
+	defer damengMutex.Unlock()
+
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		d.lease.Release()
+		d.DB, d.lease = db, lease
+		log.Logger.Debugf("Reusing existing Dameng connection pool")
+		return nil
+	}
+
+	dsn, err := buildDamengDSN(info, info.TlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build TLS DSN: %v", err)
+	}
+
+	conf := config.GetConfigMap()
+	db, err := sql.Open("dm", dsn)
+	if err != nil {
+		log.Logger.Errorf("Failed to connect to Dameng: %v", err)
+		return fmt.Errorf("failed to connect to Dameng: %v", err)
+	}
+
+	db.SetMaxOpenConns(conf.Dbms.MaxOpenConns)
+	db.SetMaxIdleConns(conf.Dbms.MaxIdleConns)
+	db.SetConnMaxLifetime(10 * time.Minute) // 连接最大生命周期
+	db.SetConnMaxIdleTime(2 * time.Minute)  // 连接最大空闲时间
+
+	d.lease.Release()
+	d.DB, d.lease = GetPoolRegistry().Register(key, "dameng", db)
+	log.Logger.Infof("Successfully connected to Dameng")
+	return nil
+}
+
+// buildDamengDSN 构建 dm://user:password@host:port?schema=xxx 形式的 DSN
+// 用户名和密码由 url.UserPassword 转义，避免包含 @、: 等字符时解析错误
+func buildDamengDSN(info *ds.ConnectionInfo, tlsConfig *ds.DatasourceTlsConfig) (string, error) {
+	params := url.Values{}
+	if info.DbName != "" {
+		params.Set("schema", info.DbName)
+	}
+	// CLOB 默认以 *DmClob 返回，直接转为字符串便于写入 Arrow
+	params.Set("clobAsString", "true")
+
+	if tlsConfig != nil && tlsConfig.UseTls == 2 {
+		// 达梦的 SSL 为双向认证，驱动只接受客户端证书和私钥文件路径，且不校验服务端证书
+		if tlsConfig.ClientCert == "" || tlsConfig.ClientKey == "" {
+			return "", fmt.Errorf("dameng TLS requires client certificate and key")
+		}
+		if tlsConfig.Mode >= 2 {
+			log.Logger.Warnf("Dameng driver does not verify the server certificate, TLS mode %d is downgraded to encryption only", tlsConfig.Mode)
+		}
+		certFile, err := writeCertToTempFileFromBase64(tlsConfig.ClientCert, "dameng-client-cert")
+		if err != nil {
+			return "", fmt.Errorf("failed to write client cert: %v", err)
+		}
+		keyFile, err := writeCertToTempFileFromBase64(tlsConfig.ClientKey, "dameng-client-key")
+		if err != nil {
+			return "", fmt.Errorf("failed to write client key: %v", err)
+		}
+		params.Set("sslCertPath", certFile)
+		params.Set("sslKeyPath", keyFile)
+	}
+
+	dsn := url.URL{
+		Scheme:   "dm",
+		User:     url.UserPassword(info.User, info.Password),
+		Host:     net.JoinHostPort(info.Host, strconv.Itoa(int(info.Port))),
+		RawQuery: params.Encode(),
+	}
+	return dsn.String(), nil
+}
+
+func (d *DamengStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+	log.Logger.Debugf("Dameng query: %s args=%v", sqlQuery, args)
+	rows, err := d.DB.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		log.Logger.Errorf("Dameng query failed: %v", err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
+func (d *DamengStrategy) Close() error {
+	d.lease.Release()
+	d.DB, d.lease = nil, nil
+	return nil
+}
+
+func (d *DamengStrategy) GetJdbcUrl() string {
+	return fmt.Sprintf("jdbc:dm://%s:%d?schema=%s&user=%s&password=%s",
+		d.info.Host, d.info.Port, d.info.DbName, d.info.User, d.info.Password)
+}
+
+func (d *DamengStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
+	if rows == nil {
+		return nil, fmt.Errorf("no rows to convert")
+	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+	pool := memory.NewGoAllocator()
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, io.EOF
+	}
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %v", err)
+	}
+
+	var fields []arrow.Field
+	valuePtrs := make([]interface{}, len(cols))
+	for i, col := range cols {
+		log.Logger.Debugf("SQL type for column %s: %s", col, colTypes[i].DatabaseTypeName())
+		arrowType := ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG, colTypes[i])
+		fields = append(fields, arrow.Field{Name: col, Type: arrowType})
+		valuePtrs[i] = damengScanTarget(strings.ToUpper(colTypes[i].DatabaseTypeName()), arrowType)
+	}
+	schema := arrow.NewSchema(fields, nil)
+	builder := array.NewRecordBuilder(pool, schema)
+	defer builder.Release()
+
+	rowCount := 0
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		for i, ptr := range valuePtrs {
+			if err := utils.AppendValueToBuilder(builder.Field(i), damengScannedValue(ptr)); err != nil {
+				log.Logger.Errorf("Failed to append value for column %d: %v", i, err)
+				return nil, err
+			}
+		}
+		rowCount++
+		if rowCount >= batchSize {
+			break // 达到批次大小，结束循环，发送给客户端
+		}
+	}
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+	if rowCount == 0 {
+		return nil, io.EOF
+	}
+	return builder.NewRecord(), nil
+}
+
+// damengScanTarget 按列类型选择扫描目标
+// 驱动对 NUMBER 返回 *DmDecimal 等自定义类型，统一经 database/sql 转换为基础类型后再写入 Arrow
+func damengScanTarget(typeName string, arrowType arrow.DataType) interface{} {
+	switch arrowType.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64:
+		return new(sql.NullInt64)
+	case arrow.FLOAT32, arrow.FLOAT64:
+		return new(sql.NullFloat64)
+	case arrow.BOOL:
+		return new(sql.NullBool)
+	}
+	if isDamengDateTimeType(typeName) {
+		return new(sql.NullTime)
+	}
+	return new(sql.NullString)
+}
+
+// damengScannedValue 取出扫描结果，NULL 返回 nil
+func damengScannedValue(target interface{}) interface{} {
+	switch v := target.(type) {
+	case *sql.NullInt64:
+		if v.Valid {
+			return v.Int64
+		}
+	case *sql.NullFloat64:
+		if v.Valid {
+			return v.Float64
+		}
+	case *sql.NullBool:
+		if v.Valid {
+			return v.Bool
+		}
+	case *sql.NullTime:
+		if v.Valid {
+			return v.Time
+		}
+	case *sql.NullString:
+		if v.Valid {
+			return v.String
+		}
+	}
+	return nil
+}
+
+// isDamengDateTimeType 判断是否为日期时间类型，TIME/INTERVAL 仍按字符串读取
+func isDamengDateTimeType(typeName string) bool {
+	return typeName == "DATE" || strings.HasPrefix(typeName, "TIMESTAMP") || strings.HasPrefix(typeName, "DATETIME")
+}
+
+func (d *DamengStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
+	// Oracle 方言不支持 CREATE TABLE IF NOT EXISTS，先检查表是否存在
+	exists, err := d.CheckTableExists(ctx, tableName)
+	if err != nil {
+		return fmt.Errorf("failed to check Dameng table: %v", err)
+	}
+	if exists {
+		log.Logger.Infof("Table already exists: %s", tableName)
+		return nil
+	}
+
+	createTableSQL := buildCreateDamengTableSQL(tableName, schema)
+	log.Logger.Infof("createTableSQL: %s", createTableSQL)
+	if _, err := d.DB.ExecContext(ctx, createTableSQL); err != nil {
+		return fmt.Errorf("failed to create Dameng table: %v", err)
+	}
+	log.Logger.Infof("Created table: %s", tableName)
+	return nil
+}
+
+// buildCreateDamengTableSQL 根据 Arrow Schema 生成创建表的 SQL 语句
+func buildCreateDamengTableSQL(tableName string, schema *arrow.Schema) string {
+	return buildCreateTableSQL(damengDialect, tableName, schema, false)
+}
+
+// splitDamengTableName 拆分 schema.table 形式的表名，未指定 schema 时返回空字符串
+func splitDamengTableName(tableName string) (string, string) {
+	parts := strings.SplitN(tableName, ".", 2)
+	if len(parts) == 2 {
+		return strings.Trim(parts[0], "\""), strings.Trim(parts[1], "\"")
+	}
+	return "", strings.Trim(tableName, "\"")
+}
+
+// damengLimitQuery 使用 ROWNUM 限制返回行数
+// 外层包一层子查询，保证带 ORDER BY 的查询先排序再截取，兼容 DM7 及 Oracle 11g
+func damengLimitQuery(query string, limit int) string {
+	return fmt.Sprintf("SELECT * FROM (%s) WHERE ROWNUM <= %d", query, limit)
+}
+
+// damengOwnerCondition 构建数据字典按模式过滤的条件，未指定模式时使用当前会话的模式
+func damengOwnerCondition(owner string) (string, []interface{}) {
+	if owner == "" {
+		return "OWNER = SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA')", nil
+	}
+	return "OWNER = ?", []interface{}{owner}
+}
+
+// resolveOwner 优先使用表名中的模式，其次使用传入的库名
+func (d *DamengStrategy) resolveOwner(database string, tableName string) (string, string) {
+	owner, table := splitDamengTableName(tableName)
+	if owner == "" {
+		owner = database
+	}
+	return owner, table
+}
+
+func (d *DamengStrategy) GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+	// database 即达梦的模式名，表名中显式指定模式时以表名为准
+	owner, table := d.resolveOwner(database, tableName)
+
+	var result TableInfoResponse
+
+	if isExactQuery {
+		// 精确查询时，只查询记录数
+		sqlQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", damengDialect.QuoteTableName(tableName))
+		log.Logger.Infof("Get table info executing query: %s with parameters: database=%s, tableName=%s", sqlQuery, database, tableName)
+
+		var rowCount int64
+		if err := d.DB.QueryRowContext(ctx, sqlQuery).Scan(&rowCount); err != nil {
+			log.Logger.Errorf("Error executing query: %v", err)
+			return nil, fmt.Errorf("error executing query: %v", err)
+		}
+		result.TableSchema = owner
+		result.TableName = tableName // 返回表名
+		result.TableRows = clampPostgresRowCount(rowCount)
+		result.TableSize = 0 // 精确查询不关心表的大小
+	} else {
+		// 检查是否启用估算模式
+		if ShouldUseEstimationOnly() {
+			// 直接使用估算方式，跳过 ALL_TABLES 查询
+			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping ALL_TABLES query")
+			FillTableInfoFromEstimation(ctx, d, database, tableName, owner, &result)
+		} else {
+			// 普通查询，先尝试从 ALL_TABLES 统计信息获取
+			rowCount, size, err := d.getAllTablesStats(ctx, owner, table)
+			result.TableSchema = owner
+			result.TableName = tableName
+			result.TableRows = clampPostgresRowCount(rowCount)
+			result.TableSize = size
+			if err != nil || result.TableRows == 0 {
+				// 未收集过统计信息时 NUM_ROWS 为空，使用估算方式
+				LogQueryFailure("ALL_TABLES", err, result.TableName, result.TableRows)
+				FillTableInfoFromEstimation(ctx, d, database, tableName, owner, &result)
+			}
+		}
+	}
+
+	// 获取表结构信息
+	columns, keys, err := d.DescribeTable(ctx, database, tableName)
+	if err != nil {
+		log.Logger.Warnf("Failed to load Dameng schema: %v", err)
+	}
+
+	log.Logger.Infof("Table info: %+v", result)
+
+	return &ds.TableInfoResponse{
+		TableName:   result.TableName,
+		RecordCount: result.TableRows,
+		RecordSize:  result.TableSize,
+		Columns:     columns,
+		Keys:        keys,
+	}, nil
+}
+
+// getAllTablesStats 从 ALL_TABLES 获取表的行数和大小
+// NUM_ROWS/AVG_ROW_LEN 由 DBMS_STATS 收集，未收集时为 NULL，按 0 返回
+func (d *DamengStrategy) getAllTablesStats(ctx context.Context, owner, tableName string) (int64, int64, error) {
+	ownerCondition, args := damengOwnerCondition(owner)
+	query := fmt.Sprintf("SELECT NVL(NUM_ROWS, 0), NVL(AVG_ROW_LEN, 0) FROM ALL_TABLES WHERE %s AND TABLE_NAME = ?", ownerCondition)
+	args = append(args, tableName)
+
+	var numRows, avgRowLen int64
+	if err := d.DB.QueryRowContext(ctx, query, args...).Scan(&numRows, &avgRowLen); err != nil {
+		return 0, 0, err
+	}
+	return numRows, numRows * avgRowLen, nil
+}
+
+// DescribeTable 获取字段元数据与表键信息，database 为模式名，表名中显式指定模式时以表名为准
+func (d *DamengStrategy) DescribeTable(ctx context.Context, database, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	owner, table := d.resolveOwner(database, tableName)
+	columns, err := d.getTableSchema(ctx, owner, table)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := d.getTableKeys(ctx, owner, table)
+	if err != nil {
+		return columns, nil, err
+	}
+	return columns, keys, nil
+}
+
+// getTableSchema 从 ALL_TAB_COLUMNS 获取表结构信息，NUMBER 等类型带上精度和标度，注释取自 ALL_COL_COMMENTS
+func (d *DamengStrategy) getTableSchema(ctx context.Context, owner, tableName string) ([]*ds.ColumnItem, error) {
+	ownerCondition, args := damengOwnerCondition(owner)
+	query := fmt.Sprintf(`
+		SELECT c.COLUMN_NAME, c.DATA_TYPE, c.CHAR_LENGTH, c.DATA_PRECISION, c.DATA_SCALE, c.NULLABLE, c.DATA_DEFAULT, m.COMMENTS
+		FROM ALL_TAB_COLUMNS c
+		LEFT JOIN ALL_COL_COMMENTS m ON m.OWNER = c.OWNER AND m.TABLE_NAME = c.TABLE_NAME AND m.COLUMN_NAME = c.COLUMN_NAME
+		WHERE c.%s AND c.TABLE_NAME = ?
+		ORDER BY c.COLUMN_ID`, ownerCondition)
+	args = append(args, tableName)
+
+	rows, err := d.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []*ds.ColumnItem
+	for rows.Next() {
+		var name, dataType string
+		var length, precision, scale sql.NullInt64
+		var nullable, defaultValue, comment sql.NullString
+		if err := rows.Scan(&name, &dataType, &length, &precision, &scale, &nullable, &defaultValue, &comment); err != nil {
+			return nil, err
+		}
+		// 数值类型的 CHAR_LENGTH 为 0
+		columns = append(columns, newColumnItem(name, damengColumnType(dataType, precision, scale), length, precision, scale,
+			nullable, defaultValue, comment, false))
+	}
+	return columns, rows.Err()
+}
+
+// getTableKeys 从 ALL_CONSTRAINTS 获取主键、唯一键与外键，从 ALL_INDEXES 获取非唯一索引
+func (d *DamengStrategy) getTableKeys(ctx context.Context, owner, tableName string) ([]*ds.TableKey, error) {
+	ownerCondition, args := damengOwnerCondition(owner)
+	args = append(args, tableName)
+	return queryTableKeys(ctx, d.DB,
+		keyQuery{query: fmt.Sprintf(`
+			SELECT k.CONSTRAINT_NAME,
+				CASE k.CONSTRAINT_TYPE WHEN 'P' THEN 'PRIMARY' WHEN 'U' THEN 'UNIQUE' ELSE 'FOREIGN' END,
+				c.COLUMN_NAME
+			FROM ALL_CONSTRAINTS k
+			JOIN ALL_CONS_COLUMNS c ON c.OWNER = k.OWNER AND c.CONSTRAINT_NAME = k.CONSTRAINT_NAME
+			WHERE k.CONSTRAINT_TYPE IN ('P', 'U', 'R') AND k.%s AND k.TABLE_NAME = ?
+			ORDER BY k.CONSTRAINT_TYPE, k.CONSTRAINT_NAME, c.POSITION`, ownerCondition), args: args},
+		keyQuery{query: fmt.Sprintf(`
+			SELECT i.INDEX_NAME, 'INDEX', c.COLUMN_NAME
+			FROM ALL_INDEXES i
+			JOIN ALL_IND_COLUMNS c ON c.INDEX_OWNER = i.OWNER AND c.INDEX_NAME = i.INDEX_NAME
+			WHERE i.UNIQUENESS = 'NONUNIQUE' AND i.%s AND i.TABLE_NAME = ?
+			ORDER BY i.INDEX_NAME, c.COLUMN_POSITION`, ownerCondition), args: args},
+	)
+}
+
+// damengColumnType 为数值类型拼接精度和标度，如 NUMBER(10,2)
+func damengColumnType(dataType string, precision, scale sql.NullInt64) string {
+	switch strings.ToUpper(dataType) {
+	case "NUMBER", "NUMERIC", "DECIMAL", "DEC":
+		if !precision.Valid || precision.Int64 <= 0 {
+			return dataType
+		}
+		if scale.Valid {
+			return fmt.Sprintf("%s(%d,%d)", dataType, precision.Int64, scale.Int64)
+		}
+		return fmt.Sprintf("%s(%d)", dataType, precision.Int64)
+	default:
+		return dataType
+	}
+}
+
+func (d *DamengStrategy) BuildWithConditionQuery(
+	tableName string,
+	fields []string,
+	filterNames []string,
+	filterOperators []ds.FilterOperator,
+	filterValues []*ds.FilterValue,
+	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
+) (string, []interface{}, error) {
+	return buildConditionQuery(damengDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, nil)
+}
+
+func (d *DamengStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	// 达梦以模式区分业务数据，SYSOBJECTS 中 TYPE$ = 'SCH' 的记录即为模式
+	var count int
+	if err := d.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM SYSOBJECTS WHERE TYPE$ = 'SCH' AND NAME = ?", dbName).Scan(&count); err != nil {
+		return fmt.Errorf("failed to check Dameng schema '%s': %v", dbName, err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	createSQL := fmt.Sprintf("CREATE SCHEMA %s", damengDialect.QuoteIdentifier(dbName))
+	if _, err := d.DB.ExecContext(ctx, createSQL); err != nil {
+		return fmt.Errorf("failed to create Dameng schema '%s': %v", dbName, err)
+	}
+	log.Logger.Infof("Created Dameng schema '%s'", dbName)
+	return nil
+}
+
+func (d *DamengStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
+	owner, table := d.resolveOwner(d.info.GetDbName(), tableName)
+	ownerCondition, args := damengOwnerCondition(owner)
+	args = append(args, table)
+
+	var count int
+	query := fmt.Sprintf("SELECT COUNT(*) FROM ALL_TABLES WHERE %s AND TABLE_NAME = ?", ownerCondition)
+	if err := d.DB.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (d *DamengStrategy) CleanupOldTables(ctx context.Context, schema string, retentionDays int) error {
+	rows, err := d.DB.QueryContext(ctx, "SELECT TABLE_NAME FROM ALL_TABLES WHERE OWNER = ? AND TABLE_NAME LIKE '20%'", schema)
+	if err != nil {
+		return fmt.Errorf("failed to query tables: %v", err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -retentionDays).Format("20060102")
+	var expiredTables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan table name: %v", err)
+		}
+		if len(table) >= 8 && table[:8] < cutoff {
+			expiredTables = append(expiredTables, table)
+		}
+	}
+	rows.Close()
+
+	for _, table := range expiredTables {
+		dropSQL := fmt.Sprintf("DROP TABLE %s.%s", damengDialect.QuoteIdentifier(schema), damengDialect.QuoteIdentifier(table))
+		if _, err := d.DB.ExecContext(ctx, dropSQL); err != nil {
+			log.Logger.Warnf("Failed to drop Dameng table %s.%s: %v", schema, table, err)
+		} else {
+			log.Logger.Infof("Dropped table '%s.%s'", schema, table)
+		}
+	}
+	return nil
+}
+
+func (d *DamengStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
+	queryTools := &QueryBuilder{}
+	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators, filterValues, filterExpr, ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build group count query: %v", err)
+	}
+
+	log.Logger.Debugf("Executing group count query: %s with args: %v", query, args)
+	rows, err := d.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute group count query: %v", err)
+	}
+	defer rows.Close()
+
+	return ProcessGroupCountResults(rows, tableName)
+}
+
+// GetTableRowCount 实现 TableInfoEstimator 接口
+func (d *DamengStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", damengDialect.QuoteTableName(tableName))
+	var rowCount int64
+	if err := d.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
+		return 0, err
+	}
+	return clampPostgresRowCount(rowCount), nil
+}
+
+// EstimateTableSize 实现 TableInfoEstimator 接口，通过采样数据估算表的大小
+func (d *DamengStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
+	// 获取100条数据估算平均行大小
+	sampleQuery := damengDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", damengDialect.QuoteTableName(tableName)), 100, 0)
+	rows, err := d.DB.QueryContext(ctx, sampleQuery)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
+	}
+	defer rows.Close()
+
+	// 获取列信息
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get columns: %v", err)
+	}
+
+	// 计算样本数据的总大小
+	var totalSampleSize int64
+	rowCount := 0
+	values := make([]interface{}, len(columns))
+	valuePtrs := make([]interface{}, len(columns))
+	for i := range valuePtrs {
+		valuePtrs[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			log.Logger.Errorf("Failed to scan sample row: %v", err)
+			continue
+		}
+
+		// 估算每行的大小
+		totalSampleSize += common.EstimateRowSize(values)
+		rowCount++
+	}
+
+	// 根据样本数据估算总大小
+	if rowCount > 0 {
+		avgRowSize := totalSampleSize / int64(rowCount)
+		estimatedSize := avgRowSize * int64(totalRows)
+		log.Logger.Infof("Estimated table size: %d bytes (avg row size: %d, total rows: %d)",
+			estimatedSize, avgRowSize, totalRows)
+		return estimatedSize, nil
+	}
+
+	return 0, fmt.Errorf("no sample data available for size estimation")
+}
@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWithConditionQueryDameng(t *testing.T) {
+	testCases := []struct {
+		name            string
+		tableName       string
+		fields          []string
+		filterNames     []string
+		filterOperators []ds.FilterOperator
+		filterValues    []*ds.FilterValue
+		sortRules       []*ds.SortRule
+		expectedQuery   string
+		expectedArgs    []interface{}
+	}{
+		{
+			name:            "Test schema qualified table with ORDER BY",
+			tableName:       "SALES.ORDERS",
+			fields:          []string{"ID", "`NAME`"},
+			filterNames:     []string{"AGE"},
+			filterOperators: []ds.FilterOperator{ds.FilterOperator_GREATER_THAN},
+			filterValues:    []*ds.FilterValue{{IntValue: 30}},
+			sortRules: []*ds.SortRule{
+				{FieldName: "NAME", SortOrder: ds.SortOrder_DESC},
+			},
+			expectedQuery: `SELECT "ID", "NAME" FROM "SALES"."ORDERS" WHERE "AGE" > ? ORDER BY "NAME" DESC`,
+			expectedArgs:  []interface{}{int32(30)},
+		},
+		{
+			name:            "Test IN and LIKE Operators",
+			tableName:       "USERS",
+			filterNames:     []string{"STATUS", "EMAIL"},
+			filterOperators: []ds.FilterOperator{ds.FilterOperator_IN_OPERATOR, ds.FilterOperator_LIKE_OPERATOR},
+			filterValues: []*ds.FilterValue{
+				{StrValues: []string{"active", "locked"}},
+				{StrValue: "%@example.com"},
+			},
+			expectedQuery: `SELECT * FROM "USERS" WHERE "STATUS" IN (?, ?) AND "EMAIL" LIKE ?`,
+			expectedArgs:  []interface{}{"active", "locked", "%@example.com"},
+		},
+		{
+			name:          "Test identifier with quote",
+			tableName:     `odd"table`,
+			expectedQuery: `SELECT * FROM "odd""table"`,
+			expectedArgs:  []interface{}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy := NewDamengStrategy(&ds.ConnectionInfo{Dbtype: int32(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG)})
+			query, args, err := strategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules, nil)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if query != tc.expectedQuery {
+				t.Errorf("Expected query: %s, got: %s", tc.expectedQuery, query)
+			}
+
+			if !reflect.DeepEqual(args, tc.expectedArgs) {
+				t.Errorf("Expected args: %v, got: %v", tc.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestBuildDamengDSN(t *testing.T) {
+	info := &ds.ConnectionInfo{
+		Host:     "127.0.0.1",
+		Port:     5236,
+		User:     "SYSDBA",
+		Password: "p@ss:word",
+		DbName:   "SALES",
+	}
+
+	dsn, err := buildDamengDSN(info, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "dm://SYSDBA:p%40ss%3Aword@127.0.0.1:5236?clobAsString=true&schema=SALES", dsn)
+
+	_, err = buildDamengDSN(info, &ds.DatasourceTlsConfig{UseTls: 2, Mode: 1})
+	assert.Error(t, err)
+}
+
+func TestDamengLimitQuery(t *testing.T) {
+	assert.Equal(t, `SELECT * FROM (SELECT * FROM "T" ORDER BY "ID") WHERE ROWNUM <= 100`,
+		damengLimitQuery(`SELECT * FROM "T" ORDER BY "ID"`, 100))
+}
+
+func TestDamengGetAllTablesStatsAndSchema(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("FROM ALL_TABLES WHERE OWNER = \\? AND TABLE_NAME = \\?").
+		WithArgs("SALES", "ORDERS").
+		WillReturnRows(sqlmock.NewRows([]string{"NUM_ROWS", "AVG_ROW_LEN"}).AddRow(int64(1000), int64(64)))
+	mock.ExpectQuery("FROM ALL_TAB_COLUMNS").
+		WithArgs("SALES", "ORDERS").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE", "CHAR_LENGTH", "DATA_PRECISION", "DATA_SCALE",
+			"NULLABLE", "DATA_DEFAULT", "COMMENTS"}).
+			AddRow("ID", "NUMBER", int64(0), int64(10), int64(0), "N", nil, "主键").
+			AddRow("AMOUNT", "NUMBER", int64(0), nil, nil, "Y", "0", nil).
+			AddRow("NOTE", "CLOB", nil, nil, nil, "Y", nil, nil))
+	mock.ExpectQuery("FROM ALL_TABLES WHERE OWNER = SYS_CONTEXT\\('USERENV', 'CURRENT_SCHEMA'\\) AND TABLE_NAME = \\?").
+		WithArgs("ORDERS").
+		WillReturnRows(sqlmock.NewRows([]string{"NUM_ROWS", "AVG_ROW_LEN"}).AddRow(int64(0), int64(0)))
+
+	strategy := &DamengStrategy{DB: db}
+	rows, size, err := strategy.getAllTablesStats(context.Background(), "SALES", "ORDERS")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), rows)
+	assert.Equal(t, int64(64000), size)
+
+	columns, err := strategy.getTableSchema(context.Background(), "SALES", "ORDERS")
+	assert.NoError(t, err)
+	assert.Equal(t, []*ds.ColumnItem{
+		{Name: "ID", DataType: "NUMBER(10,0)", NotNull: true, Precision: 10, Comment: "主键"},
+		{Name: "AMOUNT", DataType: "NUMBER", HasDefault: true, DefaultValue: "0"},
+		{Name: "NOTE", DataType: "CLOB"},
+	}, columns)
+
+	rows, _, err = strategy.getAllTablesStats(context.Background(), "", "ORDERS")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), rows)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
+
+func TestDamengTableNameAndTypes(t *testing.T) {
+	schema, table := splitDamengTableName(`"SALES"."ORDERS"`)
+	assert.Equal(t, "SALES", schema)
+	assert.Equal(t, "ORDERS", table)
+
+	dameng := ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG
+	assert.Equal(t, arrow.PrimitiveTypes.Int32, ArrowType(dameng, ParseNativeType("INTEGER")))
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, ArrowType(dameng, ParseNativeType("DOUBLE")))
+	assert.Equal(t, arrow.BinaryTypes.LargeString, ArrowType(dameng, ParseNativeType("CLOB")))
+	assert.Equal(t, arrow.FixedWidthTypes.Date32, ArrowType(dameng, ParseNativeType("DATE")))
+
+	_, isTime := damengScanTarget("TIMESTAMP", arrow.BinaryTypes.String).(*sql.NullTime)
+	assert.True(t, isTime)
+	assert.Equal(t, "2.50", damengScannedValue(&sql.NullString{String: "2.50", Valid: true}))
+	assert.Nil(t, damengScannedValue(&sql.NullInt64{}))
+
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "ID", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "AMOUNT", Type: &arrow.Decimal128Type{Precision: 12, Scale: 2}},
+		{Name: "NOTE", Type: arrow.BinaryTypes.String},
+	}, nil)
+	assert.Equal(t, `CREATE TABLE "TMP"."RESULT" ("ID" BIGINT, "AMOUNT" NUMBER(12,2), "NOTE" VARCHAR(4000))`,
+		buildCreateDamengTableSQL("TMP.RESULT", arrowSchema))
+
+	insertSQL, err := (&SQLGenerator{}).GenerateInsertSQL("TMP.RESULT", []interface{}{1, "2.00", "a", 2, "3.00", "b"}, arrowSchema,
+		ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG)
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT ALL INTO "TMP"."RESULT" ("ID", "AMOUNT", "NOTE") VALUES (?, ?, ?) `+
+		`INTO "TMP"."RESULT" ("ID", "AMOUNT", "NOTE") VALUES (?, ?, ?) SELECT 1 FROM DUAL`, insertSQL)
+}
@@ -73,15 +73,22 @@ func (a ArrowRowExtractor) ExtractRowData(record arrow.Record) ([]interface{}, e
 			case arrow.DECIMAL128:
 				decimal128Array := column.(*array.Decimal128)
 				decimalValue := decimal128Array.Value(int(rowIdx))
-				value = decimalValue.ToFloat64(decimal128Array.DataType().(*arrow.Decimal128Type).Scale) // 转换为 float64
+				// 按十进制字符串绑定，避免转换为 float64 丢失精度
+				value = decimalValue.ToString(decimal128Array.DataType().(*arrow.Decimal128Type).Scale)
 			case arrow.DATE32:
 				date32Array := column.(*array.Date32)
 				day := date32Array.Value(int(rowIdx))
 				value = time.Date(1970, time.January, 1+int(day), 0, 0, 0, 0, time.UTC) // 转换为 time.Time
 			case arrow.TIMESTAMP:
 				timestampArray := column.(*array.Timestamp)
-				timeUnit := timestampArray.DataType().(*arrow.TimestampType).Unit
+				timestampType := timestampArray.DataType().(*arrow.TimestampType)
+				timeUnit := timestampType.Unit
 				ts := timestampArray.Value(int(rowIdx)) // 获取时间戳值
+				if timestampType.TimeZone != "" {
+					// 带时区的时间戳按 UTC 时刻绑定，由驱动携带时区写入
+					value = ts.ToTime(timeUnit).UTC()
+					break
+				}
 
 				// ts 是 arrow.Timestamp 类型，我们需要将其转换为 int64
 				// var t time.Time
@@ -108,6 +115,12 @@ func (a ArrowRowExtractor) ExtractRowData(record arrow.Record) ([]interface{}, e
 			case arrow.BINARY:
 				binaryArray := column.(*array.Binary)
 				value = binaryArray.Value(int(rowIdx)) // 转换为 []byte
+			case arrow.LARGE_BINARY:
+				largeBinaryArray := column.(*array.LargeBinary)
+				value = largeBinaryArray.Value(int(rowIdx))
+			case arrow.BOOL:
+				booleanArray := column.(*array.Boolean)
+				value = booleanArray.Value(int(rowIdx))
 			default:
 				return nil, fmt.Errorf("unsupported column type: %v", column.DataType().ID())
 			}
@@ -0,0 +1,621 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/12/04
+	@note: 导入导出后的对账：两端分别计算行数、每列的和与最值或与行顺序无关的整行哈希，不一致时给出差异
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/config"
+	pb "data-service/generated/datasource"
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+)
+
+// reconcileBatchSize 计算指纹时每次读取的行数
+const reconcileBatchSize = 4096
+
+// reconcileTimeLayout 时间值统一格式化为该格式后参与对账
+const reconcileTimeLayout = "2006-01-02 15:04:05.999999"
+
+// maxReportedMismatches 对账失败的错误信息中最多列出的差异数
+const maxReportedMismatches = 5
+
+// ResolveReconcileMode 请求未指定对账强度时按 dbms.reconcile_mode，未配置或无法识别时不做额外比对
+// 源端在读取期间可能仍有写入，额外比对默认关闭，需要时由配置或请求显式开启；未过滤的流式读取始终比对行数，不受对账强度影响
+func ResolveReconcileMode(mode pb.ReconcileMode) pb.ReconcileMode {
+	if mode != pb.ReconcileMode_RECONCILE_MODE_UNSPECIFIED {
+		return mode
+	}
+	if conf := config.GetConfigMap(); conf != nil {
+		switch strings.ToLower(strings.TrimSpace(conf.Dbms.ReconcileMode)) {
+		case "count":
+			return pb.ReconcileMode_RECONCILE_MODE_COUNT
+		case "checksum":
+			return pb.ReconcileMode_RECONCILE_MODE_CHECKSUM
+		case "row_hash":
+			return pb.ReconcileMode_RECONCILE_MODE_ROW_HASH
+		}
+	}
+	return pb.ReconcileMode_RECONCILE_MODE_NONE
+}
+
+// ReconcileSource 对账一侧的数据
+type ReconcileSource struct {
+	Strategy DatabaseStrategy
+	DbType   pb.DataSourceType
+	// TableName 表名，可以带库名
+	TableName string
+	// Condition 不含 WHERE 的过滤条件，Args 为其中占位符对应的参数
+	Condition string
+	Args      []interface{}
+}
+
+// TableFingerprint 一侧数据的对账指纹
+type TableFingerprint struct {
+	Rows int64
+	// Columns 每列的统计，仅 checksum 模式
+	Columns []ColumnChecksum
+	// RowHash 各行哈希之和，与行的顺序无关，仅 row_hash 模式
+	RowHash uint64
+}
+
+// ColumnChecksum 一列的统计，为空的指标不参与比对
+type ColumnChecksum struct {
+	Name    string
+	NonNull int64
+	// Sum 非空值全部是数值时为它们的和，否则为空；数据库端只对整数与定点数求和
+	Sum string
+	// Min、Max 非空值全部是数值时为数值的最值，否则为空
+	Min string
+	Max string
+}
+
+// Reconcile 分别计算两端的指纹并比对，mode 为 none 时不对账并返回 nil
+// columns 为两端同名的列，count 模式下不使用
+func Reconcile(ctx context.Context, source, target ReconcileSource, columns []string,
+	mode pb.ReconcileMode) (*pb.ReconcileReport, error) {
+	if mode == pb.ReconcileMode_RECONCILE_MODE_NONE {
+		return nil, nil
+	}
+	sourcePrint, err := ComputeTableFingerprint(ctx, source, columns, mode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint source %s: %v", source.TableName, err)
+	}
+	targetPrint, err := ComputeTableFingerprint(ctx, target, columns, mode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint target %s: %v", target.TableName, err)
+	}
+	return CompareFingerprints(mode, sourcePrint, targetPrint), nil
+}
+
+// ComputeTableFingerprint 计算一侧数据的指纹，count 与 checksum 模式在数据库中聚合，row_hash 模式读出全部行逐行累加
+func ComputeTableFingerprint(ctx context.Context, source ReconcileSource, columns []string,
+	mode pb.ReconcileMode) (*TableFingerprint, error) {
+	d := DialectFor(source.DbType)
+	where := ""
+	if source.Condition != "" {
+		where = " WHERE " + source.Condition
+	}
+
+	if mode != pb.ReconcileMode_RECONCILE_MODE_CHECKSUM && mode != pb.ReconcileMode_RECONCILE_MODE_ROW_HASH {
+		var count int64
+		rows, err := source.Strategy.Query(ctx, "SELECT COUNT(*) FROM "+d.QuoteTableName(source.TableName)+where, source.Args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		if rows.Next() {
+			if err := rows.Scan(&count); err != nil {
+				return nil, err
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return &TableFingerprint{Rows: count}, nil
+	}
+
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns to reconcile")
+	}
+	if mode == pb.ReconcileMode_RECONCILE_MODE_CHECKSUM {
+		return computeChecksumFingerprint(ctx, d, source, columns, where)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s%s", quoteColumnList(d, columns), d.QuoteTableName(source.TableName), where)
+	rows, err := source.Strategy.Query(ctx, query, source.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	acc := NewFingerprintAccumulator(columns, mode)
+	for {
+		record, err := source.Strategy.RowsToArrowBatch(ctx, rows, reconcileBatchSize)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		err = acc.AddRecord(record)
+		record.Release()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return acc.Fingerprint(), nil
+}
+
+// reconcileColumnKind 对账时字段按数据库类型的分类
+type reconcileColumnKind int
+
+const (
+	// reconcileOther 非数值字段，只比对非空值个数
+	reconcileOther reconcileColumnKind = iota
+	// reconcileInteger 整数字段，求和前转换为定点数以免溢出
+	reconcileInteger
+	// reconcileDecimal 定点数字段
+	reconcileDecimal
+	// reconcileFloat 浮点字段，求和结果与累加顺序有关，只比对最值
+	reconcileFloat
+)
+
+// classifyReconcileColumn 按驱动返回的数据库类型名对字段分类
+func classifyReconcileColumn(typeName string) reconcileColumnKind {
+	name := strings.ToUpper(strings.TrimSpace(typeName))
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "UNSIGNED "), "_TYPE")
+	if i := strings.IndexAny(name, "( "); i > 0 && !strings.HasPrefix(name, "DOUBLE PRECISION") {
+		name = name[:i]
+	}
+	switch name {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT", "LARGEINT", "INT2", "INT4", "INT8", "BYTE":
+		return reconcileInteger
+	case "DECIMAL", "DECIMALV2", "DECIMALV3", "DECIMAL32", "DECIMAL64", "DECIMAL128", "DEC", "NUMERIC", "NUMBER":
+		return reconcileDecimal
+	case "FLOAT", "DOUBLE", "REAL", "FLOAT4", "FLOAT8", "DOUBLE PRECISION":
+		return reconcileFloat
+	}
+	return reconcileOther
+}
+
+// computeChecksumFingerprint 在数据库中聚合每列的非空值个数、和与最值，只返回一行
+// 字段类型通过不返回数据的查询获取，非数值字段只统计非空值个数
+func computeChecksumFingerprint(ctx context.Context, d Dialect, source ReconcileSource, columns []string,
+	where string) (*TableFingerprint, error) {
+	table := d.QuoteTableName(source.TableName)
+	probe, err := source.Strategy.Query(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE 1 = 0", quoteColumnList(d, columns), table))
+	if err != nil {
+		return nil, err
+	}
+	columnTypes, err := probe.ColumnTypes()
+	probe.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(columnTypes) != len(columns) {
+		return nil, fmt.Errorf("expected %d columns to reconcile, got %d", len(columns), len(columnTypes))
+	}
+
+	kinds := make([]reconcileColumnKind, len(columns))
+	selects := []string{"COUNT(*)"}
+	for i, column := range columns {
+		kinds[i] = classifyReconcileColumn(columnTypes[i].DatabaseTypeName())
+		quoted := d.QuoteIdentifier(column)
+		selects = append(selects, fmt.Sprintf("COUNT(%s)", quoted))
+		switch kinds[i] {
+		case reconcileInteger:
+			selects = append(selects, fmt.Sprintf("SUM(CAST(%s AS DECIMAL(38, 0)))", quoted))
+		case reconcileDecimal:
+			selects = append(selects, fmt.Sprintf("SUM(%s)", quoted))
+		}
+		if kinds[i] != reconcileOther {
+			selects = append(selects, fmt.Sprintf("MIN(%s)", quoted), fmt.Sprintf("MAX(%s)", quoted))
+		}
+	}
+	rows, err := source.Strategy.Query(ctx, fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(selects, ", "), table, where),
+		source.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("checksum query of %s returned no rows", source.TableName)
+	}
+
+	fingerprint := &TableFingerprint{Columns: make([]ColumnChecksum, len(columns))}
+	sums := make([]sql.NullString, len(columns))
+	mins := make([]sql.NullString, len(columns))
+	maxs := make([]sql.NullString, len(columns))
+	dest := []interface{}{&fingerprint.Rows}
+	for i, column := range columns {
+		fingerprint.Columns[i].Name = column
+		dest = append(dest, &fingerprint.Columns[i].NonNull)
+		if kinds[i] == reconcileInteger || kinds[i] == reconcileDecimal {
+			dest = append(dest, &sums[i])
+		}
+		if kinds[i] != reconcileOther {
+			dest = append(dest, &mins[i], &maxs[i])
+		}
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	for i := range fingerprint.Columns {
+		checksum := &fingerprint.Columns[i]
+		checksum.Sum, checksum.Min, checksum.Max = normalizeNumber(sums[i]), normalizeNumber(mins[i]), normalizeNumber(maxs[i])
+	}
+	return fingerprint, rows.Err()
+}
+
+// normalizeNumber 将数据库返回的数值格式化为与累加器相同的形式，NULL 为空串
+func normalizeNumber(value sql.NullString) string {
+	if !value.Valid {
+		return ""
+	}
+	num, ok := new(big.Rat).SetString(strings.TrimSpace(value.String))
+	if !ok {
+		return value.String
+	}
+	return formatRat(num)
+}
+
+// FingerprintAccumulator 逐批累加数据的对账指纹，流式发送或写入时在发送端直接计算
+type FingerprintAccumulator struct {
+	mode    pb.ReconcileMode
+	columns []string
+	rows    int64
+	rowHash uint64
+	sums    []*columnAccumulator
+	values  []string
+	valid   []bool
+}
+
+// NewFingerprintAccumulator 创建指纹累加器，columns 为空时取第一个批次的全部列
+func NewFingerprintAccumulator(columns []string, mode pb.ReconcileMode) *FingerprintAccumulator {
+	acc := &FingerprintAccumulator{mode: mode}
+	if len(columns) > 0 {
+		acc.init(columns)
+	}
+	return acc
+}
+
+func (a *FingerprintAccumulator) init(columns []string) {
+	a.columns = columns
+	a.values = make([]string, len(columns))
+	a.valid = make([]bool, len(columns))
+	if a.mode == pb.ReconcileMode_RECONCILE_MODE_CHECKSUM {
+		a.sums = make([]*columnAccumulator, len(columns))
+		for i := range a.sums {
+			a.sums[i] = &columnAccumulator{}
+		}
+	}
+}
+
+// Columns 参与对账的列，尚未确定时为空
+func (a *FingerprintAccumulator) Columns() []string {
+	return a.columns
+}
+
+// AddRecord 累加一个 Arrow 批次，列按名称匹配，不区分大小写
+func (a *FingerprintAccumulator) AddRecord(record arrow.Record) error {
+	if a.columns == nil {
+		names := make([]string, record.NumCols())
+		for i, field := range record.Schema().Fields() {
+			names[i] = field.Name
+		}
+		a.init(names)
+	}
+	if !a.hashing() {
+		a.rows += record.NumRows()
+		return nil
+	}
+
+	arrays := make([]arrow.Array, len(a.columns))
+	for i, column := range a.columns {
+		for j, field := range record.Schema().Fields() {
+			if strings.EqualFold(field.Name, column) {
+				arrays[i] = record.Column(j)
+				break
+			}
+		}
+		if arrays[i] == nil {
+			return fmt.Errorf("column %s is missing from the data to reconcile", column)
+		}
+	}
+	for row := 0; row < int(record.NumRows()); row++ {
+		for i, arr := range arrays {
+			a.values[i], a.valid[i] = reconcileValue(arr, row)
+		}
+		a.addRow()
+	}
+	return nil
+}
+
+// AddStrings 累加一行按列顺序排列的字符串值，用于 CSV 等没有类型的数据，所有值都视为非空
+func (a *FingerprintAccumulator) AddStrings(row []string) {
+	if !a.hashing() {
+		a.rows++
+		return
+	}
+	for i := range a.values {
+		a.values[i], a.valid[i] = "", false
+		if i < len(row) {
+			a.values[i], a.valid[i] = row[i], true
+		}
+	}
+	a.addRow()
+}
+
+// Fingerprint 当前累加的指纹
+func (a *FingerprintAccumulator) Fingerprint() *TableFingerprint {
+	fingerprint := &TableFingerprint{Rows: a.rows, RowHash: a.rowHash}
+	for i, sum := range a.sums {
+		fingerprint.Columns = append(fingerprint.Columns, sum.checksum(a.columns[i]))
+	}
+	return fingerprint
+}
+
+func (a *FingerprintAccumulator) hashing() bool {
+	return a.mode == pb.ReconcileMode_RECONCILE_MODE_CHECKSUM || a.mode == pb.ReconcileMode_RECONCILE_MODE_ROW_HASH
+}
+
+func (a *FingerprintAccumulator) addRow() {
+	a.rows++
+	if a.mode == pb.ReconcileMode_RECONCILE_MODE_CHECKSUM {
+		for i, sum := range a.sums {
+			if a.valid[i] {
+				sum.add(a.values[i])
+			}
+		}
+		return
+	}
+	// 每个值以存在标记和长度为前缀，避免不同的行拼接出相同的字节
+	h := fnv.New64a()
+	var prefix [binary.MaxVarintLen64 + 1]byte
+	for i, value := range a.values {
+		if !a.valid[i] {
+			h.Write([]byte{0})
+			continue
+		}
+		prefix[0] = 1
+		n := binary.PutUvarint(prefix[1:], uint64(len(value)))
+		h.Write(prefix[:n+1])
+		h.Write([]byte(value))
+	}
+	a.rowHash += h.Sum64()
+}
+
+// columnAccumulator 一列的非空数、数值和与最值
+type columnAccumulator struct {
+	nonNull int64
+	// numeric 目前为止的非空值是否都能解析为数值
+	numeric             bool
+	sum, minNum, maxNum *big.Rat
+}
+
+func (c *columnAccumulator) add(value string) {
+	c.nonNull++
+	if c.nonNull == 1 {
+		c.numeric = true
+		c.sum = new(big.Rat)
+	}
+	if !c.numeric {
+		return
+	}
+	num, ok := new(big.Rat).SetString(value)
+	if !ok {
+		c.numeric = false
+		return
+	}
+	c.sum.Add(c.sum, num)
+	if c.minNum == nil || num.Cmp(c.minNum) < 0 {
+		c.minNum = num
+	}
+	if c.maxNum == nil || num.Cmp(c.maxNum) > 0 {
+		c.maxNum = num
+	}
+}
+
+func (c *columnAccumulator) checksum(name string) ColumnChecksum {
+	checksum := ColumnChecksum{Name: name, NonNull: c.nonNull}
+	if c.numeric {
+		checksum.Sum, checksum.Min, checksum.Max = formatRat(c.sum), formatRat(c.minNum), formatRat(c.maxNum)
+	}
+	return checksum
+}
+
+// CompareFingerprints 比对两端的指纹，生成对账结果
+func CompareFingerprints(mode pb.ReconcileMode, source, target *TableFingerprint) *pb.ReconcileReport {
+	report := &pb.ReconcileReport{Mode: mode, SourceRows: source.Rows, TargetRows: target.Rows}
+	mismatch := func(column, metric, sourceValue, targetValue string) {
+		if sourceValue != targetValue {
+			report.Mismatches = append(report.Mismatches, &pb.ReconcileMismatch{
+				Column: column, Metric: metric, SourceValue: sourceValue, TargetValue: targetValue})
+		}
+	}
+	// 只有一端给出的指标（如浮点列在数据库端不求和）不参与比对
+	optionalMismatch := func(column, metric, sourceValue, targetValue string) {
+		if sourceValue != "" && targetValue != "" {
+			mismatch(column, metric, sourceValue, targetValue)
+		}
+	}
+	mismatch("", "rows", strconv.FormatInt(source.Rows, 10), strconv.FormatInt(target.Rows, 10))
+	switch mode {
+	case pb.ReconcileMode_RECONCILE_MODE_CHECKSUM:
+		for i, column := range source.Columns {
+			if i >= len(target.Columns) {
+				break
+			}
+			other := target.Columns[i]
+			mismatch(column.Name, "non_null", strconv.FormatInt(column.NonNull, 10), strconv.FormatInt(other.NonNull, 10))
+			optionalMismatch(column.Name, "sum", column.Sum, other.Sum)
+			optionalMismatch(column.Name, "min", column.Min, other.Min)
+			optionalMismatch(column.Name, "max", column.Max, other.Max)
+		}
+	case pb.ReconcileMode_RECONCILE_MODE_ROW_HASH:
+		mismatch("", "row_hash", strconv.FormatUint(source.RowHash, 16), strconv.FormatUint(target.RowHash, 16))
+	}
+	report.Matched = len(report.Mismatches) == 0
+	return report
+}
+
+// ReconcileError 对账不一致时返回列出差异的错误，一致时返回 nil
+func ReconcileError(report *pb.ReconcileReport) error {
+	if report == nil || report.Matched {
+		return nil
+	}
+	details := make([]string, 0, maxReportedMismatches)
+	for i, m := range report.Mismatches {
+		if i == maxReportedMismatches {
+			details = append(details, fmt.Sprintf("and %d more", len(report.Mismatches)-i))
+			break
+		}
+		metric := m.Metric
+		if m.Column != "" {
+			metric = m.Column + "." + metric
+		}
+		details = append(details, fmt.Sprintf("%s: source %q, target %q", metric, m.SourceValue, m.TargetValue))
+	}
+	return fmt.Errorf("reconciliation failed (%s): %s", strings.ToLower(strings.TrimPrefix(report.Mode.String(), "RECONCILE_MODE_")),
+		strings.Join(details, "; "))
+}
+
+// numericWatermark 整数或小数形式的水位
+var numericWatermark = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+// WatermarkLiteral 水位在 SQL 中的字面量，数值水位不加引号，以便与整数列按数值比较
+func WatermarkLiteral(dbType pb.DataSourceType, watermark string) string {
+	if numericWatermark.MatchString(watermark) {
+		return watermark
+	}
+	literal, _ := DialectFor(dbType).QuoteLiteral(watermark)
+	return literal
+}
+
+// WatermarkCondition 水位列在 (from, to] 区间内的条件，from 为空时不限下界并包含水位列为 NULL 的行
+func WatermarkCondition(dbType pb.DataSourceType, column, from, to string) string {
+	quoted := DialectFor(dbType).QuoteIdentifier(column)
+	condition := fmt.Sprintf("%s <= %s", quoted, WatermarkLiteral(dbType, to))
+	if from != "" {
+		return fmt.Sprintf("%s > %s AND %s", quoted, WatermarkLiteral(dbType, from), condition)
+	}
+	return fmt.Sprintf("(%s OR %s IS NULL)", condition, quoted)
+}
+
+// ReadCondition 读取请求中平铺过滤条件与过滤表达式组合后的条件，不含 WHERE 关键字
+func ReadCondition(dbType pb.DataSourceType, req PartitionedReadRequest) (string, []interface{}, error) {
+	return renderReadCondition(DialectFor(dbType), req)
+}
+
+// reconcileValue 将 Arrow 值转换为与数据库类型无关的字符串：整数与定点数去掉多余的零，时间统一格式，二进制转为十六进制
+func reconcileValue(arr arrow.Array, i int) (string, bool) {
+	if arr.IsNull(i) {
+		return "", false
+	}
+	switch a := arr.(type) {
+	case *array.Boolean:
+		if a.Value(i) {
+			return "1", true
+		}
+		return "0", true
+	case *array.Int8:
+		return strconv.FormatInt(int64(a.Value(i)), 10), true
+	case *array.Int16:
+		return strconv.FormatInt(int64(a.Value(i)), 10), true
+	case *array.Int32:
+		return strconv.FormatInt(int64(a.Value(i)), 10), true
+	case *array.Int64:
+		return strconv.FormatInt(a.Value(i), 10), true
+	case *array.Uint8:
+		return strconv.FormatUint(uint64(a.Value(i)), 10), true
+	case *array.Uint16:
+		return strconv.FormatUint(uint64(a.Value(i)), 10), true
+	case *array.Uint32:
+		return strconv.FormatUint(uint64(a.Value(i)), 10), true
+	case *array.Uint64:
+		return strconv.FormatUint(a.Value(i), 10), true
+	case *array.Float32:
+		return strconv.FormatFloat(float64(a.Value(i)), 'g', -1, 32), true
+	case *array.Float64:
+		return strconv.FormatFloat(a.Value(i), 'g', -1, 64), true
+	case *array.Decimal128:
+		return trimDecimalZeros(a.Value(i).ToString(a.DataType().(*arrow.Decimal128Type).Scale)), true
+	case *array.Decimal256:
+		return trimDecimalZeros(a.Value(i).ToString(a.DataType().(*arrow.Decimal256Type).Scale)), true
+	case *array.Timestamp:
+		tsType := a.DataType().(*arrow.TimestampType)
+		t := a.Value(i).ToTime(tsType.Unit)
+		if tsType.TimeZone != "" {
+			t = t.UTC()
+		}
+		return t.Format(reconcileTimeLayout), true
+	case *array.Date32:
+		return a.Value(i).ToTime().Format("2006-01-02"), true
+	case *array.Date64:
+		return a.Value(i).ToTime().Format("2006-01-02"), true
+	case *array.String:
+		return a.Value(i), true
+	case *array.LargeString:
+		return a.Value(i), true
+	case *array.Binary:
+		return hex.EncodeToString(a.Value(i)), true
+	case *array.LargeBinary:
+		return hex.EncodeToString(a.Value(i)), true
+	default:
+		return arr.ValueStr(i), true
+	}
+}
+
+// trimDecimalZeros 去掉定点数小数部分末尾的零，两端标度不同时仍能比对
+func trimDecimalZeros(value string) string {
+	if !strings.Contains(value, ".") {
+		return value
+	}
+	value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+	if value == "-0" {
+		return "0"
+	}
+	return value
+}
+
+// formatRat 将有理数格式化为十进制字符串，有限小数精确输出，其余保留 18 位小数
+func formatRat(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	denom := new(big.Int).Set(r.Denom())
+	digits := 0
+	for _, factor := range []int64{2, 5} {
+		f := big.NewInt(factor)
+		count := 0
+		for new(big.Int).Mod(denom, f).Sign() == 0 {
+			denom.Div(denom, f)
+			count++
+		}
+		digits = max(digits, count)
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return r.FloatString(18)
+	}
+	return r.FloatString(digits)
+}
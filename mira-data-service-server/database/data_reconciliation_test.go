@@ -0,0 +1,250 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/decimal128"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func newReconcileRecord(t *testing.T, ids []int64, amounts []string, names []string) arrow.Record {
+	t.Helper()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ID", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "amount", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for i := range ids {
+		builder.Field(0).(*array.Int64Builder).Append(ids[i])
+		if amounts[i] == "" {
+			builder.Field(1).AppendNull()
+		} else {
+			value, err := decimal128.FromString(amounts[i], 10, 2)
+			assert.NoError(t, err)
+			builder.Field(1).(*array.Decimal128Builder).Append(value)
+		}
+		if names[i] == "" {
+			builder.Field(2).AppendNull()
+		} else {
+			builder.Field(2).(*array.StringBuilder).Append(names[i])
+		}
+	}
+	return builder.NewRecord()
+}
+
+func TestFingerprintAccumulatorChecksum(t *testing.T) {
+	record := newReconcileRecord(t, []int64{1, 2, 3}, []string{"1.50", "", "-2.25"}, []string{"b", "a", ""})
+	defer record.Release()
+
+	acc := NewFingerprintAccumulator([]string{"id", "amount", "name"}, ds.ReconcileMode_RECONCILE_MODE_CHECKSUM)
+	assert.NoError(t, acc.AddRecord(record))
+	fingerprint := acc.Fingerprint()
+	assert.Equal(t, int64(3), fingerprint.Rows)
+	assert.Equal(t, []ColumnChecksum{
+		{Name: "id", NonNull: 3, Sum: "6", Min: "1", Max: "3"},
+		{Name: "amount", NonNull: 2, Sum: "-0.75", Min: "-2.25", Max: "1.5"},
+		{Name: "name", NonNull: 2},
+	}, fingerprint.Columns)
+
+	// 字符串形式的数据与 Arrow 数据得到相同的统计
+	text := NewFingerprintAccumulator([]string{"id", "amount"}, ds.ReconcileMode_RECONCILE_MODE_CHECKSUM)
+	text.AddStrings([]string{"3", "-2.250"})
+	text.AddStrings([]string{"1", "1.5"})
+	assert.Equal(t, ColumnChecksum{Name: "amount", NonNull: 2, Sum: "-0.75", Min: "-2.25", Max: "1.5"},
+		text.Fingerprint().Columns[1])
+}
+
+func TestFingerprintAccumulatorRowHash(t *testing.T) {
+	first := newReconcileRecord(t, []int64{1, 2}, []string{"1.50", ""}, []string{"a", "b"})
+	defer first.Release()
+	second := newReconcileRecord(t, []int64{2, 1}, []string{"", "1.5"}, []string{"b", "a"})
+	defer second.Release()
+	changed := newReconcileRecord(t, []int64{1, 2}, []string{"1.50", ""}, []string{"a", ""})
+	defer changed.Release()
+
+	hash := func(record arrow.Record) *TableFingerprint {
+		acc := NewFingerprintAccumulator(nil, ds.ReconcileMode_RECONCILE_MODE_ROW_HASH)
+		assert.NoError(t, acc.AddRecord(record))
+		return acc.Fingerprint()
+	}
+	// 与行的顺序无关
+	assert.Equal(t, hash(first), hash(second))
+	// NULL 与空串不同
+	assert.NotEqual(t, hash(first).RowHash, hash(changed).RowHash)
+
+	// 未指定列时取第一个批次的全部列
+	acc := NewFingerprintAccumulator(nil, ds.ReconcileMode_RECONCILE_MODE_ROW_HASH)
+	assert.Empty(t, acc.Columns())
+	assert.NoError(t, acc.AddRecord(first))
+	assert.Equal(t, []string{"ID", "amount", "name"}, acc.Columns())
+}
+
+func TestFingerprintAccumulatorMissingColumn(t *testing.T) {
+	record := newReconcileRecord(t, []int64{1}, []string{"1"}, []string{"a"})
+	defer record.Release()
+
+	acc := NewFingerprintAccumulator([]string{"id", "missing"}, ds.ReconcileMode_RECONCILE_MODE_ROW_HASH)
+	assert.ErrorContains(t, acc.AddRecord(record), "column missing is missing")
+
+	// count 模式只累加行数
+	count := NewFingerprintAccumulator([]string{"missing"}, ds.ReconcileMode_RECONCILE_MODE_COUNT)
+	assert.NoError(t, count.AddRecord(record))
+	assert.Equal(t, int64(1), count.Fingerprint().Rows)
+}
+
+func TestCompareFingerprints(t *testing.T) {
+	source := &TableFingerprint{Rows: 2, RowHash: 10, Columns: []ColumnChecksum{{Name: "id", NonNull: 2, Sum: "3", Min: "1", Max: "2"}}}
+	target := &TableFingerprint{Rows: 2, RowHash: 11, Columns: []ColumnChecksum{{Name: "id", NonNull: 2, Sum: "4", Min: "1", Max: "3"}}}
+
+	report := CompareFingerprints(ds.ReconcileMode_RECONCILE_MODE_COUNT, source, target)
+	assert.True(t, report.Matched)
+	assert.NoError(t, ReconcileError(report))
+
+	report = CompareFingerprints(ds.ReconcileMode_RECONCILE_MODE_CHECKSUM, source, target)
+	assert.False(t, report.Matched)
+	assert.Len(t, report.Mismatches, 2)
+	assert.Equal(t, "sum", report.Mismatches[0].Metric)
+	assert.EqualError(t, ReconcileError(report),
+		`reconciliation failed (checksum): id.sum: source "3", target "4"; id.max: source "2", target "3"`)
+
+	// 只有一端给出的和不参与比对
+	floatTarget := &TableFingerprint{Rows: 2, Columns: []ColumnChecksum{{Name: "id", NonNull: 2, Min: "1", Max: "2"}}}
+	assert.True(t, CompareFingerprints(ds.ReconcileMode_RECONCILE_MODE_CHECKSUM, source, floatTarget).Matched)
+
+	report = CompareFingerprints(ds.ReconcileMode_RECONCILE_MODE_ROW_HASH, source, &TableFingerprint{Rows: 1, RowHash: 10})
+	assert.EqualError(t, ReconcileError(report), `reconciliation failed (row_hash): rows: source "2", target "1"`)
+	assert.Equal(t, int64(2), report.SourceRows)
+	assert.Equal(t, int64(1), report.TargetRows)
+}
+
+func TestReconcileErrorTruncatesMismatches(t *testing.T) {
+	report := &ds.ReconcileReport{Mode: ds.ReconcileMode_RECONCILE_MODE_CHECKSUM}
+	for i := 0; i < 7; i++ {
+		report.Mismatches = append(report.Mismatches, &ds.ReconcileMismatch{Metric: "rows"})
+	}
+	assert.Contains(t, ReconcileError(report).Error(), "and 2 more")
+	assert.NoError(t, ReconcileError(nil))
+}
+
+func TestComputeTableFingerprintCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM `db`.`orders` WHERE `status` = ?")).WithArgs("paid").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(42)))
+
+	fingerprint, err := ComputeTableFingerprint(context.Background(), ReconcileSource{
+		Strategy: &MySQLStrategy{DB: db}, DbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, TableName: "db.orders",
+		Condition: "`status` = ?", Args: []interface{}{"paid"}}, nil, ds.ReconcileMode_RECONCILE_MODE_COUNT)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), fingerprint.Rows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestComputeTableFingerprintRowHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	column := sqlmock.NewColumn("id").OfType("BIGINT", int64(0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `orders`")).
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(column).AddRow(int64(2)).AddRow(int64(1)))
+
+	fingerprint, err := ComputeTableFingerprint(context.Background(), ReconcileSource{
+		Strategy: &MySQLStrategy{DB: db}, DbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, TableName: "orders"},
+		[]string{"id"}, ds.ReconcileMode_RECONCILE_MODE_ROW_HASH)
+	assert.NoError(t, err)
+
+	expected := NewFingerprintAccumulator([]string{"id"}, ds.ReconcileMode_RECONCILE_MODE_ROW_HASH)
+	expected.AddStrings([]string{"1"})
+	expected.AddStrings([]string{"2"})
+	assert.Equal(t, expected.Fingerprint(), fingerprint)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestComputeTableFingerprintChecksum(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `amount`, `score`, `name` FROM `db`.`orders` WHERE 1 = 0")).
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("id").OfType("BIGINT", int64(0)),
+			sqlmock.NewColumn("amount").OfType("DECIMAL", ""),
+			sqlmock.NewColumn("score").OfType("DOUBLE", float64(0)),
+			sqlmock.NewColumn("name").OfType("VARCHAR", "")))
+	// 聚合在数据库中完成，不读取明细行
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*), COUNT(`id`), SUM(CAST(`id` AS DECIMAL(38, 0))), MIN(`id`), MAX(`id`), " +
+		"COUNT(`amount`), SUM(`amount`), MIN(`amount`), MAX(`amount`), COUNT(`score`), MIN(`score`), MAX(`score`), " +
+		"COUNT(`name`) FROM `db`.`orders` WHERE `status` = ?")).WithArgs("paid").
+		WillReturnRows(sqlmock.NewRows([]string{"c0", "c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9", "c10", "c11", "c12"}).
+			AddRow(int64(3), int64(3), "6", "1", "3", int64(2), "-0.75", "-2.25", "1.50", int64(3), "0.5", "2.5", int64(2)))
+
+	fingerprint, err := ComputeTableFingerprint(context.Background(), ReconcileSource{
+		Strategy: &MySQLStrategy{DB: db}, DbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, TableName: "db.orders",
+		Condition: "`status` = ?", Args: []interface{}{"paid"}},
+		[]string{"id", "amount", "score", "name"}, ds.ReconcileMode_RECONCILE_MODE_CHECKSUM)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), fingerprint.Rows)
+	assert.Equal(t, []ColumnChecksum{
+		{Name: "id", NonNull: 3, Sum: "6", Min: "1", Max: "3"},
+		{Name: "amount", NonNull: 2, Sum: "-0.75", Min: "-2.25", Max: "1.5"},
+		{Name: "score", NonNull: 3, Min: "0.5", Max: "2.5"},
+		{Name: "name", NonNull: 2},
+	}, fingerprint.Columns)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// 与发送端累加的指纹一致
+	record := newReconcileRecord(t, []int64{1, 2, 3}, []string{"1.50", "", "-2.25"}, []string{"b", "a", ""})
+	defer record.Release()
+	acc := NewFingerprintAccumulator([]string{"id", "amount", "name"}, ds.ReconcileMode_RECONCILE_MODE_CHECKSUM)
+	assert.NoError(t, acc.AddRecord(record))
+	sent := acc.Fingerprint()
+	fingerprint.Columns = append(fingerprint.Columns[:2], fingerprint.Columns[3])
+	assert.True(t, CompareFingerprints(ds.ReconcileMode_RECONCILE_MODE_CHECKSUM, fingerprint, sent).Matched)
+}
+
+func TestClassifyReconcileColumn(t *testing.T) {
+	assert.Equal(t, reconcileInteger, classifyReconcileColumn("UNSIGNED BIGINT"))
+	assert.Equal(t, reconcileInteger, classifyReconcileColumn("INT8"))
+	assert.Equal(t, reconcileDecimal, classifyReconcileColumn("numeric"))
+	assert.Equal(t, reconcileFloat, classifyReconcileColumn("DOUBLE PRECISION"))
+	assert.Equal(t, reconcileOther, classifyReconcileColumn("POINT"))
+	assert.Equal(t, reconcileOther, classifyReconcileColumn(""))
+}
+
+func TestWatermarkLiteral(t *testing.T) {
+	doris := ds.DataSourceType_DATA_SOURCE_TYPE_DORIS
+	assert.Equal(t, "42", WatermarkLiteral(doris, "42"))
+	assert.Equal(t, "-1.5", WatermarkLiteral(doris, "-1.5"))
+	assert.Equal(t, "'2025-01-01 00:00:00'", WatermarkLiteral(doris, "2025-01-01 00:00:00"))
+	assert.Equal(t, "'Inf'", WatermarkLiteral(doris, "Inf"))
+}
+
+func TestWatermarkCondition(t *testing.T) {
+	assert.Equal(t, `"ts" > '2025-01-01' AND "ts" <= '2025-01-02'`,
+		WatermarkCondition(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, "ts", "2025-01-01", "2025-01-02"))
+	assert.Equal(t, "(`id` <= 100 OR `id` IS NULL)", WatermarkCondition(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "id", "", "100"))
+}
+
+func TestTrimDecimalZerosAndFormatRat(t *testing.T) {
+	assert.Equal(t, "1.5", trimDecimalZeros("1.500"))
+	assert.Equal(t, "2", trimDecimalZeros("2.00"))
+	assert.Equal(t, "0", trimDecimalZeros("-0.00"))
+	assert.Equal(t, "100", trimDecimalZeros("100"))
+
+	assert.Equal(t, "7", formatRat(big.NewRat(7, 1)))
+	assert.Equal(t, "0.125", formatRat(big.NewRat(1, 8)))
+	assert.Equal(t, "0.333333333333333333", formatRat(big.NewRat(1, 3)))
+}
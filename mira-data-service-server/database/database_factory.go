@@ -32,6 +32,12 @@ func DatabaseFactory(dbType pb.DataSourceType, info *pb.ConnectionInfo) (Databas
 		return NewVastbaseStrategy(info), nil
 	case pb.DataSourceType_DATA_SOURCE_TYPE_GBASE:
 		return NewGBaseStrategy(info), nil
+	case pb.DataSourceType_DATA_SOURCE_TYPE_HIVE:
+		return NewHiveStrategy(info), nil
+	case pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		return NewPostgresStrategy(info), nil
+	case pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG:
+		return NewDamengStrategy(info), nil
 	default:
 		return nil, errors.New("unknown database type")
 	}
@@ -48,6 +54,12 @@ func GetDB(dbStrategy DatabaseStrategy) *sql.DB {
 		return vastbaseStrategy.DB
 	} else if gbaseStrategy, ok := dbStrategy.(*GBaseStrategy); ok {
 		return gbaseStrategy.DB
+	} else if hiveStrategy, ok := dbStrategy.(*HiveStrategy); ok {
+		return hiveStrategy.DB
+	} else if postgresStrategy, ok := dbStrategy.(*PostgresStrategy); ok {
+		return postgresStrategy.DB
+	} else if damengStrategy, ok := dbStrategy.(*DamengStrategy); ok {
+		return damengStrategy.DB
 	} else {
 		return nil
 	}
@@ -0,0 +1,425 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/05
+	@note: SQL 方言：统一标识符引用、占位符、分页、字面量转义与建表类型映射
+
+*
+*/
+package database
+
+import (
+	pb "data-service/generated/datasource"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// Dialect 描述一种数据库的 SQL 方言，所有 SELECT/INSERT/CREATE/导出语句都应通过它拼接表名、字段名和字面量
+type Dialect interface {
+	// Name 方言名称，用于日志
+	Name() string
+	// QuoteIdentifier 为单个标识符加引号，调用方已加的引号会先被去除，标识符内的引号字符会被转义
+	QuoteIdentifier(name string) string
+	// QuoteTableName 为表名加引号，支持 schema.table 形式的方言会分别处理每一段
+	QuoteTableName(tableName string) string
+	// QualifyTableName 生成带库名的表名，database 为空或表名已带库名时只处理表名
+	QualifyTableName(database, tableName string) string
+	// Placeholder 第 index 个绑定参数的占位符，index 从 1 开始
+	Placeholder(index int) string
+	// LimitOffset 为查询添加分页，limit <= 0 时不限制行数
+	LimitOffset(query string, limit, offset int) string
+	// QuoteLiteral 将参数转为转义后的 SQL 字面量，只用于无法绑定参数的语句
+	QuoteLiteral(value interface{}) (string, error)
+	// ColumnType Arrow 类型对应的建表字段类型
+	ColumnType(arrowType arrow.DataType) string
+	// ClearTableSQL 清空表数据的语句，TRUNCATE 会隐式提交事务的方言使用 DELETE，以便与后续写入一起回滚
+	ClearTableSQL(tableName string) string
+	// AddColumnSQL 新增可空列的语句
+	AddColumnSQL(tableName, column, columnType string) string
+	// AlterColumnTypeSQL 修改列类型的语句，需要重新声明可空性的方言按 nullable 保留原有约束
+	AlterColumnTypeSQL(tableName, column, columnType string, nullable bool) string
+	// RandomFunction 返回 [0, 1) 随机数的函数
+	RandomFunction() string
+	// TableSample 按比例随机抽样时写在表名后的子句，不支持时返回空串
+	TableSample(percent float64) string
+	// HashBucket 将字段值与种子确定性地映射到 [0, 10000) 的表达式，column 已加引号，不支持时返回空串
+	HashBucket(column string, seed int64) string
+}
+
+// sqlDialect Dialect 的通用实现，各数据库只在配置上有差异
+type sqlDialect struct {
+	name string
+	// 标识符引号，反引号或双引号
+	quote byte
+	// 表名是否按 schema.table（或 db.table）拆分后分别加引号
+	splitQualified bool
+	// 字符串字面量中反斜杠是否为转义符（MySQL 系与 Hive 默认如此）
+	backslashEscape bool
+	// 单引号是否用反斜杠转义，Hive 不支持两个单引号的写法
+	backslashQuote bool
+	// 清空表时使用 DELETE 代替 TRUNCATE
+	clearWithDelete bool
+	placeholder     func(index int) string
+	limitOffset     func(query string, limit, offset int) string
+	// 新增列和修改列类型的语法，为空时使用 MySQL 的写法
+	addColumn       func(table, column, columnType string) string
+	alterColumnType func(table, column, columnType string, nullable bool) string
+	// 抽样相关语法，randomFunc 为空时使用 RAND()，tableSample、hashBucket 为空表示不支持
+	randomFunc  string
+	tableSample func(percent string) string
+	hashBucket  func(column, seed string) string
+}
+
+var (
+	mysqlDialect = &sqlDialect{name: "mysql", quote: '`', splitQualified: true, backslashEscape: true, clearWithDelete: true,
+		limitOffset: standardLimitOffset, hashBucket: mysqlHashBucket}
+	gbaseDialect = &sqlDialect{name: "gbase", quote: '`', splitQualified: true, backslashEscape: true,
+		limitOffset: standardLimitOffset, hashBucket: mysqlHashBucket}
+	dorisDialect = &sqlDialect{name: "doris", quote: '`', splitQualified: true, backslashEscape: true,
+		limitOffset: standardLimitOffset, tableSample: percentTableSample, hashBucket: dorisHashBucket}
+	hiveDialect = &sqlDialect{name: "hive", quote: '`', splitQualified: true, backslashEscape: true, backslashQuote: true,
+		limitOffset: hiveLimitOffset, addColumn: hiveAddColumn, alterColumnType: hiveAlterColumnType,
+		randomFunc: "rand()", tableSample: percentTableSample, hashBucket: hiveHashBucket}
+	// Kingbase 与 Vastbase 的临时表名由调用方拼接，可能包含点号，整体作为一个标识符
+	kingbaseDialect = &sqlDialect{name: "kingbase", quote: '"', placeholder: dollarPlaceholder,
+		limitOffset: standardLimitOffset, alterColumnType: postgresAlterColumnType,
+		randomFunc: "random()", tableSample: bernoulliTableSample, hashBucket: postgresHashBucket}
+	vastbaseDialect = &sqlDialect{name: "vastbase", quote: '"', placeholder: dollarPlaceholder,
+		limitOffset: standardLimitOffset, alterColumnType: postgresAlterColumnType,
+		randomFunc: "random()", tableSample: bernoulliTableSample, hashBucket: postgresHashBucket}
+	postgresDialect = &sqlDialect{name: "postgresql", quote: '"', splitQualified: true, placeholder: dollarPlaceholder,
+		limitOffset: standardLimitOffset, alterColumnType: postgresAlterColumnType,
+		randomFunc: "random()", tableSample: bernoulliTableSample, hashBucket: postgresHashBucket}
+	damengDialect = &sqlDialect{name: "dameng", quote: '"', splitQualified: true, clearWithDelete: true,
+		limitOffset: damengLimitOffset, alterColumnType: damengAlterColumnType,
+		tableSample: damengTableSample}
+)
+
+// DialectFor 根据数据源类型获取 SQL 方言，未知类型按 MySQL 处理
+func DialectFor(dbType pb.DataSourceType) Dialect {
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE:
+		return kingbaseDialect
+	case pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE:
+		return vastbaseDialect
+	case pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		return postgresDialect
+	case pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG:
+		return damengDialect
+	case pb.DataSourceType_DATA_SOURCE_TYPE_HIVE:
+		return hiveDialect
+	case pb.DataSourceType_DATA_SOURCE_TYPE_GBASE:
+		return gbaseDialect
+	case pb.DataSourceType_DATA_SOURCE_TYPE_DORIS:
+		return dorisDialect
+	default:
+		return mysqlDialect
+	}
+}
+
+func (d *sqlDialect) Name() string {
+	return d.name
+}
+
+func (d *sqlDialect) QuoteIdentifier(name string) string {
+	q := string(d.quote)
+	return q + strings.ReplaceAll(unquoteIdentifier(name), q, q+q) + q
+}
+
+func (d *sqlDialect) QuoteTableName(tableName string) string {
+	if !d.splitQualified {
+		return d.QuoteIdentifier(tableName)
+	}
+	parts := strings.SplitN(strings.TrimSpace(tableName), ".", 2)
+	if len(parts) == 2 {
+		return d.QuoteIdentifier(parts[0]) + "." + d.QuoteIdentifier(parts[1])
+	}
+	return d.QuoteIdentifier(tableName)
+}
+
+func (d *sqlDialect) QualifyTableName(database, tableName string) string {
+	if database == "" || (d.splitQualified && strings.Contains(tableName, ".")) {
+		return d.QuoteTableName(tableName)
+	}
+	return d.QuoteIdentifier(database) + "." + d.QuoteIdentifier(tableName)
+}
+
+func (d *sqlDialect) Placeholder(index int) string {
+	if d.placeholder == nil {
+		return "?"
+	}
+	return d.placeholder(index)
+}
+
+func (d *sqlDialect) LimitOffset(query string, limit, offset int) string {
+	if limit <= 0 && offset <= 0 {
+		return query
+	}
+	return d.limitOffset(query, limit, offset)
+}
+
+func (d *sqlDialect) RandomFunction() string {
+	if d.randomFunc == "" {
+		return "RAND()"
+	}
+	return d.randomFunc
+}
+
+func (d *sqlDialect) TableSample(percent float64) string {
+	if d.tableSample == nil {
+		return ""
+	}
+	return d.tableSample(strconv.FormatFloat(percent, 'f', -1, 64))
+}
+
+func (d *sqlDialect) HashBucket(column string, seed int64) string {
+	if d.hashBucket == nil {
+		return ""
+	}
+	return d.hashBucket(column, d.quoteString("#"+strconv.FormatInt(seed, 10)))
+}
+
+func (d *sqlDialect) QuoteLiteral(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return d.quoteString(v), nil
+	case []byte:
+		return d.quoteString(string(v)), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case time.Time:
+		return d.quoteString(v.Format("2006-01-02 15:04:05.999999")), nil
+	default:
+		return "", fmt.Errorf("unsupported argument type: %T", value)
+	}
+}
+
+func (d *sqlDialect) quoteString(s string) string {
+	if d.backslashEscape {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	if d.backslashQuote {
+		return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// ColumnType 由类型映射注册表按方言名查找
+func (d *sqlDialect) ColumnType(arrowType arrow.DataType) string {
+	return typeRegistry[d.name].columnType(arrowType)
+}
+
+func (d *sqlDialect) ClearTableSQL(tableName string) string {
+	if d.clearWithDelete {
+		return "DELETE FROM " + d.QuoteTableName(tableName)
+	}
+	return "TRUNCATE TABLE " + d.QuoteTableName(tableName)
+}
+
+func (d *sqlDialect) AddColumnSQL(tableName, column, columnType string) string {
+	table, col := d.QuoteTableName(tableName), d.QuoteIdentifier(column)
+	if d.addColumn != nil {
+		return d.addColumn(table, col, columnType)
+	}
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s NULL", table, col, columnType)
+}
+
+func (d *sqlDialect) AlterColumnTypeSQL(tableName, column, columnType string, nullable bool) string {
+	table, col := d.QuoteTableName(tableName), d.QuoteIdentifier(column)
+	if d.alterColumnType != nil {
+		return d.alterColumnType(table, col, columnType, nullable)
+	}
+	// MODIFY COLUMN 会重置列定义，需要重新声明可空性
+	constraint := "NULL"
+	if !nullable {
+		constraint = "NOT NULL"
+	}
+	return fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s %s %s", table, col, columnType, constraint)
+}
+
+func postgresAlterColumnType(table, column, columnType string, _ bool) string {
+	return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", table, column, columnType)
+}
+
+func damengAlterColumnType(table, column, columnType string, _ bool) string {
+	return fmt.Sprintf("ALTER TABLE %s MODIFY %s %s", table, column, columnType)
+}
+
+func hiveAddColumn(table, column, columnType string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMNS (%s %s)", table, column, columnType)
+}
+
+func hiveAlterColumnType(table, column, columnType string, _ bool) string {
+	return fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s %s", table, column, column, columnType)
+}
+
+// sampleBuckets 哈希抽样的桶数，抽样比例精确到 0.01%
+const sampleBuckets = 10000
+
+// bernoulliTableSample PostgreSQL 系按行抽样，比例是行级概率
+func bernoulliTableSample(percent string) string {
+	return fmt.Sprintf("TABLESAMPLE BERNOULLI (%s)", percent)
+}
+
+// percentTableSample Hive 与 Doris 的按比例抽样，实际按数据块抽取，比例为近似值
+func percentTableSample(percent string) string {
+	return fmt.Sprintf("TABLESAMPLE(%s PERCENT)", percent)
+}
+
+func damengTableSample(percent string) string {
+	return fmt.Sprintf("SAMPLE(%s)", percent)
+}
+
+func mysqlHashBucket(column, seed string) string {
+	return fmt.Sprintf("CRC32(CONCAT(%s, %s)) %% %d", column, seed, sampleBuckets)
+}
+
+func dorisHashBucket(column, seed string) string {
+	return fmt.Sprintf("ABS(murmur_hash3_32(CONCAT(CAST(%s AS STRING), %s))) %% %d", column, seed, sampleBuckets)
+}
+
+func hiveHashBucket(column, seed string) string {
+	return fmt.Sprintf("pmod(hash(concat(cast(%s AS STRING), %s)), %d)", column, seed, sampleBuckets)
+}
+
+func postgresHashBucket(column, seed string) string {
+	return fmt.Sprintf("mod(abs(hashtext(CAST(%s AS TEXT) || %s)::bigint), %d)", column, seed, sampleBuckets)
+}
+
+// unquoteIdentifier 去除调用方传入的一层反引号或双引号，并还原其中转义的引号
+func unquoteIdentifier(name string) string {
+	trimmed := strings.TrimSpace(name)
+	if len(trimmed) >= 2 {
+		first, last := trimmed[0], trimmed[len(trimmed)-1]
+		if first == last && (first == '`' || first == '"') {
+			q := string(first)
+			return strings.ReplaceAll(trimmed[1:len(trimmed)-1], q+q, q)
+		}
+	}
+	return trimmed
+}
+
+// standardLimitOffset LIMIT n OFFSET m，MySQL 系与 PostgreSQL 系通用
+func standardLimitOffset(query string, limit, offset int) string {
+	if limit <= 0 {
+		// MySQL 不支持单独的 OFFSET，使用最大行数代替
+		return fmt.Sprintf("%s LIMIT %d OFFSET %d", query, uint64(1<<63-1), offset)
+	}
+	if offset <= 0 {
+		return fmt.Sprintf("%s LIMIT %d", query, limit)
+	}
+	return fmt.Sprintf("%s LIMIT %d OFFSET %d", query, limit, offset)
+}
+
+// hiveLimitOffset Hive 2.0 起支持 LIMIT offset, n
+func hiveLimitOffset(query string, limit, offset int) string {
+	if limit <= 0 {
+		limit = 1<<31 - 1
+	}
+	if offset <= 0 {
+		return fmt.Sprintf("%s LIMIT %d", query, limit)
+	}
+	return fmt.Sprintf("%s LIMIT %d, %d", query, offset, limit)
+}
+
+// damengLimitOffset 只限制行数时使用 ROWNUM，兼容 DM7 及 Oracle 11g；带偏移量时使用 OFFSET ... FETCH
+func damengLimitOffset(query string, limit, offset int) string {
+	if offset <= 0 {
+		return damengLimitQuery(query, limit)
+	}
+	if limit <= 0 {
+		return fmt.Sprintf("%s OFFSET %d ROWS", query, offset)
+	}
+	return fmt.Sprintf("%s OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", query, offset, limit)
+}
+
+// quoteColumnList 为字段列表加引号，字段为空时返回 *
+func quoteColumnList(d Dialect, fields []string) string {
+	if len(fields) == 0 {
+		return "*"
+	}
+	quoted := make([]string, len(fields))
+	for i, f := range fields {
+		quoted[i] = d.QuoteIdentifier(f)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// buildOrderByClause 生成 ORDER BY 子句，没有排序规则时返回空串
+func buildOrderByClause(d Dialect, sortRules []*pb.SortRule) string {
+	var orders []string
+	for _, rule := range sortRules {
+		if rule == nil || rule.FieldName == "" {
+			continue
+		}
+		order := "ASC"
+		if rule.SortOrder == pb.SortOrder_DESC {
+			order = "DESC"
+		}
+		orders = append(orders, fmt.Sprintf("%s %s", d.QuoteIdentifier(rule.FieldName), order))
+	}
+	if len(orders) == 0 {
+		return ""
+	}
+	return " ORDER BY " + strings.Join(orders, ", ")
+}
+
+// newFilterDialect 按方言渲染过滤条件，onIn 为渲染 IN/NOT IN 前的回调，可为空
+func newFilterDialect(d Dialect, onIn func(fieldName string) error) *FilterDialect {
+	return &FilterDialect{QuoteIdent: d.QuoteIdentifier, Placeholder: d.Placeholder, OnIn: onIn}
+}
+
+// buildConditionQuery 按方言生成带过滤与排序的 SELECT 语句，各数据源的 BuildWithConditionQuery 共用
+func buildConditionQuery(d Dialect, tableName string, fields []string, filterNames []string,
+	filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue, sortRules []*pb.SortRule,
+	filterExpr *pb.FilterExpression, onIn func(fieldName string) error) (string, []interface{}, error) {
+	var queryBuilder strings.Builder
+
+	// 构建 SELECT 子句
+	queryBuilder.WriteString("SELECT ")
+	queryBuilder.WriteString(quoteColumnList(d, fields))
+	queryBuilder.WriteString(" FROM ")
+	queryBuilder.WriteString(d.QuoteTableName(tableName))
+
+	// 构建 WHERE 子句
+	whereClause, args, err := newFilterDialect(d, onIn).BuildWhereClause(filterNames, filterOperators, filterValues,
+		filterExpr, []interface{}{})
+	if err != nil {
+		return "", nil, err
+	}
+	queryBuilder.WriteString(whereClause)
+
+	// 构建 ORDER BY 子句
+	queryBuilder.WriteString(buildOrderByClause(d, sortRules))
+
+	return queryBuilder.String(), args, nil
+}
+
+// buildCreateTableSQL 根据 Arrow Schema 按方言生成建表语句，prefixColumns 为放在最前面的额外字段定义
+func buildCreateTableSQL(d Dialect, tableName string, schema *arrow.Schema, ifNotExists bool, prefixColumns ...string) string {
+	columns := append([]string{}, prefixColumns...)
+	for _, field := range schema.Fields() {
+		columns = append(columns, fmt.Sprintf("%s %s", d.QuoteIdentifier(field.Name), d.ColumnType(field.Type)))
+	}
+	create := "CREATE TABLE "
+	if ifNotExists {
+		create = "CREATE TABLE IF NOT EXISTS "
+	}
+	return fmt.Sprintf("%s%s (%s)", create, d.QuoteTableName(tableName), strings.Join(columns, ", "))
+}
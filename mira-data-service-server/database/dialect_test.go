@@ -0,0 +1,74 @@
+package database
+
+import (
+	ds "data-service/generated/datasource"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectQuoteIdentifier(t *testing.T) {
+	mysql := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
+	assert.Equal(t, "`users`", mysql.QuoteIdentifier("users"))
+	// 调用方已加的引号会被去除，不会重复加引号
+	assert.Equal(t, "`users`", mysql.QuoteIdentifier("`users`"))
+	// 标识符中的引号被转义，无法闭合引号后拼接 SQL
+	assert.Equal(t, "`a`` ; DROP TABLE t; --`", mysql.QuoteIdentifier("a` ; DROP TABLE t; --"))
+	assert.Equal(t, "`db`.`t`", mysql.QuoteTableName("db.t"))
+	assert.Equal(t, "`db`.`t`", mysql.QualifyTableName("db", "t"))
+	assert.Equal(t, "`other`.`t`", mysql.QualifyTableName("db", "other.t"))
+
+	pg := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL)
+	assert.Equal(t, `"odd""name"`, pg.QuoteIdentifier(`odd"name`))
+	assert.Equal(t, `"sales"."orders"`, pg.QuoteTableName("sales.orders"))
+
+	// Kingbase 的表名整体作为一个标识符
+	kb := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE)
+	assert.Equal(t, `"tmp.result"`, kb.QuoteTableName("tmp.result"))
+}
+
+func TestDialectPlaceholderAndLimit(t *testing.T) {
+	assert.Equal(t, "?", DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL).Placeholder(3))
+	assert.Equal(t, "$3", DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS).Placeholder(3))
+
+	mysql := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
+	assert.Equal(t, "SELECT 1", mysql.LimitOffset("SELECT 1", 0, 0))
+	assert.Equal(t, "SELECT 1 LIMIT 10", mysql.LimitOffset("SELECT 1", 10, 0))
+	assert.Equal(t, "SELECT 1 LIMIT 10 OFFSET 20", mysql.LimitOffset("SELECT 1", 10, 20))
+
+	hive := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE)
+	assert.Equal(t, "SELECT 1 LIMIT 20, 10", hive.LimitOffset("SELECT 1", 10, 20))
+
+	dm := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG)
+	assert.Equal(t, "SELECT 1 OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY", dm.LimitOffset("SELECT 1", 10, 20))
+}
+
+func TestDialectQuoteLiteral(t *testing.T) {
+	tests := []struct {
+		name    string
+		dbType  ds.DataSourceType
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{"doris string", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, `it's \' OR 1=1`, `'it''s \\'' OR 1=1'`, false},
+		{"postgres string", ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, `it's \`, `'it''s \'`, false},
+		{"hive string", ds.DataSourceType_DATA_SOURCE_TYPE_HIVE, `it's`, `'it\'s'`, false},
+		{"int", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, int32(7), "7", false},
+		{"float", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, 99.5, "99.5", false},
+		{"bool", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, true, "TRUE", false},
+		{"nil", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, nil, "NULL", false},
+		{"unsupported", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, struct{}{}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DialectFor(tt.dbType).QuoteLiteral(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -17,22 +17,41 @@ import (
 	"data-service/log"
 	"database/sql"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/apache/arrow/go/v15/arrow"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
 )
 
 var (
-	dorisDB *sql.DB
-	once    sync.Once
+	dorisMutex sync.Mutex
 )
 
+// killQueryTimeout 取消时发出 KILL QUERY 的超时时间
+const killQueryTimeout = 10 * time.Second
+
+type killQueryKey struct{}
+
+// WithKillQueryOnCancel 标记 ctx 取消时在 Doris 上中止正在执行的语句，只用于异步作业等长时间执行的调用
+// 未标记的上下文取消时只关闭本地连接，不额外设置 trace id。
+func WithKillQueryOnCancel(ctx context.Context) context.Context {
+	return context.WithValue(ctx, killQueryKey{}, true)
+}
+
+// killQueryOnCancelEnabled ctx 是否要求取消时中止 Doris 上的语句
+func killQueryOnCancelEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(killQueryKey{}).(bool)
+	return enabled
+}
+
 // DorisStrategy Doris专用策略，实现DatabaseStrategy接口
 type DorisStrategy struct {
-	Info *ds.ConnectionInfo
-	DB   *sql.DB
+	Info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
 }
 
 // NewDorisStrategy 创建Doris策略（统一连接池）
@@ -49,60 +68,37 @@ func (d *DorisStrategy) ConnectToDB() error {
 
 // ConnectToDBWithPass 实现DatabaseStrategy接口
 func (d *DorisStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
-	var err error
-	once.Do(func() {
-		// 只创建一次连接池
-		var dsn string
-
-		// TLS 分支
-		log.Logger.Infof("TlsConfig: %+v", info.TlsConfig)
-		if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
-			if tlsErr := setupTLSConfig(info.TlsConfig); tlsErr != nil {
-				err = fmt.Errorf("failed to setup TLS configuration: %v", tlsErr)
-				return
-			}
-			// Doris 使用 MySQL 协议，带上 tls 参数
-			dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/?tls=%s&parseTime=true&loc=UTC",
-				info.User, info.Password, info.Host, info.Port, common.MYSQL_TLS_CONFIG)
-			log.Logger.Infof("Connecting to Doris (MySQL protocol) with TLS enabled")
-		} else {
-			dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/?parseTime=true&loc=UTC",
-				info.User, info.Password, info.Host, info.Port)
-			log.Logger.Infof("Connecting to Doris (MySQL protocol) without TLS")
-		}
-
-		db, dbErr := sql.Open("mysql", dsn)
-		if dbErr != nil {
-			err = dbErr
-			return
-		}
-
-		// 设置连接池参数
-		config := config.GetConfigMap()
-		db.SetMaxOpenConns(config.DorisConfig.MaxOpenConns)
-		db.SetMaxIdleConns(config.DorisConfig.MaxIdleConns)
-		db.SetConnMaxLifetime(time.Duration(config.DorisConfig.MaxLifeTime) * time.Minute)
-		db.SetConnMaxIdleTime(time.Duration(config.DorisConfig.MaxIdleTime) * time.Minute)
-
-		dorisDB = db
+	// 连接池不区分库名，因此指纹中不包含 DbName；共享连接池上不执行 USE，
+	// 指定库的语句通过 ExecInDB/QueryInDB 在专用连接上先 USE 再执行
+	key := PoolFingerprint("doris", &ds.ConnectionInfo{
+		Host:      info.Host,
+		Port:      info.Port,
+		User:      info.User,
+		Password:  info.Password,
+		TlsConfig: info.TlsConfig,
 	})
 
+	db, lease, err := d.getOrCreatePool(key, info)
 	if err != nil {
 		return err
 	}
 
-	d.DB = dorisDB
+	d.lease.Release()
+	d.DB, d.lease = db, lease
 	d.Info = info // 保存连接信息
 
-	// 切换到目标数据库
+	// 确认目标数据库存在，不修改共享连接的当前库
 	if info.DbName != "" {
-		useSQL := fmt.Sprintf("USE `%s`", info.DbName)
-		_, err = d.DB.Exec(useSQL)
+		var count int
+		err = d.DB.QueryRow("SELECT COUNT(*) FROM information_schema.schemata WHERE schema_name = ?", info.DbName).Scan(&count)
+		if err == nil && count == 0 {
+			err = fmt.Errorf("unknown database '%s'", info.DbName)
+		}
 		if err != nil {
-			log.Logger.Errorf("Failed to switch to database '%s': %v", info.DbName, err)
+			log.Logger.Errorf("Failed to use database '%s': %v", info.DbName, err)
 			return err
 		}
-		log.Logger.Infof("Switched to database: %s", info.DbName)
+		log.Logger.Infof("Using database: %s", info.DbName)
 	}
 
 	// 打印连接池状态
@@ -111,10 +107,54 @@ func (d *DorisStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
 	return nil
 }
 
+// getOrCreatePool 从 PoolRegistry 获取 Doris 连接池，不存在时新建
+func (d *DorisStrategy) getOrCreatePool(key string, info *ds.ConnectionInfo) (*sql.DB, *PoolLease, error) {
+	dorisMutex.Lock()
+	defer dorisMutex.Unlock()
+
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		log.Logger.Debugf("Reusing existing Doris connection pool")
+		return db, lease, nil
+	}
+
+	var dsn string
+
+	// TLS 分支
+	log.Logger.Infof("TlsConfig: %+v", info.TlsConfig)
+	if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
+		if err := setupTLSConfig(info.TlsConfig); err != nil {
+			return nil, nil, fmt.Errorf("failed to setup TLS configuration: %v", err)
+		}
+		// Doris 使用 MySQL 协议，带上 tls 参数
+		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/?tls=%s&parseTime=true&loc=UTC",
+			info.User, info.Password, info.Host, info.Port, common.MYSQL_TLS_CONFIG)
+		log.Logger.Infof("Connecting to Doris (MySQL protocol) with TLS enabled")
+	} else {
+		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/?parseTime=true&loc=UTC",
+			info.User, info.Password, info.Host, info.Port)
+		log.Logger.Infof("Connecting to Doris (MySQL protocol) without TLS")
+	}
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 设置连接池参数
+	config := config.GetConfigMap()
+	db.SetMaxOpenConns(config.DorisConfig.MaxOpenConns)
+	db.SetMaxIdleConns(config.DorisConfig.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(config.DorisConfig.MaxLifeTime) * time.Minute)
+	db.SetConnMaxIdleTime(time.Duration(config.DorisConfig.MaxIdleTime) * time.Minute)
+
+	db, lease := GetPoolRegistry().Register(key, "doris", db)
+	return db, lease, nil
+}
+
 // Query 实现DatabaseStrategy接口
-func (d *DorisStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+func (d *DorisStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
 	log.Logger.Debugf("Executing query: %s with args: %v", sqlQuery, args)
-	rows, err := d.DB.Query(sqlQuery, args...)
+	rows, err := d.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		log.Logger.Errorf("Query failed: %v", err)
 		return nil, err
@@ -123,16 +163,10 @@ func (d *DorisStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows,
 	return rows, nil
 }
 
-// Close 实现DatabaseStrategy接口
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
 func (d *DorisStrategy) Close() error {
-	if d.DB != nil {
-		err := d.DB.Close()
-		if err != nil {
-			log.Logger.Errorf("Failed to close Doris connection: %v", err)
-			return err
-		}
-		log.Logger.Info("Doris connection closed successfully")
-	}
+	d.lease.Release()
+	d.DB, d.lease = nil, nil
 	return nil
 }
 
@@ -141,29 +175,29 @@ func (d *DorisStrategy) GetJdbcUrl() string {
 	return ""
 }
 
-// RowsToArrowBatch 实现DatabaseStrategy接口
-func (d *DorisStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.Record, error) {
-	return nil, nil
+// RowsToArrowBatch 实现DatabaseStrategy接口，Doris 兼容 MySQL 协议，列类型按 MySQL 驱动返回的类型转换
+func (d *DorisStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
+	return (&MySQLStrategy{}).RowsToArrowBatch(ctx, rows, batchSize)
 }
 
 // CreateTemporaryTableIfNotExists 实现DatabaseStrategy接口
-func (d *DorisStrategy) CreateTemporaryTableIfNotExists(tableName string, schema *arrow.Schema) error {
+func (d *DorisStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
 	return nil
 }
 
 // GetTableInfo 实现DatabaseStrategy接口
-func (d *DorisStrategy) GetTableInfo(database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+func (d *DorisStrategy) GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
 	// 定义 SQL 查询
 	var sqlQuery string
 
 	if isExactQuery {
 		// 精确查询时，只查询记录数
-		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s.%s", database, tableName)
+		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s", dorisDialect.QualifyTableName(database, tableName))
 	} else {
 		// 普通查询，获取表的相关信息
-		sqlQuery = fmt.Sprintf("SELECT table_schema, table_name, table_rows, data_length "+
-			"FROM information_schema.tables "+
-			"WHERE table_schema = '%s' AND table_name = '%s'", database, tableName)
+		sqlQuery = "SELECT table_schema, table_name, table_rows, data_length " +
+			"FROM information_schema.tables " +
+			"WHERE table_schema = ? AND table_name = ?"
 	}
 
 	// 记录日志
@@ -173,7 +207,7 @@ func (d *DorisStrategy) GetTableInfo(database string, tableName string, isExactQ
 
 	if isExactQuery {
 		// 精确查询，只返回记录数
-		if err := d.DB.QueryRow(sqlQuery).Scan(&result.TableRows); err != nil {
+		if err := d.DB.QueryRowContext(ctx, sqlQuery).Scan(&result.TableRows); err != nil {
 			return nil, err
 		}
 		result.TableSchema = ""      // 没有表模式
@@ -181,13 +215,13 @@ func (d *DorisStrategy) GetTableInfo(database string, tableName string, isExactQ
 		result.TableSize = 0         // 精确查询不关心表的大小
 	} else {
 		// 普通查询，返回更多的表信息
-		if err := d.DB.QueryRow(sqlQuery).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize); err != nil {
+		if err := d.DB.QueryRowContext(ctx, sqlQuery, database, tableName).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize); err != nil {
 			return nil, err
 		}
 	}
 
 	// 获取表结构信息
-	columns, err := d.getTableSchema(database, tableName)
+	columns, keys, err := d.DescribeTable(ctx, database, tableName)
 	if err != nil {
 		log.Logger.Errorf("Failed to get table schema: %v", err)
 		// 不返回错误，只记录日志，表结构信息为空
@@ -199,40 +233,30 @@ func (d *DorisStrategy) GetTableInfo(database string, tableName string, isExactQ
 		RecordCount: result.TableRows,
 		RecordSize:  result.TableSize,
 		Columns:     columns,
+		Keys:        keys,
 	}, nil
 }
 
-// 获取表结构信息
-func (d *DorisStrategy) getTableSchema(database, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT
-              FROM INFORMATION_SCHEMA.COLUMNS 
-              WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
-              ORDER BY ORDINAL_POSITION`
-
-	rows, err := d.DB.Query(query, database, tableName)
+// DescribeTable 获取字段元数据与表键信息，Doris 的排序键列按表模型列出：Unique 模型为唯一键，其余模型为索引键
+func (d *DorisStrategy) DescribeTable(ctx context.Context, database, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	columns, err := queryColumnItems(ctx, d.DB, mysqlColumnsQuery, database, tableName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer rows.Close()
-
-	var columns []*ds.ColumnItem
-	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
-		var isNullable, columnDefault, comment sql.NullString
-
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault, &comment)
-		if err != nil {
-			return nil, err
-		}
-
-		columns = append(columns, &col)
+	keys, err := queryTableKeys(ctx, d.DB, keyQuery{query: `
+		SELECT COLUMN_KEY,
+			CASE COLUMN_KEY WHEN 'PRI' THEN 'PRIMARY' WHEN 'UNI' THEN 'UNIQUE' ELSE 'INDEX' END,
+			COLUMN_NAME
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND COLUMN_KEY <> ''
+		ORDER BY ORDINAL_POSITION`, args: []interface{}{database, tableName}})
+	if err != nil {
+		return columns, nil, err
 	}
-
-	return columns, nil
+	return columns, withAutoIncrementKey(keys, columns), nil
 }
 
-// BuildWithConditionQuery 实现DatabaseStrategy接口
+// BuildWithConditionQuery 实现DatabaseStrategy接口，Doris 兼容 MySQL 协议，使用反引号和 ? 占位符
 func (d *DorisStrategy) BuildWithConditionQuery(
 	tableName string,
 	fields []string,
@@ -240,16 +264,15 @@ func (d *DorisStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
 ) (string, []interface{}, error) {
-	// 实现条件查询构建逻辑
-	// 这里可以根据需要实现具体的查询构建
-	return "", nil, fmt.Errorf("BuildWithConditionQuery not implemented for Doris")
+	return buildConditionQuery(dorisDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, nil)
 }
 
 // EnsureDatabaseExists 实现DatabaseStrategy接口
-func (d *DorisStrategy) EnsureDatabaseExists(dbName string) error {
-	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`", dbName)
-	_, err := d.DB.Exec(createQuery)
+func (d *DorisStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dorisDialect.QuoteIdentifier(dbName))
+	_, err := d.DB.ExecContext(ctx, createQuery)
 	if err != nil {
 		return fmt.Errorf("failed to create Doris database '%s': %v", dbName, err)
 	}
@@ -258,21 +281,21 @@ func (d *DorisStrategy) EnsureDatabaseExists(dbName string) error {
 }
 
 // CleanupOldTables 实现DatabaseStrategy接口
-func (d *DorisStrategy) CleanupOldTables(dbName string, retentionDays int) error {
+func (d *DorisStrategy) CleanupOldTables(ctx context.Context, dbName string, retentionDays int) error {
 	// 实现旧表清理逻辑
 	return fmt.Errorf("CleanupOldTables not implemented for Doris")
 }
 
 // GetGroupCountInfo 实现DatabaseStrategy接口
-func (d *DorisStrategy) GetGroupCountInfo(tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue) (*ds.GroupCountResponse, error) {
+func (d *DorisStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
 	// 实现分组统计逻辑
 	return nil, fmt.Errorf("GetGroupCountInfo not implemented for Doris")
 }
 
 // CheckTableExists 实现DatabaseStrategy接口
-func (d *DorisStrategy) CheckTableExists(tableName string) (bool, error) {
+func (d *DorisStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
 	var exists bool
-	err := d.DB.QueryRow("SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = ? AND table_name = ?",
+	err := d.DB.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = ? AND table_name = ?",
 		common.MIRA_TMP_TASK_DB, tableName).Scan(&exists)
 	if err != nil {
 		return false, err
@@ -292,11 +315,16 @@ func (d *DorisStrategy) ExecInDB(ctx context.Context, dbName string, sqlQuery st
 	defer conn.Close()
 
 	if dbName != "" {
-		if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE `%s`", dbName)); err != nil {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE %s", dorisDialect.QuoteIdentifier(dbName))); err != nil {
 			return nil, fmt.Errorf("failed to USE database '%s': %v", dbName, err)
 		}
 	}
 
+	stop, err := d.killQueryOnCancel(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
 	return conn.ExecContext(ctx, sqlQuery, args...)
 }
 
@@ -312,26 +340,71 @@ func (d *DorisStrategy) QueryInDB(ctx context.Context, dbName string, sqlQuery s
 	}
 
 	if dbName != "" {
-		if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE `%s`", dbName)); err != nil {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE %s", dorisDialect.QuoteIdentifier(dbName))); err != nil {
 			conn.Close()
 			return nil, func() {}, fmt.Errorf("failed to USE database '%s': %v", dbName, err)
 		}
 	}
 
+	stop, err := d.killQueryOnCancel(ctx, conn)
+	if err != nil {
+		conn.Close()
+		return nil, func() {}, err
+	}
 	rows, err := conn.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
+		stop()
 		conn.Close()
 		return nil, func() {}, err
 	}
 
 	// 由调用方负责清理：先关 rows，再归还该物理连接
 	done := func() {
+		stop()
 		_ = rows.Close()
 		_ = conn.Close()
 	}
 	return rows, done, nil
 }
 
+// killQueryOnCancel ctx 取消时按 trace id 执行 KILL QUERY 中止 conn 上正在执行的语句
+// 驱动在 ctx 取消时只关闭本地连接，FE 上的 INSERT、SELECT INTO OUTFILE 等仍会继续执行，需要显式中止。
+// 语句执行前在 conn 上设置随机的 trace id，KILL QUERY 按 trace id 可以经任意 FE 发出，也只需要中止自己语句的权限，
+// 取消时才从连接池取连接发出 KILL。trace id 无法设置时返回错误，避免作业取消后语句仍在 Doris 上执行。
+// 只对 WithKillQueryOnCancel 标记的上下文生效；返回的 stop 在语句结束后调用，会等待已发出的 KILL 完成。
+func (d *DorisStrategy) killQueryOnCancel(ctx context.Context, conn *sql.Conn) (stop func(), err error) {
+	if ctx.Done() == nil || !killQueryOnCancelEnabled(ctx) {
+		return func() {}, nil
+	}
+	traceID := "mira_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET session_context = 'trace_id:%s'", traceID)); err != nil {
+		return nil, fmt.Errorf("failed to set doris trace id, query cannot be killed on cancel: %v", err)
+	}
+
+	finished := make(chan struct{})
+	killed := make(chan struct{})
+	go func() {
+		defer close(killed)
+		select {
+		case <-finished:
+		case <-ctx.Done():
+			killCtx, cancel := context.WithTimeout(context.Background(), killQueryTimeout)
+			defer cancel()
+			if _, err := d.DB.ExecContext(killCtx, fmt.Sprintf("KILL QUERY '%s'", traceID)); err != nil {
+				log.Logger.Errorf("Failed to kill doris query %s: %v", traceID, err)
+				return
+			}
+			log.Logger.Infof("Killed doris query %s: %v", traceID, ctx.Err())
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(finished) })
+		<-killed
+	}, nil
+}
+
 // PrintConnectionPoolStats 打印详细的连接池统计信息
 func (d *DorisStrategy) PrintConnectionPoolStats() {
 	if d.DB == nil {
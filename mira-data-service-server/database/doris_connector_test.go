@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDorisExecInDBKillsQueryOnCancel(t *testing.T) {
+	setup()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	require.NoError(t, err)
+	defer db.Close()
+	mock.MatchExpectationsInOrder(false)
+	mock.ExpectExec("USE `job_1`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SET session_context = 'trace_id:mira_[0-9a-f]{32}'`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO `t` SELECT \\* FROM `s`").WillDelayFor(5 * time.Second).WillReturnResult(sqlmock.NewResult(0, 10))
+	// 按 trace id 中止，不依赖语句所在的 FE
+	mock.ExpectExec(`KILL QUERY 'mira_[0-9a-f]{32}'`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ctx, cancel := context.WithTimeout(WithKillQueryOnCancel(context.Background()), 50*time.Millisecond)
+	defer cancel()
+	_, err = (&DorisStrategy{DB: db}).ExecInDB(ctx, "job_1", "INSERT INTO `t` SELECT * FROM `s`")
+	assert.Error(t, err)
+	// 返回前已在 FE 上中止语句
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDorisExecInDBWithoutCancel(t *testing.T) {
+	setup()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	strategy := &DorisStrategy{DB: db}
+
+	// 不可取消的上下文不设置 trace id
+	mock.ExpectExec("DROP TABLE `t`").WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = strategy.ExecInDB(context.Background(), "", "DROP TABLE `t`")
+	require.NoError(t, err)
+
+	// 未标记中止的可取消上下文同样不设置 trace id
+	mock.ExpectExec("DROP TABLE `t`").WillReturnResult(sqlmock.NewResult(0, 0))
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = strategy.ExecInDB(ctx, "", "DROP TABLE `t`")
+	cancel()
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDorisExecInDBTraceIDRejected(t *testing.T) {
+	setup()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	require.NoError(t, err)
+	defer db.Close()
+	strategy := &DorisStrategy{DB: db}
+
+	// 无法设置 trace id 时不执行语句，避免取消后无法中止
+	mock.ExpectExec(`SET session_context`).WillReturnError(fmt.Errorf("Unknown system variable 'session_context'"))
+	ctx, cancel := context.WithCancel(WithKillQueryOnCancel(context.Background()))
+	defer cancel()
+	_, err = strategy.ExecInDB(ctx, "", "DROP TABLE `t`")
+	assert.ErrorContains(t, err, "failed to set doris trace id")
+
+	// 语句正常结束时不发出 KILL
+	mock.ExpectExec(`SET session_context`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DROP TABLE `t`").WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = strategy.ExecInDB(ctx, "", "DROP TABLE `t`")
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,270 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/03
+	@note: 过滤表达式树的统一翻译器，支持 AND/OR/NOT 任意嵌套，按方言渲染为 WHERE 子句
+
+*
+*/
+package database
+
+import (
+	ds "data-service/generated/datasource"
+	"fmt"
+	"strings"
+)
+
+// FilterDialect 描述过滤条件在不同数据库下的渲染方式
+type FilterDialect struct {
+	// QuoteIdent 字段名的引用方式，为空时原样输出
+	QuoteIdent func(name string) string
+	// Placeholder 参数占位符，index 为参数在 args 中的序号（从 1 开始），为空时使用 ?
+	Placeholder func(index int) string
+	// Literal 不为空时取值以字面量内联到 SQL 中，用于 SELECT ... INTO OUTFILE 等无法绑定参数的场景
+	Literal func(value interface{}) (string, error)
+	// NotEqual 不等于运算符，为空时使用 OperatorToString 的结果
+	NotEqual string
+	// OnIn 渲染 IN/NOT IN 条件前的回调，例如为过滤字段添加索引
+	OnIn func(fieldName string) error
+}
+
+var (
+	// questionMarkDialect MySQL/GBase/Doris 等使用 ? 占位符、字段名原样输出
+	questionMarkDialect = &FilterDialect{}
+	// dollarPlaceholder Kingbase/Vastbase/PostgreSQL 使用的 $1,$2 占位符
+	dollarPlaceholder = func(index int) string { return fmt.Sprintf("$%d", index) }
+)
+
+// FlatFiltersToExpression 将平铺的 filterNames/filterOperators/filterValues 转为以 AND 连接的过滤表达式
+// 任一数组为空时视为没有过滤条件
+func FlatFiltersToExpression(filterNames []string, filterOperators []ds.FilterOperator,
+	filterValues []*ds.FilterValue) (*ds.FilterExpression, error) {
+	if len(filterNames) == 0 || len(filterOperators) == 0 || len(filterValues) == 0 {
+		return nil, nil
+	}
+	if len(filterNames) != len(filterOperators) || len(filterOperators) != len(filterValues) {
+		return nil, fmt.Errorf("filterNames, filterOperators, and filterValues must have the same length")
+	}
+
+	conditions := make([]*ds.FilterCondition, len(filterNames))
+	for i := range filterNames {
+		conditions[i] = &ds.FilterCondition{
+			FieldName:  filterNames[i],
+			FieldValue: filterValues[i],
+			Operator:   filterOperators[i],
+		}
+	}
+	return FilterConditionsToExpression(conditions), nil
+}
+
+// FilterConditionsToExpression 将 FilterCondition 列表转为以 AND 连接的过滤表达式，忽略字段名为空的条件
+func FilterConditionsToExpression(conditions []*ds.FilterCondition) *ds.FilterExpression {
+	var children []*ds.FilterExpression
+	for _, fc := range conditions {
+		if fc == nil || fc.FieldName == "" {
+			continue
+		}
+		children = append(children, &ds.FilterExpression{Node: &ds.FilterExpression_Condition{Condition: fc}})
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return &ds.FilterExpression{Node: &ds.FilterExpression_Group{
+		Group: &ds.FilterGroup{Logic: ds.LogicalOperator_LOGICAL_AND, Children: children},
+	}}
+}
+
+// AndFilterExpressions 以 AND 组合多个过滤表达式，忽略空表达式，顶层的 AND 组会被展开以避免多余的括号
+func AndFilterExpressions(exprs ...*ds.FilterExpression) *ds.FilterExpression {
+	var children []*ds.FilterExpression
+	for _, expr := range exprs {
+		if expr == nil || expr.GetNode() == nil {
+			continue
+		}
+		if group := expr.GetGroup(); group != nil && group.Logic == ds.LogicalOperator_LOGICAL_AND {
+			children = append(children, group.Children...)
+			continue
+		}
+		children = append(children, expr)
+	}
+	switch len(children) {
+	case 0:
+		return nil
+	case 1:
+		return children[0]
+	default:
+		return &ds.FilterExpression{Node: &ds.FilterExpression_Group{
+			Group: &ds.FilterGroup{Logic: ds.LogicalOperator_LOGICAL_AND, Children: children},
+		}}
+	}
+}
+
+// BuildWhereClause 将平铺过滤条件与过滤表达式以 AND 组合后渲染为 WHERE 子句
+// 返回值包含前导的 " WHERE "，没有任何条件时返回空串；args 为已有的参数，新参数追加在其后
+func (d *FilterDialect) BuildWhereClause(filterNames []string, filterOperators []ds.FilterOperator,
+	filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression, args []interface{}) (string, []interface{}, error) {
+	flat, err := FlatFiltersToExpression(filterNames, filterOperators, filterValues)
+	if err != nil {
+		return "", nil, err
+	}
+	condition, args, err := d.Render(AndFilterExpressions(flat, filterExpr), args)
+	if err != nil {
+		return "", nil, err
+	}
+	if condition == "" {
+		return "", args, nil
+	}
+	return " WHERE " + condition, args, nil
+}
+
+// Render 渲染过滤表达式，顶层不加括号，嵌套的逻辑组加括号，NOT 渲染为 NOT (...)
+func (d *FilterDialect) Render(expr *ds.FilterExpression, args []interface{}) (string, []interface{}, error) {
+	return d.render(expr, args, true)
+}
+
+func (d *FilterDialect) render(expr *ds.FilterExpression, args []interface{}, top bool) (string, []interface{}, error) {
+	switch node := expr.GetNode().(type) {
+	case *ds.FilterExpression_Condition:
+		return d.renderCondition(node.Condition, args)
+
+	case *ds.FilterExpression_Not:
+		inner, newArgs, err := d.render(node.Not, args, true)
+		if err != nil {
+			return "", nil, err
+		}
+		if inner == "" {
+			return "", nil, fmt.Errorf("NOT filter expression has no operand")
+		}
+		return fmt.Sprintf("NOT (%s)", inner), newArgs, nil
+
+	case *ds.FilterExpression_Group:
+		logic := "AND"
+		if node.Group.GetLogic() == ds.LogicalOperator_LOGICAL_OR {
+			logic = "OR"
+		}
+
+		var parts []string
+		for _, child := range node.Group.GetChildren() {
+			part, newArgs, err := d.render(child, args, false)
+			if err != nil {
+				return "", nil, err
+			}
+			args = newArgs
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		switch {
+		case len(parts) == 0:
+			return "", args, nil
+		case len(parts) == 1:
+			return parts[0], args, nil
+		case top:
+			return strings.Join(parts, " "+logic+" "), args, nil
+		default:
+			return "(" + strings.Join(parts, " "+logic+" ") + ")", args, nil
+		}
+
+	default:
+		// 空表达式不产生任何条件
+		return "", args, nil
+	}
+}
+
+// renderCondition 渲染单个过滤条件
+func (d *FilterDialect) renderCondition(fc *ds.FilterCondition, args []interface{}) (string, []interface{}, error) {
+	if fc == nil || fc.FieldName == "" {
+		return "", nil, fmt.Errorf("filter condition has empty field name")
+	}
+	field := fc.FieldName
+	if d.QuoteIdent != nil {
+		field = d.QuoteIdent(fc.FieldName)
+	}
+
+	switch fc.Operator {
+	case ds.FilterOperator_IS_NULL:
+		return field + " IS NULL", args, nil
+	case ds.FilterOperator_IS_NOT_NULL:
+		return field + " IS NOT NULL", args, nil
+	}
+
+	values := d.conditionValues(fc.FieldValue)
+	if len(values) == 0 {
+		return "", nil, fmt.Errorf("no valid values found for filter '%s'", fc.FieldName)
+	}
+
+	switch fc.Operator {
+	case ds.FilterOperator_IN_OPERATOR, ds.FilterOperator_NOT_IN_OPERATOR:
+		if d.OnIn != nil {
+			if err := d.OnIn(fc.FieldName); err != nil {
+				return "", nil, err
+			}
+		}
+		placeholders := make([]string, len(values))
+		for i, v := range values {
+			placeholder, newArgs, err := d.bind(v, args)
+			if err != nil {
+				return "", nil, err
+			}
+			placeholders[i], args = placeholder, newArgs
+		}
+		return fmt.Sprintf("%s %s (%s)", field, d.operator(fc.Operator), strings.Join(placeholders, ", ")), args, nil
+
+	case ds.FilterOperator_BETWEEN_OPERATOR:
+		if len(values) < 2 {
+			return "", nil, fmt.Errorf("BETWEEN filter '%s' requires two values, got %d", fc.FieldName, len(values))
+		}
+		low, args, err := d.bind(values[0], args)
+		if err != nil {
+			return "", nil, err
+		}
+		high, args, err := d.bind(values[1], args)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s BETWEEN %s AND %s", field, low, high), args, nil
+
+	default:
+		// 比较、LIKE/NOT LIKE 以及等于，取第一个有效值
+		placeholder, args, err := d.bind(values[0], args)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s %s %s", field, d.operator(fc.Operator), placeholder), args, nil
+	}
+}
+
+// conditionValues 提取条件的取值，字面量模式下 int_value 为 0 时仍按 0 输出，与原有导出 SQL 的行为一致
+func (d *FilterDialect) conditionValues(value *ds.FilterValue) []interface{} {
+	if value == nil {
+		return nil
+	}
+	queryTools := &QueryBuilder{}
+	values := queryTools.ExtractQueryArgs([]*ds.FilterValue{value})
+	if len(values) == 0 && d.Literal != nil {
+		values = append(values, value.IntValue)
+	}
+	return values
+}
+
+// bind 追加参数并返回占位符，字面量模式下直接返回字面量
+func (d *FilterDialect) bind(value interface{}, args []interface{}) (string, []interface{}, error) {
+	if d.Literal != nil {
+		literal, err := d.Literal(value)
+		return literal, args, err
+	}
+	args = append(args, value)
+	if d.Placeholder == nil {
+		return "?", args, nil
+	}
+	return d.Placeholder(len(args)), args, nil
+}
+
+func (d *FilterDialect) operator(operator ds.FilterOperator) string {
+	if operator == ds.FilterOperator_NOT_EQUAL && d.NotEqual != "" {
+		return d.NotEqual
+	}
+	queryTools := &QueryBuilder{}
+	return queryTools.OperatorToString(operator)
+}
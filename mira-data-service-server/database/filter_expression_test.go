@@ -0,0 +1,167 @@
+package database
+
+import (
+	ds "data-service/generated/datasource"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func condition(name string, op ds.FilterOperator, value *ds.FilterValue) *ds.FilterExpression {
+	return &ds.FilterExpression{Node: &ds.FilterExpression_Condition{Condition: &ds.FilterCondition{
+		FieldName: name, Operator: op, FieldValue: value,
+	}}}
+}
+
+func group(logic ds.LogicalOperator, children ...*ds.FilterExpression) *ds.FilterExpression {
+	return &ds.FilterExpression{Node: &ds.FilterExpression_Group{Group: &ds.FilterGroup{Logic: logic, Children: children}}}
+}
+
+func not(expr *ds.FilterExpression) *ds.FilterExpression {
+	return &ds.FilterExpression{Node: &ds.FilterExpression_Not{Not: expr}}
+}
+
+// (a = 1 OR b > 2) AND NOT c LIKE 'x%'
+func analystExpression() *ds.FilterExpression {
+	return group(ds.LogicalOperator_LOGICAL_AND,
+		group(ds.LogicalOperator_LOGICAL_OR,
+			condition("a", ds.FilterOperator_EQUAL, &ds.FilterValue{IntValue: 1}),
+			condition("b", ds.FilterOperator_GREATER_THAN, &ds.FilterValue{IntValue: 2}),
+		),
+		not(condition("c", ds.FilterOperator_LIKE_OPERATOR, &ds.FilterValue{StrValue: "x%"})),
+	)
+}
+
+func TestBuildWithConditionQueryFilterExpression(t *testing.T) {
+	testCases := []struct {
+		name          string
+		strategy      DatabaseStrategy
+		expectedQuery string
+	}{
+		{"MySQL", &MySQLStrategy{}, "SELECT * FROM `t` WHERE (`a` = ? OR `b` > ?) AND NOT (`c` LIKE ?)"},
+		{"GBase", &GBaseStrategy{}, "SELECT * FROM `t` WHERE (`a` = ? OR `b` > ?) AND NOT (`c` LIKE ?)"},
+		{"Doris", &DorisStrategy{}, "SELECT * FROM `t` WHERE (`a` = ? OR `b` > ?) AND NOT (`c` LIKE ?)"},
+		{"Hive", &HiveStrategy{}, "SELECT * FROM `t` WHERE (`a` = ? OR `b` > ?) AND NOT (`c` LIKE ?)"},
+		{"Dameng", &DamengStrategy{}, `SELECT * FROM "t" WHERE ("a" = ? OR "b" > ?) AND NOT ("c" LIKE ?)`},
+		{"Kingbase", &KingbaseStrategy{}, `SELECT * FROM "t" WHERE ("a" = $1 OR "b" > $2) AND NOT ("c" LIKE $3)`},
+		{"Vastbase", &VastbaseStrategy{}, `SELECT * FROM "t" WHERE ("a" = $1 OR "b" > $2) AND NOT ("c" LIKE $3)`},
+		{"PostgreSQL", &PostgresStrategy{}, `SELECT * FROM "t" WHERE ("a" = $1 OR "b" > $2) AND NOT ("c" LIKE $3)`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, args, err := tc.strategy.BuildWithConditionQuery("t", nil, nil, nil, nil, nil, analystExpression())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, query)
+			assert.Equal(t, []interface{}{int32(1), int32(2), "x%"}, args)
+		})
+	}
+}
+
+func TestFilterDialectRender(t *testing.T) {
+	pgDialect := &FilterDialect{Placeholder: dollarPlaceholder}
+
+	testCases := []struct {
+		name          string
+		expr          *ds.FilterExpression
+		expectedWhere string
+		expectedArgs  []interface{}
+	}{
+		{
+			name:          "BETWEEN",
+			expr:          condition("age", ds.FilterOperator_BETWEEN_OPERATOR, &ds.FilterValue{IntValues: []int32{0, 18}}),
+			expectedWhere: "age BETWEEN $1 AND $2",
+			expectedArgs:  []interface{}{int32(0), int32(18)},
+		},
+		{
+			name: "IS NULL and IS NOT NULL",
+			expr: group(ds.LogicalOperator_LOGICAL_OR,
+				condition("deleted_at", ds.FilterOperator_IS_NULL, nil),
+				condition("restored_at", ds.FilterOperator_IS_NOT_NULL, nil),
+			),
+			expectedWhere: "deleted_at IS NULL OR restored_at IS NOT NULL",
+			expectedArgs:  []interface{}{},
+		},
+		{
+			name: "NOT IN and NOT LIKE",
+			expr: group(ds.LogicalOperator_LOGICAL_AND,
+				condition("status", ds.FilterOperator_NOT_IN_OPERATOR, &ds.FilterValue{StrValues: []string{"a", "b"}}),
+				condition("email", ds.FilterOperator_NOT_LIKE_OPERATOR, &ds.FilterValue{StrValue: "%@test"}),
+			),
+			expectedWhere: "status NOT IN ($1, $2) AND email NOT LIKE $3",
+			expectedArgs:  []interface{}{"a", "b", "%@test"},
+		},
+		{
+			name: "Nested groups and empty children",
+			expr: group(ds.LogicalOperator_LOGICAL_OR,
+				group(ds.LogicalOperator_LOGICAL_AND,
+					condition("a", ds.FilterOperator_EQUAL, &ds.FilterValue{IntValue: 1}),
+					group(ds.LogicalOperator_LOGICAL_OR,
+						condition("b", ds.FilterOperator_EQUAL, &ds.FilterValue{IntValue: 2}),
+						condition("c", ds.FilterOperator_EQUAL, &ds.FilterValue{IntValue: 3}),
+					),
+				),
+				group(ds.LogicalOperator_LOGICAL_AND),
+				not(group(ds.LogicalOperator_LOGICAL_AND,
+					condition("d", ds.FilterOperator_LESS_THAN, &ds.FilterValue{FloatValue: 1.5}),
+				)),
+			),
+			expectedWhere: "(a = $1 AND (b = $2 OR c = $3)) OR NOT (d < $4)",
+			expectedArgs:  []interface{}{int32(1), int32(2), int32(3), 1.5},
+		},
+		{
+			name:          "Empty expression",
+			expr:          &ds.FilterExpression{},
+			expectedWhere: "",
+			expectedArgs:  []interface{}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			where, args, err := pgDialect.Render(tc.expr, []interface{}{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedWhere, where)
+			assert.Equal(t, tc.expectedArgs, args)
+		})
+	}
+}
+
+func TestFilterDialectErrors(t *testing.T) {
+	_, _, err := questionMarkDialect.Render(
+		condition("age", ds.FilterOperator_BETWEEN_OPERATOR, &ds.FilterValue{IntValues: []int32{1}}), nil)
+	assert.Error(t, err)
+
+	_, _, err = questionMarkDialect.Render(not(group(ds.LogicalOperator_LOGICAL_AND)), nil)
+	assert.Error(t, err)
+
+	_, _, err = questionMarkDialect.Render(condition("status", ds.FilterOperator_EQUAL, &ds.FilterValue{}), nil)
+	assert.EqualError(t, err, "no valid values found for filter 'status'")
+}
+
+func TestBuildWhereClauseCombinesFlatFilters(t *testing.T) {
+	where, args, err := questionMarkDialect.BuildWhereClause(
+		[]string{"region"}, []ds.FilterOperator{ds.FilterOperator_EQUAL}, []*ds.FilterValue{{StrValue: "north"}},
+		analystExpression(), []interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, " WHERE region = ? AND (a = ? OR b > ?) AND NOT (c LIKE ?)", where)
+	assert.Equal(t, []interface{}{"north", int32(1), int32(2), "x%"}, args)
+
+	_, _, err = questionMarkDialect.BuildWhereClause([]string{"a", "b"}, []ds.FilterOperator{ds.FilterOperator_EQUAL},
+		[]*ds.FilterValue{{IntValue: 1}}, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestLiteralFilterDialect(t *testing.T) {
+	dialect := &FilterDialect{Literal: dorisDialect.QuoteLiteral, NotEqual: "<>"}
+	where, args, err := dialect.Render(group(ds.LogicalOperator_LOGICAL_AND,
+		group(ds.LogicalOperator_LOGICAL_OR,
+			condition("name", ds.FilterOperator_EQUAL, &ds.FilterValue{StrValue: "O'Brien"}),
+			condition("score", ds.FilterOperator_NOT_EQUAL, &ds.FilterValue{IntValue: 0}),
+		),
+		condition("active", ds.FilterOperator_IN_OPERATOR, &ds.FilterValue{BoolValues: []bool{true, false}}),
+	), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "(name = 'O''Brien' OR score <> 0) AND active IN (TRUE, FALSE)", where)
+	assert.Empty(t, args)
+}
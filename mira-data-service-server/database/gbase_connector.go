@@ -10,6 +10,7 @@
 package database
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"data-service/common"
@@ -33,13 +34,13 @@ import (
 )
 
 var (
-	gbasePoolMap = make(map[string]*sql.DB)
-	gbaseMutex   sync.Mutex
+	gbaseMutex sync.Mutex
 )
 
 type GBaseStrategy struct {
-	info *ds.ConnectionInfo
-	DB   *sql.DB
+	info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
 }
 
 func NewGBaseStrategy(info *ds.ConnectionInfo) *GBaseStrategy {
@@ -157,21 +158,15 @@ func (g *GBaseStrategy) ConnectToDB() error {
 }
 
 func (g *GBaseStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
-	dbType := utils.GetDbTypeName(info.Dbtype)
-
-	// Build connection pool key with TLS info
-	tlsKey := "no-tls"
-	if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
-		tlsKey = fmt.Sprintf("tls-%d-%s", info.TlsConfig.Mode, info.TlsConfig.ServerName)
-	}
-	key := fmt.Sprintf("%s_%s_%d_%s_%s_%s", dbType, info.Host, info.Port, info.DbName, info.Password, tlsKey)
+	key := PoolFingerprint("gbase", info)
 
 	gbaseMutex.Lock()
 	defer gbaseMutex.Unlock()
 
 	// Check if connection pool already exists
-	if db, ok := gbasePoolMap[key]; ok {
-		g.DB = db
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		g.lease.Release()
+		g.DB, g.lease = db, lease
 		log.Logger.Debugf("Reusing existing GBase connection pool")
 		return nil
 	}
@@ -214,15 +209,15 @@ func (g *GBaseStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
 	db.SetConnMaxIdleTime(2 * time.Minute)
 
 	// Save database instance after successful connection
-	g.DB = db
-	gbasePoolMap[key] = db
+	g.lease.Release()
+	g.DB, g.lease = GetPoolRegistry().Register(key, "gbase", db)
 	log.Logger.Info("Successfully connected to GBase with username and password")
 	return nil
 }
 
-func (g *GBaseStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+func (g *GBaseStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
 	log.Logger.Debugf("Executing query: %s with args: %v\n", sqlQuery, args)
-	rows, err := g.DB.Query(sqlQuery, args...)
+	rows, err := g.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		log.Logger.Errorf("Query failed: %v\n", err)
 		return nil, err
@@ -232,17 +227,10 @@ func (g *GBaseStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows,
 	return rows, nil
 }
 
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
 func (g *GBaseStrategy) Close() error {
-	if g.DB != nil {
-		err := g.DB.Close()
-		if err != nil {
-			log.Logger.Errorf("Failed to close GBase connection: %v", err)
-			return err
-		}
-		log.Logger.Info("GBase connection closed successfully")
-		return nil
-	}
-	log.Logger.Warn("Attempted to close a non-initialized DB connection")
+	g.lease.Release()
+	g.DB, g.lease = nil, nil
 	return nil
 }
 
@@ -260,10 +248,14 @@ func (g *GBaseStrategy) GetJdbcUrl() string {
 }
 
 // RowsToArrowBatch converts database rows to Arrow Record batch
-func (g *GBaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.Record, error) {
+func (g *GBaseStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
 	if rows == nil {
 		return nil, fmt.Errorf("no rows to convert")
 	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
 
 	pool := memory.NewGoAllocator()
 
@@ -282,12 +274,9 @@ func (g *GBaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.R
 	// Build Arrow Schema
 	var fields []arrow.Field
 	for i, col := range cols {
-		arrowType, err := gbaseTypeToArrowType(colTypes[i])
+		arrowType := ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_GBASE, colTypes[i])
 		log.Logger.Debugf("SQL type for column %s: %s", col, colTypes[i].DatabaseTypeName())
 		log.Logger.Debugf("Arrow type for column %s: %s", col, arrowType)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert SQL type to Arrow type: %v", err)
-		}
 		fields = append(fields, arrow.Field{Name: col, Type: arrowType})
 	}
 	schema := arrow.NewSchema(fields, nil)
@@ -323,57 +312,20 @@ func (g *GBaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.R
 		}
 	}
 
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+
 	// Create Arrow Record batch
 	record := builder.NewRecord()
 	return record, nil
 }
 
-// gbaseTypeToArrowType converts GBase SQL column type to Arrow type
-func gbaseTypeToArrowType(colType *sql.ColumnType) (arrow.DataType, error) {
-	switch colType.DatabaseTypeName() {
-	case "VARCHAR", "CHAR":
-		return arrow.BinaryTypes.String, nil
-	case "TEXT", "LONGTEXT", "MEDIUMTEXT", "TINYTEXT":
-		return arrow.BinaryTypes.LargeString, nil
-	case "TINYINT":
-		return arrow.PrimitiveTypes.Int8, nil
-	case "SMALLINT":
-		return arrow.PrimitiveTypes.Int16, nil
-	case "MEDIUMINT", "INT":
-		return arrow.PrimitiveTypes.Int32, nil
-	case "BIGINT":
-		return arrow.PrimitiveTypes.Int64, nil
-	case "UNSIGNED TINYINT":
-		return arrow.PrimitiveTypes.Uint8, nil
-	case "UNSIGNED SMALLINT":
-		return arrow.PrimitiveTypes.Uint16, nil
-	case "UNSIGNED MEDIUMINT", "UNSIGNED INT":
-		return arrow.PrimitiveTypes.Uint32, nil
-	case "UNSIGNED BIGINT":
-		return arrow.PrimitiveTypes.Uint64, nil
-	case "FLOAT":
-		return arrow.PrimitiveTypes.Float32, nil
-	case "DOUBLE":
-		return arrow.PrimitiveTypes.Float64, nil
-	case "TIMESTAMP", "DATETIME":
-		return arrow.BinaryTypes.String, nil
-	case "DECIMAL", "NUMERIC":
-		precision, scale, ok := colType.DecimalSize()
-		if !ok {
-			return nil, fmt.Errorf("unable to get precision and scale for DECIMAL column: %s", colType.Name())
-		}
-		return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}, nil
-	case "DATE":
-		return arrow.BinaryTypes.String, nil
-	default:
-		return arrow.BinaryTypes.String, nil
-	}
-}
-
-func (g *GBaseStrategy) CreateTemporaryTableIfNotExists(tableName string, schema *arrow.Schema) error {
+func (g *GBaseStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
 	// Check if table exists
 	var exists bool
-	err := g.DB.QueryRow("SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
+	err := g.DB.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check if table exists: %v", err)
 	}
@@ -382,7 +334,7 @@ func (g *GBaseStrategy) CreateTemporaryTableIfNotExists(tableName string, schema
 		// Create table
 		createTableSQL := buildCreateGBaseTableSQL(tableName, schema)
 		log.Logger.Infof("createTableSQL: %s", createTableSQL)
-		_, err = g.DB.Exec(createTableSQL)
+		_, err = g.DB.ExecContext(ctx, createTableSQL)
 		if err != nil {
 			return fmt.Errorf("failed to create table: %v", err)
 		}
@@ -396,85 +348,27 @@ func (g *GBaseStrategy) CreateTemporaryTableIfNotExists(tableName string, schema
 
 // buildCreateGBaseTableSQL generates CREATE TABLE SQL based on Arrow Schema
 func buildCreateGBaseTableSQL(tableName string, schema *arrow.Schema) string {
-	var columns []string
-
 	// Generate random UUID for primary key field name (first 10 chars)
 	randomUUID := "PK_" + strings.ReplaceAll(uuid.New().String(), "-", "")[:10]
+	primaryKey := fmt.Sprintf("%s INT AUTO_INCREMENT PRIMARY KEY", gbaseDialect.QuoteIdentifier(randomUUID))
 
-	// Add auto-increment primary key
-	columns = append(columns, fmt.Sprintf("`%s` INT AUTO_INCREMENT PRIMARY KEY", randomUUID))
-
-	// Add other fields
-	for _, field := range schema.Fields() {
-		columnType := convertArrowTypeToGBaseType(field.Type)
-		columns = append(columns, fmt.Sprintf("`%s` %s", field.Name, columnType))
-	}
-
-	// Return complete CREATE TABLE statement with explicit charset and collation
 	// Use utf8mb4 to support 4-byte UTF-8 characters (emoji, etc.)
-	return fmt.Sprintf("CREATE TABLE `%s` (%s) ENGINE=EXPRESS DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
-		tableName, strings.Join(columns, ", "))
-}
-
-// convertArrowTypeToGBaseType converts Arrow data type to GBase SQL type
-func convertArrowTypeToGBaseType(arrowType arrow.DataType) string {
-	switch arrowType.ID() {
-	case arrow.INT8:
-		return "TINYINT"
-	case arrow.INT16:
-		return "SMALLINT"
-	case arrow.INT32:
-		return "INT"
-	case arrow.INT64:
-		return "BIGINT"
-	case arrow.UINT8:
-		return "TINYINT UNSIGNED"
-	case arrow.UINT16:
-		return "SMALLINT UNSIGNED"
-	case arrow.UINT32:
-		return "INT UNSIGNED"
-	case arrow.UINT64:
-		return "BIGINT UNSIGNED"
-	case arrow.FLOAT32:
-		return "FLOAT"
-	case arrow.FLOAT64:
-		return "DOUBLE"
-	case arrow.BOOL:
-		return "BOOLEAN"
-	case arrow.STRING:
-		return "VARCHAR(255)"
-	case arrow.LARGE_STRING:
-		return "TEXT"
-	case arrow.BINARY:
-		return "BLOB"
-	case arrow.TIMESTAMP:
-		return "TIMESTAMP"
-	case arrow.DATE32:
-		return "DATE"
-	case arrow.DATE64:
-		return "DATETIME"
-	case arrow.DECIMAL128, arrow.DECIMAL256:
-		decimalType := arrowType.(arrow.FixedWidthDataType)
-		precision := decimalType.(*arrow.Decimal128Type).Precision
-		scale := decimalType.(*arrow.Decimal128Type).Scale
-		return fmt.Sprintf("DECIMAL(%d,%d)", precision, scale)
-	default:
-		return "TEXT"
-	}
+	return buildCreateTableSQL(gbaseDialect, tableName, schema, false, primaryKey) +
+		" ENGINE=EXPRESS DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"
 }
 
-func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+func (g *GBaseStrategy) GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
 	// 定义 SQL 查询
 	var sqlQuery string
 
 	if isExactQuery {
 		// 精确查询时，只查询记录数
-		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s.%s", database, tableName)
+		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s", gbaseDialect.QualifyTableName(database, tableName))
 	} else {
 		// 先尝试单机部署方式，从information_schema.tables查询data_length和table_rows
-		sqlQuery = fmt.Sprintf("SELECT table_schema, table_name, table_rows, data_length "+
-			"FROM information_schema.tables "+
-			"WHERE table_schema = '%s' AND table_name = '%s'", database, tableName)
+		sqlQuery = "SELECT table_schema, table_name, table_rows, data_length " +
+			"FROM information_schema.tables " +
+			"WHERE table_schema = ? AND table_name = ?"
 	}
 
 	// 记录日志
@@ -484,7 +378,7 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 
 	if isExactQuery {
 		// 精确查询，只返回记录数
-		if err := g.DB.QueryRow(sqlQuery).Scan(&result.TableRows); err != nil {
+		if err := g.DB.QueryRowContext(ctx, sqlQuery).Scan(&result.TableRows); err != nil {
 			return nil, err
 		}
 		result.TableSchema = ""      // 没有表模式
@@ -495,23 +389,23 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 		if ShouldUseEstimationOnly() {
 			// 直接使用估算方式，跳过 information_schema 和 CLUSTER_TABLES 查询
 			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping information_schema and CLUSTER_TABLES query")
-			FillTableInfoFromEstimation(g, database, tableName, database, &result)
+			FillTableInfoFromEstimation(ctx, g, database, tableName, database, &result)
 		} else {
 			// 普通查询，先尝试单机方式
-			err := g.DB.QueryRow(sqlQuery).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
+			err := g.DB.QueryRowContext(ctx, sqlQuery, database, tableName).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
 			if err != nil || result.TableName == "" || result.TableRows == 0 {
 				// 单机方式失败或返回空结果，尝试集群方式
 				LogQueryFailure("information_schema.tables", err, result.TableName, result.TableRows)
 
 				// 集群部署，从CLUSTER_TABLES表查询data_length
-				clusterQuery := fmt.Sprintf("SELECT table_schema, table_name, table_storage_size "+
-					"FROM CLUSTER_TABLES "+
-					"WHERE table_schema = '%s' AND table_name = '%s'", database, tableName)
+				clusterQuery := "SELECT table_schema, table_name, table_storage_size " +
+					"FROM CLUSTER_TABLES " +
+					"WHERE table_schema = ? AND table_name = ?"
 
 				log.Logger.Infof("Executing cluster query: %s", clusterQuery)
 
 				// 集群方式只能获取data_length，table_rows通过getTableRowCount获取
-				clusterScanErr := g.DB.QueryRow(clusterQuery).Scan(&result.TableSchema, &result.TableName, &result.TableSize)
+				clusterScanErr := g.DB.QueryRowContext(ctx, clusterQuery, database, tableName).Scan(&result.TableSchema, &result.TableName, &result.TableSize)
 				if clusterScanErr != nil || result.TableName == "" {
 					// 集群查询失败或返回空结果，使用估算方式
 					if clusterScanErr != nil {
@@ -521,11 +415,11 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 					}
 
 					// 使用通用函数进行估算
-					FillTableInfoFromEstimation(g, database, tableName, database, &result)
+					FillTableInfoFromEstimation(ctx, g, database, tableName, database, &result)
 				} else {
 					// 集群查询成功，继续获取行数
 					// 通过GetTableRowCount获取table_rows
-					rowCount, err := g.GetTableRowCount(database, tableName)
+					rowCount, err := g.GetTableRowCount(ctx, database, tableName)
 					if err != nil {
 						log.Logger.Errorf("Failed to get table row count: %v", err)
 						// 不返回错误，只记录日志，table_rows为0
@@ -539,7 +433,7 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 	}
 
 	// 获取表结构信息
-	columns, err := g.getTableSchema(database, tableName)
+	columns, keys, err := g.DescribeTable(ctx, database, tableName)
 	if err != nil {
 		log.Logger.Errorf("Failed to get table schema: %v", err)
 		// 不返回错误，只记录日志，表结构信息为空
@@ -553,37 +447,13 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 		RecordCount: result.TableRows,
 		RecordSize:  result.TableSize,
 		Columns:     columns,
+		Keys:        keys,
 	}, nil
 }
 
-// getTableSchema retrieves table structure information
-func (g *GBaseStrategy) getTableSchema(database, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT
-              FROM INFORMATION_SCHEMA.COLUMNS 
-              WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
-              ORDER BY ORDINAL_POSITION`
-
-	rows, err := g.DB.Query(query, database, tableName)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var columns []*ds.ColumnItem
-	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
-		var isNullable, columnDefault, comment sql.NullString
-
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault, &comment)
-		if err != nil {
-			return nil, err
-		}
-
-		columns = append(columns, &col)
-	}
-
-	return columns, nil
+// DescribeTable 获取字段元数据与表键信息
+func (g *GBaseStrategy) DescribeTable(ctx context.Context, database, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	return describeMySQLTable(ctx, g.DB, database, tableName)
 }
 
 func (g *GBaseStrategy) BuildWithConditionQuery(
@@ -593,98 +463,14 @@ func (g *GBaseStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
 ) (string, []interface{}, error) {
-	var queryBuilder strings.Builder
-	args := []interface{}{}
-	queryTools := &QueryBuilder{}
-
-	// Build SELECT clause
-	queryBuilder.WriteString("SELECT ")
-	if len(fields) > 0 {
-		quotedFields := make([]string, len(fields))
-		for i, f := range fields {
-			trimmed := strings.TrimSpace(f)
-			if strings.HasPrefix(trimmed, "`") && strings.HasSuffix(trimmed, "`") {
-				quotedFields[i] = trimmed
-			} else {
-				quotedFields[i] = fmt.Sprintf("`%s`", trimmed)
-			}
-		}
-		queryBuilder.WriteString(strings.Join(quotedFields, ", "))
-	} else {
-		queryBuilder.WriteString("*")
-	}
-	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(tableName)
-
-	// Build WHERE clause
-	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
-		if len(filterNames) != len(filterOperators) || len(filterOperators) != len(filterValues) {
-			return "", nil, fmt.Errorf("filterNames, filterOperators, and filterValues must have the same length")
-		}
-
-		queryBuilder.WriteString(" WHERE ")
-
-		conditions := make([]string, len(filterNames))
-		for i := range filterNames {
-			operator := filterOperators[i]
-			filterValue := filterValues[i]
-			filterName := filterNames[i]
-
-			argsFromFilter := queryTools.ExtractQueryArgs([]*ds.FilterValue{filterValue})
-			if len(argsFromFilter) == 0 {
-				return "", nil, fmt.Errorf("no valid values found for filter '%s'", filterName)
-			}
-
-			switch operator {
-			case ds.FilterOperator_IN_OPERATOR:
-				var inPlaceholders []string
-				for _, v := range argsFromFilter {
-					inPlaceholders = append(inPlaceholders, "?")
-					args = append(args, v)
-				}
-				conditions[i] = fmt.Sprintf("%s IN (%s)", filterName, strings.Join(inPlaceholders, ", "))
-
-			case ds.FilterOperator_GREATER_THAN, ds.FilterOperator_LESS_THAN,
-				ds.FilterOperator_GREATER_THAN_OR_EQUAL, ds.FilterOperator_LESS_THAN_OR_EQUAL,
-				ds.FilterOperator_NOT_EQUAL:
-				conditions[i] = fmt.Sprintf("%s %s ?", filterName, queryTools.OperatorToString(operator))
-				args = append(args, argsFromFilter[0])
-
-			case ds.FilterOperator_LIKE_OPERATOR:
-				conditions[i] = fmt.Sprintf("%s LIKE ?", filterName)
-				args = append(args, argsFromFilter[0])
-
-			default:
-				conditions[i] = fmt.Sprintf("%s = ?", filterName)
-				args = append(args, argsFromFilter[0])
-			}
-		}
-
-		queryBuilder.WriteString(strings.Join(conditions, " AND "))
-	}
-
-	// Build ORDER BY clause
-	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
-		orders := make([]string, len(sortRules))
-		for i, rule := range sortRules {
-			order := "ASC"
-			if rule.SortOrder == ds.SortOrder_DESC {
-				order = "DESC"
-			}
-			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
-		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
-	}
-
-	return queryBuilder.String(), args, nil
+	return buildConditionQuery(gbaseDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, nil)
 }
 
-func (g *GBaseStrategy) EnsureDatabaseExists(dbName string) error {
-	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`", dbName)
-	_, err := g.DB.Exec(createQuery)
+func (g *GBaseStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", gbaseDialect.QuoteIdentifier(dbName))
+	_, err := g.DB.ExecContext(ctx, createQuery)
 	if err != nil {
 		return fmt.Errorf("failed to create GBase database '%s': %v", dbName, err)
 	}
@@ -692,19 +478,19 @@ func (g *GBaseStrategy) EnsureDatabaseExists(dbName string) error {
 	return nil
 }
 
-func (g *GBaseStrategy) CheckTableExists(tableName string) (bool, error) {
+func (g *GBaseStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
 	var exists bool
-	err := g.DB.QueryRow("SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
+	err := g.DB.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 	return exists, nil
 }
 
-func (g *GBaseStrategy) CleanupOldTables(dbName string, retentionDays int) error {
+func (g *GBaseStrategy) CleanupOldTables(ctx context.Context, dbName string, retentionDays int) error {
 	// Switch to specified database
-	useQuery := fmt.Sprintf("USE `%s`", dbName)
-	if _, err := g.DB.Exec(useQuery); err != nil {
+	useQuery := fmt.Sprintf("USE %s", gbaseDialect.QuoteIdentifier(dbName))
+	if _, err := g.DB.ExecContext(ctx, useQuery); err != nil {
 		return fmt.Errorf("failed to switch to database '%s': %v", dbName, err)
 	}
 
@@ -716,7 +502,7 @@ func (g *GBaseStrategy) CleanupOldTables(dbName string, retentionDays int) error
 		SELECT table_name
 		FROM information_schema.tables
 		WHERE table_schema = ? AND table_name LIKE ?`
-	rows, err := g.Query(query, dbName, "20%")
+	rows, err := g.Query(ctx, query, dbName, "20%")
 	if err != nil {
 		return fmt.Errorf("failed to query tables: %v", err)
 	}
@@ -731,8 +517,8 @@ func (g *GBaseStrategy) CleanupOldTables(dbName string, retentionDays int) error
 
 		// Check if table name is earlier than retention date
 		if len(tableName) >= 8 && tableName[:8] < cutoffDate {
-			dropQuery := fmt.Sprintf("DROP TABLE `%s`", tableName)
-			if _, err := g.DB.Exec(dropQuery); err != nil {
+			dropQuery := fmt.Sprintf("DROP TABLE %s", gbaseDialect.QuoteIdentifier(tableName))
+			if _, err := g.DB.ExecContext(ctx, dropQuery); err != nil {
 				log.Logger.Errorf("failed to drop table '%s': %v", tableName, err)
 			} else {
 				log.Logger.Infof("Dropped table '%s'", tableName)
@@ -743,19 +529,19 @@ func (g *GBaseStrategy) CleanupOldTables(dbName string, retentionDays int) error
 	return nil
 }
 
-func (g *GBaseStrategy) GetGroupCountInfo(tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue) (*ds.GroupCountResponse, error) {
+func (g *GBaseStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
 	queryTools := &QueryBuilder{}
 
 	// Use BuildGroupCountQuery to generate SQL query and parameters
 	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators,
-		filterValues, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL) // GBase uses MySQL-compatible queries
+		filterValues, filterExpr, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL) // GBase uses MySQL-compatible queries
 	if err != nil {
 		return nil, fmt.Errorf("failed to build group count query: %v", err)
 	}
 
 	// Execute query
 	log.Logger.Debugf("Executing group count query: %s with args: %v", query, args)
-	rows, err := g.Query(query, args...)
+	rows, err := g.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute group count query: %v", err)
 	}
@@ -765,20 +551,20 @@ func (g *GBaseStrategy) GetGroupCountInfo(tableName string, groupBy []string, fi
 }
 
 // getTableRowCount 获取表的总行数
-func (g *GBaseStrategy) getTableRowCount(database string, tableName string) (int32, error) {
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s.%s", database, tableName)
+func (g *GBaseStrategy) getTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", gbaseDialect.QualifyTableName(database, tableName))
 	var rowCount int32
-	if err := g.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
+	if err := g.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
 	return rowCount, nil
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (g *GBaseStrategy) estimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (g *GBaseStrategy) estimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
 	// 获取100条数据估算平均行大小
-	sampleQuery := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 100", database, tableName)
-	rows, err := g.DB.Query(sampleQuery)
+	sampleQuery := gbaseDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", gbaseDialect.QualifyTableName(database, tableName)), 100, 0)
+	rows, err := g.DB.QueryContext(ctx, sampleQuery)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
 	}
@@ -838,11 +624,11 @@ func gbase8aCipherSuites() []uint16 {
 }
 
 // GetTableRowCount 实现 TableInfoEstimator 接口
-func (g *GBaseStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
-	return g.getTableRowCount(database, tableName)
+func (g *GBaseStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	return g.getTableRowCount(ctx, database, tableName)
 }
 
 // EstimateTableSize 实现 TableInfoEstimator 接口
-func (g *GBaseStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
-	return g.estimateTableSize(database, tableName, totalRows)
+func (g *GBaseStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
+	return g.estimateTableSize(ctx, database, tableName, totalRows)
 }
@@ -65,6 +65,8 @@ func InitGormConnection() error {
 	// 自动迁移表结构
 	err = db.AutoMigrate(
 		&models.CleanupTask{},
+		&models.ImportWatermark{},
+		&models.AsyncJob{},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to auto migrate: %v", err)
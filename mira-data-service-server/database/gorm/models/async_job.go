@@ -0,0 +1,33 @@
+package models
+
+import (
+	"data-service/common"
+	"time"
+)
+
+// AsyncJob 异步作业，记录请求、执行进度与结果
+type AsyncJob struct {
+	ID              uint              `gorm:"primarykey"`
+	JobID           string            `gorm:"uniqueIndex;size:64;not null;comment:作业ID"`
+	JobType         string            `gorm:"index;size:64;not null;comment:作业类型(import_data, export_csv_file_from_doris, etc)"`
+	Status          common.TaskStatus `gorm:"index;size:32;not null;default:'pending';comment:作业状态(pending, running, completed, failed, cancelled)"`
+	Request         string            `gorm:"type:text;comment:请求(JSON)"`
+	Result          string            `gorm:"type:text;comment:结果(JSON)"`
+	Phase           string            `gorm:"size:255;comment:当前阶段"`
+	RowsProcessed   int64             `gorm:"default:0;comment:已处理的行数"`
+	BytesProcessed  int64             `gorm:"default:0;comment:已处理的字节数"`
+	Percent         float64           `gorm:"default:0;comment:完成百分比"`
+	ErrorMessage    string            `gorm:"type:text;comment:错误信息"`
+	Resources       string            `gorm:"type:text;comment:作业创建的表与写入的对象前缀(JSON)，取消或失联后只清理这些资源"`
+	CancelRequested bool              `gorm:"default:false;comment:是否已请求取消"`
+	Worker          string            `gorm:"size:255;comment:执行节点"`
+	HeartbeatAt     *time.Time        `gorm:"comment:执行节点最近一次心跳时间"`
+	StartedAt       *time.Time        `gorm:"comment:开始时间"`
+	CompletedAt     *time.Time        `gorm:"comment:结束时间"`
+	CreatedAt       time.Time         `gorm:"index"`
+	UpdatedAt       time.Time
+}
+
+func (AsyncJob) TableName() string {
+	return "t_data_service_async_jobs"
+}
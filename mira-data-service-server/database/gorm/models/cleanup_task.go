@@ -9,7 +9,7 @@ import (
 type CleanupTask struct {
 	ID            uint              `gorm:"primarykey"`
 	JobInstanceID string            `gorm:"uniqueIndex;size:255;not null;comment:作业实例ID"`
-	TaskType      string            `gorm:"not null;comment:任务类型(doris_table, mira_table, etc)"`
+	TaskType      string            `gorm:"not null;comment:任务类型(doris_table, mira_table, export_files, etc)"`
 	Status        common.TaskStatus `gorm:"not null;default:'pending';comment:任务状态(pending, running, completed, failed)"`
 	TablesFound   int               `gorm:"default:0;comment:找到的表数量"`
 	TablesDropped int               `gorm:"default:0;comment:成功删除的表数量"`
@@ -18,6 +18,7 @@ type CleanupTask struct {
 	CompletedAt   *time.Time        `gorm:"comment:完成时间"`
 	RetryCount    int               `gorm:"default:0;comment:重试次数"`
 	MaxRetries    int               `gorm:"default:3;comment:最大重试次数"`
+	ExpiresAt     *time.Time        `gorm:"index;comment:过期时间，到期前不执行清理"`
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
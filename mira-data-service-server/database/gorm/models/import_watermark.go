@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// ImportWatermark 增量导入的水位，每个导入目标表一条记录
+type ImportWatermark struct {
+	ID              uint   `gorm:"primarykey"`
+	DbName          string `gorm:"uniqueIndex:idx_import_watermark_target;size:255;not null;comment:目标库名"`
+	TargetTable     string `gorm:"uniqueIndex:idx_import_watermark_target;size:255;not null;comment:目标表名"`
+	AssetName       string `gorm:"size:255;comment:数据资产名称"`
+	WatermarkColumn string `gorm:"size:255;not null;comment:水位列"`
+	Watermark       string `gorm:"size:255;comment:已导入数据中水位列的最大值"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (ImportWatermark) TableName() string {
+	return "t_data_service_import_watermarks"
+}
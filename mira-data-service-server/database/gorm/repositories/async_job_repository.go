@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"data-service/common"
+	"data-service/database/gorm/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// unfinishedStatuses 尚未结束的作业状态，结束后的作业不再更新
+var unfinishedStatuses = []common.TaskStatus{common.TaskStatusPending, common.TaskStatusRunning}
+
+type AsyncJobRepository struct {
+	db *gorm.DB
+}
+
+func NewAsyncJobRepository(db *gorm.DB) *AsyncJobRepository {
+	return &AsyncJobRepository{db: db}
+}
+
+// Create 创建异步作业
+func (r *AsyncJobRepository) Create(job *models.AsyncJob) error {
+	return r.db.Create(job).Error
+}
+
+// FindByJobID 根据作业ID查找作业，不存在时返回 gorm.ErrRecordNotFound
+func (r *AsyncJobRepository) FindByJobID(jobID string) (*models.AsyncJob, error) {
+	var job models.AsyncJob
+	err := r.db.Where("job_id = ?", jobID).First(&job).Error
+	return &job, err
+}
+
+// List 分页查找作业，按创建时间倒序，jobType、status 为空时不过滤
+func (r *AsyncJobRepository) List(jobType string, status common.TaskStatus, offset, limit int) ([]models.AsyncJob, int64, error) {
+	query := r.db.Model(&models.AsyncJob{})
+	if jobType != "" {
+		query = query.Where("job_type = ?", jobType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var jobs []models.AsyncJob
+	err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&jobs).Error
+	return jobs, totalCount, err
+}
+
+// MarkRunning 将待执行的作业标记为执行中，返回是否标记成功
+func (r *AsyncJobRepository) MarkRunning(jobID, worker string) (bool, error) {
+	now := time.Now()
+	result := r.db.Model(&models.AsyncJob{}).
+		Where("job_id = ? AND status = ?", jobID, common.TaskStatusPending).
+		Updates(map[string]interface{}{
+			"status":       common.TaskStatusRunning,
+			"worker":       worker,
+			"started_at":   &now,
+			"heartbeat_at": &now,
+			"updated_at":   now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateProgress 更新执行进度与已创建的资源并刷新心跳
+func (r *AsyncJobRepository) UpdateProgress(jobID, phase string, rows, bytes int64, percent float64, createdResources string) error {
+	now := time.Now()
+	return r.db.Model(&models.AsyncJob{}).
+		Where("job_id = ? AND status = ?", jobID, common.TaskStatusRunning).
+		Updates(map[string]interface{}{
+			"phase":           phase,
+			"rows_processed":  rows,
+			"bytes_processed": bytes,
+			"percent":         percent,
+			"resources":       createdResources,
+			"heartbeat_at":    &now,
+			"updated_at":      now,
+		}).Error
+}
+
+// Finish 结束作业，只更新尚未结束的作业，返回是否更新成功
+func (r *AsyncJobRepository) Finish(jobID string, status common.TaskStatus, result, errorMsg string) (bool, error) {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":       status,
+		"completed_at": &now,
+		"updated_at":   now,
+	}
+	if result != "" {
+		updates["result"] = result
+	}
+	if errorMsg != "" {
+		updates["error_message"] = errorMsg
+	}
+
+	res := r.db.Model(&models.AsyncJob{}).
+		Where("job_id = ? AND status IN ?", jobID, unfinishedStatuses).
+		Updates(updates)
+	return res.RowsAffected > 0, res.Error
+}
+
+// FailStale 将失联的作业标记为失败，只更新心跳仍早于 before 的执行中作业或创建早于 before 仍未开始的作业，
+// 查出失联作业之后又刷新了心跳的作业不受影响，返回是否更新成功
+func (r *AsyncJobRepository) FailStale(jobID string, before time.Time, errorMsg string) (bool, error) {
+	now := time.Now()
+	res := r.db.Model(&models.AsyncJob{}).
+		Where("job_id = ? AND ((status = ? AND heartbeat_at < ?) OR (status = ? AND created_at < ?))",
+			jobID, common.TaskStatusRunning, before, common.TaskStatusPending, before).
+		Updates(map[string]interface{}{
+			"status":        common.TaskStatusFailed,
+			"error_message": errorMsg,
+			"completed_at":  &now,
+			"updated_at":    now,
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+// RequestCancel 标记作业已请求取消，作业已结束时返回 false
+func (r *AsyncJobRepository) RequestCancel(jobID string) (bool, error) {
+	res := r.db.Model(&models.AsyncJob{}).
+		Where("job_id = ? AND status IN ?", jobID, unfinishedStatuses).
+		Updates(map[string]interface{}{
+			"cancel_requested": true,
+			"updated_at":       time.Now(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+// FindStale 查找执行节点失联的作业：心跳早于 before 的执行中作业，以及创建早于 before 仍未开始的作业
+func (r *AsyncJobRepository) FindStale(before time.Time) ([]models.AsyncJob, error) {
+	var jobs []models.AsyncJob
+	err := r.db.Where("(status = ? AND heartbeat_at < ?) OR (status = ? AND created_at < ?)",
+		common.TaskStatusRunning, before, common.TaskStatusPending, before).
+		Order("created_at ASC").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// WorkerAlive 执行节点是否仍在为其他作业写入心跳：有心跳不早于 since 的执行中作业
+func (r *AsyncJobRepository) WorkerAlive(worker string, since time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.AsyncJob{}).
+		Where("worker = ? AND status = ? AND heartbeat_at >= ?", worker, common.TaskStatusRunning, since).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -51,6 +51,16 @@ func (r *CleanupTaskRepository) UpdateStatus(taskID uint, status common.TaskStat
 	return r.db.Model(&models.CleanupTask{}).Where("id = ?", taskID).Updates(updates).Error
 }
 
+// ResetPending 将任务重置为待处理并更新过期时间
+func (r *CleanupTaskRepository) ResetPending(taskID uint, expiresAt *time.Time) error {
+	return r.db.Model(&models.CleanupTask{}).Where("id = ?", taskID).
+		Updates(map[string]interface{}{
+			"status":     common.TaskStatusPending,
+			"expires_at": expiresAt,
+			"updated_at": time.Now(),
+		}).Error
+}
+
 // IncrementRetryCount 增加重试次数
 func (r *CleanupTaskRepository) IncrementRetryCount(taskID uint) error {
 	return r.db.Model(&models.CleanupTask{}).Where("id = ?", taskID).
@@ -103,10 +113,10 @@ func (r *CleanupTaskRepository) CountRetryTasks(twoMinutesAgo time.Time) (int64,
 	return totalCount, err
 }
 
-// FindPendingTasksWithPagination 分页查找状态为pending的任务
+// FindPendingTasksWithPagination 分页查找状态为pending且已到期的任务
 func (r *CleanupTaskRepository) FindPendingTasksWithPagination(offset, limit int) ([]models.CleanupTask, error) {
 	var tasks []models.CleanupTask
-	err := r.db.Where("status = ?", common.TaskStatusPending).
+	err := r.db.Where("status = ? AND (expires_at IS NULL OR expires_at <= ?)", common.TaskStatusPending, time.Now()).
 		Order("created_at ASC"). // 按创建时间升序，优先处理较早的任务
 		Offset(offset).
 		Limit(limit).
@@ -114,11 +124,11 @@ func (r *CleanupTaskRepository) FindPendingTasksWithPagination(offset, limit int
 	return tasks, err
 }
 
-// CountPendingTasks 统计状态为pending的任务数量
+// CountPendingTasks 统计状态为pending且已到期的任务数量
 func (r *CleanupTaskRepository) CountPendingTasks() (int64, error) {
 	var totalCount int64
 	err := r.db.Model(&models.CleanupTask{}).
-		Where("status = ?", common.TaskStatusPending).
+		Where("status = ? AND (expires_at IS NULL OR expires_at <= ?)", common.TaskStatusPending, time.Now()).
 		Count(&totalCount).Error
 	return totalCount, err
 }
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ImportWatermarkRepository struct {
+	db *gorm.DB
+}
+
+func NewImportWatermarkRepository(db *gorm.DB) *ImportWatermarkRepository {
+	return &ImportWatermarkRepository{db: db}
+}
+
+// FindByTarget 根据目标库表查找水位，不存在时返回 gorm.ErrRecordNotFound
+func (r *ImportWatermarkRepository) FindByTarget(dbName, targetTable string) (*models.ImportWatermark, error) {
+	var watermark models.ImportWatermark
+	err := r.db.Where("db_name = ? AND target_table = ?", dbName, targetTable).First(&watermark).Error
+	return &watermark, err
+}
+
+// Save 保存目标库表的水位，已存在时覆盖水位列和水位
+func (r *ImportWatermarkRepository) Save(watermark *models.ImportWatermark) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "db_name"}, {Name: "target_table"}},
+		DoUpdates: clause.AssignmentColumns([]string{"asset_name", "watermark_column", "watermark", "updated_at"}),
+	}).Create(watermark).Error
+}
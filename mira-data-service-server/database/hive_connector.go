@@ -0,0 +1,619 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/10/16
+	@note: 连接hive功能
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/common"
+	"data-service/config"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	_ "sqlflow.org/gohive"
+)
+
+var (
+	hiveMutex sync.Mutex
+)
+
+// ErrHiveTLSUnsupported Hive 数据源不支持 TLS：驱动不支持 TLS，Doris JDBC Catalog 中的 Hive JDBC 驱动也无法加载请求中的证书
+var ErrHiveTLSUnsupported = errors.New("TLS connection is not supported for Hive data source")
+
+// HiveStrategy 通过 HiveServer2 访问 Hive 数据源
+// HiveServer2 不支持服务端参数绑定，查询参数在 Query 中转为字面量后再执行
+type HiveStrategy struct {
+	info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
+}
+
+func NewHiveStrategy(info *ds.ConnectionInfo) *HiveStrategy {
+	return &HiveStrategy{
+		info: info,
+	}
+}
+
+func (h *HiveStrategy) ConnectToDB() error {
+	return nil
+}
+
+func (h *HiveStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
+	// 驱动暂不支持 TLS，显式报错，避免静默降级为明文连接
+	if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
+		return ErrHiveTLSUnsupported
+	}
+
+	key := PoolFingerprint("hive", info)
+
+	hiveMutex.Lock()
+	defer hiveMutex.Unlock()
+
+	// 检查连接池是否已经存在
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		h.lease.Release()
+		h.DB, h.lease = db, lease
+		log.Logger.Debugf("Reusing existing Hive connection pool")
+		return nil
+	}
+
+	dsn := buildHiveDSN(info)
+	log.Logger.Infof("Connecting to Hive without TLS")
+
+	conf := config.GetConfigMap()
+
+	// 打开数据库连接
+	db, err := sql.Open("hive", dsn)
+	if err != nil {
+		log.Logger.Errorf("Failed to connect to Hive: %v", err)
+		return fmt.Errorf("failed to connect to Hive: %v", err)
+	}
+
+	// 设置连接池参数
+	db.SetMaxOpenConns(conf.Dbms.MaxOpenConns) // 最大打开连接数
+	db.SetMaxIdleConns(conf.Dbms.MaxIdleConns) // 最大空闲连接数
+	db.SetConnMaxLifetime(10 * time.Minute)    // 连接最大生命周期
+	db.SetConnMaxIdleTime(2 * time.Minute)     // 连接最大空闲时间
+
+	// 成功连接后，保存数据库实例
+	h.lease.Release()
+	h.DB, h.lease = GetPoolRegistry().Register(key, "hive", db)
+	log.Logger.Info("Successfully connected to Hive with username and password")
+	return nil
+}
+
+// buildHiveDSN 构建 HiveServer2 连接串，格式为 user:password@host:port/db?auth=xxx
+func buildHiveDSN(info *ds.ConnectionInfo) string {
+	// 未配置用户名时使用 NOSASL，否则使用 PLAIN（LDAP / 无认证的 HiveServer2 均适用）
+	auth := "NOSASL"
+	if info.User != "" {
+		auth = "PLAIN"
+	}
+	return fmt.Sprintf("%s:%s@%s:%d/%s?auth=%s",
+		info.User, info.Password, info.Host, info.Port, info.DbName, auth)
+}
+
+func (h *HiveStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+	log.Logger.Debugf("Executing query: %s with args: %v\n", sqlQuery, args)
+	// HiveServer2 不支持占位符，先将参数渲染为字面量
+	renderedQuery, err := renderHiveQuery(sqlQuery, args)
+	if err != nil {
+		log.Logger.Errorf("Failed to render Hive query: %v\n", err)
+		return nil, err
+	}
+	rows, err := h.DB.QueryContext(ctx, renderedQuery)
+	if err != nil {
+		log.Logger.Errorf("Query failed: %v\n", err)
+		return nil, err
+	}
+	log.Logger.Debugf("%s Query executed successfully", renderedQuery)
+
+	return rows, nil
+}
+
+// renderHiveQuery 将查询中的 ? 占位符替换为转义后的字面量，字符串字面量内的 ? 保持不变
+func renderHiveQuery(query string, args []interface{}) (string, error) {
+	if len(args) == 0 {
+		return query, nil
+	}
+
+	var sb strings.Builder
+	argIndex := 0
+	var quote byte // 当前所处的字符串字面量或标识符的引号，0 表示不在引号内
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			sb.WriteByte(c)
+			if c == '\\' && quote != '`' && i+1 < len(query) {
+				// 跳过被转义的字符
+				i++
+				sb.WriteByte(query[i])
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			sb.WriteByte(c)
+		case c == '?':
+			if argIndex >= len(args) {
+				return "", fmt.Errorf("insufficient arguments for query")
+			}
+			literal, err := formatHiveLiteral(args[argIndex])
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(literal)
+			argIndex++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	// 检查是否有多余参数
+	if argIndex < len(args) {
+		return "", fmt.Errorf("too many arguments for query")
+	}
+
+	return sb.String(), nil
+}
+
+// formatHiveLiteral 将参数格式化为 Hive 字面量，字符串中的反斜杠和单引号需要转义
+func formatHiveLiteral(arg interface{}) (string, error) {
+	switch v := arg.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		escaped := strings.ReplaceAll(v, `\`, `\\`)
+		escaped = strings.ReplaceAll(escaped, `'`, `\'`)
+		return "'" + escaped + "'", nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	default:
+		return "", fmt.Errorf("unsupported argument type: %T", arg)
+	}
+}
+
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
+func (h *HiveStrategy) Close() error {
+	h.lease.Release()
+	h.DB, h.lease = nil, nil
+	return nil
+}
+
+func (h *HiveStrategy) GetJdbcUrl() string {
+	// 构建 JDBC URL，HiveServer2 的会话参数使用分号分隔
+	jdbcUrl := fmt.Sprintf(
+		"jdbc:hive2://%s:%d/%s;user=%s;password=%s",
+		h.info.Host,
+		h.info.Port,
+		h.info.DbName,
+		h.info.User,     // 添加用户名
+		h.info.Password, // 添加密码
+	)
+	return jdbcUrl
+}
+
+// 从数据库游标中按行读取数据，并构建当前批次的 Arrow Record
+func (h *HiveStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
+	if rows == nil {
+		return nil, fmt.Errorf("no rows to convert")
+	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	// 创建内存分配器
+	pool := memory.NewGoAllocator()
+
+	// 获取列名
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, io.EOF
+	}
+
+	// 获取每列的类型
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %v", err)
+	}
+
+	// 构建 Arrow Schema
+	var fields []arrow.Field
+	for i, col := range cols {
+		log.Logger.Debugf("SQL type for column %s: %s", col, colTypes[i].DatabaseTypeName())
+		arrowType := ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE, colTypes[i])
+		fields = append(fields, arrow.Field{Name: hiveColumnName(col), Type: arrowType})
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	// 创建 Arrow RecordBuilder
+	builder := array.NewRecordBuilder(pool, schema)
+	defer builder.Release()
+
+	// 准备存储行数据的容器
+	values := make([]interface{}, len(cols))
+	valuePtrs := make([]interface{}, len(cols))
+	for i := range valuePtrs {
+		valuePtrs[i] = &values[i]
+	}
+	rowCount := 0
+	// 遍历 rows 并填充 Arrow Builder
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		// 将值添加到 Arrow Builder 中
+		for i, val := range values {
+			err := utils.AppendValueToBuilder(builder.Field(i), val)
+			if err != nil {
+				log.Logger.Errorf("Failed to append value for column %d: %v", i, err)
+				return nil, err
+			}
+		}
+		rowCount++
+		if rowCount >= batchSize {
+			break // 达到批次大小，结束循环，发送给客户端
+		}
+	}
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	// 检查是否还有剩余的行数据
+	if rowCount == 0 {
+		// 如果没有更多数据，返回 io.EOF 表示结束
+		return nil, io.EOF
+	}
+
+	// 创建 Arrow 批次 (Record)
+	record := builder.NewRecord()
+	return record, nil
+}
+
+// hiveColumnName HiveServer2 默认返回 "表名.列名" 形式的列名，这里只保留列名
+func hiveColumnName(col string) string {
+	if idx := strings.LastIndex(col, "."); idx >= 0 {
+		return col[idx+1:]
+	}
+	return col
+}
+
+func (h *HiveStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
+	createTableSQL := buildCreateHiveTableSQL(tableName, schema)
+	log.Logger.Infof("createTableSQL: %s", createTableSQL)
+	if _, err := h.DB.ExecContext(ctx, createTableSQL); err != nil {
+		return fmt.Errorf("failed to create table: %v", err)
+	}
+	log.Logger.Infof("Created table if not exists: %s", tableName)
+	return nil
+}
+
+// buildCreateHiveTableSQL 根据 Arrow Schema 生成创建表的 SQL 语句
+func buildCreateHiveTableSQL(tableName string, schema *arrow.Schema) string {
+	return buildCreateTableSQL(hiveDialect, tableName, schema, true) + " STORED AS ORC"
+}
+
+func (h *HiveStrategy) GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+	qualifiedTable := hiveDialect.QualifyTableName(database, tableName)
+
+	var result TableInfoResponse
+
+	if isExactQuery {
+		// 精确查询时，只查询记录数
+		sqlQuery := fmt.Sprintf("SELECT COUNT(*) AS table_rows FROM %s", qualifiedTable)
+		log.Logger.Infof("Executing query: %s with parameters: database=%s, tableName=%s", sqlQuery, database, tableName)
+
+		var rowCount int64
+		if err := h.DB.QueryRowContext(ctx, sqlQuery).Scan(&rowCount); err != nil {
+			return nil, err
+		}
+		result.TableSchema = ""      // 没有表模式
+		result.TableName = tableName // 返回表名
+		result.TableRows = clampHiveRowCount(rowCount)
+		result.TableSize = 0 // 精确查询不关心表的大小
+	} else {
+		// 检查是否启用估算模式
+		if ShouldUseEstimationOnly() {
+			// 直接使用估算方式，跳过表统计信息查询
+			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping Hive table statistics")
+			FillTableInfoFromEstimation(ctx, h, database, tableName, database, &result)
+		} else {
+			// 普通查询，先尝试从 Hive 表统计信息（TBLPROPERTIES）获取
+			props, err := h.getTableProperties(ctx, qualifiedTable)
+			rowCount, size := parseHiveTableStats(props)
+			result.TableSchema = database
+			result.TableName = tableName
+			result.TableRows = clampHiveRowCount(rowCount)
+			result.TableSize = size
+			if err != nil || result.TableRows == 0 {
+				// 未执行 ANALYZE 或分区表没有表级统计信息时，使用估算方式
+				LogQueryFailure("hive table statistics", err, result.TableName, result.TableRows)
+				FillTableInfoFromEstimation(ctx, h, database, tableName, database, &result)
+			}
+		}
+	}
+
+	// 获取表结构信息
+	columns, keys, err := h.DescribeTable(ctx, database, tableName)
+	if err != nil {
+		log.Logger.Errorf("Failed to get table schema: %v", err)
+		// 不返回错误，只记录日志，表结构信息为空
+	}
+
+	log.Logger.Infof("Table info: %+v", result)
+
+	// 返回查询结果
+	return &ds.TableInfoResponse{
+		TableName:   result.TableName,
+		RecordCount: result.TableRows,
+		RecordSize:  result.TableSize,
+		Columns:     columns,
+		Keys:        keys,
+	}, nil
+}
+
+// getTableProperties 读取 Hive 表属性，统计信息（numRows/totalSize/rawDataSize）保存在其中
+func (h *HiveStrategy) getTableProperties(ctx context.Context, qualifiedTable string) (map[string]string, error) {
+	rows, err := h.DB.QueryContext(ctx, fmt.Sprintf("SHOW TBLPROPERTIES %s", qualifiedTable))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	props := make(map[string]string)
+	for rows.Next() {
+		var name, value sql.NullString
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, err
+		}
+		props[strings.TrimSpace(name.String)] = strings.TrimSpace(value.String)
+	}
+	return props, rows.Err()
+}
+
+// parseHiveTableStats 从表属性中解析行数和大小，统计缺失或无效（-1）时返回 0
+func parseHiveTableStats(props map[string]string) (int64, int64) {
+	parse := func(key string) int64 {
+		v, err := strconv.ParseInt(props[key], 10, 64)
+		if err != nil || v < 0 {
+			return 0
+		}
+		return v
+	}
+
+	rowCount := parse("numRows")
+	// totalSize 为存储上的实际大小，缺失时退化为未压缩的 rawDataSize
+	size := parse("totalSize")
+	if size == 0 {
+		size = parse("rawDataSize")
+	}
+	return rowCount, size
+}
+
+// clampHiveRowCount TableInfoResponse 的行数为 int32，超出范围时截断
+func clampHiveRowCount(rowCount int64) int32 {
+	if rowCount > math.MaxInt32 {
+		log.Logger.Warnf("Hive table row count %d exceeds int32, truncated", rowCount)
+		return math.MaxInt32
+	}
+	return int32(rowCount)
+}
+
+// DescribeTable 获取字段元数据，Hive 不强制约束，不返回可空性和表键信息
+func (h *HiveStrategy) DescribeTable(ctx context.Context, database, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	columns, err := h.getTableSchema(ctx, hiveDialect.QualifyTableName(database, tableName))
+	return columns, nil, err
+}
+
+// 获取表结构信息
+func (h *HiveStrategy) getTableSchema(ctx context.Context, qualifiedTable string) ([]*ds.ColumnItem, error) {
+	rows, err := h.DB.QueryContext(ctx, fmt.Sprintf("DESCRIBE %s", qualifiedTable))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []*ds.ColumnItem
+	for rows.Next() {
+		var name, dataType, comment sql.NullString
+		if err := rows.Scan(&name, &dataType, &comment); err != nil {
+			return nil, err
+		}
+		colName := strings.TrimSpace(name.String)
+		// 空行或 "# Partition Information" 之后是分区列的重复描述
+		if colName == "" || strings.HasPrefix(colName, "#") {
+			break
+		}
+		columns = append(columns, &ds.ColumnItem{
+			Name:     colName,
+			DataType: strings.TrimSpace(dataType.String),
+			Comment:  strings.TrimSpace(comment.String),
+		})
+	}
+
+	return columns, rows.Err()
+}
+
+func (h *HiveStrategy) BuildWithConditionQuery(
+	tableName string,
+	fields []string,
+	filterNames []string,
+	filterOperators []ds.FilterOperator,
+	filterValues []*ds.FilterValue,
+	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
+) (string, []interface{}, error) {
+	return buildConditionQuery(hiveDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, nil)
+}
+
+func (h *HiveStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", hiveDialect.QuoteIdentifier(dbName))
+	_, err := h.DB.ExecContext(ctx, createQuery)
+	if err != nil {
+		return fmt.Errorf("failed to create Hive database '%s': %v", dbName, err)
+	}
+	log.Logger.Infof("Created Hive database '%s'", dbName)
+	return nil
+}
+
+func (h *HiveStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
+	query, err := renderHiveQuery("SHOW TABLES LIKE ?", []interface{}{tableName})
+	if err != nil {
+		return false, err
+	}
+	rows, err := h.DB.QueryContext(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	return rows.Next(), rows.Err()
+}
+
+func (h *HiveStrategy) CleanupOldTables(ctx context.Context, dbName string, retentionDays int) error {
+	// 获取当前日期并计算保留的截止日期
+	cutoffDate := time.Now().AddDate(0, 0, -retentionDays).Format("20060102")
+
+	// 查询符合条件的表，Hive 的 LIKE 使用 * 作为通配符
+	query := fmt.Sprintf("SHOW TABLES IN %s LIKE ?", hiveDialect.QuoteIdentifier(dbName))
+	rows, err := h.Query(ctx, query, "20*")
+	if err != nil {
+		return fmt.Errorf("failed to query tables: %v", err)
+	}
+
+	var expiredTables []string
+	for rows.Next() {
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan table name: %v", err)
+		}
+		// 检查表名是否早于保留日期
+		if len(tableName) >= 8 && tableName[:8] < cutoffDate {
+			expiredTables = append(expiredTables, tableName)
+		}
+	}
+	rows.Close()
+
+	// 遍历结果，删除过期表
+	for _, tableName := range expiredTables {
+		dropQuery := fmt.Sprintf("DROP TABLE IF EXISTS %s", hiveDialect.QualifyTableName(dbName, tableName))
+		if _, err := h.DB.ExecContext(ctx, dropQuery); err != nil {
+			log.Logger.Errorf("failed to drop table '%s': %v", tableName, err)
+		} else {
+			log.Logger.Infof("Dropped table '%s'", tableName)
+		}
+	}
+
+	return nil
+}
+
+func (h *HiveStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
+	queryTools := &QueryBuilder{}
+
+	// 使用BuildGroupCountQuery生成SQL查询语句和参数，占位符由 Query 渲染为字面量
+	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators,
+		filterValues, filterExpr, ds.DataSourceType_DATA_SOURCE_TYPE_HIVE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build group count query: %v", err)
+	}
+
+	// 执行查询
+	log.Logger.Debugf("Executing group count query: %s with args: %v", query, args)
+	rows, err := h.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute group count query: %v", err)
+	}
+	defer rows.Close()
+
+	return ProcessGroupCountResults(rows, tableName)
+}
+
+// GetTableRowCount 实现 TableInfoEstimator 接口
+func (h *HiveStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", hiveDialect.QualifyTableName(database, tableName))
+	var rowCount int64
+	if err := h.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
+		return 0, err
+	}
+	return clampHiveRowCount(rowCount), nil
+}
+
+// EstimateTableSize 实现 TableInfoEstimator 接口，通过采样数据估算表的大小
+func (h *HiveStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
+	// 获取100条数据估算平均行大小
+	sampleQuery := hiveDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", hiveDialect.QualifyTableName(database, tableName)), 100, 0)
+	rows, err := h.DB.QueryContext(ctx, sampleQuery)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
+	}
+	defer rows.Close()
+
+	// 获取列信息
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get columns: %v", err)
+	}
+
+	// 计算样本数据的总大小
+	var totalSampleSize int64
+	rowCount := 0
+	values := make([]interface{}, len(columns))
+	valuePtrs := make([]interface{}, len(columns))
+	for i := range valuePtrs {
+		valuePtrs[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			log.Logger.Errorf("Failed to scan sample row: %v", err)
+			continue
+		}
+
+		// 估算每行的大小
+		totalSampleSize += common.EstimateRowSize(values)
+		rowCount++
+	}
+
+	// 根据样本数据估算总大小
+	if rowCount > 0 {
+		avgRowSize := totalSampleSize / int64(rowCount)
+		estimatedSize := avgRowSize * int64(totalRows)
+		log.Logger.Infof("Estimated table size: %d bytes (avg row size: %d, total rows: %d)",
+			estimatedSize, avgRowSize, totalRows)
+		return estimatedSize, nil
+	}
+
+	return 0, fmt.Errorf("no sample data available for size estimation")
+}
@@ -0,0 +1,230 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWithConditionQueryHive(t *testing.T) {
+	testCases := []struct {
+		name            string
+		tableName       string
+		fields          []string
+		filterNames     []string
+		filterOperators []ds.FilterOperator
+		filterValues    []*ds.FilterValue
+		sortRules       []*ds.SortRule
+		expectedQuery   string
+		expectedArgs    []interface{}
+	}{
+		{
+			name:            "Test Greater Than Operator with ORDER BY",
+			tableName:       "users",
+			fields:          []string{"id", "name"},
+			filterNames:     []string{"age"},
+			filterOperators: []ds.FilterOperator{ds.FilterOperator_GREATER_THAN},
+			filterValues:    []*ds.FilterValue{{IntValue: 30}},
+			sortRules: []*ds.SortRule{
+				{FieldName: "name", SortOrder: ds.SortOrder_ASC},
+			},
+			expectedQuery: "SELECT `id`, `name` FROM `users` WHERE `age` > ? ORDER BY `name` ASC",
+			expectedArgs:  []interface{}{int32(30)},
+		},
+		{
+			name:            "Test IN Operator with qualified table name",
+			tableName:       "ods.orders",
+			fields:          []string{"`order_id`"},
+			filterNames:     []string{"status"},
+			filterOperators: []ds.FilterOperator{ds.FilterOperator_IN_OPERATOR},
+			filterValues:    []*ds.FilterValue{{StrValues: []string{"paid", "shipped"}}},
+			expectedQuery:   "SELECT `order_id` FROM `ods`.`orders` WHERE `status` IN (?, ?)",
+			expectedArgs:    []interface{}{"paid", "shipped"},
+		},
+		{
+			name:          "Test Query without Sorting or Conditions",
+			tableName:     "customers",
+			expectedQuery: "SELECT * FROM `customers`",
+			expectedArgs:  []interface{}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hiveStrategy := HiveStrategy{}
+			query, args, err := hiveStrategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules, nil)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if query != tc.expectedQuery {
+				t.Errorf("Expected query: %s, got: %s", tc.expectedQuery, query)
+			}
+
+			if !reflect.DeepEqual(args, tc.expectedArgs) {
+				t.Errorf("Expected args: %v, got: %v", tc.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestRenderHiveQuery(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		args    []interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Test string and number arguments",
+			query: "SELECT * FROM `t` WHERE `name` = ? AND `age` > ?",
+			args:  []interface{}{"o'brien\\", int32(18)},
+			want:  "SELECT * FROM `t` WHERE `name` = 'o\\'brien\\\\' AND `age` > 18",
+		},
+		{
+			name:  "Test placeholder inside literal is kept",
+			query: "SELECT '?' AS q, `a?` FROM `t` WHERE `flag` = ?",
+			args:  []interface{}{true},
+			want:  "SELECT '?' AS q, `a?` FROM `t` WHERE `flag` = TRUE",
+		},
+		{
+			name:    "Test insufficient arguments",
+			query:   "SELECT * FROM `t` WHERE `a` = ? AND `b` = ?",
+			args:    []interface{}{1},
+			wantErr: true,
+		},
+		{
+			name:    "Test too many arguments",
+			query:   "SELECT * FROM `t` WHERE `a` = ?",
+			args:    []interface{}{1, 2},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := renderHiveQuery(tc.query, tc.args)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseHiveTableStats(t *testing.T) {
+	testCases := []struct {
+		name     string
+		props    map[string]string
+		wantRows int64
+		wantSize int64
+	}{
+		{
+			name:     "Test table with statistics",
+			props:    map[string]string{"numRows": "1200", "totalSize": "40960", "rawDataSize": "102400"},
+			wantRows: 1200,
+			wantSize: 40960,
+		},
+		{
+			name:     "Test fall back to rawDataSize",
+			props:    map[string]string{"numRows": "10", "rawDataSize": "2048"},
+			wantRows: 10,
+			wantSize: 2048,
+		},
+		{
+			name:     "Test statistics not computed",
+			props:    map[string]string{"numRows": "-1", "totalSize": "-1"},
+			wantRows: 0,
+			wantSize: 0,
+		},
+		{
+			name:     "Test missing statistics",
+			props:    map[string]string{},
+			wantRows: 0,
+			wantSize: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rows, size := parseHiveTableStats(tc.props)
+			assert.Equal(t, tc.wantRows, rows)
+			assert.Equal(t, tc.wantSize, size)
+		})
+	}
+}
+
+func TestHiveGetTablePropertiesAndSchema(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SHOW TBLPROPERTIES `ods`.`orders`").
+		WillReturnRows(sqlmock.NewRows([]string{"prpt_name", "prpt_value"}).
+			AddRow("numRows", "300").
+			AddRow("totalSize", "8192 "))
+	mock.ExpectQuery("DESCRIBE `ods`.`orders`").
+		WillReturnRows(sqlmock.NewRows([]string{"col_name", "data_type", "comment"}).
+			AddRow("id", "bigint", "").
+			AddRow("amount", "decimal(10,2)", "").
+			AddRow("dt", "string", "").
+			AddRow("", nil, nil).
+			AddRow("# Partition Information", nil, nil).
+			AddRow("dt", "string", ""))
+
+	strategy := &HiveStrategy{DB: db}
+	qualifiedTable := hiveDialect.QualifyTableName("ods", "orders")
+
+	props, err := strategy.getTableProperties(context.Background(), qualifiedTable)
+	assert.NoError(t, err)
+	rows, size := parseHiveTableStats(props)
+	assert.Equal(t, int64(300), rows)
+	assert.Equal(t, int64(8192), size)
+
+	columns, err := strategy.getTableSchema(context.Background(), qualifiedTable)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ds.ColumnItem{
+		{Name: "id", DataType: "bigint"},
+		{Name: "amount", DataType: "decimal(10,2)"},
+		{Name: "dt", DataType: "string"},
+	}, columns)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
+
+func TestHiveTypeConversion(t *testing.T) {
+	hive := ds.DataSourceType_DATA_SOURCE_TYPE_HIVE
+	assert.Equal(t, "INT", ParseNativeType("INT_TYPE").Name)
+	assert.Equal(t, "DECIMAL", ParseNativeType("decimal(10,2)").Name)
+	assert.Equal(t, "ARRAY", ParseNativeType("array<string>").Name)
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, ArrowType(hive, ParseNativeType("BIGINT")))
+	assert.Equal(t, arrow.BinaryTypes.LargeString, ArrowType(hive, ParseNativeType("STRING")))
+	assert.Equal(t, timestampType, ArrowType(hive, ParseNativeType("TIMESTAMP")))
+	assert.Equal(t, "amount", hiveColumnName("orders.amount"))
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "name", Type: &arrow.StringType{}},
+		{Name: "amount", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+	}, nil)
+	assert.Equal(t, "CREATE TABLE IF NOT EXISTS `ods`.`test_table` (`name` STRING, `amount` DECIMAL(10,2)) STORED AS ORC",
+		buildCreateHiveTableSQL("ods.test_table", schema))
+}
+
+func TestHiveConnectRejectsTLS(t *testing.T) {
+	info := &ds.ConnectionInfo{Host: "10.0.0.1", Port: 10000, DbName: "default",
+		TlsConfig: &ds.DatasourceTlsConfig{UseTls: 2, Mode: 2, CaCert: "ca"}}
+	assert.ErrorIs(t, (&HiveStrategy{}).ConnectToDBWithPass(info), ErrHiveTLSUnsupported)
+}
@@ -10,6 +10,7 @@
 package database
 
 import (
+	"context"
 	"data-service/common"
 	"data-service/config"
 	ds "data-service/generated/datasource"
@@ -21,7 +22,6 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"strings"
 	"sync"
 	"time"
 
@@ -32,14 +32,14 @@ import (
 )
 
 var (
-	kingbasePoolMap = make(map[string]*sql.DB)
-	kingbaseMutex   sync.Mutex
+	kingbaseMutex sync.Mutex
 )
 
 // KingbaseStrategy is a struct that implements DatabaseStrategy for Kingbase database
 type KingbaseStrategy struct {
-	info *ds.ConnectionInfo
-	DB   *sql.DB
+	info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
 }
 
 func NewKingbaseStrategy(info *ds.ConnectionInfo) *KingbaseStrategy {
@@ -48,10 +48,14 @@ func NewKingbaseStrategy(info *ds.ConnectionInfo) *KingbaseStrategy {
 	}
 }
 
-func (k *KingbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.Record, error) {
+func (k *KingbaseStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
 	if rows == nil {
 		return nil, fmt.Errorf("no rows to convert")
 	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
 
 	// 创建内存分配器
 	pool := memory.NewGoAllocator()
@@ -72,11 +76,7 @@ func (k *KingbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arro
 	var fields []arrow.Field
 	for i, col := range cols {
 		log.Logger.Debugf("SQL type for column %s: %s", col, colTypes[i].DatabaseTypeName())
-		arrowType, err := kingbaseTypeToArrowType(colTypes[i])
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert SQL type to Arrow type: %v", err)
-		}
-		fields = append(fields, arrow.Field{Name: col, Type: arrowType})
+		fields = append(fields, arrow.Field{Name: col, Type: ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, colTypes[i])})
 	}
 	schema := arrow.NewSchema(fields, nil)
 
@@ -109,6 +109,11 @@ func (k *KingbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arro
 			break // 达到批次大小，结束循环，发送给客户端
 		}
 	}
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+
 	// 检查是否还有剩余的行数据
 	if rowCount == 0 {
 		// 如果没有更多数据，返回 io.EOF 表示结束
@@ -120,99 +125,16 @@ func (k *KingbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arro
 	return record, nil
 }
 
-// 将 SQL 列类型转换为 Arrow 类型
-func kingbaseTypeToArrowType(colType *sql.ColumnType) (arrow.DataType, error) {
-	// 获取数据库类型名称
-	dbTypeName := strings.ToUpper(colType.DatabaseTypeName())
-
-	// 处理浮点数类型
-	if strings.Contains(dbTypeName, "FLOAT") || strings.Contains(dbTypeName, "DOUBLE") {
-		return arrow.PrimitiveTypes.Float64, nil
-	}
-
-	if dbTypeName == "REAL" {
-		return arrow.PrimitiveTypes.Float32, nil
-	}
-
-	if strings.Contains(dbTypeName, "DECIMAL") || strings.Contains(dbTypeName, "NUMERIC") {
-		precision, scale, ok := colType.DecimalSize()
-		if !ok {
-			return nil, fmt.Errorf("unable to get precision and scale for column: %s", colType.Name())
-		}
-		return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}, nil
-	}
-
-	// 处理 INT 和无符号类型
-	switch {
-	case strings.Contains(dbTypeName, "TINYINT"):
-		return arrow.PrimitiveTypes.Int8, nil
-	case strings.Contains(dbTypeName, "SMALLINT"):
-		return arrow.PrimitiveTypes.Int16, nil
-	case strings.Contains(dbTypeName, "MEDIUMINT"):
-		return arrow.PrimitiveTypes.Int32, nil
-	case strings.Contains(dbTypeName, "BIGINT"):
-		return arrow.PrimitiveTypes.Int64, nil
-	case strings.Contains(dbTypeName, "INT"), dbTypeName == "INTEGER":
-		return arrow.PrimitiveTypes.Int32, nil
-	case strings.Contains(dbTypeName, "UNSIGNED TINYINT"):
-		return arrow.PrimitiveTypes.Uint8, nil
-	case strings.Contains(dbTypeName, "UNSIGNED SMALLINT"):
-		return arrow.PrimitiveTypes.Uint16, nil
-	case strings.Contains(dbTypeName, "UNSIGNED MEDIUMINT"), strings.Contains(dbTypeName, "UNSIGNED INT"):
-		return arrow.PrimitiveTypes.Uint32, nil
-	case strings.Contains(dbTypeName, "UNSIGNED BIGINT"):
-		return arrow.PrimitiveTypes.Uint64, nil
-	}
-
-	// 处理 CHAR 或 TEXT 类型
-	if strings.Contains(dbTypeName, "CHAR") {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	if strings.Contains(dbTypeName, "TEXT") {
-		return arrow.BinaryTypes.LargeString, nil
-	}
-
-	// 处理 TIME 类型
-	if dbTypeName == "TIME" {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	// 处理 YEAR 类型
-	if dbTypeName == "YEAR" {
-		return arrow.PrimitiveTypes.Int64, nil
-	}
-
-	// 处理 DATE 类型
-	if dbTypeName == "DATE" {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	// 处理 TIMESTAMP 类型
-	if dbTypeName == "TIMESTAMP" {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	// 处理其他类型
-	return arrow.BinaryTypes.String, nil
-}
-
 func (k *KingbaseStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
-	dbType := utils.GetDbTypeName(info.Dbtype)
-
-	// 构建连接池键，包含 TLS 配置信息
-	tlsKey := "no-tls"
-	if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
-		tlsKey = fmt.Sprintf("tls-%d-%s", info.TlsConfig.Mode, info.TlsConfig.ServerName)
-	}
-	key := fmt.Sprintf("%s_%s_%d_%s_%s_%s", dbType, info.Host, info.Port, info.DbName, info.Password, tlsKey)
+	key := PoolFingerprint("kingbase", info)
 
 	kingbaseMutex.Lock()
 	defer kingbaseMutex.Unlock()
 
 	// 检查连接池是否已经存在
-	if db, ok := kingbasePoolMap[key]; ok {
-		k.DB = db
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		k.lease.Release()
+		k.DB, k.lease = db, lease
 		log.Logger.Debugf("Reusing existing Kingbase connection pool")
 		return nil
 	}
@@ -257,8 +179,8 @@ func (k *KingbaseStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
 	db.SetMaxIdleConns(conf.Dbms.MaxIdleConns) // 最大空闲连接数
 
 	// 成功连接后，保存数据库实例
-	k.DB = db
-	kingbasePoolMap[key] = db
+	k.lease.Release()
+	k.DB, k.lease = GetPoolRegistry().Register(key, "kingbase", db)
 	log.Logger.Info("Successfully connected to Kingbase with username and password")
 	return nil
 }
@@ -267,10 +189,10 @@ func (k *KingbaseStrategy) ConnectToDB() error {
 	return nil
 }
 
-func (k *KingbaseStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+func (k *KingbaseStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
 	log.Logger.Debugf("Executing query: %s with args: %v\n", sqlQuery, args)
 	// 执行查询，args 用于绑定 SQL 查询中的占位符
-	rows, err := k.DB.Query(sqlQuery, args...)
+	rows, err := k.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		log.Logger.Errorf("Query failed: %v\n", err)
 		return nil, err
@@ -281,21 +203,11 @@ func (k *KingbaseStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Row
 
 }
 
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
 func (k *KingbaseStrategy) Close() error {
-	// 检查数据库连接是否已经初始化
-	if k.DB != nil {
-		err := k.DB.Close()
-		if err != nil {
-			log.Logger.Errorf("Failed to close Kingbase connection: %v", err)
-			return err
-		}
-		log.Logger.Info("Kingbase connection closed successfully")
-		return nil
-	}
-	// 如果数据库连接未初始化，直接返回 nil
-	log.Logger.Warn("Attempted to close a non-initialized DB connection")
+	k.lease.Release()
+	k.DB, k.lease = nil, nil
 	return nil
-
 }
 
 func (k *KingbaseStrategy) GetJdbcUrl() string {
@@ -311,11 +223,11 @@ func (k *KingbaseStrategy) GetJdbcUrl() string {
 	return jdbcUrl
 }
 
-func (k *KingbaseStrategy) CreateTemporaryTableIfNotExists(tableName string, schema *arrow.Schema) error {
+func (k *KingbaseStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
 
 	// 检查表是否存在
 	var exists bool
-	err := k.DB.QueryRow(`SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1)`, tableName).Scan(&exists)
+	err := k.DB.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1)`, tableName).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check if table exists: %v", err)
 	}
@@ -324,7 +236,7 @@ func (k *KingbaseStrategy) CreateTemporaryTableIfNotExists(tableName string, sch
 		// 创建临时表
 		createTableSQL := buildCreateKingbaseTableSQL(tableName, schema)
 		log.Logger.Infof("createTableSQL: %s", createTableSQL)
-		_, err = k.DB.Exec(createTableSQL)
+		_, err = k.DB.ExecContext(ctx, createTableSQL)
 		if err != nil {
 			return fmt.Errorf("failed to create table: %v", err)
 		}
@@ -338,69 +250,17 @@ func (k *KingbaseStrategy) CreateTemporaryTableIfNotExists(tableName string, sch
 
 // buildCreateTableSQL 根据 Arrow Schema 生成创建表的 SQL 语句
 func buildCreateKingbaseTableSQL(tableName string, schema *arrow.Schema) string {
-	var columns []string
-	for _, field := range schema.Fields() {
-		columnType := convertArrowTypeToKingbaseType(field.Type)
-		columns = append(columns, fmt.Sprintf("\"%s\" %s", field.Name, columnType))
-	}
-	return fmt.Sprintf("CREATE TABLE \"%s\" (%s)", tableName, strings.Join(columns, ", "))
+	return buildCreateTableSQL(kingbaseDialect, tableName, schema, false)
 }
 
-// convertArrowTypeToSQLType 将 Arrow 数据类型转换为 SQL 数据类型
-func convertArrowTypeToKingbaseType(arrowType arrow.DataType) string {
-	switch arrowType.ID() {
-	case arrow.INT8:
-		return "SMALLINT"
-	case arrow.INT16:
-		return "SMALLINT"
-	case arrow.INT32:
-		return "INTEGER"
-	case arrow.INT64:
-		return "BIGINT"
-	case arrow.UINT8:
-		return "SMALLINT"
-	case arrow.UINT16:
-		return "INTEGER"
-	case arrow.UINT32:
-		return "BIGINT"
-	case arrow.UINT64:
-		return "NUMERIC(20)"
-	case arrow.FLOAT32:
-		return "REAL"
-	case arrow.FLOAT64:
-		return "DOUBLE PRECISION"
-	case arrow.BOOL:
-		return "BOOLEAN"
-	case arrow.STRING:
-		return "VARCHAR(255)"
-	case arrow.LARGE_STRING:
-		return "TEXT"
-	case arrow.BINARY:
-		return "BYTEA"
-	case arrow.TIMESTAMP:
-		return "TIMESTAMP"
-	case arrow.DATE32:
-		return "DATE"
-	case arrow.DATE64:
-		return "TIMESTAMP"
-	case arrow.DECIMAL128, arrow.DECIMAL256:
-		decimalType := arrowType.(arrow.FixedWidthDataType)
-		precision := decimalType.(*arrow.Decimal128Type).Precision
-		scale := decimalType.(*arrow.Decimal128Type).Scale
-		return fmt.Sprintf("DECIMAL(%d,%d)", precision, scale)
-	default:
-		return "TEXT" // 默认使用 TEXT 类型
-	}
-}
-
-func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+func (k *KingbaseStrategy) GetTableInfo(ctx context.Context, schemaName string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
 	// 定义 SQL 查询
 	var sqlQuery string
 
 	if isExactQuery {
 		// 精确查询时，只查询记录数
 		// 使用 fmt.Sprintf 动态构建查询语句，注意 schemaName 和 tableName 是直接插入的
-		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s", tableName)
+		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s", kingbaseDialect.QuoteTableName(tableName))
 	} else {
 		// 普通查询，获取表的相关信息
 		// 使用参数化查询来防止 SQL 注入，确保 schemaName 和 tableName 不被直接拼接
@@ -415,7 +275,7 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 
 	if isExactQuery {
 		// 如果是精确查询，只获取记录数
-		err := k.DB.QueryRow(sqlQuery).Scan(&result.TableRows)
+		err := k.DB.QueryRowContext(ctx, sqlQuery).Scan(&result.TableRows)
 		if err != nil {
 			log.Logger.Errorf("Error executing query: %v", err)
 			return nil, fmt.Errorf("error executing query: %v", err)
@@ -428,20 +288,20 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 		if ShouldUseEstimationOnly() {
 			// 直接使用估算方式，跳过 pg_stat_user_tables 查询
 			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping pg_stat_user_tables query")
-			FillTableInfoFromEstimation(k, "", tableName, "public", &result)
+			FillTableInfoFromEstimation(ctx, k, "", tableName, "public", &result)
 		} else {
 			// 普通查询，先尝试从 pg_stat_user_tables 获取
-			err := k.DB.QueryRow(sqlQuery, tableName, "public").Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
+			err := k.DB.QueryRowContext(ctx, sqlQuery, tableName, "public").Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
 			if err != nil || result.TableName == "" || result.TableRows == 0 {
 				// 查询失败或返回空结果，使用估算方式
 				LogQueryFailure("pg_stat_user_tables", err, result.TableName, result.TableRows)
-				FillTableInfoFromEstimation(k, "", tableName, "public", &result)
+				FillTableInfoFromEstimation(ctx, k, "", tableName, "public", &result)
 			}
 		}
 	}
 
 	// 获取表结构信息
-	columns, err := k.getTableSchema(schemaName, tableName)
+	columns, keys, err := k.DescribeTable(ctx, schemaName, tableName)
 	if err != nil {
 		log.Logger.Errorf("Failed to get table schema: %v", err)
 		// 不返回错误，只记录日志，表结构信息为空
@@ -455,154 +315,27 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 		RecordCount: result.TableRows,
 		RecordSize:  result.TableSize,
 		Columns:     columns,
+		Keys:        keys,
 	}, nil
 }
 
-// 获取表结构信息
-func (k *KingbaseStrategy) getTableSchema(schemaName, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT column_name, data_type, character_maximum_length, is_nullable, column_default
-              FROM information_schema.columns 
-              WHERE table_schema = $1 AND table_name = $2
-              ORDER BY ordinal_position`
-
-	rows, err := k.DB.Query(query, "public", tableName)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var columns []*ds.ColumnItem
-	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
-		var isNullable, columnDefault sql.NullString
-
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault)
-		if err != nil {
-			return nil, err
-		}
-
-		columns = append(columns, &col)
-	}
-
-	return columns, nil
+// DescribeTable 获取字段元数据与表键信息，表位于 public 模式下
+func (k *KingbaseStrategy) DescribeTable(ctx context.Context, _ string, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	return describePostgresTable(ctx, k.DB, "public", tableName)
 }
 
 func (k *KingbaseStrategy) BuildWithConditionQuery(tableName string, fields []string, filterNames []string,
-	filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, sortRules []*ds.SortRule) (string, []interface{}, error) {
-	quoteIdentifier := func(identifier string) string {
-		return fmt.Sprintf("\"%s\"", identifier)
-	}
-
-	var queryBuilder strings.Builder
-	queryTools := &QueryBuilder{}
-	args := make([]interface{}, 0)
-	paramIndex := 1
-
-	// 构建 SELECT 子句
-	queryBuilder.WriteString("SELECT ")
-	if len(fields) > 0 {
-		// 先去掉反引号，再加上双引号
-		quotedFields := make([]string, len(fields))
-		for i, f := range fields {
-			trimmed := strings.TrimSpace(f)
-			// 去除两端反引号
-			trimmed = strings.Trim(trimmed, "`")
-			// 如果已经有双引号则不重复加
-			if strings.HasPrefix(trimmed, "\"") && strings.HasSuffix(trimmed, "\"") {
-				quotedFields[i] = trimmed
-			} else {
-				quotedFields[i] = fmt.Sprintf("\"%s\"", trimmed)
-			}
-		}
-		queryBuilder.WriteString(strings.Join(quotedFields, ", "))
-	} else {
-		queryBuilder.WriteString("*")
-	}
-	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(quoteIdentifier(tableName))
-
-	// 构建 WHERE 子句
-	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
-		queryBuilder.WriteString(" WHERE ")
-
-		conditions := make([]string, len(filterNames))
-		for i := range filterNames {
-			operator := filterOperators[i]
-			filterName := filterNames[i]
-			filterValue := filterValues[i]
-
-			// 使用 ExtractQueryArgs 提取参数
-			argsFromFilter := queryTools.ExtractQueryArgs([]*ds.FilterValue{filterValue})
-
-			// 根据运算符类型处理不同的条件
-			switch operator {
-			case ds.FilterOperator_IN_OPERATOR:
-				if len(argsFromFilter) > 0 {
-					// filterName字段加上普通索引，加速查询
-					err := AddIndexToFilterName(k.DB, pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, k.info.DbName, tableName, filterName)
-					if err != nil {
-						return "", nil, err
-					}
-					inPlaceholders := make([]string, len(argsFromFilter))
-					for j := range argsFromFilter {
-						inPlaceholders[j] = fmt.Sprintf("$%d", paramIndex)
-						args = append(args, argsFromFilter[j])
-						paramIndex++
-					}
-					conditions[i] = fmt.Sprintf("%s IN (%s)", filterName, strings.Join(inPlaceholders, ", "))
-				}
-
-			case ds.FilterOperator_GREATER_THAN, ds.FilterOperator_LESS_THAN,
-				ds.FilterOperator_GREATER_THAN_OR_EQUAL, ds.FilterOperator_LESS_THAN_OR_EQUAL,
-				ds.FilterOperator_NOT_EQUAL:
-				if len(argsFromFilter) > 0 {
-					conditions[i] = fmt.Sprintf("%s %s $%d", filterName, queryTools.OperatorToString(operator), paramIndex)
-					args = append(args, argsFromFilter[0]) // 提取第一个参数
-					paramIndex++
-				}
-
-			case ds.FilterOperator_LIKE_OPERATOR:
-				if len(argsFromFilter) > 0 {
-					conditions[i] = fmt.Sprintf("%s LIKE $%d", filterName, paramIndex)
-					args = append(args, argsFromFilter[0]) // 提取第一个参数
-					paramIndex++
-				}
-
-			default:
-				if len(argsFromFilter) > 0 {
-					conditions[i] = fmt.Sprintf("%s = $%d", filterName, paramIndex)
-					args = append(args, argsFromFilter[0]) // 提取第一个参数
-					paramIndex++
-				}
-			}
-		}
-
-		queryBuilder.WriteString(strings.Join(conditions, " AND "))
+	filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, sortRules []*ds.SortRule, filterExpr *ds.FilterExpression) (string, []interface{}, error) {
+	// IN/NOT IN 过滤字段加上普通索引，加速查询
+	addIndex := func(fieldName string) error {
+		return AddIndexToFilterName(k.DB, pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, k.info.DbName, tableName, fieldName)
 	}
-
-	// 构建 ORDER BY 子句
-	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
-		orders := make([]string, len(sortRules))
-		for i, rule := range sortRules {
-			order := "ASC"
-			if rule.SortOrder == ds.SortOrder_DESC {
-				order = "DESC"
-			}
-			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
-		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
-	}
-
-	return queryBuilder.String(), args, nil
+	return buildConditionQuery(kingbaseDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, addIndex)
 }
 
-func (k *KingbaseStrategy) EnsureDatabaseExists(dbName string) error {
+func (k *KingbaseStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
 	// 检查数据库是否存在
-	query := fmt.Sprintf("SELECT datname FROM pg_database WHERE datname = '%s'", dbName)
-	rows, err := k.DB.Query(query)
+	rows, err := k.DB.QueryContext(ctx, "SELECT datname FROM pg_database WHERE datname = $1", dbName)
 	if err != nil {
 		return fmt.Errorf("failed to check database existence in Kingbase: %v", err)
 	}
@@ -615,8 +348,8 @@ func (k *KingbaseStrategy) EnsureDatabaseExists(dbName string) error {
 	}
 
 	// 创建数据库
-	createQuery := fmt.Sprintf("CREATE DATABASE %s", dbName)
-	_, err = k.DB.Exec(createQuery)
+	createQuery := fmt.Sprintf("CREATE DATABASE %s", kingbaseDialect.QuoteIdentifier(dbName))
+	_, err = k.DB.ExecContext(ctx, createQuery)
 	if err != nil {
 		return fmt.Errorf("failed to create Kingbase database '%s': %v", dbName, err)
 	}
@@ -624,19 +357,19 @@ func (k *KingbaseStrategy) EnsureDatabaseExists(dbName string) error {
 	return nil
 }
 
-func (k *KingbaseStrategy) CheckTableExists(tableName string) (bool, error) {
+func (k *KingbaseStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
 	var exists bool
-	err := k.DB.QueryRow(`SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1)`, tableName).Scan(&exists)
+	err := k.DB.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1)`, tableName).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 	return exists, nil
 }
 
-func (k *KingbaseStrategy) CleanupOldTables(dbName string, retentionDays int) error {
+func (k *KingbaseStrategy) CleanupOldTables(ctx context.Context, dbName string, retentionDays int) error {
 	// 切换到指定数据库
 	useQuery := fmt.Sprintf("SET search_path TO \"%s\"", dbName)
-	if _, err := k.DB.Exec(useQuery); err != nil {
+	if _, err := k.DB.ExecContext(ctx, useQuery); err != nil {
 		return fmt.Errorf("failed to switch to database '%s': %v", dbName, err)
 	}
 
@@ -648,7 +381,7 @@ func (k *KingbaseStrategy) CleanupOldTables(dbName string, retentionDays int) er
 		SELECT table_name
 		FROM information_schema.tables
 		WHERE table_catalog = ? AND table_name LIKE ?`
-	rows, err := k.Query(query, dbName, "20%")
+	rows, err := k.Query(ctx, query, dbName, "20%")
 	if err != nil {
 		return fmt.Errorf("failed to query tables: %v", err)
 	}
@@ -663,8 +396,8 @@ func (k *KingbaseStrategy) CleanupOldTables(dbName string, retentionDays int) er
 
 		// 检查表名是否早于保留日期
 		if len(tableName) >= 8 && tableName[:8] < cutoffDate {
-			dropQuery := fmt.Sprintf("DROP TABLE \"%s\"", tableName)
-			if _, err := k.DB.Exec(dropQuery); err != nil {
+			dropQuery := fmt.Sprintf("DROP TABLE %s", kingbaseDialect.QuoteIdentifier(tableName))
+			if _, err := k.DB.ExecContext(ctx, dropQuery); err != nil {
 				log.Logger.Errorf("failed to drop table '%s': %v", tableName, err)
 			} else {
 				log.Logger.Infof("Dropped table '%s'", tableName)
@@ -675,19 +408,19 @@ func (k *KingbaseStrategy) CleanupOldTables(dbName string, retentionDays int) er
 	return nil
 }
 
-func (k *KingbaseStrategy) GetGroupCountInfo(tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue) (*ds.GroupCountResponse, error) {
+func (k *KingbaseStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
 	queryTools := &QueryBuilder{}
 
 	// 使用BuildGroupCountQuery生成SQL查询语句和参数
 	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators,
-		filterValues, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE)
+		filterValues, filterExpr, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build group count query: %v", err)
 	}
 
 	// 执行查询
 	log.Logger.Debugf("Executing group count query: %s with args: %v", query, args)
-	rows, err := k.Query(query, args...)
+	rows, err := k.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute group count query: %v", err)
 	}
@@ -786,29 +519,29 @@ func writeCertToTempFileFromBase64(certContent, prefix string) (string, error) {
 	return tempFile.Name(), nil
 }
 
-func (k *KingbaseStrategy) getTableRowCount(tableName string) (int32, error) {
-	return k.GetTableRowCount("", tableName)
+func (k *KingbaseStrategy) getTableRowCount(ctx context.Context, tableName string) (int32, error) {
+	return k.GetTableRowCount(ctx, "", tableName)
 }
 
-func (k *KingbaseStrategy) estimateTableSize(tableName string, totalRows int32) (int64, error) {
-	return k.EstimateTableSize("", tableName, totalRows)
+func (k *KingbaseStrategy) estimateTableSize(ctx context.Context, tableName string, totalRows int32) (int64, error) {
+	return k.EstimateTableSize(ctx, "", tableName, totalRows)
 }
 
 // getTableRowCount 获取表的总行数
-func (k *KingbaseStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", tableName)
+func (k *KingbaseStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", kingbaseDialect.QuoteTableName(tableName))
 	var rowCount int32
-	if err := k.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
+	if err := k.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
 	return rowCount, nil
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (k *KingbaseStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (k *KingbaseStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
 	// 获取100条数据估算平均行大小
-	sampleQuery := fmt.Sprintf("SELECT * FROM %s LIMIT 100", tableName)
-	rows, err := k.DB.Query(sampleQuery)
+	sampleQuery := kingbaseDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", kingbaseDialect.QuoteTableName(tableName)), 100, 0)
+	rows, err := k.DB.QueryContext(ctx, sampleQuery)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
 	}
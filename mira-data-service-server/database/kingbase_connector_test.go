@@ -45,7 +45,7 @@ func TestBuildWithConditionQueryKingbase(t *testing.T) {
 			sortRules: []*ds.SortRule{
 				{FieldName: "name", SortOrder: ds.SortOrder_ASC},
 			},
-			expectedQuery: `SELECT "id", "name" FROM "users" WHERE age > $1 ORDER BY name ASC`,
+			expectedQuery: `SELECT "id", "name" FROM "users" WHERE "age" > $1 ORDER BY "name" ASC`,
 			expectedArgs:  []interface{}{int32(30)},
 		},
 		{
@@ -62,7 +62,7 @@ func TestBuildWithConditionQueryKingbase(t *testing.T) {
 				{FieldName: "total_amount", SortOrder: ds.SortOrder_DESC},
 				{FieldName: "customer_id", SortOrder: ds.SortOrder_ASC},
 			},
-			expectedQuery: `SELECT "order_id", "customer_id", "total_amount" FROM "orders" WHERE status = $1 AND total_amount >= $2 ORDER BY total_amount DESC, customer_id ASC`,
+			expectedQuery: `SELECT "order_id", "customer_id", "total_amount" FROM "orders" WHERE "status" = $1 AND "total_amount" >= $2 ORDER BY "total_amount" DESC, "customer_id" ASC`,
 			expectedArgs:  []interface{}{"completed", float64(100.0)},
 		},
 		{
@@ -75,7 +75,7 @@ func TestBuildWithConditionQueryKingbase(t *testing.T) {
 			sortRules: []*ds.SortRule{
 				{FieldName: "price", SortOrder: ds.SortOrder_DESC},
 			},
-			expectedQuery: `SELECT "product_id", "name", "price" FROM "products" ORDER BY price DESC`,
+			expectedQuery: `SELECT "product_id", "name", "price" FROM "products" ORDER BY "price" DESC`,
 			expectedArgs:  []interface{}{},
 		},
 		{
@@ -95,7 +95,7 @@ func TestBuildWithConditionQueryKingbase(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			kbStrategy := KingbaseStrategy{}
-			query, args, err := kbStrategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules)
+			query, args, err := kbStrategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules, nil)
 			if err != nil {
 				t.Errorf("Unexpected error: %v", err)
 				return
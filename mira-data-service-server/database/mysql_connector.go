@@ -10,6 +10,7 @@
 package database
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"data-service/common"
@@ -33,8 +34,7 @@ import (
 )
 
 var (
-	mysqlPoolMap = make(map[string]*sql.DB)
-	mysqlMutex   sync.Mutex
+	mysqlMutex sync.Mutex
 )
 
 // TableInfoResponse 结构体用于存储查询结果
@@ -46,8 +46,9 @@ type TableInfoResponse struct {
 }
 
 type MySQLStrategy struct {
-	info *ds.ConnectionInfo
-	DB   *sql.DB
+	info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
 }
 
 func NewMySQLStrategy(info *ds.ConnectionInfo) *MySQLStrategy {
@@ -165,25 +166,20 @@ func (m *MySQLStrategy) ConnectToDB() error {
 }
 
 func (m *MySQLStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
-	dbType := utils.GetDbTypeName(info.Dbtype)
-
-	// 构建连接池键，包含 TLS 配置信息
-	tlsKey := "no-tls"
-	if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
-		tlsKey = fmt.Sprintf("tls-%d-%s", info.TlsConfig.Mode, info.TlsConfig.ServerName)
-	}
-	key := fmt.Sprintf("%s_%s_%d_%s_%s_%s", dbType, info.Host, info.Port, info.DbName, info.Password, tlsKey)
+	key := PoolFingerprint("mysql", info)
 
 	mysqlMutex.Lock()
 	defer mysqlMutex.Unlock()
 
 	// 检查连接池是否已经存在
-	if db, ok := mysqlPoolMap[key]; ok {
-		m.DB = db
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		m.lease.Release()
+		m.DB, m.lease = db, lease
 		log.Logger.Debugf("Reusing existing MySQL connection pool")
 		return nil
 	}
 
+	// 会话时区固定为 UTC，TIMESTAMP 字段按 UTC 时刻读写
 	var dsn string
 
 	// 检查是否需要设置 TLS
@@ -196,13 +192,13 @@ func (m *MySQLStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
 		}
 
 		// 构造带 TLS 的 DSN
-		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?tls=%s&parseTime=true&loc=UTC",
+		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?tls=%s&parseTime=true&loc=UTC&time_zone=%%27%%2B00%%3A00%%27",
 			info.User, info.Password, info.Host, info.Port, info.DbName, common.MYSQL_TLS_CONFIG)
 
 		log.Logger.Infof("Connecting to MySQL with TLS enabled")
 	} else {
 		// 构造普通 DSN
-		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&loc=UTC",
+		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&loc=UTC&time_zone=%%27%%2B00%%3A00%%27",
 			info.User, info.Password, info.Host, info.Port, info.DbName)
 
 		log.Logger.Infof("Connecting to MySQL without TLS")
@@ -223,16 +219,16 @@ func (m *MySQLStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
 	db.SetConnMaxIdleTime(2 * time.Minute)     // 连接最大空闲时间
 
 	// 成功连接后，保存数据库实例
-	m.DB = db
-	mysqlPoolMap[key] = db
+	m.lease.Release()
+	m.DB, m.lease = GetPoolRegistry().Register(key, "mysql", db)
 	log.Logger.Info("Successfully connected to MySQL with username and password")
 	return nil
 }
 
-func (m *MySQLStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+func (m *MySQLStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
 	log.Logger.Debugf("Executing query: %s with args: %v\n", sqlQuery, args)
 	// 执行查询，args 用于绑定 SQL 查询中的占位符
-	rows, err := m.DB.Query(sqlQuery, args...)
+	rows, err := m.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		log.Logger.Errorf("Query failed: %v\n", err)
 		return nil, err
@@ -242,19 +238,10 @@ func (m *MySQLStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows,
 	return rows, nil
 }
 
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
 func (m *MySQLStrategy) Close() error {
-	// 检查数据库连接是否已经初始化
-	if m.DB != nil {
-		err := m.DB.Close()
-		if err != nil {
-			log.Logger.Errorf("Failed to close MySQL connection: %v", err)
-			return err
-		}
-		log.Logger.Info("MySQL connection closed successfully")
-		return nil
-	}
-	// 如果数据库连接未初始化，直接返回 nil
-	log.Logger.Warn("Attempted to close a non-initialized DB connection")
+	m.lease.Release()
+	m.DB, m.lease = nil, nil
 	return nil
 }
 
@@ -272,10 +259,14 @@ func (m *MySQLStrategy) GetJdbcUrl() string {
 }
 
 // 从数据库游标中按行读取数据，并构建当前批次的 Arrow Record
-func (m *MySQLStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.Record, error) {
+func (m *MySQLStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
 	if rows == nil {
 		return nil, fmt.Errorf("no rows to convert")
 	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
 
 	// 创建内存分配器
 	pool := memory.NewGoAllocator()
@@ -300,12 +291,9 @@ func (m *MySQLStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.R
 	// 构建 Arrow Schema
 	var fields []arrow.Field
 	for i, col := range cols {
-		arrowType, err := mysqlTypeToArrowType(colTypes[i])
+		arrowType := ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, colTypes[i])
 		log.Logger.Debugf("SQL type for column %s: %s", col, colTypes[i].DatabaseTypeName())
 		log.Logger.Debugf("Arrow type for column %s: %s", col, arrowType)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert SQL type to Arrow type: %v", err)
-		}
 		fields = append(fields, arrow.Field{Name: col, Type: arrowType})
 	}
 	schema := arrow.NewSchema(fields, nil)
@@ -339,59 +327,20 @@ func (m *MySQLStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.R
 			break // 达到批次大小，结束循环，发送给客户端
 		}
 	}
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+
 	// 创建 Arrow 批次 (Record)
 	record := builder.NewRecord()
 	return record, nil
 }
 
-// 将 SQL 列类型转换为 Arrow 类型
-func mysqlTypeToArrowType(colType *sql.ColumnType) (arrow.DataType, error) {
-	switch colType.DatabaseTypeName() {
-	case "VARCHAR", "CHAR":
-		return arrow.BinaryTypes.String, nil
-	case "TEXT", "LONGTEXT", "MEDIUMTEXT", "TINYTEXT":
-		return arrow.BinaryTypes.LargeString, nil
-	case "TINYINT":
-		return arrow.PrimitiveTypes.Int8, nil
-	case "SMALLINT":
-		return arrow.PrimitiveTypes.Int16, nil
-	case "MEDIUMINT", "INT":
-		return arrow.PrimitiveTypes.Int32, nil
-	case "BIGINT":
-		return arrow.PrimitiveTypes.Int64, nil
-	case "UNSIGNED TINYINT":
-		return arrow.PrimitiveTypes.Uint8, nil
-	case "UNSIGNED SMALLINT":
-		return arrow.PrimitiveTypes.Uint16, nil
-	case "UNSIGNED MEDIUMINT", "UNSIGNED INT":
-		return arrow.PrimitiveTypes.Uint32, nil
-	case "UNSIGNED BIGINT":
-		return arrow.PrimitiveTypes.Uint64, nil
-	case "FLOAT":
-		return arrow.PrimitiveTypes.Float32, nil
-	case "DOUBLE":
-		return arrow.PrimitiveTypes.Float64, nil
-	case "TIMESTAMP", "DATETIME":
-		return arrow.BinaryTypes.String, nil
-	case "DECIMAL", "NUMERIC":
-		// 提取精度和标度
-		precision, scale, ok := colType.DecimalSize()
-		if !ok {
-			return nil, fmt.Errorf("unable to get precision and scale for DECIMAL column: %s", colType.Name())
-		}
-		return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}, nil
-	case "DATE":
-		return arrow.BinaryTypes.String, nil
-	// 处理其他类型
-	default:
-		return arrow.BinaryTypes.String, nil
-	}
-}
-
-func (m *MySQLStrategy) CreateTemporaryTableIfNotExists(tableName string, schema *arrow.Schema) error {
+func (m *MySQLStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
 	// 检查表是否存在
 	var exists bool
-	err := m.DB.QueryRow("SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
+	err := m.DB.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check if table exists: %v", err)
 	}
@@ -400,7 +349,7 @@ func (m *MySQLStrategy) CreateTemporaryTableIfNotExists(tableName string, schema
 		// 创建临时表
 		createTableSQL := buildCreateMysqlTableSQL(tableName, schema)
 		log.Logger.Infof("createTableSQL: %s", createTableSQL)
-		_, err = m.DB.Exec(createTableSQL)
+		_, err = m.DB.ExecContext(ctx, createTableSQL)
 		if err != nil {
 			return fmt.Errorf("failed to create table: %v", err)
 		}
@@ -414,83 +363,25 @@ func (m *MySQLStrategy) CreateTemporaryTableIfNotExists(tableName string, schema
 
 // buildCreateTableSQL 根据 Arrow Schema 生成创建表的 SQL 语句
 func buildCreateMysqlTableSQL(tableName string, schema *arrow.Schema) string {
-	var columns []string
-
-	// 生成一个随机的 UUID 并截取前 10 个字符
+	// 生成一个随机的 UUID 并截取前 10 个字符，作为自增主键的字段名
 	randomUUID := "PK_" + strings.ReplaceAll(uuid.New().String(), "-", "")[:10]
+	primaryKey := fmt.Sprintf("%s INT AUTO_INCREMENT PRIMARY KEY", mysqlDialect.QuoteIdentifier(randomUUID))
 
-	// 将生成的 10 字符 UUID 作为自增主键的字段名
-	columns = append(columns, fmt.Sprintf("`%s` INT AUTO_INCREMENT PRIMARY KEY", randomUUID))
-
-	// 添加其他字段
-	for _, field := range schema.Fields() {
-		columnType := convertArrowTypeToMysqlType(field.Type)
-		columns = append(columns, fmt.Sprintf("`%s` %s", field.Name, columnType))
-	}
-
-	// 返回完整的 CREATE TABLE 语句
-	return fmt.Sprintf("CREATE TABLE `%s` (%s)", tableName, strings.Join(columns, ", "))
-}
-
-// convertArrowTypeToSQLType 将 Arrow 数据类型转换为 SQL 数据类型
-func convertArrowTypeToMysqlType(arrowType arrow.DataType) string {
-	switch arrowType.ID() {
-	case arrow.INT8:
-		return "TINYINT"
-	case arrow.INT16:
-		return "SMALLINT"
-	case arrow.INT32:
-		return "INT"
-	case arrow.INT64:
-		return "BIGINT"
-	case arrow.UINT8:
-		return "TINYINT UNSIGNED"
-	case arrow.UINT16:
-		return "SMALLINT UNSIGNED"
-	case arrow.UINT32:
-		return "INT UNSIGNED"
-	case arrow.UINT64:
-		return "BIGINT UNSIGNED"
-	case arrow.FLOAT32:
-		return "FLOAT"
-	case arrow.FLOAT64:
-		return "DOUBLE"
-	case arrow.BOOL:
-		return "BOOLEAN"
-	case arrow.STRING:
-		return "VARCHAR(255)"
-	case arrow.LARGE_STRING:
-		return "TEXT"
-	case arrow.BINARY:
-		return "BLOB"
-	case arrow.TIMESTAMP:
-		return "TIMESTAMP"
-	case arrow.DATE32:
-		return "DATE"
-	case arrow.DATE64:
-		return "DATETIME"
-	case arrow.DECIMAL128, arrow.DECIMAL256:
-		decimalType := arrowType.(arrow.FixedWidthDataType)
-		precision := decimalType.(*arrow.Decimal128Type).Precision
-		scale := decimalType.(*arrow.Decimal128Type).Scale
-		return fmt.Sprintf("DECIMAL(%d,%d)", precision, scale)
-	default:
-		return "TEXT" // 默认使用 TEXT 类型
-	}
+	return buildCreateTableSQL(mysqlDialect, tableName, schema, false, primaryKey)
 }
 
-func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+func (m *MySQLStrategy) GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
 	// 定义 SQL 查询
 	var sqlQuery string
 
 	if isExactQuery {
 		// 精确查询时，只查询记录数
-		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s.%s", database, tableName)
+		sqlQuery = fmt.Sprintf("SELECT COUNT(*) as table_rows FROM %s", mysqlDialect.QualifyTableName(database, tableName))
 	} else {
 		// 普通查询，获取表的相关信息
-		sqlQuery = fmt.Sprintf("SELECT table_schema, table_name, table_rows, data_length "+
-			"FROM information_schema.tables "+
-			"WHERE table_schema = '%s' AND table_name = '%s'", database, tableName)
+		sqlQuery = "SELECT table_schema, table_name, table_rows, data_length " +
+			"FROM information_schema.tables " +
+			"WHERE table_schema = ? AND table_name = ?"
 	}
 
 	// 记录日志
@@ -500,7 +391,7 @@ func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQ
 
 	if isExactQuery {
 		// 精确查询，只返回记录数
-		if err := m.DB.QueryRow(sqlQuery).Scan(&result.TableRows); err != nil {
+		if err := m.DB.QueryRowContext(ctx, sqlQuery).Scan(&result.TableRows); err != nil {
 			return nil, err
 		}
 		result.TableSchema = ""      // 没有表模式
@@ -511,21 +402,21 @@ func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQ
 		if ShouldUseEstimationOnly() {
 			// 直接使用估算方式，跳过 information_schema 查询
 			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping information_schema query")
-			FillTableInfoFromEstimation(m, database, tableName, database, &result)
+			FillTableInfoFromEstimation(ctx, m, database, tableName, database, &result)
 		} else {
 			// 普通查询，先尝试从 information_schema.tables 获取
-			err := m.DB.QueryRow(sqlQuery).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
+			err := m.DB.QueryRowContext(ctx, sqlQuery, database, tableName).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
 			if err != nil || result.TableName == "" || result.TableRows == 0 {
 				// 查询失败或返回空结果，使用估算方式
 				LogQueryFailure("information_schema.tables", err, result.TableName, result.TableRows)
 
-				FillTableInfoFromEstimation(m, database, tableName, database, &result)
+				FillTableInfoFromEstimation(ctx, m, database, tableName, database, &result)
 			}
 		}
 	}
 
 	// 获取表结构信息
-	columns, err := m.getTableSchema(database, tableName)
+	columns, keys, err := m.DescribeTable(ctx, database, tableName)
 	if err != nil {
 		log.Logger.Errorf("Failed to get table schema: %v", err)
 		// 不返回错误，只记录日志，表结构信息为空
@@ -539,37 +430,13 @@ func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQ
 		RecordCount: result.TableRows,
 		RecordSize:  result.TableSize,
 		Columns:     columns,
+		Keys:        keys,
 	}, nil
 }
 
-// 获取表结构信息
-func (m *MySQLStrategy) getTableSchema(database, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT
-              FROM INFORMATION_SCHEMA.COLUMNS 
-              WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
-              ORDER BY ORDINAL_POSITION`
-
-	rows, err := m.DB.Query(query, database, tableName)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var columns []*ds.ColumnItem
-	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
-		var isNullable, columnDefault, comment sql.NullString
-
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault, &comment)
-		if err != nil {
-			return nil, err
-		}
-
-		columns = append(columns, &col)
-	}
-
-	return columns, nil
+// DescribeTable 获取字段元数据与表键信息
+func (m *MySQLStrategy) DescribeTable(ctx context.Context, database, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	return describeMySQLTable(ctx, m.DB, database, tableName)
 }
 
 func (m *MySQLStrategy) BuildWithConditionQuery(
@@ -579,104 +446,14 @@ func (m *MySQLStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
 ) (string, []interface{}, error) {
-	var queryBuilder strings.Builder
-	args := []interface{}{}
-	queryTools := &QueryBuilder{}
-
-	// 构建 SELECT 子句
-	queryBuilder.WriteString("SELECT ")
-	if len(fields) > 0 {
-		// 检查每个字段是否有反引号，没有则加上
-		quotedFields := make([]string, len(fields))
-		for i, f := range fields {
-			trimmed := strings.TrimSpace(f)
-			if strings.HasPrefix(trimmed, "`") && strings.HasSuffix(trimmed, "`") {
-				quotedFields[i] = trimmed
-			} else {
-				quotedFields[i] = fmt.Sprintf("`%s`", trimmed)
-			}
-		}
-		queryBuilder.WriteString(strings.Join(quotedFields, ", "))
-	} else {
-		queryBuilder.WriteString("*")
-	}
-	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(tableName)
-
-	// 构建 WHERE 子句
-	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
-		if len(filterNames) != len(filterOperators) || len(filterOperators) != len(filterValues) {
-			return "", nil, fmt.Errorf("filterNames, filterOperators, and filterValues must have the same length")
-		}
-
-		queryBuilder.WriteString(" WHERE ")
-
-		conditions := make([]string, len(filterNames))
-		for i := range filterNames {
-			operator := filterOperators[i]
-			filterValue := filterValues[i]
-			filterName := filterNames[i]
-
-			// 提取有效值
-			argsFromFilter := queryTools.ExtractQueryArgs([]*ds.FilterValue{filterValue})
-			if len(argsFromFilter) == 0 {
-				return "", nil, fmt.Errorf("no valid values found for filter '%s'", filterName)
-			}
-
-			switch operator {
-			case ds.FilterOperator_IN_OPERATOR:
-				// 构建 IN 操作符的条件
-				var inPlaceholders []string
-				for _, v := range argsFromFilter {
-					inPlaceholders = append(inPlaceholders, "?")
-					args = append(args, v)
-				}
-				conditions[i] = fmt.Sprintf("%s IN (%s)", filterName, strings.Join(inPlaceholders, ", "))
-
-			case ds.FilterOperator_GREATER_THAN, ds.FilterOperator_LESS_THAN,
-				ds.FilterOperator_GREATER_THAN_OR_EQUAL, ds.FilterOperator_LESS_THAN_OR_EQUAL,
-				ds.FilterOperator_NOT_EQUAL:
-				// 构建比较操作符的条件
-				conditions[i] = fmt.Sprintf("%s %s ?", filterName, queryTools.OperatorToString(operator))
-				args = append(args, argsFromFilter[0]) // 取第一个有效值
-
-			case ds.FilterOperator_LIKE_OPERATOR:
-				// 构建 LIKE 操作符的条件
-				conditions[i] = fmt.Sprintf("%s LIKE ?", filterName)
-				args = append(args, argsFromFilter[0]) // 取第一个有效值
-
-			default:
-				// 默认等于操作
-				conditions[i] = fmt.Sprintf("%s = ?", filterName)
-				args = append(args, argsFromFilter[0]) // 取第一个有效值
-			}
-		}
-
-		queryBuilder.WriteString(strings.Join(conditions, " AND "))
-	}
-
-	// 构建 ORDER BY 子句
-	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
-		orders := make([]string, len(sortRules))
-		for i, rule := range sortRules {
-			order := "ASC"
-			if rule.SortOrder == ds.SortOrder_DESC {
-				order = "DESC"
-			}
-			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
-		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
-	}
-
-	return queryBuilder.String(), args, nil
+	return buildConditionQuery(mysqlDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, nil)
 }
 
-func (m *MySQLStrategy) EnsureDatabaseExists(dbName string) error {
-	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`", dbName)
-	_, err := m.DB.Exec(createQuery)
+func (m *MySQLStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	createQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", mysqlDialect.QuoteIdentifier(dbName))
+	_, err := m.DB.ExecContext(ctx, createQuery)
 	if err != nil {
 		return fmt.Errorf("failed to create MySQL database '%s': %v", dbName, err)
 	}
@@ -684,19 +461,19 @@ func (m *MySQLStrategy) EnsureDatabaseExists(dbName string) error {
 	return nil
 }
 
-func (m *MySQLStrategy) CheckTableExists(tableName string) (bool, error) {
+func (m *MySQLStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
 	var exists bool
-	err := m.DB.QueryRow("SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
+	err := m.DB.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", tableName).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 	return exists, nil
 }
 
-func (m *MySQLStrategy) CleanupOldTables(dbName string, retentionDays int) error {
+func (m *MySQLStrategy) CleanupOldTables(ctx context.Context, dbName string, retentionDays int) error {
 	// 切换到指定数据库
-	useQuery := fmt.Sprintf("USE `%s`", dbName)
-	if _, err := m.DB.Exec(useQuery); err != nil {
+	useQuery := fmt.Sprintf("USE %s", mysqlDialect.QuoteIdentifier(dbName))
+	if _, err := m.DB.ExecContext(ctx, useQuery); err != nil {
 		return fmt.Errorf("failed to switch to database '%s': %v", dbName, err)
 	}
 
@@ -708,7 +485,7 @@ func (m *MySQLStrategy) CleanupOldTables(dbName string, retentionDays int) error
 		SELECT table_name
 		FROM information_schema.tables
 		WHERE table_schema = ? AND table_name LIKE ?`
-	rows, err := m.Query(query, dbName, "20%")
+	rows, err := m.Query(ctx, query, dbName, "20%")
 	if err != nil {
 		return fmt.Errorf("failed to query tables: %v", err)
 	}
@@ -723,8 +500,8 @@ func (m *MySQLStrategy) CleanupOldTables(dbName string, retentionDays int) error
 
 		// 检查表名是否早于保留日期
 		if len(tableName) >= 8 && tableName[:8] < cutoffDate {
-			dropQuery := fmt.Sprintf("DROP TABLE `%s`", tableName)
-			if _, err := m.DB.Exec(dropQuery); err != nil {
+			dropQuery := fmt.Sprintf("DROP TABLE %s", mysqlDialect.QuoteIdentifier(tableName))
+			if _, err := m.DB.ExecContext(ctx, dropQuery); err != nil {
 				log.Logger.Errorf("failed to drop table '%s': %v", tableName, err)
 			} else {
 				log.Logger.Infof("Dropped table '%s'", tableName)
@@ -735,19 +512,19 @@ func (m *MySQLStrategy) CleanupOldTables(dbName string, retentionDays int) error
 	return nil
 }
 
-func (m *MySQLStrategy) GetGroupCountInfo(tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue) (*ds.GroupCountResponse, error) {
+func (m *MySQLStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
 	queryTools := &QueryBuilder{}
 
 	// 使用BuildGroupCountQuery生成SQL查询语句和参数
 	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators,
-		filterValues, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
+		filterValues, filterExpr, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build group count query: %v", err)
 	}
 
 	// 执行查询
 	log.Logger.Debugf("Executing group count query: %s with args: %v", query, args)
-	rows, err := m.Query(query, args...)
+	rows, err := m.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute group count query: %v", err)
 	}
@@ -757,20 +534,20 @@ func (m *MySQLStrategy) GetGroupCountInfo(tableName string, groupBy []string, fi
 }
 
 // getTableRowCount 获取表的总行数
-func (m *MySQLStrategy) getTableRowCount(database string, tableName string) (int32, error) {
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s.%s", database, tableName)
+func (m *MySQLStrategy) getTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", mysqlDialect.QualifyTableName(database, tableName))
 	var rowCount int32
-	if err := m.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
+	if err := m.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
 	return rowCount, nil
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (m *MySQLStrategy) estimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (m *MySQLStrategy) estimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
 	// 获取100条数据估算平均行大小
-	sampleQuery := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 100", database, tableName)
-	rows, err := m.DB.Query(sampleQuery)
+	sampleQuery := mysqlDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", mysqlDialect.QualifyTableName(database, tableName)), 100, 0)
+	rows, err := m.DB.QueryContext(ctx, sampleQuery)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
 	}
@@ -815,11 +592,11 @@ func (m *MySQLStrategy) estimateTableSize(database string, tableName string, tot
 }
 
 // GetTableRowCount 实现 TableInfoEstimator 接口
-func (m *MySQLStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
-	return m.getTableRowCount(database, tableName)
+func (m *MySQLStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	return m.getTableRowCount(ctx, database, tableName)
 }
 
 // EstimateTableSize 实现 TableInfoEstimator 接口
-func (m *MySQLStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
-	return m.estimateTableSize(database, tableName, totalRows)
+func (m *MySQLStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
+	return m.estimateTableSize(ctx, database, tableName, totalRows)
 }
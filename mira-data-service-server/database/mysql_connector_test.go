@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	ds "data-service/generated/datasource"
 	"errors"
 	"reflect"
@@ -43,7 +44,7 @@ func TestBuildWithConditionQuery(t *testing.T) {
 			sortRules: []*ds.SortRule{
 				{FieldName: "name", SortOrder: ds.SortOrder_ASC},
 			},
-			wantQuery: "SELECT id, name FROM users WHERE age > ? ORDER BY name ASC",
+			wantQuery: "SELECT `id`, `name` FROM `users` WHERE `age` > ? ORDER BY `name` ASC",
 			wantArgs:  []interface{}{int32(25)},
 			wantErr:   nil,
 		},
@@ -61,7 +62,7 @@ func TestBuildWithConditionQuery(t *testing.T) {
 				{FieldName: "total_amount", SortOrder: ds.SortOrder_DESC},
 				{FieldName: "customer_id", SortOrder: ds.SortOrder_ASC},
 			},
-			wantQuery: "SELECT order_id, customer_id, total_amount FROM orders WHERE status = ? AND total_amount >= ? ORDER BY total_amount DESC, customer_id ASC",
+			wantQuery: "SELECT `order_id`, `customer_id`, `total_amount` FROM `orders` WHERE `status` = ? AND `total_amount` >= ? ORDER BY `total_amount` DESC, `customer_id` ASC",
 			wantArgs:  []interface{}{"completed", 100.0},
 			wantErr:   nil,
 		},
@@ -75,7 +76,7 @@ func TestBuildWithConditionQuery(t *testing.T) {
 			sortRules: []*ds.SortRule{
 				{FieldName: "price", SortOrder: ds.SortOrder_DESC},
 			},
-			wantQuery: "SELECT product_id, name, price FROM products ORDER BY price DESC",
+			wantQuery: "SELECT `product_id`, `name`, `price` FROM `products` ORDER BY `price` DESC",
 			wantArgs:  []interface{}{},
 			wantErr:   nil,
 		},
@@ -87,7 +88,7 @@ func TestBuildWithConditionQuery(t *testing.T) {
 			filterOperators: []ds.FilterOperator{},
 			filterValues:    []*ds.FilterValue{},
 			sortRules:       []*ds.SortRule{},
-			wantQuery:       "SELECT * FROM customers",
+			wantQuery:       "SELECT * FROM `customers`",
 			wantArgs:        []interface{}{},
 			wantErr:         nil,
 		},
@@ -103,6 +104,7 @@ func TestBuildWithConditionQuery(t *testing.T) {
 				tt.filterOperators,
 				tt.filterValues,
 				tt.sortRules,
+				nil,
 			)
 
 			if gotQuery != tt.wantQuery {
@@ -177,7 +179,7 @@ func TestEstimateTableSize(t *testing.T) {
 					AddRow(2, "test2", 200).
 					AddRow(3, "test3", 300)
 
-				mock.ExpectQuery(`SELECT \* FROM testdb\.testtable LIMIT 100`).
+				mock.ExpectQuery("SELECT \\* FROM `testdb`\\.`testtable` LIMIT 100").
 					WillReturnRows(rows)
 			},
 			expectedSize:  0, // Will be calculated, so we'll check it's > 0
@@ -189,7 +191,7 @@ func TestEstimateTableSize(t *testing.T) {
 			tableName: "testtable",
 			totalRows: 1000,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT \* FROM testdb\.testtable LIMIT 100`).
+				mock.ExpectQuery("SELECT \\* FROM `testdb`\\.`testtable` LIMIT 100").
 					WillReturnError(errors.New("table does not exist"))
 			},
 			expectedSize:  0,
@@ -204,7 +206,7 @@ func TestEstimateTableSize(t *testing.T) {
 				columns := []string{"id", "name"}
 				rows := sqlmock.NewRows(columns) // Empty rows
 
-				mock.ExpectQuery(`SELECT \* FROM testdb\.testtable LIMIT 100`).
+				mock.ExpectQuery("SELECT \\* FROM `testdb`\\.`testtable` LIMIT 100").
 					WillReturnRows(rows)
 			},
 			expectedSize:  0,
@@ -230,7 +232,7 @@ func TestEstimateTableSize(t *testing.T) {
 			}
 
 			// Execute the method
-			size, err := strategy.estimateTableSize(tt.database, tt.tableName, tt.totalRows)
+			size, err := strategy.estimateTableSize(context.Background(), tt.database, tt.tableName, tt.totalRows)
 
 			// Verify expectations
 			if err := mock.ExpectationsWereMet(); err != nil {
@@ -268,3 +270,30 @@ func TestEstimateTableSize(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryAndRowsToArrowBatchWithCancelledContext(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id FROM users").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+
+	strategy := &MySQLStrategy{DB: db}
+	ctx, cancel := context.WithCancel(context.Background())
+	rows, err := strategy.Query(ctx, "SELECT id FROM users")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	// 取消后批次读取应返回取消原因，而不是被当作读取完毕
+	cancel()
+	record, err := strategy.RowsToArrowBatch(ctx, rows, 10)
+	assert.Nil(t, record)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// 已取消的 ctx 不应再下发查询
+	_, err = strategy.Query(ctx, "SELECT id FROM users")
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,514 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/20
+	@note: 分片并发读取：按数值键的取值区间拆成多条范围查询，在连接池上并发执行后合并为 Arrow 流
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/common"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// ErrPartitionUnavailable 无法为本次读取划分分片，调用方应退回单条查询
+var ErrPartitionUnavailable = errors.New("partitioned read unavailable")
+
+// partitionBuffer 每个分片在发送端缓存的批次数，保序模式下限制未轮到的分片占用的内存
+const partitionBuffer = 2
+
+// PartitionedReadRequest 分片读取的参数，字段、过滤与排序条件与 BuildWithConditionQuery 相同
+type PartitionedReadRequest struct {
+	TableName        string
+	Fields           []string
+	FilterNames      []string
+	FilterOperators  []pb.FilterOperator
+	FilterValues     []*pb.FilterValue
+	FilterExpression *pb.FilterExpression
+	SortRules        []*pb.SortRule
+	// Parallelism 同时执行的范围查询数
+	Parallelism int
+	// PartitionColumn 分片字段，为空时依次从 Keys、源表主键中选择单列键
+	PartitionColumn string
+	Keys            []*pb.TableKey
+	// Sample 抽样参数，设置后按抽样读取，不分片也不支持续传
+	Sample *pb.SampleOptions
+}
+
+// NewPartitionedReadRequest 由请求中的并发读取参数生成分片读取参数，未设置并发数时 Parallelism 为 0
+func NewPartitionedReadRequest(tableName string, fields []string, filterNames []string,
+	filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue, sortRules []*pb.SortRule,
+	filterExpr *pb.FilterExpression, options *pb.ParallelReadOptions) PartitionedReadRequest {
+	return PartitionedReadRequest{
+		TableName:        tableName,
+		Fields:           fields,
+		FilterNames:      filterNames,
+		FilterOperators:  filterOperators,
+		FilterValues:     filterValues,
+		FilterExpression: filterExpr,
+		SortRules:        sortRules,
+		Parallelism:      int(options.GetParallelism()),
+		PartitionColumn:  strings.TrimSpace(options.GetPartitionColumn()),
+		Keys:             options.GetKeys(),
+	}
+}
+
+// rangeQuery 单个分片的查询语句
+type rangeQuery struct {
+	query string
+	args  []interface{}
+}
+
+// PartitionedRead 分片读取计划，由 PlanPartitionedRead 生成
+type PartitionedRead struct {
+	Column string
+	// Ordered 为 true 时按分片顺序依次输出，否则各分片的批次交错输出
+	Ordered     bool
+	parallelism int
+	queries     []rangeQuery
+}
+
+// Partitions 分片数量
+func (p *PartitionedRead) Partitions() int {
+	return len(p.queries)
+}
+
+// PlanPartitionedRead 选择分片字段并按 MIN/MAX 划分取值区间
+// 指定排序规则时按第一个排序字段分片，分片间按排序方向排列，从而保持全局顺序
+// 找不到可用的数值分片字段、表为空时返回 ErrPartitionUnavailable
+func PlanPartitionedRead(ctx context.Context, strategy DatabaseStrategy, dbType pb.DataSourceType,
+	req PartitionedReadRequest) (*PartitionedRead, error) {
+	if req.Parallelism <= 1 {
+		return nil, fmt.Errorf("%w: parallelism %d", ErrPartitionUnavailable, req.Parallelism)
+	}
+	d := DialectFor(dbType)
+
+	column, nullable, desc := "", true, false
+	ordered := false
+	for _, rule := range req.SortRules {
+		if rule == nil || rule.FieldName == "" {
+			continue
+		}
+		column, desc, ordered = rule.FieldName, rule.SortOrder == pb.SortOrder_DESC, true
+		if req.PartitionColumn != "" && !strings.EqualFold(req.PartitionColumn, column) {
+			log.Logger.Warnf("Partition column %s ignored, partitioning by sort column %s to keep order",
+				req.PartitionColumn, column)
+		}
+		break
+	}
+	if column == "" {
+		column = req.PartitionColumn
+	}
+	if column == "" {
+		// 键列不含 NULL，无需单独读取 NULL 分片
+		nullable = false
+		column = partitionColumnFromKeys(req.Keys)
+		if column == "" {
+			pk, err := detectPrimaryKeyColumn(ctx, strategy, dbType, req.TableName)
+			if err != nil {
+				log.Logger.Warnf("Failed to detect primary key of %s: %v", req.TableName, err)
+			}
+			column = pk
+		}
+		if column == "" {
+			return nil, fmt.Errorf("%w: no single-column key found on %s", ErrPartitionUnavailable, req.TableName)
+		}
+	}
+
+	condition, args, err := renderReadCondition(d, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges, err := queryPartitionRanges(ctx, strategy, d, req.TableName, column, condition, args, req.Parallelism)
+	if err != nil {
+		return nil, err
+	}
+	if desc {
+		for i, j := 0, len(ranges)-1; i < j; i, j = i+1, j-1 {
+			ranges[i], ranges[j] = ranges[j], ranges[i]
+		}
+	}
+	if nullable {
+		nullRange := partitionRange{isNull: true}
+		if nullsFirst(dbType, desc) {
+			ranges = append([]partitionRange{nullRange}, ranges...)
+		} else {
+			ranges = append(ranges, nullRange)
+		}
+	}
+
+	plan := &PartitionedRead{Column: column, Ordered: ordered, parallelism: req.Parallelism}
+	for _, r := range ranges {
+		plan.queries = append(plan.queries, buildRangeQuery(d, req, column, condition, args, r))
+	}
+	return plan, nil
+}
+
+// renderReadCondition 渲染平铺过滤条件与过滤表达式组合后的条件，不含 WHERE 关键字
+func renderReadCondition(d Dialect, req PartitionedReadRequest) (string, []interface{}, error) {
+	flat, err := FlatFiltersToExpression(req.FilterNames, req.FilterOperators, req.FilterValues)
+	if err != nil {
+		return "", nil, err
+	}
+	return newFilterDialect(d, nil).Render(AndFilterExpressions(flat, req.FilterExpression), []interface{}{})
+}
+
+// partitionColumnFromKeys 从表键信息中选择单列键，优先自增主键，其次主键
+func partitionColumnFromKeys(keys []*pb.TableKey) string {
+	for _, keyType := range []pb.KeyType{pb.KeyType_KEY_TYPE_AUTO_INCREMENT, pb.KeyType_KEY_TYPE_PRIMARY} {
+		for _, k := range keys {
+			if k == nil || k.KeyType != keyType || len(k.ColumnNames) != 1 {
+				continue
+			}
+			if column := strings.TrimSpace(k.ColumnNames[0]); column != "" {
+				return column
+			}
+		}
+	}
+	return ""
+}
+
+// detectPrimaryKeyColumn 查询源表的主键，仅在主键为单列时返回列名
+func detectPrimaryKeyColumn(ctx context.Context, strategy DatabaseStrategy, dbType pb.DataSourceType, tableName string) (string, error) {
+	var query string
+	var args []interface{}
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_HIVE:
+		return "", nil
+	case pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG:
+		owner, table := splitDamengTableName(tableName)
+		ownerCondition, ownerArgs := damengOwnerCondition(owner)
+		query = fmt.Sprintf(`
+			SELECT c.COLUMN_NAME
+			FROM ALL_CONSTRAINTS k
+			JOIN ALL_CONS_COLUMNS c ON c.OWNER = k.OWNER AND c.CONSTRAINT_NAME = k.CONSTRAINT_NAME
+			WHERE k.CONSTRAINT_TYPE = 'P' AND k.%s AND k.TABLE_NAME = ?`, ownerCondition)
+		args = append(ownerArgs, table)
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		schemaName, table := splitQualifiedTableName(tableName)
+		if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE || dbType == pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE {
+			// 表名整体作为一个标识符，位于当前模式下
+			schemaName, table = "", unquoteIdentifier(tableName)
+		}
+		query = `
+			SELECT kcu.column_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.key_column_usage kcu
+				ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema AND kcu.table_name = tc.table_name
+			WHERE tc.constraint_type = 'PRIMARY KEY'
+				AND tc.table_schema = COALESCE(NULLIF($1, ''), current_schema()) AND tc.table_name = $2`
+		args = []interface{}{schemaName, table}
+	default:
+		schemaName, table := splitQualifiedTableName(tableName)
+		query = `SELECT COLUMN_NAME
+			FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE
+			WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'`
+		args = []interface{}{schemaName, table}
+	}
+
+	rows, err := strategy.Query(ctx, query, args...)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return "", err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(columns) != 1 {
+		return "", nil
+	}
+	return columns[0], nil
+}
+
+// partitionRange 分片字段的一个取值区间 [lower, upper)，lower/upper 为空表示该侧不设边界
+// isNull 表示分片字段为 NULL 的行
+type partitionRange struct {
+	lower  interface{}
+	upper  interface{}
+	isNull bool
+}
+
+// queryPartitionRanges 按过滤后的 MIN/MAX 把取值区间等分为最多 n 段，首尾两段不设外侧边界
+func queryPartitionRanges(ctx context.Context, strategy DatabaseStrategy, d Dialect, tableName, column string,
+	condition string, args []interface{}, n int) ([]partitionRange, error) {
+	quoted := d.QuoteIdentifier(column)
+	query := fmt.Sprintf("SELECT MIN(%s), MAX(%s) FROM %s", quoted, quoted, d.QuoteTableName(tableName))
+	if condition != "" {
+		query += " WHERE " + condition
+	}
+	rows, err := strategy.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query range of %s: %v", column, err)
+	}
+	defer rows.Close()
+	var minValue, maxValue sql.NullString
+	if rows.Next() {
+		if err := rows.Scan(&minValue, &maxValue); err != nil {
+			return nil, fmt.Errorf("failed to scan range of %s: %v", column, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query range of %s: %v", column, err)
+	}
+	if !minValue.Valid || !maxValue.Valid {
+		return nil, fmt.Errorf("%w: column %s has no values", ErrPartitionUnavailable, column)
+	}
+	return splitPartitionRange(column, strings.TrimSpace(minValue.String), strings.TrimSpace(maxValue.String), n)
+}
+
+// splitPartitionRange 将 [minValue, maxValue] 等分为最多 n 段，整数列按整数边界划分，其余数值列按浮点数划分
+func splitPartitionRange(column, minValue, maxValue string, n int) ([]partitionRange, error) {
+	var bounds []interface{}
+	if lo, err := strconv.ParseInt(minValue, 10, 64); err == nil {
+		hi, err := strconv.ParseInt(maxValue, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: column %s is not numeric", ErrPartitionUnavailable, column)
+		}
+		// 先转为无符号数再相减，MIN 为负数时跨度可能超过 int64 范围；共 span+1 个取值，第 i 个边界为 lo + (span+1)*i/n
+		span := uint64(hi) - uint64(lo)
+		if span < uint64(n) {
+			n = int(span) + 1
+		}
+		q, r := span/uint64(n), span%uint64(n)
+		for i := uint64(1); i < uint64(n); i++ {
+			bounds = append(bounds, int64(uint64(lo)+q*i+(r+1)*i/uint64(n)))
+		}
+	} else {
+		lo, err := strconv.ParseFloat(minValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: column %s is not numeric", ErrPartitionUnavailable, column)
+		}
+		hi, err := strconv.ParseFloat(maxValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: column %s is not numeric", ErrPartitionUnavailable, column)
+		}
+		if hi > lo {
+			step := (hi - lo) / float64(n)
+			for i := 1; i < n; i++ {
+				bounds = append(bounds, lo+step*float64(i))
+			}
+		}
+	}
+
+	ranges := make([]partitionRange, 0, len(bounds)+1)
+	var lower interface{}
+	for _, bound := range bounds {
+		ranges = append(ranges, partitionRange{lower: lower, upper: bound})
+		lower = bound
+	}
+	return append(ranges, partitionRange{lower: lower}), nil
+}
+
+// nullsFirst 升序时 NULL 是否排在最前；PostgreSQL 系与达梦把 NULL 视为最大值，MySQL 系与 Hive 视为最小值
+func nullsFirst(dbType pb.DataSourceType, desc bool) bool {
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS,
+		pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG:
+		return desc
+	default:
+		return !desc
+	}
+}
+
+// buildRangeQuery 生成单个分片的查询，过滤条件加括号后与区间条件以 AND 组合
+func buildRangeQuery(d Dialect, req PartitionedReadRequest, column, condition string, args []interface{},
+	r partitionRange) rangeQuery {
+	quoted := d.QuoteIdentifier(column)
+	queryArgs := append([]interface{}{}, args...)
+	var predicates []string
+	if condition != "" {
+		predicates = append(predicates, "("+condition+")")
+	}
+	switch {
+	case r.isNull:
+		predicates = append(predicates, quoted+" IS NULL")
+	case r.lower == nil && r.upper == nil:
+		predicates = append(predicates, quoted+" IS NOT NULL")
+	default:
+		if r.lower != nil {
+			queryArgs = append(queryArgs, r.lower)
+			predicates = append(predicates, fmt.Sprintf("%s >= %s", quoted, d.Placeholder(len(queryArgs))))
+		}
+		if r.upper != nil {
+			queryArgs = append(queryArgs, r.upper)
+			predicates = append(predicates, fmt.Sprintf("%s < %s", quoted, d.Placeholder(len(queryArgs))))
+		}
+	}
+
+	return rangeQuery{query: buildSelectQuery(d, d.QuoteTableName(req.TableName), req.Fields, predicates, req.SortRules), args: queryArgs}
+}
+
+// buildSelectQuery 生成以 AND 连接 predicates 的 SELECT 语句，predicates 为空时不带 WHERE
+// source 为已加引号的表名，也可以是带抽样子句的表名
+func buildSelectQuery(d Dialect, source string, fields []string, predicates []string, sortRules []*pb.SortRule) string {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString("SELECT ")
+	queryBuilder.WriteString(quoteColumnList(d, fields))
+	queryBuilder.WriteString(" FROM ")
+	queryBuilder.WriteString(source)
+	if len(predicates) > 0 {
+		queryBuilder.WriteString(" WHERE ")
+		queryBuilder.WriteString(strings.Join(predicates, " AND "))
+	}
+	queryBuilder.WriteString(buildOrderByClause(d, sortRules))
+	return queryBuilder.String()
+}
+
+// partitionBatch 分片读取到的一个批次或读取错误
+type partitionBatch struct {
+	record arrow.Record
+	err    error
+}
+
+// Read 并发执行各分片查询，把 Arrow 批次依次交给 emit，emit 返回后批次即被释放
+// 分片按顺序下发，前一个分片拿到连接后才下发下一个，保序模式下正在输出的分片不会因连接池耗尽而等待后面的分片
+func (p *PartitionedRead) Read(ctx context.Context, strategy DatabaseStrategy, emit func(arrow.Record) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	outs := make([]chan partitionBatch, len(p.queries))
+	shared := make(chan partitionBatch, partitionBuffer*p.parallelism)
+	for i := range outs {
+		if p.Ordered {
+			outs[i] = make(chan partitionBatch, partitionBuffer)
+		} else {
+			outs[i] = shared
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var workers sync.WaitGroup
+		defer func() {
+			workers.Wait()
+			if !p.Ordered {
+				close(shared)
+			}
+		}()
+		sem := make(chan struct{}, p.parallelism)
+		for i, q := range p.queries {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			started := make(chan struct{})
+			workers.Add(1)
+			go func(out chan partitionBatch, q rangeQuery) {
+				defer workers.Done()
+				defer func() { <-sem }()
+				p.readRange(ctx, strategy, q, started, out)
+				if p.Ordered {
+					close(out)
+				}
+			}(outs[i], q)
+			<-started
+		}
+	}()
+
+	consume := func(ch <-chan partitionBatch) error {
+		for {
+			var batch partitionBatch
+			var ok bool
+			select {
+			case batch, ok = <-ch:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if !ok {
+				return nil
+			}
+			if batch.err != nil {
+				return batch.err
+			}
+			err := emit(batch.record)
+			batch.record.Release()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if !p.Ordered {
+		return consume(shared)
+	}
+	for _, out := range outs {
+		if err := consume(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRange 执行单个分片查询并逐批发送，查询返回后关闭 started
+func (p *PartitionedRead) readRange(ctx context.Context, strategy DatabaseStrategy, q rangeQuery,
+	started chan struct{}, out chan<- partitionBatch) {
+	send := func(batch partitionBatch) bool {
+		select {
+		case out <- batch:
+			return true
+		case <-ctx.Done():
+			if batch.record != nil {
+				batch.record.Release()
+			}
+			return false
+		}
+	}
+
+	log.Logger.Debugf("Partition query: %s, args: %v", q.query, q.args)
+	rows, err := strategy.Query(ctx, q.query, q.args...)
+	close(started)
+	if err != nil {
+		send(partitionBatch{err: fmt.Errorf("error executing partition query: %v", err)})
+		return
+	}
+	defer rows.Close()
+
+	batchSize, err := CalculateEstimatedBatchSize(rows, common.STREAM_DATA_SIZE)
+	if err != nil {
+		send(partitionBatch{err: fmt.Errorf("failed to calculate estimated batch size: %v", err)})
+		return
+	}
+	for {
+		record, err := strategy.RowsToArrowBatch(ctx, rows, batchSize)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			send(partitionBatch{err: fmt.Errorf("error reading Arrow batch: %v", err)})
+			return
+		}
+		if !send(partitionBatch{record: record}) {
+			return
+		}
+	}
+}
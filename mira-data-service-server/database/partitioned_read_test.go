@@ -0,0 +1,223 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPartitionRange(t *testing.T) {
+	ranges, err := splitPartitionRange("id", "1", "100", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{
+		{upper: int64(26)},
+		{lower: int64(26), upper: int64(51)},
+		{lower: int64(51), upper: int64(76)},
+		{lower: int64(76)},
+	}, ranges)
+
+	// 取值个数少于分片数时按取值个数分片
+	ranges, err = splitPartitionRange("id", "5", "6", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{{upper: int64(6)}, {lower: int64(6)}}, ranges)
+
+	// 跨度超过 int64 范围时不溢出
+	ranges, err = splitPartitionRange("id", "-9223372036854775808", "9223372036854775807", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{{upper: int64(0)}, {lower: int64(0)}}, ranges)
+
+	ranges, err = splitPartitionRange("id", strconv.FormatInt(math.MinInt64, 10), strconv.FormatInt(math.MaxInt64, 10), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{
+		{upper: int64(math.MinInt64 / 2)},
+		{lower: int64(math.MinInt64 / 2), upper: int64(0)},
+		{lower: int64(0), upper: int64(math.MaxInt64/2 + 1)},
+		{lower: int64(math.MaxInt64/2 + 1)},
+	}, ranges)
+
+	// MIN 和 MAX 都为负数
+	ranges, err = splitPartitionRange("id", "-100", "-1", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{
+		{upper: int64(-75)},
+		{lower: int64(-75), upper: int64(-50)},
+		{lower: int64(-50), upper: int64(-25)},
+		{lower: int64(-25)},
+	}, ranges)
+
+	ranges, err = splitPartitionRange("score", "0.5", "2.5", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{{upper: 1.5}, {lower: 1.5}}, ranges)
+
+	ranges, err = splitPartitionRange("id", "7", "7", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []partitionRange{{}}, ranges)
+
+	_, err = splitPartitionRange("created", "2024-01-01 00:00:00", "2024-02-01 00:00:00", 4)
+	assert.True(t, errors.Is(err, ErrPartitionUnavailable))
+}
+
+func TestPartitionColumnFromKeys(t *testing.T) {
+	keys := []*ds.TableKey{
+		{KeyType: ds.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"code"}},
+		{KeyType: ds.KeyType_KEY_TYPE_AUTO_INCREMENT, ColumnNames: []string{"a", "b"}},
+		{KeyType: ds.KeyType_KEY_TYPE_AUTO_INCREMENT, ColumnNames: []string{" id "}},
+	}
+	assert.Equal(t, "id", partitionColumnFromKeys(keys))
+	assert.Equal(t, "code", partitionColumnFromKeys(keys[:2]))
+	assert.Equal(t, "", partitionColumnFromKeys(nil))
+}
+
+func TestPlanPartitionedRead(t *testing.T) {
+	t.Run("detects primary key and keeps filters", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE").WithArgs("", "t").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+		mock.ExpectQuery("SELECT MIN\\(`id`\\), MAX\\(`id`\\) FROM `t` WHERE `a` = \\? OR `b` = \\?").
+			WithArgs("x", "y").
+			WillReturnRows(sqlmock.NewRows([]string{"min", "max"}).AddRow(int64(1), int64(10)))
+
+		expr := &ds.FilterExpression{Node: &ds.FilterExpression_Group{Group: &ds.FilterGroup{
+			Logic: ds.LogicalOperator_LOGICAL_OR,
+			Children: []*ds.FilterExpression{
+				{Node: &ds.FilterExpression_Condition{Condition: &ds.FilterCondition{FieldName: "a",
+					FieldValue: &ds.FilterValue{StrValue: "x"}, Operator: ds.FilterOperator_EQUAL}}},
+				{Node: &ds.FilterExpression_Condition{Condition: &ds.FilterCondition{FieldName: "b",
+					FieldValue: &ds.FilterValue{StrValue: "y"}, Operator: ds.FilterOperator_EQUAL}}},
+			},
+		}}}
+		plan, err := PlanPartitionedRead(context.Background(), &MySQLStrategy{DB: db}, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			PartitionedReadRequest{TableName: "t", Fields: []string{"id", "a"}, FilterExpression: expr, Parallelism: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, "id", plan.Column)
+		assert.False(t, plan.Ordered)
+		assert.Equal(t, []rangeQuery{
+			{query: "SELECT `id`, `a` FROM `t` WHERE (`a` = ? OR `b` = ?) AND `id` < ?", args: []interface{}{"x", "y", int64(6)}},
+			{query: "SELECT `id`, `a` FROM `t` WHERE (`a` = ? OR `b` = ?) AND `id` >= ?", args: []interface{}{"x", "y", int64(6)}},
+		}, plan.queries)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("sort column keeps order and reads nulls", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT MIN\("score"\), MAX\("score"\) FROM "t"`).
+			WillReturnRows(sqlmock.NewRows([]string{"min", "max"}).AddRow(int64(0), int64(3)))
+
+		plan, err := PlanPartitionedRead(context.Background(), &MySQLStrategy{DB: db}, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE,
+			PartitionedReadRequest{TableName: "t", PartitionColumn: "id", Parallelism: 2,
+				SortRules: []*ds.SortRule{{FieldName: "score", SortOrder: ds.SortOrder_DESC}}})
+		assert.NoError(t, err)
+		assert.Equal(t, "score", plan.Column)
+		assert.True(t, plan.Ordered)
+		assert.Equal(t, []rangeQuery{
+			{query: `SELECT * FROM "t" WHERE "score" IS NULL ORDER BY "score" DESC`, args: []interface{}{}},
+			{query: `SELECT * FROM "t" WHERE "score" >= $1 ORDER BY "score" DESC`, args: []interface{}{int64(2)}},
+			{query: `SELECT * FROM "t" WHERE "score" < $1 ORDER BY "score" DESC`, args: []interface{}{int64(2)}},
+		}, plan.queries)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("falls back without key or rows", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		strategy := &MySQLStrategy{DB: db}
+
+		mock.ExpectQuery("FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("a").AddRow("b"))
+		_, err = PlanPartitionedRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			PartitionedReadRequest{TableName: "t", Parallelism: 4})
+		assert.True(t, errors.Is(err, ErrPartitionUnavailable))
+
+		mock.ExpectQuery("SELECT MIN").WillReturnRows(sqlmock.NewRows([]string{"min", "max"}).AddRow(nil, nil))
+		_, err = PlanPartitionedRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			PartitionedReadRequest{TableName: "t", Parallelism: 4, Keys: []*ds.TableKey{
+				{KeyType: ds.KeyType_KEY_TYPE_AUTO_INCREMENT, ColumnNames: []string{"id"}}}})
+		assert.True(t, errors.Is(err, ErrPartitionUnavailable))
+
+		_, err = PlanPartitionedRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			PartitionedReadRequest{TableName: "t", Parallelism: 1})
+		assert.True(t, errors.Is(err, ErrPartitionUnavailable))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPartitionedReadRead(t *testing.T) {
+	newPlan := func(ordered bool) *PartitionedRead {
+		return &PartitionedRead{Column: "id", Ordered: ordered, parallelism: 3, queries: []rangeQuery{
+			{query: "SELECT `id` FROM `t` WHERE `id` < ?", args: []interface{}{int64(3)}},
+			{query: "SELECT `id` FROM `t` WHERE `id` >= ? AND `id` < ?", args: []interface{}{int64(3), int64(5)}},
+			{query: "SELECT `id` FROM `t` WHERE `id` >= ?", args: []interface{}{int64(5)}},
+		}}
+	}
+	expectRanges := func(mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(false)
+		column := sqlmock.NewColumn("id").OfType("BIGINT", int64(0))
+		mock.ExpectQuery("WHERE `id` < \\?$").WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRowsWithColumnDefinition(column).AddRow(int64(1)).AddRow(int64(2)))
+		mock.ExpectQuery("WHERE `id` >= \\? AND `id` < \\?$").WithArgs(int64(3), int64(5)).
+			WillReturnRows(sqlmock.NewRowsWithColumnDefinition(column).AddRow(int64(3)).AddRow(int64(4)))
+		mock.ExpectQuery("WHERE `id` >= \\?$").WithArgs(int64(5)).
+			WillReturnRows(sqlmock.NewRowsWithColumnDefinition(column).AddRow(int64(5)))
+	}
+	collect := func(ids *[]int64) func(arrow.Record) error {
+		return func(record arrow.Record) error {
+			*ids = append(*ids, record.Column(0).(*array.Int64).Int64Values()...)
+			return nil
+		}
+	}
+
+	t.Run("ordered", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectRanges(mock)
+
+		var ids []int64
+		assert.NoError(t, newPlan(true).Read(context.Background(), &MySQLStrategy{DB: db}, collect(&ids)))
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("interleaved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectRanges(mock)
+
+		var ids []int64
+		assert.NoError(t, newPlan(false).Read(context.Background(), &MySQLStrategy{DB: db}, collect(&ids)))
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stops on range error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.MatchExpectationsInOrder(false)
+		mock.ExpectQuery("SELECT").WillReturnError(errors.New("connection reset"))
+		mock.ExpectQuery("SELECT").WillReturnError(errors.New("connection reset"))
+		mock.ExpectQuery("SELECT").WillReturnError(errors.New("connection reset"))
+
+		err = newPlan(true).Read(context.Background(), &MySQLStrategy{DB: db}, func(arrow.Record) error { return nil })
+		assert.ErrorContains(t, err, "connection reset")
+	})
+
+}
@@ -0,0 +1,251 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/10/28
+	@note: 全局连接池注册表，按连接指纹复用 *sql.DB，定期回收没有被引用的空闲连接池
+
+*
+*/
+package database
+
+import (
+	"crypto/sha256"
+	"data-service/config"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// 未配置 pool_idle_timeout 时连接池的默认空闲回收时间
+	defaultPoolIdleTimeout = 30 * time.Minute
+	// 空闲连接池巡检间隔
+	poolJanitorInterval = 5 * time.Minute
+)
+
+// pooledDB 注册表中的一个连接池
+type pooledDB struct {
+	db        *sql.DB
+	kind      string
+	createdAt time.Time
+	lastUsed  time.Time
+	// refs 未归还的 PoolLease 数量，大于 0 时连接池不会被回收
+	refs int
+}
+
+// PoolLease 策略对连接池的引用，策略 Close 时归还
+// 未调用 Close 的策略被垃圾回收时由 finalizer 归还，避免连接池一直无法回收
+type PoolLease struct {
+	registry *PoolRegistry
+	key      string
+	once     sync.Once
+}
+
+// Release 归还引用，可重复调用，nil 时不做任何事
+func (l *PoolLease) Release() {
+	if l == nil {
+		return
+	}
+	l.once.Do(func() {
+		runtime.SetFinalizer(l, nil)
+		l.registry.release(l.key)
+	})
+}
+
+// PoolStats 单个连接池的统计信息
+type PoolStats struct {
+	Key                string        `json:"key"`
+	Kind               string        `json:"kind"`
+	MaxOpenConnections int           `json:"max_open_connections"`
+	OpenConnections    int           `json:"open_connections"`
+	InUse              int           `json:"in_use"`
+	Idle               int           `json:"idle"`
+	WaitCount          int64         `json:"wait_count"`
+	WaitDuration       time.Duration `json:"wait_duration"`
+	CreatedAt          time.Time     `json:"created_at"`
+	LastUsed           time.Time     `json:"last_used"`
+	Refs               int           `json:"refs"`
+}
+
+// PoolRegistry 按连接指纹管理所有数据源的连接池
+type PoolRegistry struct {
+	mu    sync.Mutex
+	pools map[string]*pooledDB
+}
+
+var (
+	poolRegistry    = &PoolRegistry{pools: make(map[string]*pooledDB)}
+	poolJanitorOnce sync.Once
+)
+
+// GetPoolRegistry 获取全局连接池注册表
+func GetPoolRegistry() *PoolRegistry {
+	return poolRegistry
+}
+
+// PoolFingerprint 生成连接池指纹：驱动类型、主机、端口、用户、库名以及 TLS 配置和密码的摘要
+// 密码只以摘要形式参与，避免明文出现在统计信息和日志中，同时密码变更后会建立新的连接池
+func PoolFingerprint(kind string, info *ds.ConnectionInfo) string {
+	return fmt.Sprintf("%s://%s@%s:%d/%s?tls=%s&auth=%s", kind, info.User, info.Host, info.Port, info.DbName,
+		tlsFingerprint(info.TlsConfig), shortDigest(info.Password))
+}
+
+// tlsFingerprint 计算 TLS 配置摘要，未开启 TLS 时返回 none
+func tlsFingerprint(tlsConfig *ds.DatasourceTlsConfig) string {
+	if tlsConfig == nil || tlsConfig.UseTls != 2 {
+		return "none"
+	}
+	return shortDigest(fmt.Sprintf("%d|%s|%s|%s|%s", tlsConfig.Mode, tlsConfig.ServerName,
+		tlsConfig.CaCert, tlsConfig.ClientCert, tlsConfig.ClientKey))
+}
+
+func shortDigest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Acquire 按指纹获取已注册的连接池并增加引用，使用完毕后需归还返回的 PoolLease
+func (r *PoolRegistry) Acquire(key string) (*sql.DB, *PoolLease, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.pools[key]
+	if !ok {
+		return nil, nil, false
+	}
+	return p.db, r.lease(key, p), true
+}
+
+// Register 注册新建的连接池并增加引用，若并发请求已注册同一指纹，则关闭新建的连接池并返回已有的
+func (r *PoolRegistry) Register(key string, kind string, db *sql.DB) (*sql.DB, *PoolLease) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if p, ok := r.pools[key]; ok {
+		if p.db != db {
+			_ = db.Close()
+		}
+		return p.db, r.lease(key, p)
+	}
+	now := time.Now()
+	p := &pooledDB{db: db, kind: kind, createdAt: now, lastUsed: now}
+	r.pools[key] = p
+	log.Logger.Infof("Registered %s connection pool, total pools: %d", kind, len(r.pools))
+	return db, r.lease(key, p)
+}
+
+// lease 增加连接池引用，调用方需持有 r.mu
+func (r *PoolRegistry) lease(key string, p *pooledDB) *PoolLease {
+	p.refs++
+	p.lastUsed = time.Now()
+	l := &PoolLease{registry: r, key: key}
+	runtime.SetFinalizer(l, (*PoolLease).Release)
+	return l
+}
+
+// release 减少连接池引用，并以归还时间作为最近使用时间
+func (r *PoolRegistry) release(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if p, ok := r.pools[key]; ok && p.refs > 0 {
+		p.refs--
+		p.lastUsed = time.Now()
+	}
+}
+
+// EvictIdle 关闭没有被引用、超过 idleTimeout 未被使用且没有使用中连接的连接池，返回回收数量
+func (r *PoolRegistry) EvictIdle(idleTimeout time.Duration) int {
+	r.mu.Lock()
+	var evicted []*pooledDB
+	for key, p := range r.pools {
+		if p.refs > 0 || time.Since(p.lastUsed) < idleTimeout || p.db.Stats().InUse > 0 {
+			continue
+		}
+		delete(r.pools, key)
+		evicted = append(evicted, p)
+	}
+	r.mu.Unlock()
+
+	// 在锁外关闭，db.Close 会等待归还中的连接
+	for _, p := range evicted {
+		if err := p.db.Close(); err != nil {
+			log.Logger.Warnf("Failed to close idle %s connection pool: %v", p.kind, err)
+		}
+	}
+	if len(evicted) > 0 {
+		log.Logger.Infof("Evicted %d idle connection pools", len(evicted))
+	}
+	return len(evicted)
+}
+
+// Stats 返回所有连接池的统计信息，按指纹排序
+func (r *PoolRegistry) Stats() []PoolStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := make([]PoolStats, 0, len(r.pools))
+	for key, p := range r.pools {
+		s := p.db.Stats()
+		stats = append(stats, PoolStats{
+			Key:                key,
+			Kind:               p.kind,
+			MaxOpenConnections: s.MaxOpenConnections,
+			OpenConnections:    s.OpenConnections,
+			InUse:              s.InUse,
+			Idle:               s.Idle,
+			WaitCount:          s.WaitCount,
+			WaitDuration:       s.WaitDuration,
+			CreatedAt:          p.createdAt,
+			LastUsed:           p.lastUsed,
+			Refs:               p.refs,
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Key < stats[j].Key })
+	return stats
+}
+
+// LogStats 打印所有连接池的状态
+func (r *PoolRegistry) LogStats() {
+	stats := r.Stats()
+	log.Logger.Infof("=== 连接池注册表状态，共 %d 个连接池 ===", len(stats))
+	for _, s := range stats {
+		log.Logger.Infof("  - %s: 总连接数=%d, 使用中=%d, 空闲=%d, 等待次数=%d, 总等待时长=%v, 引用数=%d, 最近使用=%s",
+			s.Key, s.OpenConnections, s.InUse, s.Idle, s.WaitCount, s.WaitDuration, s.Refs, s.LastUsed.Format(time.RFC3339))
+		if s.WaitCount > 0 {
+			log.Logger.Warnf("%s 有%d个请求等待过连接，考虑增加最大连接数", s.Key, s.WaitCount)
+		}
+	}
+}
+
+// poolIdleTimeout 读取连接池空闲回收时间（分钟），未配置时使用默认值
+func poolIdleTimeout() time.Duration {
+	conf := config.GetConfigMap()
+	if conf == nil || conf.Dbms.PoolIdleTimeout <= 0 {
+		return defaultPoolIdleTimeout
+	}
+	return time.Duration(conf.Dbms.PoolIdleTimeout) * time.Minute
+}
+
+// StartPoolJanitor 启动空闲连接池回收任务，只会启动一次
+func StartPoolJanitor() {
+	poolJanitorOnce.Do(func() {
+		go func() {
+			log.Logger.Info("Starting connection pool janitor")
+			ticker := time.NewTicker(poolJanitorInterval)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				poolRegistry.EvictIdle(poolIdleTimeout())
+				poolRegistry.LogStats()
+			}
+		}()
+	})
+}
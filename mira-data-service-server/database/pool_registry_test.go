@@ -0,0 +1,88 @@
+package database
+
+import (
+	ds "data-service/generated/datasource"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolFingerprint(t *testing.T) {
+	setup()
+
+	info := &ds.ConnectionInfo{Host: "127.0.0.1", Port: 3306, User: "root", Password: "secret", DbName: "demo"}
+	key := PoolFingerprint("mysql", info)
+	assert.Contains(t, key, "mysql://root@127.0.0.1:3306/demo?tls=none&auth=")
+	assert.NotContains(t, key, "secret")
+
+	// 库名、密码、TLS 配置变化都会得到不同的连接池
+	assert.NotEqual(t, key, PoolFingerprint("mysql", &ds.ConnectionInfo{Host: "127.0.0.1", Port: 3306, User: "root", Password: "secret", DbName: "other"}))
+	assert.NotEqual(t, key, PoolFingerprint("mysql", &ds.ConnectionInfo{Host: "127.0.0.1", Port: 3306, User: "root", Password: "changed", DbName: "demo"}))
+	assert.NotEqual(t, key, PoolFingerprint("kingbase", info))
+
+	tlsA := &ds.ConnectionInfo{Host: "127.0.0.1", Port: 3306, User: "root", DbName: "demo",
+		TlsConfig: &ds.DatasourceTlsConfig{UseTls: 2, Mode: 2, CaCert: "ca-a"}}
+	tlsB := &ds.ConnectionInfo{Host: "127.0.0.1", Port: 3306, User: "root", DbName: "demo",
+		TlsConfig: &ds.DatasourceTlsConfig{UseTls: 2, Mode: 2, CaCert: "ca-b"}}
+	assert.NotEqual(t, PoolFingerprint("mysql", tlsA), PoolFingerprint("mysql", tlsB))
+	assert.Equal(t, "none", tlsFingerprint(&ds.DatasourceTlsConfig{UseTls: 1, CaCert: "ignored"}))
+}
+
+func TestPoolRegistryReuseAndEvict(t *testing.T) {
+	setup()
+
+	registry := &PoolRegistry{pools: make(map[string]*pooledDB)}
+
+	first, firstMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	second, secondMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+
+	_, _, ok := registry.Acquire("k")
+	assert.False(t, ok)
+
+	db, registered := registry.Register("k", "mysql", first)
+	assert.Same(t, first, db)
+	// 并发注册同一指纹时保留先注册的连接池，后来的会被关闭
+	secondMock.ExpectClose()
+	db, concurrent := registry.Register("k", "mysql", second)
+	assert.Same(t, first, db)
+	assert.NoError(t, secondMock.ExpectationsWereMet())
+
+	db, acquired, ok := registry.Acquire("k")
+	assert.True(t, ok)
+	assert.Same(t, first, db)
+
+	stats := registry.Stats()
+	assert.Len(t, stats, 1)
+	assert.Equal(t, "k", stats[0].Key)
+	assert.Equal(t, "mysql", stats[0].Kind)
+	assert.Equal(t, 3, stats[0].Refs)
+
+	// 仍被引用的连接池即使超过空闲时间也不回收
+	registry.pools["k"].lastUsed = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, 0, registry.EvictIdle(time.Hour))
+
+	registered.Release()
+	concurrent.Release()
+	acquired.Release()
+	acquired.Release() // 重复归还不会多减引用
+	assert.Equal(t, 0, registry.pools["k"].refs)
+
+	// 归还时刷新最近使用时间，未超过空闲时间不回收
+	assert.Equal(t, 0, registry.EvictIdle(time.Hour))
+
+	firstMock.ExpectClose()
+	registry.pools["k"].lastUsed = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, 1, registry.EvictIdle(time.Hour))
+	assert.NoError(t, firstMock.ExpectationsWereMet())
+
+	_, _, ok = registry.Acquire("k")
+	assert.False(t, ok)
+}
@@ -0,0 +1,553 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/10/20
+	@note: 连接 PostgreSQL / openGauss
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/common"
+	"data-service/config"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+	"database/sql"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	_ "gitee.com/opengauss/openGauss-connector-go-pq"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+var (
+	postgresMutex sync.Mutex
+)
+
+// PostgresStrategy 访问原生 PostgreSQL 与 openGauss 数据源
+// 两者协议一致，区别仅在驱动：PostgreSQL 使用 pgx，openGauss 需要其 SHA256 认证，使用 openGauss 驱动
+type PostgresStrategy struct {
+	info   *ds.ConnectionInfo
+	dbType ds.DataSourceType
+	DB     *sql.DB
+	lease  *PoolLease
+}
+
+func NewPostgresStrategy(info *ds.ConnectionInfo) *PostgresStrategy {
+	dbType := ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL
+	if info != nil && info.Dbtype == int32(ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS) {
+		dbType = ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS
+	}
+	return &PostgresStrategy{
+		info:   info,
+		dbType: dbType,
+	}
+}
+
+// isPostgresFamily 判断是否为 PostgreSQL 协议的数据源
+func isPostgresFamily(dbType ds.DataSourceType) bool {
+	return dbType == ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL ||
+		dbType == ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS
+}
+
+// name 返回用于日志的数据源名称
+func (p *PostgresStrategy) name() string {
+	if p.dbType == ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS {
+		return "openGauss"
+	}
+	return "PostgreSQL"
+}
+
+// driverName 返回 database/sql 注册的驱动名称
+func (p *PostgresStrategy) driverName() string {
+	if p.dbType == ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS {
+		return "opengauss"
+	}
+	return "pgx"
+}
+
+func (p *PostgresStrategy) ConnectToDB() error {
+	return p.ConnectToDBWithPass(p.info)
+}
+
+func (p *PostgresStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
+	key := PoolFingerprint(p.driverName(), info)
+
+	postgresMutex.Lock()
+	defer postgresMutex.Unlock()
+
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		p.lease.Release()
+		p.DB, p.lease = db, lease
+		log.Logger.Debugf("Reusing existing %s connection pool", p.name())
+		return nil
+	}
+
+	// 构建 DSN，支持 disable/require/verify-ca/verify-full
+	dsn, err := buildPostgresDSN(info, info.TlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build TLS DSN: %v", err)
+	}
+
+	conf := config.GetConfigMap()
+	db, err := sql.Open(p.driverName(), dsn)
+	if err != nil {
+		log.Logger.Errorf("Failed to connect to %s: %v", p.name(), err)
+		return fmt.Errorf("failed to connect to %s: %v", p.name(), err)
+	}
+
+	db.SetMaxOpenConns(conf.Dbms.MaxOpenConns)
+	db.SetMaxIdleConns(conf.Dbms.MaxIdleConns)
+	db.SetConnMaxLifetime(10 * time.Minute) // 连接最大生命周期
+	db.SetConnMaxIdleTime(2 * time.Minute)  // 连接最大空闲时间
+
+	p.lease.Release()
+	p.DB, p.lease = GetPoolRegistry().Register(key, p.driverName(), db)
+	log.Logger.Infof("Successfully connected to %s", p.name())
+	return nil
+}
+
+// buildPostgresDSN 构建 key=value 形式的 DSN，sslmode 与 DatasourceTlsConfig.Mode 对应
+func buildPostgresDSN(info *ds.ConnectionInfo, tlsConfig *ds.DatasourceTlsConfig) (string, error) {
+	params := []string{
+		"host=" + postgresDSNValue(info.Host),
+		fmt.Sprintf("port=%d", info.Port),
+		"user=" + postgresDSNValue(info.User),
+		"password=" + postgresDSNValue(info.Password),
+		"dbname=" + postgresDSNValue(info.DbName),
+	}
+
+	// 如果没有 TLS 配置或 UseTls != 2，使用 disable 模式
+	if tlsConfig == nil || tlsConfig.UseTls != 2 {
+		params = append(params, "sslmode=disable")
+		return strings.Join(params, " "), nil
+	}
+
+	params = append(params, "sslmode="+postgresSSLMode(tlsConfig.Mode))
+
+	// 处理 CA 证书（Mode 2 和 3 需要）
+	if tlsConfig.CaCert != "" && tlsConfig.Mode >= 2 {
+		caFile, err := writeCertToTempFileFromBase64(tlsConfig.CaCert, "postgres-ca")
+		if err != nil {
+			return "", fmt.Errorf("failed to write CA cert: %v", err)
+		}
+		params = append(params, "sslrootcert="+postgresDSNValue(caFile))
+	}
+
+	// 处理客户端证书和私钥（双向认证时需要同时提供）
+	if tlsConfig.ClientCert != "" && tlsConfig.ClientKey != "" {
+		certFile, err := writeCertToTempFileFromBase64(tlsConfig.ClientCert, "postgres-client-cert")
+		if err != nil {
+			return "", fmt.Errorf("failed to write client cert: %v", err)
+		}
+		keyFile, err := writeCertToTempFileFromBase64(tlsConfig.ClientKey, "postgres-client-key")
+		if err != nil {
+			return "", fmt.Errorf("failed to write client key: %v", err)
+		}
+		params = append(params, "sslcert="+postgresDSNValue(certFile), "sslkey="+postgresDSNValue(keyFile))
+	}
+
+	return strings.Join(params, " "), nil
+}
+
+// postgresSSLMode 将 TLS 模式映射为 libpq 的 sslmode
+func postgresSSLMode(mode int32) string {
+	switch mode {
+	case 2:
+		return "verify-ca" // 验证 CA 证书
+	case 3:
+		return "verify-full" // 验证 CA 证书和主机名
+	default:
+		return "require" // 要求 SSL，但不验证证书
+	}
+}
+
+// postgresDSNValue 对 DSN 中的值加单引号，避免密码等包含空格或引号时被截断
+func postgresDSNValue(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `'`, `\'`)
+	return "'" + escaped + "'"
+}
+
+func (p *PostgresStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+	log.Logger.Debugf("%s query: %s args=%v", p.name(), sqlQuery, args)
+	rows, err := p.DB.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		log.Logger.Errorf("%s query failed: %v", p.name(), err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
+func (p *PostgresStrategy) Close() error {
+	p.lease.Release()
+	p.DB, p.lease = nil, nil
+	return nil
+}
+
+func (p *PostgresStrategy) GetJdbcUrl() string {
+	scheme := "postgresql"
+	if p.dbType == ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS {
+		scheme = "opengauss"
+	}
+	return fmt.Sprintf("jdbc:%s://%s:%d/%s?user=%s&password=%s",
+		scheme, p.info.Host, p.info.Port, p.info.DbName, p.info.User, p.info.Password)
+}
+
+func (p *PostgresStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
+	if rows == nil {
+		return nil, fmt.Errorf("no rows to convert")
+	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+	pool := memory.NewGoAllocator()
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, io.EOF
+	}
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %v", err)
+	}
+
+	var fields []arrow.Field
+	for i, col := range cols {
+		log.Logger.Debugf("SQL type for column %s: %s", col, colTypes[i].DatabaseTypeName())
+		fields = append(fields, arrow.Field{Name: col, Type: ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, colTypes[i])})
+	}
+	schema := arrow.NewSchema(fields, nil)
+	builder := array.NewRecordBuilder(pool, schema)
+	defer builder.Release()
+
+	values := make([]interface{}, len(cols))
+	valuePtrs := make([]interface{}, len(cols))
+	for i := range valuePtrs {
+		valuePtrs[i] = &values[i]
+	}
+
+	rowCount := 0
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		for i, val := range values {
+			if err := utils.AppendValueToBuilder(builder.Field(i), val); err != nil {
+				log.Logger.Errorf("Failed to append value for column %d: %v", i, err)
+				return nil, err
+			}
+		}
+		rowCount++
+		if rowCount >= batchSize {
+			break // 达到批次大小，结束循环，发送给客户端
+		}
+	}
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
+	if rowCount == 0 {
+		return nil, io.EOF
+	}
+	return builder.NewRecord(), nil
+}
+
+func (p *PostgresStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
+	createTableSQL := buildCreatePostgresTableSQL(tableName, schema)
+	log.Logger.Infof("createTableSQL: %s", createTableSQL)
+	if _, err := p.DB.ExecContext(ctx, createTableSQL); err != nil {
+		return fmt.Errorf("failed to create %s table: %v", p.name(), err)
+	}
+	log.Logger.Infof("Created table if not exists: %s", tableName)
+	return nil
+}
+
+// buildCreatePostgresTableSQL 根据 Arrow Schema 生成创建表的 SQL 语句
+func buildCreatePostgresTableSQL(tableName string, schema *arrow.Schema) string {
+	return buildCreateTableSQL(postgresDialect, tableName, schema, true)
+}
+
+// splitPostgresTableName 拆分 schema.table 形式的表名，未指定 schema 时返回空字符串，由 current_schema() 决定
+func splitPostgresTableName(tableName string) (string, string) {
+	parts := strings.SplitN(tableName, ".", 2)
+	if len(parts) == 2 {
+		return strings.Trim(parts[0], "\""), strings.Trim(parts[1], "\"")
+	}
+	return "", strings.Trim(tableName, "\"")
+}
+
+func (p *PostgresStrategy) GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+	// database 为库名，schema 从 schema.table 形式的表名中解析
+	schemaName, table := splitPostgresTableName(tableName)
+
+	var result TableInfoResponse
+
+	if isExactQuery {
+		// 精确查询时，只查询记录数
+		sqlQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", postgresDialect.QuoteTableName(tableName))
+		log.Logger.Infof("Get table info executing query: %s with parameters: database=%s, tableName=%s", sqlQuery, database, tableName)
+
+		var rowCount int64
+		if err := p.DB.QueryRowContext(ctx, sqlQuery).Scan(&rowCount); err != nil {
+			log.Logger.Errorf("Error executing query: %v", err)
+			return nil, fmt.Errorf("error executing query: %v", err)
+		}
+		result.TableSchema = schemaName
+		result.TableName = tableName // 返回表名
+		result.TableRows = clampPostgresRowCount(rowCount)
+		result.TableSize = 0 // 精确查询不关心表的大小
+	} else {
+		// 检查是否启用估算模式
+		if ShouldUseEstimationOnly() {
+			// 直接使用估算方式，跳过 pg_class 查询
+			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping pg_class query")
+			FillTableInfoFromEstimation(ctx, p, database, tableName, schemaName, &result)
+		} else {
+			// 普通查询，先尝试从 pg_class 统计信息获取
+			rowCount, size, err := p.getPgClassStats(ctx, schemaName, table)
+			result.TableSchema = schemaName
+			result.TableName = tableName
+			result.TableRows = clampPostgresRowCount(rowCount)
+			result.TableSize = size
+			if err != nil || result.TableRows == 0 {
+				// 表从未执行过 VACUUM/ANALYZE 时 reltuples 无效，使用估算方式
+				LogQueryFailure("pg_class", err, result.TableName, result.TableRows)
+				FillTableInfoFromEstimation(ctx, p, database, tableName, schemaName, &result)
+			}
+		}
+	}
+
+	// 获取表结构信息
+	columns, keys, err := p.DescribeTable(ctx, database, tableName)
+	if err != nil {
+		log.Logger.Warnf("Failed to load %s schema: %v", p.name(), err)
+	}
+
+	log.Logger.Infof("Table info: %+v", result)
+
+	return &ds.TableInfoResponse{
+		TableName:   result.TableName,
+		RecordCount: result.TableRows,
+		RecordSize:  result.TableSize,
+		Columns:     columns,
+		Keys:        keys,
+	}, nil
+}
+
+// getPgClassStats 基于 pg_class 估算表的行数和大小
+// reltuples/relpages 是上次 VACUUM/ANALYZE 时的快照，按当前物理页数等比换算，与优化器的估算方式一致
+func (p *PostgresStrategy) getPgClassStats(ctx context.Context, schemaName, tableName string) (int64, int64, error) {
+	query := `SELECT c.reltuples, c.relpages, pg_relation_size(c.oid), pg_total_relation_size(c.oid),
+			current_setting('block_size')::bigint
+		FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema()) AND c.relname = $2`
+
+	var relTuples float64
+	var relPages, relationSize, totalSize, blockSize int64
+	if err := p.DB.QueryRowContext(ctx, query, schemaName, tableName).
+		Scan(&relTuples, &relPages, &relationSize, &totalSize, &blockSize); err != nil {
+		return 0, 0, err
+	}
+
+	return estimatePostgresRowCount(relTuples, relPages, relationSize, blockSize), totalSize, nil
+}
+
+// estimatePostgresRowCount 根据 pg_class 中的行密度与当前页数估算行数
+// reltuples 为 -1（PG14+ 未分析）或 0 时返回 0，由调用方退化为采样估算
+func estimatePostgresRowCount(relTuples float64, relPages, relationSize, blockSize int64) int64 {
+	if relTuples <= 0 {
+		return 0
+	}
+	if relPages <= 0 || blockSize <= 0 {
+		return int64(math.Round(relTuples))
+	}
+	currentPages := relationSize / blockSize
+	if currentPages <= 0 {
+		return int64(math.Round(relTuples))
+	}
+	density := relTuples / float64(relPages)
+	return int64(math.Round(density * float64(currentPages)))
+}
+
+// clampPostgresRowCount TableInfoResponse 的行数为 int32，超出范围时截断
+func clampPostgresRowCount(rowCount int64) int32 {
+	if rowCount > math.MaxInt32 {
+		log.Logger.Warnf("Table row count %d exceeds int32, truncated", rowCount)
+		return math.MaxInt32
+	}
+	return int32(rowCount)
+}
+
+// DescribeTable 获取字段元数据与表键信息，schema 从 schema.table 形式的表名中解析
+func (p *PostgresStrategy) DescribeTable(ctx context.Context, _ string, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	schemaName, table := splitPostgresTableName(tableName)
+	return describePostgresTable(ctx, p.DB, schemaName, table)
+}
+
+func (p *PostgresStrategy) BuildWithConditionQuery(
+	tableName string,
+	fields []string,
+	filterNames []string,
+	filterOperators []ds.FilterOperator,
+	filterValues []*ds.FilterValue,
+	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
+) (string, []interface{}, error) {
+	return buildConditionQuery(postgresDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, nil)
+}
+
+func (p *PostgresStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	// CREATE DATABASE 不支持 IF NOT EXISTS，先查询 pg_database
+	var exists bool
+	if err := p.DB.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM pg_database WHERE datname = $1)", dbName).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check %s database '%s': %v", p.name(), dbName, err)
+	}
+	if exists {
+		return nil
+	}
+
+	createSQL := fmt.Sprintf("CREATE DATABASE %s", postgresDialect.QuoteIdentifier(dbName))
+	if _, err := p.DB.ExecContext(ctx, createSQL); err != nil {
+		// 并发创建时可能已被其他请求创建
+		if strings.Contains(err.Error(), "already exists") {
+			return nil
+		}
+		return fmt.Errorf("failed to create %s database '%s': %v", p.name(), dbName, err)
+	}
+	log.Logger.Infof("Created %s database '%s'", p.name(), dbName)
+	return nil
+}
+
+func (p *PostgresStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
+	schemaName, table := splitPostgresTableName(tableName)
+	var exists bool
+	err := p.DB.QueryRowContext(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM information_schema.tables
+			WHERE table_schema = COALESCE(NULLIF($1, ''), current_schema()) AND table_name = $2)`,
+		schemaName, table).Scan(&exists)
+	return exists, err
+}
+
+func (p *PostgresStrategy) CleanupOldTables(ctx context.Context, schema string, retentionDays int) error {
+	query := `
+		SELECT table_name
+		FROM information_schema.tables
+		WHERE table_schema = $1 AND table_name LIKE '20%'`
+	rows, err := p.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return fmt.Errorf("failed to query tables: %v", err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -retentionDays).Format("20060102")
+	var expiredTables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan table name: %v", err)
+		}
+		if len(table) >= 8 && table[:8] < cutoff {
+			expiredTables = append(expiredTables, table)
+		}
+	}
+	rows.Close()
+
+	for _, table := range expiredTables {
+		dropSQL := fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", postgresDialect.QuoteIdentifier(schema), postgresDialect.QuoteIdentifier(table))
+		if _, err := p.DB.ExecContext(ctx, dropSQL); err != nil {
+			log.Logger.Warnf("Failed to drop %s table %s.%s: %v", p.name(), schema, table, err)
+		} else {
+			log.Logger.Infof("Dropped table '%s.%s'", schema, table)
+		}
+	}
+	return nil
+}
+
+func (p *PostgresStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
+	queryTools := &QueryBuilder{}
+	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators, filterValues, filterExpr, p.dbType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build group count query: %v", err)
+	}
+
+	log.Logger.Debugf("Executing group count query: %s with args: %v", query, args)
+	rows, err := p.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute group count query: %v", err)
+	}
+	defer rows.Close()
+
+	return ProcessGroupCountResults(rows, tableName)
+}
+
+// GetTableRowCount 实现 TableInfoEstimator 接口
+func (p *PostgresStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", postgresDialect.QuoteTableName(tableName))
+	var rowCount int64
+	if err := p.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
+		return 0, err
+	}
+	return clampPostgresRowCount(rowCount), nil
+}
+
+// EstimateTableSize 实现 TableInfoEstimator 接口，通过采样数据估算表的大小
+func (p *PostgresStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
+	// 获取100条数据估算平均行大小
+	sampleQuery := postgresDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", postgresDialect.QuoteTableName(tableName)), 100, 0)
+	rows, err := p.DB.QueryContext(ctx, sampleQuery)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
+	}
+	defer rows.Close()
+
+	// 获取列信息
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get columns: %v", err)
+	}
+
+	// 计算样本数据的总大小
+	var totalSampleSize int64
+	rowCount := 0
+	values := make([]interface{}, len(columns))
+	valuePtrs := make([]interface{}, len(columns))
+	for i := range valuePtrs {
+		valuePtrs[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			log.Logger.Errorf("Failed to scan sample row: %v", err)
+			continue
+		}
+
+		// 估算每行的大小
+		totalSampleSize += common.EstimateRowSize(values)
+		rowCount++
+	}
+
+	// 根据样本数据估算总大小
+	if rowCount > 0 {
+		avgRowSize := totalSampleSize / int64(rowCount)
+		estimatedSize := avgRowSize * int64(totalRows)
+		log.Logger.Infof("Estimated table size: %d bytes (avg row size: %d, total rows: %d)",
+			estimatedSize, avgRowSize, totalRows)
+		return estimatedSize, nil
+	}
+
+	return 0, fmt.Errorf("no sample data available for size estimation")
+}
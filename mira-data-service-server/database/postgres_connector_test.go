@@ -0,0 +1,174 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWithConditionQueryPostgres(t *testing.T) {
+	testCases := []struct {
+		name            string
+		tableName       string
+		fields          []string
+		filterNames     []string
+		filterOperators []ds.FilterOperator
+		filterValues    []*ds.FilterValue
+		sortRules       []*ds.SortRule
+		expectedQuery   string
+		expectedArgs    []interface{}
+	}{
+		{
+			name:            "Test schema qualified table with ORDER BY",
+			tableName:       "sales.orders",
+			fields:          []string{"id", "`name`"},
+			filterNames:     []string{"age"},
+			filterOperators: []ds.FilterOperator{ds.FilterOperator_GREATER_THAN},
+			filterValues:    []*ds.FilterValue{{IntValue: 30}},
+			sortRules: []*ds.SortRule{
+				{FieldName: "name", SortOrder: ds.SortOrder_DESC},
+			},
+			expectedQuery: `SELECT "id", "name" FROM "sales"."orders" WHERE "age" > $1 ORDER BY "name" DESC`,
+			expectedArgs:  []interface{}{int32(30)},
+		},
+		{
+			name:            "Test IN and LIKE Operators",
+			tableName:       "users",
+			filterNames:     []string{"status", "email"},
+			filterOperators: []ds.FilterOperator{ds.FilterOperator_IN_OPERATOR, ds.FilterOperator_LIKE_OPERATOR},
+			filterValues: []*ds.FilterValue{
+				{StrValues: []string{"active", "locked"}},
+				{StrValue: "%@example.com"},
+			},
+			expectedQuery: `SELECT * FROM "users" WHERE "status" IN ($1, $2) AND "email" LIKE $3`,
+			expectedArgs:  []interface{}{"active", "locked", "%@example.com"},
+		},
+		{
+			name:          "Test identifier with quote",
+			tableName:     `odd"table`,
+			expectedQuery: `SELECT * FROM "odd""table"`,
+			expectedArgs:  []interface{}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy := NewPostgresStrategy(&ds.ConnectionInfo{Dbtype: int32(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL)})
+			query, args, err := strategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules, nil)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if query != tc.expectedQuery {
+				t.Errorf("Expected query: %s, got: %s", tc.expectedQuery, query)
+			}
+
+			if !reflect.DeepEqual(args, tc.expectedArgs) {
+				t.Errorf("Expected args: %v, got: %v", tc.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestBuildPostgresDSN(t *testing.T) {
+	info := &ds.ConnectionInfo{
+		Host:     "127.0.0.1",
+		Port:     5432,
+		User:     "postgres",
+		Password: "p@ss word'1",
+		DbName:   "demo",
+	}
+
+	dsn, err := buildPostgresDSN(info, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `host='127.0.0.1' port=5432 user='postgres' password='p@ss word\'1' dbname='demo' sslmode=disable`, dsn)
+
+	dsn, err = buildPostgresDSN(info, &ds.DatasourceTlsConfig{UseTls: 2, Mode: 1})
+	assert.NoError(t, err)
+	assert.Contains(t, dsn, "sslmode=require")
+	assert.NotContains(t, dsn, "sslrootcert")
+
+	assert.Equal(t, "verify-ca", postgresSSLMode(2))
+	assert.Equal(t, "verify-full", postgresSSLMode(3))
+	assert.Equal(t, "require", postgresSSLMode(0))
+}
+
+func TestEstimatePostgresRowCount(t *testing.T) {
+	testCases := []struct {
+		name         string
+		relTuples    float64
+		relPages     int64
+		relationSize int64
+		blockSize    int64
+		want         int64
+	}{
+		{name: "Test never analyzed", relTuples: -1, relPages: 0, relationSize: 8192, blockSize: 8192, want: 0},
+		{name: "Test no pages", relTuples: 500, relPages: 0, relationSize: 0, blockSize: 8192, want: 500},
+		{name: "Test table grown since analyze", relTuples: 1000, relPages: 10, relationSize: 20 * 8192, blockSize: 8192, want: 2000},
+		{name: "Test table unchanged", relTuples: 1000, relPages: 10, relationSize: 10 * 8192, blockSize: 8192, want: 1000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, estimatePostgresRowCount(tc.relTuples, tc.relPages, tc.relationSize, tc.blockSize))
+		})
+	}
+}
+
+func TestPostgresGetPgClassStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("FROM pg_class c JOIN pg_namespace n").
+		WithArgs("sales", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"reltuples", "relpages", "pg_relation_size", "pg_total_relation_size", "current_setting"}).
+			AddRow(float64(1000), int64(10), int64(15*8192), int64(300000), int64(8192)))
+
+	strategy := &PostgresStrategy{DB: db, dbType: ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL}
+	rows, size, err := strategy.getPgClassStats(context.Background(), "sales", "orders")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), rows)
+	assert.Equal(t, int64(300000), size)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
+
+func TestPostgresTableNameAndTypes(t *testing.T) {
+	schema, table := splitPostgresTableName(`"sales"."orders"`)
+	assert.Equal(t, "sales", schema)
+	assert.Equal(t, "orders", table)
+	schema, table = splitPostgresTableName("orders")
+	assert.Equal(t, "", schema)
+	assert.Equal(t, "orders", table)
+
+	postgres := ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL
+	assert.Equal(t, arrow.PrimitiveTypes.Int16, ArrowType(postgres, ParseNativeType("INT2")))
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, ArrowType(postgres, ParseNativeType("INT8")))
+	assert.Equal(t, arrow.PrimitiveTypes.Float32, ArrowType(postgres, ParseNativeType("FLOAT4")))
+	assert.Equal(t, arrow.BinaryTypes.LargeString, ArrowType(postgres, ParseNativeType("JSONB")))
+	assert.Equal(t, timestampTZType, ArrowType(postgres, ParseNativeType("timestamp with time zone")))
+
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "amount", Type: &arrow.Decimal128Type{Precision: 12, Scale: 2}},
+		{Name: "note", Type: arrow.BinaryTypes.String},
+	}, nil)
+	assert.Equal(t, `CREATE TABLE IF NOT EXISTS "tmp"."result" ("id" BIGINT, "amount" NUMERIC(12,2), "note" TEXT)`,
+		buildCreatePostgresTableSQL("tmp.result", arrowSchema))
+
+	sql, err := (&SQLGenerator{}).GenerateInsertSQL("tmp.result", []interface{}{1, "2.00", "a"}, arrowSchema,
+		ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS)
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "tmp"."result" ("id", "amount", "note") VALUES ($1, $2, $3)`, sql)
+}
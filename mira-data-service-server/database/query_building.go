@@ -82,131 +82,143 @@ func (q *QueryBuilder) OperatorToString(operator pb.FilterOperator) string {
 		return "LIKE"
 	case pb.FilterOperator_IN_OPERATOR:
 		return "IN"
+	case pb.FilterOperator_NOT_LIKE_OPERATOR:
+		return "NOT LIKE"
+	case pb.FilterOperator_NOT_IN_OPERATOR:
+		return "NOT IN"
+	case pb.FilterOperator_BETWEEN_OPERATOR:
+		return "BETWEEN"
+	case pb.FilterOperator_IS_NULL:
+		return "IS NULL"
+	case pb.FilterOperator_IS_NOT_NULL:
+		return "IS NOT NULL"
 	default:
 		return "="
 	}
 }
 
-// BuildGroupCountQuery 构建分组计数查询的SQL语句
+// BuildGroupCountQuery 构建分组计数查询的SQL语句，filterExpr 与平铺的过滤条件以 AND 组合
 func (q *QueryBuilder) BuildGroupCountQuery(tableName string, groupBy []string, filterNames []string,
-	filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue, dbType pb.DataSourceType) (string, []interface{}, error) {
+	filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue, filterExpr *pb.FilterExpression,
+	dbType pb.DataSourceType) (string, []interface{}, error) {
 
 	var queryBuilder strings.Builder
-	args := []interface{}{}
-	paramIndex := 1 // 用于Kingbase等使用$1,$2形式参数的数据库
-
-	// 确定参数占位符格式
-	var placeholder string
-	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-		placeholder = "$%d"
-	} else {
-		placeholder = "?"
-	}
+	dialect := DialectFor(dbType)
 
 	// 构建 SELECT 子句，包含 COUNT(*)
 	queryBuilder.WriteString("SELECT COUNT(*) as count FROM ")
-
-	// 处理表名，Kingbase需要添加双引号
-	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-		queryBuilder.WriteString(fmt.Sprintf("\"%s\"", tableName))
-	} else {
-		queryBuilder.WriteString(tableName)
-	}
+	queryBuilder.WriteString(dialect.QuoteTableName(tableName))
 
 	// 构建 WHERE 子句（如果有过滤条件）
-	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
-		if len(filterNames) != len(filterOperators) || len(filterOperators) != len(filterValues) {
-			return "", nil, fmt.Errorf("filterNames, filterOperators, and filterValues must have the same length")
-		}
+	whereClause, args, err := newFilterDialect(dialect, nil).BuildWhereClause(filterNames, filterOperators, filterValues,
+		filterExpr, []interface{}{})
+	if err != nil {
+		return "", nil, err
+	}
+	queryBuilder.WriteString(whereClause)
 
-		queryBuilder.WriteString(" WHERE ")
+	// 如果 groupBy 不为空，添加 GROUP BY 子句
+	if len(groupBy) > 0 {
+		queryBuilder.WriteString(" GROUP BY ")
+		queryBuilder.WriteString(quoteColumnList(dialect, groupBy))
+	}
 
-		conditions := make([]string, len(filterNames))
-		for i := range filterNames {
-			operator := filterOperators[i]
-			filterValue := filterValues[i]
-			filterName := filterNames[i]
+	return queryBuilder.String(), args, nil
+}
 
-			// Kingbase需要给字段名添加双引号
-			if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-				filterName = fmt.Sprintf("\"%s\"", filterName)
-			}
+// aggregateFunctionSQL 聚合函数对应的 SQL 函数名，以及结果列名的默认前缀
+var aggregateFunctionSQL = map[pb.AggregateFunction]struct{ name, aliasPrefix string }{
+	pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT:          {"COUNT", "count"},
+	pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT_DISTINCT: {"COUNT", "count_distinct"},
+	pb.AggregateFunction_AGGREGATE_FUNCTION_SUM:            {"SUM", "sum"},
+	pb.AggregateFunction_AGGREGATE_FUNCTION_AVG:            {"AVG", "avg"},
+	pb.AggregateFunction_AGGREGATE_FUNCTION_MIN:            {"MIN", "min"},
+	pb.AggregateFunction_AGGREGATE_FUNCTION_MAX:            {"MAX", "max"},
+}
 
-			// 提取有效值
-			argsFromFilter := q.ExtractQueryArgs([]*pb.FilterValue{filterValue})
-			if len(argsFromFilter) == 0 {
-				return "", nil, fmt.Errorf("no valid values found for filter '%s'", filterName)
-			}
+// BuildAggregateQuery 构建分组聚合查询，每个分组返回一行：分组字段在前，聚合列在后
+// aggregates 为空时只统计每组的 COUNT(*)；having 与 sortRules 中的字段名可以是聚合列的结果列名或分组字段，
+// 结果列名在 HAVING 中展开为聚合表达式，因为 PostgreSQL 系数据库不支持在 HAVING 中引用列别名
+func (q *QueryBuilder) BuildAggregateQuery(tableName string, groupBy []string, aggregates []*pb.AggregateField,
+	filterNames []string, filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue,
+	filterExpr *pb.FilterExpression, having *pb.FilterExpression, sortRules []*pb.SortRule,
+	dbType pb.DataSourceType) (string, []interface{}, error) {
+	dialect := DialectFor(dbType)
+	if len(aggregates) == 0 {
+		aggregates = []*pb.AggregateField{{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT}}
+	}
 
-			switch operator {
-			case pb.FilterOperator_IN_OPERATOR:
-				// 构建 IN 操作符的条件
-				var inPlaceholders []string
-				for _, v := range argsFromFilter {
-					if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-						inPlaceholders = append(inPlaceholders, fmt.Sprintf(placeholder, paramIndex))
-						paramIndex++
-					} else {
-						inPlaceholders = append(inPlaceholders, placeholder)
-					}
-					args = append(args, v)
-				}
-				conditions[i] = fmt.Sprintf("%s IN (%s)", filterName, strings.Join(inPlaceholders, ", "))
-
-			case pb.FilterOperator_GREATER_THAN, pb.FilterOperator_LESS_THAN,
-				pb.FilterOperator_GREATER_THAN_OR_EQUAL, pb.FilterOperator_LESS_THAN_OR_EQUAL,
-				pb.FilterOperator_NOT_EQUAL:
-				// 构建比较操作符的条件
-				if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-					conditions[i] = fmt.Sprintf("%s %s %s", filterName, q.OperatorToString(operator), fmt.Sprintf(placeholder, paramIndex))
-					paramIndex++
-				} else {
-					conditions[i] = fmt.Sprintf("%s %s %s", filterName, q.OperatorToString(operator), placeholder)
-				}
-				args = append(args, argsFromFilter[0]) // 取第一个有效值
-
-			case pb.FilterOperator_LIKE_OPERATOR:
-				// 构建 LIKE 操作符的条件
-				if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-					conditions[i] = fmt.Sprintf("%s LIKE %s", filterName, fmt.Sprintf(placeholder, paramIndex))
-					paramIndex++
-				} else {
-					conditions[i] = fmt.Sprintf("%s LIKE %s", filterName, placeholder)
-				}
-				args = append(args, argsFromFilter[0]) // 取第一个有效值
-
-			default:
-				// 默认等于操作
-				if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-					conditions[i] = fmt.Sprintf("%s = %s", filterName, fmt.Sprintf(placeholder, paramIndex))
-					paramIndex++
-				} else {
-					conditions[i] = fmt.Sprintf("%s = %s", filterName, placeholder)
-				}
-				args = append(args, argsFromFilter[0]) // 取第一个有效值
-			}
+	columns := make([]string, 0, len(groupBy)+len(aggregates))
+	for _, field := range groupBy {
+		if strings.TrimSpace(field) == "" {
+			return "", nil, fmt.Errorf("group by field must not be empty")
+		}
+		columns = append(columns, dialect.QuoteIdentifier(field))
+	}
+	// 结果列名到聚合表达式的映射，用于渲染 HAVING
+	aliasExprs := make(map[string]string, len(aggregates))
+	for _, agg := range aggregates {
+		fn, ok := aggregateFunctionSQL[agg.GetFunction()]
+		if !ok {
+			return "", nil, fmt.Errorf("unsupported aggregate function: %v", agg.GetFunction())
+		}
+		var expr, alias string
+		switch {
+		case agg.GetFieldName() != "" && agg.GetFunction() == pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT_DISTINCT:
+			expr = fmt.Sprintf("COUNT(DISTINCT %s)", dialect.QuoteIdentifier(agg.GetFieldName()))
+			alias = fn.aliasPrefix + "_" + agg.GetFieldName()
+		case agg.GetFieldName() != "":
+			expr = fmt.Sprintf("%s(%s)", fn.name, dialect.QuoteIdentifier(agg.GetFieldName()))
+			alias = fn.aliasPrefix + "_" + agg.GetFieldName()
+		case agg.GetFunction() == pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT:
+			expr, alias = "COUNT(*)", fn.aliasPrefix
+		default:
+			return "", nil, fmt.Errorf("aggregate function %v requires a field name", agg.GetFunction())
 		}
+		if agg.GetAlias() != "" {
+			alias = agg.GetAlias()
+		}
+		if _, dup := aliasExprs[alias]; dup {
+			return "", nil, fmt.Errorf("duplicate aggregate column: %s", alias)
+		}
+		aliasExprs[alias] = expr
+		columns = append(columns, fmt.Sprintf("%s AS %s", expr, dialect.QuoteIdentifier(alias)))
+	}
 
-		queryBuilder.WriteString(strings.Join(conditions, " AND "))
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString("SELECT ")
+	queryBuilder.WriteString(strings.Join(columns, ", "))
+	queryBuilder.WriteString(" FROM ")
+	queryBuilder.WriteString(dialect.QuoteTableName(tableName))
+
+	whereClause, args, err := newFilterDialect(dialect, nil).BuildWhereClause(filterNames, filterOperators, filterValues,
+		filterExpr, []interface{}{})
+	if err != nil {
+		return "", nil, err
 	}
+	queryBuilder.WriteString(whereClause)
 
-	// 如果 groupBy 不为空，添加 GROUP BY 子句
 	if len(groupBy) > 0 {
 		queryBuilder.WriteString(" GROUP BY ")
+		queryBuilder.WriteString(quoteColumnList(dialect, groupBy))
+	}
 
-		// 处理字段名，Kingbase需要添加双引号
-		var processedGroupBy []string
-		if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-			processedGroupBy = make([]string, len(groupBy))
-			for i, field := range groupBy {
-				processedGroupBy[i] = fmt.Sprintf("\"%s\"", field)
-			}
-		} else {
-			processedGroupBy = groupBy
+	havingDialect := newFilterDialect(dialect, nil)
+	havingDialect.QuoteIdent = func(name string) string {
+		if expr, ok := aliasExprs[name]; ok {
+			return expr
 		}
-
-		queryBuilder.WriteString(strings.Join(processedGroupBy, ", "))
+		return dialect.QuoteIdentifier(name)
+	}
+	havingClause, args, err := havingDialect.Render(having, args)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid having expression: %v", err)
+	}
+	if havingClause != "" {
+		queryBuilder.WriteString(" HAVING ")
+		queryBuilder.WriteString(havingClause)
 	}
 
+	queryBuilder.WriteString(buildOrderByClause(dialect, sortRules))
 	return queryBuilder.String(), args, nil
 }
@@ -122,7 +122,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				filterValues:    []*pb.FilterValue{},
 				dbType:          pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 			},
-			wantQuery: "SELECT COUNT(*) as count FROM test_table",
+			wantQuery: "SELECT COUNT(*) as count FROM `test_table`",
 			wantArgs:  []interface{}{},
 			wantErr:   false, // 现在不再报错
 		},
@@ -136,7 +136,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				filterValues:    []*pb.FilterValue{},
 				dbType:          pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 			},
-			wantQuery: "SELECT COUNT(*) as count FROM test_table GROUP BY category, region",
+			wantQuery: "SELECT COUNT(*) as count FROM `test_table` GROUP BY `category`, `region`",
 			wantArgs:  []interface{}{},
 			wantErr:   false,
 		},
@@ -168,7 +168,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				},
 				dbType: pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 			},
-			wantQuery: "SELECT COUNT(*) as count FROM test_table WHERE status = ? GROUP BY category",
+			wantQuery: "SELECT COUNT(*) as count FROM `test_table` WHERE `status` = ? GROUP BY `category`",
 			wantArgs:  []interface{}{"active"},
 			wantErr:   false,
 		},
@@ -190,6 +190,45 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 			wantArgs:  []interface{}{"active"},
 			wantErr:   false,
 		},
+		{
+			name: "PostgreSQL带schema的分组查询",
+			args: args{
+				tableName:       "sales.orders",
+				groupBy:         []string{"category"},
+				filterNames:     []string{"status", "amount"},
+				filterOperators: []pb.FilterOperator{pb.FilterOperator_EQUAL, pb.FilterOperator_GREATER_THAN},
+				filterValues: []*pb.FilterValue{
+					{
+						StrValue: "active",
+					},
+					{
+						IntValue: 100,
+					},
+				},
+				dbType: pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL,
+			},
+			wantQuery: "SELECT COUNT(*) as count FROM \"sales\".\"orders\" WHERE \"status\" = $1 AND \"amount\" > $2 GROUP BY \"category\"",
+			wantArgs:  []interface{}{"active", int32(100)},
+			wantErr:   false,
+		},
+		{
+			name: "达梦带schema的分组查询",
+			args: args{
+				tableName:       "SALES.ORDERS",
+				groupBy:         []string{"CATEGORY"},
+				filterNames:     []string{"STATUS"},
+				filterOperators: []pb.FilterOperator{pb.FilterOperator_IN_OPERATOR},
+				filterValues: []*pb.FilterValue{
+					{
+						StrValues: []string{"paid", "shipped"},
+					},
+				},
+				dbType: pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG,
+			},
+			wantQuery: "SELECT COUNT(*) as count FROM \"SALES\".\"ORDERS\" WHERE \"STATUS\" IN (?, ?) GROUP BY \"CATEGORY\"",
+			wantArgs:  []interface{}{"paid", "shipped"},
+			wantErr:   false,
+		},
 		{
 			name: "MySQL带IN条件的分组查询",
 			args: args{
@@ -204,7 +243,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				},
 				dbType: pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 			},
-			wantQuery: "SELECT COUNT(*) as count FROM test_table WHERE region IN (?, ?, ?) GROUP BY category",
+			wantQuery: "SELECT COUNT(*) as count FROM `test_table` WHERE `region` IN (?, ?, ?) GROUP BY `category`",
 			wantArgs:  []interface{}{"北京", "上海", "广州"},
 			wantErr:   false,
 		},
@@ -243,7 +282,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				},
 				dbType: pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 			},
-			wantQuery: "SELECT COUNT(*) as count FROM test_table WHERE status = ? AND price > ? GROUP BY category, year",
+			wantQuery: "SELECT COUNT(*) as count FROM `test_table` WHERE `status` = ? AND `price` > ? GROUP BY `category`, `year`",
 			wantArgs:  []interface{}{"active", float64(100.0)},
 			wantErr:   false,
 		},
@@ -319,7 +358,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				},
 				dbType: pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 			},
-			wantQuery: "SELECT COUNT(*) as count FROM test_table WHERE status = ?",
+			wantQuery: "SELECT COUNT(*) as count FROM `test_table` WHERE `status` = ?",
 			wantArgs:  []interface{}{"inactive"},
 			wantErr:   false,
 		},
@@ -352,6 +391,7 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 				tt.args.filterNames,
 				tt.args.filterOperators,
 				tt.args.filterValues,
+				nil,
 				tt.args.dbType,
 			)
 
@@ -379,3 +419,105 @@ func TestQueryBuilder_BuildGroupCountQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBuilder_BuildAggregateQuery(t *testing.T) {
+	countOver := func(field string, value int32) *pb.FilterExpression {
+		return &pb.FilterExpression{Node: &pb.FilterExpression_Condition{Condition: &pb.FilterCondition{
+			FieldName: field, FieldValue: &pb.FilterValue{IntValue: value}, Operator: pb.FilterOperator_GREATER_THAN,
+		}}}
+	}
+	tests := []struct {
+		name       string
+		tableName  string
+		groupBy    []string
+		aggregates []*pb.AggregateField
+		having     *pb.FilterExpression
+		sortRules  []*pb.SortRule
+		dbType     pb.DataSourceType
+		wantQuery  string
+		wantArgs   []interface{}
+		errMessage string
+	}{
+		{
+			name:      "Defaults to count per group",
+			tableName: "orders",
+			groupBy:   []string{"region"},
+			dbType:    pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			wantQuery: "SELECT `region`, COUNT(*) AS `count` FROM `orders` WHERE `status` = ? GROUP BY `region`",
+			wantArgs:  []interface{}{"paid"},
+		},
+		{
+			name:      "Aggregates with having on alias and sort",
+			tableName: "orders",
+			groupBy:   []string{"region", "city"},
+			aggregates: []*pb.AggregateField{
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_SUM, FieldName: "amount"},
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_AVG, FieldName: "amount", Alias: "avg_amt"},
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT_DISTINCT, FieldName: "user_id"},
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_MIN, FieldName: "created"},
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_MAX, FieldName: "created"},
+			},
+			having:    countOver("count_distinct_user_id", 10),
+			sortRules: []*pb.SortRule{{FieldName: "sum_amount", SortOrder: pb.SortOrder_DESC}},
+			dbType:    pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE,
+			wantQuery: `SELECT "region", "city", SUM("amount") AS "sum_amount", AVG("amount") AS "avg_amt", ` +
+				`COUNT(DISTINCT "user_id") AS "count_distinct_user_id", MIN("created") AS "min_created", MAX("created") AS "max_created" ` +
+				`FROM "orders" WHERE "status" = $1 GROUP BY "region", "city" HAVING COUNT(DISTINCT "user_id") > $2 ORDER BY "sum_amount" DESC`,
+			wantArgs: []interface{}{"paid", int32(10)},
+		},
+		{
+			name:      "Doris table qualified by database",
+			tableName: "job_db.orders",
+			groupBy:   []string{"region"},
+			aggregates: []*pb.AggregateField{
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT, FieldName: "user_id", Alias: "users"},
+			},
+			having:    countOver("region", 5),
+			dbType:    pb.DataSourceType_DATA_SOURCE_TYPE_DORIS,
+			wantQuery: "SELECT `region`, COUNT(`user_id`) AS `users` FROM `job_db`.`orders` WHERE `status` = ? GROUP BY `region` HAVING `region` > ?",
+			wantArgs:  []interface{}{"paid", int32(5)},
+		},
+		{
+			name:       "Sum requires a field",
+			tableName:  "orders",
+			aggregates: []*pb.AggregateField{{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_SUM}},
+			dbType:     pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			errMessage: "aggregate function AGGREGATE_FUNCTION_SUM requires a field name",
+		},
+		{
+			name:      "Duplicate result column",
+			tableName: "orders",
+			aggregates: []*pb.AggregateField{
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_COUNT},
+				{Function: pb.AggregateFunction_AGGREGATE_FUNCTION_SUM, FieldName: "amount", Alias: "count"},
+			},
+			dbType:     pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			errMessage: "duplicate aggregate column: count",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &QueryBuilder{}
+			gotQuery, gotArgs, err := q.BuildAggregateQuery(tt.tableName, tt.groupBy, tt.aggregates,
+				[]string{"status"}, []pb.FilterOperator{pb.FilterOperator_EQUAL}, []*pb.FilterValue{{StrValue: "paid"}},
+				nil, tt.having, tt.sortRules, tt.dbType)
+
+			if tt.errMessage != "" {
+				if err == nil || err.Error() != tt.errMessage {
+					t.Errorf("BuildAggregateQuery() error = %v, want %v", err, tt.errMessage)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BuildAggregateQuery() unexpected error = %v", err)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("BuildAggregateQuery() gotQuery = %v, want %v", gotQuery, tt.wantQuery)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("BuildAggregateQuery() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
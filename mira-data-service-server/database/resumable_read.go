@@ -0,0 +1,228 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/24
+	@note: 可续传的单条查询读取：按键排序生成查询，断流后按键值（keyset 分页）或行偏移续传
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/common"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+)
+
+// ResumableRead 可续传的读取计划，由 PlanResumableRead 生成
+type ResumableRead struct {
+	Query string
+	Args  []interface{}
+
+	kind        string
+	keyColumn   string
+	fingerprint string
+	// rows 已发送的总行数，含续传前发送的部分
+	rows int64
+}
+
+// PlanResumableRead 生成可续传的查询，resume 为上次读取返回的令牌，为空表示从头读取
+// 没有排序规则时按单列键排序，续传时使用 keyset 分页（WHERE key > 上一批最后的键值）；
+// 有排序规则时追加单列键作为次序兜底，续传时跳过已发送的行数
+// 既没有排序规则也找不到单列键时读取顺序不确定，返回 nil，调用方按原查询读取且不返回令牌
+func PlanResumableRead(ctx context.Context, strategy DatabaseStrategy, dbType pb.DataSourceType,
+	req PartitionedReadRequest, fingerprint string, resume *common.ResumeToken) (*ResumableRead, error) {
+	if resume != nil && resume.Kind == common.ResumeByPart {
+		return nil, fmt.Errorf("%w: token is not for a direct database read", common.ErrInvalidResumeToken)
+	}
+	d := DialectFor(dbType)
+
+	keyColumn := ""
+	if resume != nil {
+		keyColumn = resume.KeyColumn
+	} else {
+		keyColumn = partitionColumnFromKeys(req.Keys)
+		if keyColumn == "" {
+			pk, err := detectPrimaryKeyColumn(ctx, strategy, dbType, req.TableName)
+			if err != nil {
+				log.Logger.Warnf("Failed to detect primary key of %s: %v", req.TableName, err)
+			}
+			keyColumn = pk
+		}
+	}
+
+	sorted := false
+	orderBy := make([]*pb.SortRule, 0, len(req.SortRules)+1)
+	for _, rule := range req.SortRules {
+		if rule == nil || rule.FieldName == "" {
+			continue
+		}
+		sorted = true
+		orderBy = append(orderBy, rule)
+	}
+	if !sorted && keyColumn == "" {
+		if resume != nil {
+			return nil, fmt.Errorf("%w: read order of %s is not deterministic", common.ErrInvalidResumeToken, req.TableName)
+		}
+		return nil, nil
+	}
+	if keyColumn != "" && !containsSortField(orderBy, keyColumn) {
+		orderBy = append(orderBy, &pb.SortRule{FieldName: keyColumn, SortOrder: pb.SortOrder_ASC})
+	}
+
+	plan := &ResumableRead{kind: common.ResumeByOffset, keyColumn: keyColumn, fingerprint: fingerprint}
+	if !sorted && selectsColumn(req.Fields, keyColumn) {
+		plan.kind = common.ResumeByKey
+	}
+	if resume != nil {
+		plan.kind, plan.rows = resume.Kind, resume.Rows
+	}
+
+	condition, args, err := renderReadCondition(d, req)
+	if err != nil {
+		return nil, err
+	}
+	var predicates []string
+	if condition != "" {
+		predicates = append(predicates, "("+condition+")")
+	}
+	if resume != nil && resume.Kind == common.ResumeByKey && resume.LastKey != "" {
+		lastKey, err := parseResumeKey(resume.KeyType, resume.LastKey)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, lastKey)
+		predicates = append(predicates, fmt.Sprintf("%s > %s", d.QuoteIdentifier(keyColumn), d.Placeholder(len(args))))
+	}
+	plan.Query = buildSelectQuery(d, d.QuoteTableName(req.TableName), req.Fields, predicates, orderBy)
+	if plan.kind == common.ResumeByOffset && plan.rows > 0 {
+		plan.Query = d.LimitOffset(plan.Query, 0, int(plan.rows))
+	}
+	plan.Args = args
+	return plan, nil
+}
+
+// Rows 已发送的总行数，续传时包含上次读取已发送的部分
+func (r *ResumableRead) Rows() int64 {
+	return r.rows
+}
+
+// Advance 记录已发送的批次并返回该批次之后的续传令牌
+// 按键续传时取批次最后一行的键值，键值为空或类型不支持时改为按行偏移续传（查询已按键排序，偏移同样有效）
+func (r *ResumableRead) Advance(record arrow.Record) (string, error) {
+	r.rows += record.NumRows()
+	token := &common.ResumeToken{Kind: r.kind, Fingerprint: r.fingerprint, Rows: r.rows, KeyColumn: r.keyColumn}
+	if r.kind == common.ResumeByKey && record.NumRows() > 0 {
+		keyType, lastKey, ok := lastKeyValue(record, r.keyColumn)
+		if ok {
+			token.KeyType, token.LastKey = keyType, lastKey
+		} else {
+			log.Logger.Warnf("Key column %s cannot be used for keyset resume, falling back to offset", r.keyColumn)
+			r.kind = common.ResumeByOffset
+			token.Kind = common.ResumeByOffset
+		}
+	}
+	return token.Encode()
+}
+
+// containsSortField 排序规则中是否已包含该字段
+func containsSortField(sortRules []*pb.SortRule, column string) bool {
+	for _, rule := range sortRules {
+		if strings.EqualFold(rule.FieldName, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectsColumn 查询结果中是否包含该列，fields 为空表示 SELECT *
+func selectsColumn(fields []string, column string) bool {
+	if len(fields) == 0 {
+		return true
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// lastKeyValue 读取批次最后一行的键值，返回键值类型与字符串形式
+func lastKeyValue(record arrow.Record, column string) (string, string, bool) {
+	index := -1
+	for i, field := range record.Schema().Fields() {
+		if strings.EqualFold(field.Name, column) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return "", "", false
+	}
+	row := int(record.NumRows()) - 1
+	col := record.Column(index)
+	if col.IsNull(row) {
+		return "", "", false
+	}
+	switch arr := col.(type) {
+	case *array.Int8:
+		return common.ResumeKeyInt, strconv.FormatInt(int64(arr.Value(row)), 10), true
+	case *array.Int16:
+		return common.ResumeKeyInt, strconv.FormatInt(int64(arr.Value(row)), 10), true
+	case *array.Int32:
+		return common.ResumeKeyInt, strconv.FormatInt(int64(arr.Value(row)), 10), true
+	case *array.Int64:
+		return common.ResumeKeyInt, strconv.FormatInt(arr.Value(row), 10), true
+	case *array.Uint8:
+		return common.ResumeKeyUint, strconv.FormatUint(uint64(arr.Value(row)), 10), true
+	case *array.Uint16:
+		return common.ResumeKeyUint, strconv.FormatUint(uint64(arr.Value(row)), 10), true
+	case *array.Uint32:
+		return common.ResumeKeyUint, strconv.FormatUint(uint64(arr.Value(row)), 10), true
+	case *array.Uint64:
+		return common.ResumeKeyUint, strconv.FormatUint(arr.Value(row), 10), true
+	case *array.Float32:
+		return common.ResumeKeyFloat, strconv.FormatFloat(float64(arr.Value(row)), 'g', -1, 32), true
+	case *array.Float64:
+		return common.ResumeKeyFloat, strconv.FormatFloat(arr.Value(row), 'g', -1, 64), true
+	case *array.String:
+		return common.ResumeKeyString, arr.Value(row), true
+	case *array.LargeString:
+		return common.ResumeKeyString, arr.Value(row), true
+	default:
+		return "", "", false
+	}
+}
+
+// parseResumeKey 按令牌中记录的类型还原键值，用作 keyset 分页的绑定参数
+func parseResumeKey(keyType, value string) (interface{}, error) {
+	var (
+		key interface{}
+		err error
+	)
+	switch keyType {
+	case common.ResumeKeyInt:
+		key, err = strconv.ParseInt(value, 10, 64)
+	case common.ResumeKeyUint:
+		key, err = strconv.ParseUint(value, 10, 64)
+	case common.ResumeKeyFloat:
+		key, err = strconv.ParseFloat(value, 64)
+	case common.ResumeKeyString:
+		key = value
+	default:
+		err = fmt.Errorf("unknown key type %q", keyType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", common.ErrInvalidResumeToken, err)
+	}
+	return key, nil
+}
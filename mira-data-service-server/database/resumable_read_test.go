@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"data-service/common"
+	ds "data-service/generated/datasource"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanResumableReadByKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	strategy := &MySQLStrategy{DB: db}
+
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE").WithArgs("", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+
+	req := PartitionedReadRequest{TableName: "t", Fields: []string{"id", "name"},
+		FilterNames: []string{"name"}, FilterOperators: []ds.FilterOperator{ds.FilterOperator_EQUAL},
+		FilterValues: []*ds.FilterValue{{StrValue: "a"}}}
+	plan, err := PlanResumableRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, req, "fp", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT `id`, `name` FROM `t` WHERE (`name` = ?) ORDER BY `id` ASC", plan.Query)
+	assert.Equal(t, []interface{}{"a"}, plan.Args)
+
+	// 每个批次之后的令牌记录最后一行的键值和累计行数
+	encoded, err := plan.Advance(newIDRecord(t, 3, 7))
+	assert.NoError(t, err)
+	token, err := common.DecodeResumeToken(encoded, "fp")
+	assert.NoError(t, err)
+	assert.Equal(t, common.ResumeByKey, token.Kind)
+	assert.Equal(t, int64(2), token.Rows)
+	assert.Equal(t, "id", token.KeyColumn)
+	assert.Equal(t, common.ResumeKeyInt, token.KeyType)
+	assert.Equal(t, "7", token.LastKey)
+
+	// 续传时使用 keyset 分页，不再探测主键
+	resumed, err := PlanResumableRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, req, "fp", token)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT `id`, `name` FROM `t` WHERE (`name` = ?) AND `id` > ? ORDER BY `id` ASC", resumed.Query)
+	assert.Equal(t, []interface{}{"a", int64(7)}, resumed.Args)
+	assert.Equal(t, int64(2), resumed.Rows())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlanResumableReadByOffset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	strategy := &MySQLStrategy{DB: db}
+
+	// 有排序规则时追加键作为次序兜底，按偏移续传
+	req := PartitionedReadRequest{TableName: "t", Fields: []string{"name"},
+		SortRules: []*ds.SortRule{{FieldName: "score", SortOrder: ds.SortOrder_DESC}},
+		Keys:      []*ds.TableKey{{KeyType: ds.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id"}}}}
+	plan, err := PlanResumableRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, req, "fp", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT "name" FROM "t" ORDER BY "score" DESC, "id" ASC`, plan.Query)
+
+	encoded, err := plan.Advance(newIDRecord(t, 1, 2, 3))
+	assert.NoError(t, err)
+	token, err := common.DecodeResumeToken(encoded, "fp")
+	assert.NoError(t, err)
+	assert.Equal(t, common.ResumeByOffset, token.Kind)
+
+	resumed, err := PlanResumableRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, req, "fp", token)
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT "name" FROM "t" ORDER BY "score" DESC, "id" ASC LIMIT 9223372036854775807 OFFSET 3`, resumed.Query)
+
+	// 既没有排序也没有键时不支持续传
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}))
+	plan, err = PlanResumableRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		PartitionedReadRequest{TableName: "t"}, "fp", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, plan)
+
+	_, err = PlanResumableRead(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		PartitionedReadRequest{TableName: "t"}, "fp", &common.ResumeToken{Kind: common.ResumeByPart})
+	assert.True(t, errors.Is(err, common.ErrInvalidResumeToken))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumableReadFallsBackToOffset(t *testing.T) {
+	plan := &ResumableRead{kind: common.ResumeByKey, keyColumn: "id", fingerprint: "fp"}
+
+	// 键值为空时改为按偏移续传，之后的令牌保持偏移方式
+	builder := array.NewInt64Builder(memory.DefaultAllocator)
+	defer builder.Release()
+	builder.AppendValues([]int64{1, 0}, []bool{true, false})
+	column := builder.NewArray()
+	defer column.Release()
+	record := array.NewRecord(arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64, Nullable: true}}, nil),
+		[]arrow.Array{column}, 2)
+	defer record.Release()
+
+	encoded, err := plan.Advance(record)
+	assert.NoError(t, err)
+	token, err := common.DecodeResumeToken(encoded, "fp")
+	assert.NoError(t, err)
+	assert.Equal(t, common.ResumeByOffset, token.Kind)
+
+	encoded, err = plan.Advance(newIDRecord(t, 5))
+	assert.NoError(t, err)
+	token, err = common.DecodeResumeToken(encoded, "fp")
+	assert.NoError(t, err)
+	assert.Equal(t, common.ResumeByOffset, token.Kind)
+	assert.Equal(t, int64(3), token.Rows)
+}
+
+// newIDRecord 构造只有 id 列的 Arrow 批次
+func newIDRecord(t *testing.T, ids ...int64) arrow.Record {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil))
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).AppendValues(ids, nil)
+	record := builder.NewRecord()
+	t.Cleanup(record.Release)
+	return record
+}
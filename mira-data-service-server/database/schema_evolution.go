@@ -0,0 +1,366 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/6/20
+	@note: 写入已存在表时 Arrow schema 与表结构的比对和演进
+
+*
+*/
+package database
+
+import (
+	"context"
+	"data-service/config"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// SchemaEvolutionPolicy 写入已存在表时的结构演进策略
+type SchemaEvolutionPolicy string
+
+const (
+	// SchemaEvolutionReject 缺失列或类型不兼容时拒绝写入，不修改表结构
+	SchemaEvolutionReject SchemaEvolutionPolicy = "reject"
+	// SchemaEvolutionAddColumns 为缺失的列新增可空列
+	SchemaEvolutionAddColumns SchemaEvolutionPolicy = "add_columns"
+	// SchemaEvolutionEvolve 新增缺失列，并把较窄的列放宽为写入数据的类型
+	SchemaEvolutionEvolve SchemaEvolutionPolicy = "evolve"
+)
+
+// SchemaEvolutionPolicyFromConfig 读取 dbms.schema_evolution，未配置或无法识别时按 reject 处理
+func SchemaEvolutionPolicyFromConfig() SchemaEvolutionPolicy {
+	conf := config.GetConfigMap()
+	if conf == nil {
+		return SchemaEvolutionReject
+	}
+	switch policy := SchemaEvolutionPolicy(strings.ToLower(strings.TrimSpace(conf.Dbms.SchemaEvolution))); policy {
+	case SchemaEvolutionAddColumns, SchemaEvolutionEvolve:
+		return policy
+	default:
+		return SchemaEvolutionReject
+	}
+}
+
+// TableColumn 表中已有列的定义，Length 仅对字符类型有效，0 表示未知或不限长度
+type TableColumn struct {
+	Name     string
+	DataType string
+	Length   int64
+	Nullable bool
+}
+
+// SchemaChangeKind 表结构差异的类型
+type SchemaChangeKind string
+
+const (
+	SchemaChangeAddColumn    SchemaChangeKind = "add column"
+	SchemaChangeWidenColumn  SchemaChangeKind = "widen column"
+	SchemaChangeIncompatible SchemaChangeKind = "incompatible column"
+)
+
+// SchemaChange 单个列的差异，From 为表中现有类型，To 为写入数据需要的类型
+type SchemaChange struct {
+	Kind     SchemaChangeKind
+	Column   string
+	From     string
+	To       string
+	Nullable bool
+}
+
+func (c SchemaChange) String() string {
+	switch c.Kind {
+	case SchemaChangeAddColumn:
+		return fmt.Sprintf("%s %s %s", c.Kind, c.Column, c.To)
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", c.Kind, c.Column, c.From, c.To)
+	}
+}
+
+// SchemaMismatchError 写入数据与目标表结构不一致且当前策略不允许自动演进
+type SchemaMismatchError struct {
+	TableName string
+	Policy    SchemaEvolutionPolicy
+	Changes   []SchemaChange
+}
+
+func (e *SchemaMismatchError) Error() string {
+	diffs := make([]string, len(e.Changes))
+	for i, change := range e.Changes {
+		diffs[i] = change.String()
+	}
+	return fmt.Sprintf("schema of table %s does not match incoming data (schema_evolution=%s): %s",
+		e.TableName, e.Policy, strings.Join(diffs, "; "))
+}
+
+// ReconcileTableSchema 在写入任何数据前比对 Arrow schema 与表中现有列，并按策略演进表结构
+//
+// 表不存在或无法读取列定义时不做处理，由后续写入报告错误。ctx 的超时由调用方通过 WithCallTimeout 附加。
+// 类型不兼容的列在任何策略下都会被拒绝；放宽列类型仅在 evolve 策略下执行，其他策略只记录警告，
+// 数据本身未超出原有类型范围时仍可以写入。
+func ReconcileTableSchema(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, tableName string,
+	schema *arrow.Schema, policy SchemaEvolutionPolicy) error {
+	live, err := describeTableColumns(ctx, db, dbType, tableName)
+	if err != nil {
+		log.Logger.Warnf("Skip schema reconciliation, failed to describe table %s: %v", tableName, err)
+		return nil
+	}
+	if len(live) == 0 {
+		return nil
+	}
+
+	dialect := DialectFor(dbType)
+	changes := DiffTableSchema(dialect, live, schema)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var rejected, apply []SchemaChange
+	for _, change := range changes {
+		switch {
+		case change.Kind == SchemaChangeIncompatible:
+			rejected = append(rejected, change)
+		case change.Kind == SchemaChangeAddColumn && policy == SchemaEvolutionReject:
+			rejected = append(rejected, change)
+		case change.Kind == SchemaChangeWidenColumn && policy != SchemaEvolutionEvolve:
+			log.Logger.Warnf("Table %s may be too narrow for incoming data, %s", tableName, change)
+		default:
+			apply = append(apply, change)
+		}
+	}
+	if len(rejected) > 0 {
+		return &SchemaMismatchError{TableName: tableName, Policy: policy, Changes: rejected}
+	}
+
+	for _, change := range apply {
+		var statement string
+		if change.Kind == SchemaChangeAddColumn {
+			statement = dialect.AddColumnSQL(tableName, change.Column, change.To)
+		} else {
+			statement = dialect.AlterColumnTypeSQL(tableName, change.Column, change.To, change.Nullable)
+		}
+		log.Logger.Infof("Evolving schema of table %s: %s", tableName, statement)
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to %s on table %s: %v", change, tableName, err)
+		}
+	}
+	return nil
+}
+
+// DiffTableSchema 比对 Arrow schema 与表中现有列，列名不区分大小写
+//
+// 表中多出的列不视为差异，写入语句只列出 Arrow schema 中的列。
+func DiffTableSchema(dialect Dialect, live []TableColumn, schema *arrow.Schema) []SchemaChange {
+	existing := make(map[string]TableColumn, len(live))
+	for _, column := range live {
+		existing[strings.ToLower(column.Name)] = column
+	}
+
+	var changes []SchemaChange
+	for _, field := range schema.Fields() {
+		wanted := dialect.ColumnType(field.Type)
+		column, ok := existing[strings.ToLower(field.Name)]
+		if !ok {
+			changes = append(changes, SchemaChange{Kind: SchemaChangeAddColumn, Column: field.Name, To: wanted, Nullable: true})
+			continue
+		}
+
+		have := classifyColumnType(column.DataType, column.Length)
+		want := classifyColumnType(wanted, 0)
+		change := SchemaChange{Column: column.Name, From: describeLiveType(column), To: wanted, Nullable: column.Nullable}
+		switch {
+		case !typesCompatible(want.family, have.family):
+			change.Kind = SchemaChangeIncompatible
+			changes = append(changes, change)
+		case want.family == have.family && want.wider(have):
+			change.Kind = SchemaChangeWidenColumn
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// describeLiveType 字符类型带上长度，便于在差异中看出需要放宽的原因
+func describeLiveType(column TableColumn) string {
+	if column.Length > 0 && !strings.Contains(column.DataType, "(") &&
+		classifyColumnType(column.DataType, column.Length).family == familyString {
+		return fmt.Sprintf("%s(%d)", column.DataType, column.Length)
+	}
+	return column.DataType
+}
+
+// 列类型族，用于判断能否写入以及是否需要放宽
+const (
+	familyUnknown  = ""
+	familyInteger  = "integer"
+	familyFloat    = "float"
+	familyDecimal  = "decimal"
+	familyString   = "string"
+	familyTemporal = "temporal"
+	familyBool     = "bool"
+	familyBinary   = "binary"
+)
+
+// columnTypeClass 类型族及其宽度，字符类型的 rank 为长度，0 表示不限长度
+type columnTypeClass struct {
+	family string
+	rank   int64
+}
+
+// wider 当前类型是否比 other 更宽，仅对同一类型族有意义
+func (c columnTypeClass) wider(other columnTypeClass) bool {
+	if c.family == familyString {
+		return other.rank > 0 && (c.rank == 0 || c.rank > other.rank)
+	}
+	return c.rank > other.rank
+}
+
+var typeLengthPattern = regexp.MustCompile(`\(\s*(\d+)`)
+
+// classifyColumnType 归类各数据库的类型名，如 varchar(255)、character varying、NUMBER(10,0)、bigint unsigned
+func classifyColumnType(dataType string, length int64) columnTypeClass {
+	normalized := strings.ToLower(strings.TrimSpace(dataType))
+	if match := typeLengthPattern.FindStringSubmatch(normalized); match != nil && length == 0 {
+		length, _ = strconv.ParseInt(match[1], 10, 64)
+	}
+	unsigned := strings.HasSuffix(normalized, " unsigned")
+	normalized = strings.TrimSuffix(normalized, " unsigned")
+	if idx := strings.Index(normalized, "("); idx >= 0 {
+		normalized = strings.TrimSpace(normalized[:idx])
+	}
+
+	integerRank := map[string]int64{
+		"tinyint": 1, "smallint": 2, "int2": 2, "mediumint": 3,
+		"int": 4, "integer": 4, "int4": 4, "bigint": 5, "int8": 5,
+	}
+	if rank, ok := integerRank[normalized]; ok {
+		if unsigned {
+			// 无符号整数需要更宽一级的有符号类型才能容纳
+			rank++
+		}
+		return columnTypeClass{family: familyInteger, rank: rank}
+	}
+
+	switch normalized {
+	case "float", "real", "float4":
+		return columnTypeClass{family: familyFloat, rank: 1}
+	case "double", "double precision", "float8", "binary_double":
+		return columnTypeClass{family: familyFloat, rank: 2}
+	case "decimal", "numeric", "number", "dec":
+		return columnTypeClass{family: familyDecimal}
+	case "char", "character", "nchar", "varchar", "character varying", "varchar2", "nvarchar", "nvarchar2":
+		return columnTypeClass{family: familyString, rank: length}
+	case "tinytext":
+		return columnTypeClass{family: familyString, rank: 255}
+	case "text", "mediumtext", "longtext", "string", "clob", "nclob":
+		return columnTypeClass{family: familyString}
+	case "date", "datetime", "time", "timestamp", "timestamp without time zone", "timestamp with time zone",
+		"time without time zone", "time with time zone", "timestamptz":
+		return columnTypeClass{family: familyTemporal}
+	case "bool", "boolean", "bit":
+		return columnTypeClass{family: familyBool}
+	case "blob", "tinyblob", "mediumblob", "longblob", "bytea", "binary", "varbinary", "image":
+		return columnTypeClass{family: familyBinary}
+	}
+	return columnTypeClass{family: familyUnknown}
+}
+
+// typesCompatible 判断写入数据的类型族能否写入已有列，数据库能隐式转换的组合都视为兼容
+//
+// 字符串可以由数据库解析为任意类型，只有时间/二进制与数值/布尔之间的写入必然失败。
+func typesCompatible(incoming, existing string) bool {
+	if incoming == familyUnknown || existing == familyUnknown || incoming == existing ||
+		incoming == familyString || existing == familyString {
+		return true
+	}
+	numeric := map[string]bool{familyInteger: true, familyFloat: true, familyDecimal: true, familyBool: true}
+	return numeric[incoming] && numeric[existing]
+}
+
+// describeTableColumns 读取表中现有列的名称、类型、字符长度和可空性，表不存在时返回空
+func describeTableColumns(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, tableName string) ([]TableColumn, error) {
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_HIVE:
+		columns, err := (&HiveStrategy{DB: db}).getTableSchema(ctx, hiveDialect.QuoteTableName(tableName))
+		if err != nil {
+			return nil, err
+		}
+		result := make([]TableColumn, len(columns))
+		for i, column := range columns {
+			result[i] = TableColumn{Name: column.Name, DataType: column.DataType, Nullable: true}
+		}
+		return result, nil
+	case pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG:
+		owner, table := splitDamengTableName(tableName)
+		ownerCondition, args := damengOwnerCondition(owner)
+		query := fmt.Sprintf(`
+			SELECT COLUMN_NAME, DATA_TYPE, DATA_LENGTH, NULLABLE
+			FROM ALL_TAB_COLUMNS
+			WHERE %s AND TABLE_NAME = ?
+			ORDER BY COLUMN_ID`, ownerCondition)
+		return scanTableColumns(ctx, db, query, append(args, table)...)
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE:
+		// 表名整体作为一个标识符，建在当前模式下
+		query := `
+			SELECT column_name, data_type, character_maximum_length, is_nullable
+			FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = $1
+			ORDER BY ordinal_position`
+		return scanTableColumns(ctx, db, query, unquoteIdentifier(tableName))
+	case pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		schemaName, table := splitQualifiedTableName(tableName)
+		query := `
+			SELECT column_name, data_type, character_maximum_length, is_nullable
+			FROM information_schema.columns
+			WHERE table_schema = COALESCE(NULLIF($1, ''), current_schema()) AND table_name = $2
+			ORDER BY ordinal_position`
+		return scanTableColumns(ctx, db, query, schemaName, table)
+	default:
+		schemaName, table := splitQualifiedTableName(tableName)
+		query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE
+			FROM INFORMATION_SCHEMA.COLUMNS
+			WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ?
+			ORDER BY ORDINAL_POSITION`
+		return scanTableColumns(ctx, db, query, schemaName, table)
+	}
+}
+
+// splitQualifiedTableName 拆分 schema.table 形式的表名，并去除标识符引号
+func splitQualifiedTableName(tableName string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(tableName), ".", 2)
+	if len(parts) == 2 {
+		return unquoteIdentifier(parts[0]), unquoteIdentifier(parts[1])
+	}
+	return "", unquoteIdentifier(tableName)
+}
+
+// scanTableColumns 读取 (列名, 类型, 字符长度, 可空) 四列的查询结果，可空列取值为 YES/Y 表示可空
+func scanTableColumns(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]TableColumn, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []TableColumn
+	for rows.Next() {
+		var column TableColumn
+		var length sql.NullInt64
+		var nullable sql.NullString
+		if err := rows.Scan(&column.Name, &column.DataType, &length, &nullable); err != nil {
+			return nil, err
+		}
+		column.Length = length.Int64
+		flag := strings.ToUpper(nullable.String)
+		column.Nullable = flag == "YES" || flag == "Y"
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
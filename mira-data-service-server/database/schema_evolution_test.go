@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTableSchema(t *testing.T) {
+	live := []TableColumn{
+		{Name: "ID", DataType: "int", Nullable: false},
+		{Name: "name", DataType: "varchar", Length: 50, Nullable: true},
+		{Name: "created", DataType: "datetime", Nullable: true},
+		{Name: "note", DataType: "text", Nullable: true},
+		{Name: "extra", DataType: "int", Nullable: true},
+	}
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "created", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "note", Type: arrow.BinaryTypes.String},
+		{Name: "score", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	changes := DiffTableSchema(mysqlDialect, live, schema)
+	assert.Equal(t, []SchemaChange{
+		{Kind: SchemaChangeWidenColumn, Column: "ID", From: "int", To: "BIGINT", Nullable: false},
+		{Kind: SchemaChangeWidenColumn, Column: "name", From: "varchar(50)", To: "VARCHAR(255)", Nullable: true},
+		{Kind: SchemaChangeIncompatible, Column: "created", From: "datetime", To: "INT", Nullable: true},
+		{Kind: SchemaChangeAddColumn, Column: "score", To: "DOUBLE", Nullable: true},
+	}, changes)
+}
+
+func TestClassifyColumnType(t *testing.T) {
+	assert.Equal(t, columnTypeClass{family: familyInteger, rank: 5}, classifyColumnType("int(10) unsigned", 0))
+	assert.Equal(t, columnTypeClass{family: familyString, rank: 64}, classifyColumnType("character varying", 64))
+	assert.Equal(t, columnTypeClass{family: familyString, rank: 255}, classifyColumnType("VARCHAR(255)", 0))
+	assert.Equal(t, columnTypeClass{family: familyDecimal}, classifyColumnType("NUMBER(10,0)", 22))
+	assert.Equal(t, columnTypeClass{family: familyFloat, rank: 2}, classifyColumnType("DOUBLE PRECISION", 0))
+	assert.Equal(t, columnTypeClass{family: familyUnknown}, classifyColumnType("geometry", 0))
+
+	// 字符串可以写入任意列，时间与数值之间不可互写
+	assert.True(t, typesCompatible(familyString, familyInteger))
+	assert.True(t, typesCompatible(familyInteger, familyDecimal))
+	assert.False(t, typesCompatible(familyTemporal, familyFloat))
+	assert.False(t, typesCompatible(familyInteger, familyBinary))
+}
+
+func TestDialectAlterColumnSQL(t *testing.T) {
+	assert.Equal(t, "ALTER TABLE `t` ADD COLUMN `c` BIGINT NULL", mysqlDialect.AddColumnSQL("t", "c", "BIGINT"))
+	assert.Equal(t, "ALTER TABLE `t` MODIFY COLUMN `c` BIGINT NOT NULL", mysqlDialect.AlterColumnTypeSQL("t", "c", "BIGINT", false))
+	assert.Equal(t, `ALTER TABLE "t" ALTER COLUMN "c" TYPE BIGINT`, kingbaseDialect.AlterColumnTypeSQL("t", "c", "BIGINT", false))
+	assert.Equal(t, `ALTER TABLE "S"."T" MODIFY "C" BIGINT`, damengDialect.AlterColumnTypeSQL("S.T", "C", "BIGINT", true))
+	assert.Equal(t, "ALTER TABLE `db`.`t` ADD COLUMNS (`c` BIGINT)", hiveDialect.AddColumnSQL("db.t", "c", "BIGINT"))
+	assert.Equal(t, "ALTER TABLE `t` CHANGE COLUMN `c` `c` BIGINT", hiveDialect.AlterColumnTypeSQL("t", "c", "BIGINT", true))
+}
+
+func expectMysqlColumns(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.COLUMNS").
+		WithArgs("", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE", "CHARACTER_MAXIMUM_LENGTH", "IS_NULLABLE"}).
+			AddRow("id", "int", nil, "NO").
+			AddRow("name", "varchar", int64(50), "YES"))
+}
+
+func TestReconcileTableSchema(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "score", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	t.Run("reject reports diff without altering", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectMysqlColumns(mock)
+
+		err = ReconcileTableSchema(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "t", schema, SchemaEvolutionReject)
+		var mismatch *SchemaMismatchError
+		assert.True(t, errors.As(err, &mismatch))
+		assert.EqualError(t, err, "schema of table t does not match incoming data (schema_evolution=reject): add column score DOUBLE")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("add columns only", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectMysqlColumns(mock)
+		mock.ExpectExec("ALTER TABLE `t` ADD COLUMN `score` DOUBLE NULL").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = ReconcileTableSchema(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "t", schema, SchemaEvolutionAddColumns)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("evolve widens and adds", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectMysqlColumns(mock)
+		mock.ExpectExec("ALTER TABLE `t` MODIFY COLUMN `id` BIGINT NOT NULL").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("ALTER TABLE `t` MODIFY COLUMN `name` VARCHAR\\(255\\) NULL").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("ALTER TABLE `t` ADD COLUMN `score` DOUBLE NULL").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = ReconcileTableSchema(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "t", schema, SchemaEvolutionEvolve)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("missing table is left to the writer", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("FROM information_schema.columns").
+			WithArgs("t").
+			WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "character_maximum_length", "is_nullable"}))
+
+		err = ReconcileTableSchema(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, "t", schema, SchemaEvolutionReject)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -22,71 +22,56 @@ import (
 
 type SQLGeneration interface {
 	GenerateInsertSQL(tableName string, rowData []interface{}, schema *arrow.Schema, dbType pb.DataSourceType) (string, error)
+	GenerateWriteSQL(tableName string, rowData []interface{}, schema *arrow.Schema, dbType pb.DataSourceType, opts WriteOptions) (string, error)
 	BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) string
-	BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) string
+	BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) (string, error)
+	BuildSelectIntoOutfileSQLWithPrefix(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf, objectPrefix string) (string, error)
+}
+
+// ExportObjectPrefix 导出文件在批量数据桶中的对象前缀，runTag 区分同一任务的多次导出，为空时所有导出共用同一前缀
+func ExportObjectPrefix(jobInstanceId, runTag string) string {
+	if runTag == "" {
+		return jobInstanceId + "/export_"
+	}
+	return jobInstanceId + "/export_" + runTag + "_"
 }
 
 type SQLGenerator struct {
 }
 
 func (s *SQLGenerator) GenerateInsertSQL(tableName string, rowData []interface{}, schema *arrow.Schema, dbType pb.DataSourceType) (string, error) {
+	return s.GenerateWriteSQL(tableName, rowData, schema, dbType, WriteOptions{})
+}
+
+// GenerateWriteSQL 按写入模式生成批量写入语句，追加模式与 GenerateInsertSQL 一致
+func (s *SQLGenerator) GenerateWriteSQL(tableName string, rowData []interface{}, schema *arrow.Schema, dbType pb.DataSourceType, opts WriteOptions) (string, error) {
 	numCols := schema.NumFields()
 	if len(rowData)%numCols != 0 {
 		return "", fmt.Errorf("row data length (%d) does not match schema length (%d)", len(rowData), numCols)
 	}
 
 	// 构建列名
-	var columns []string
+	columns := make([]string, numCols)
 	for i := 0; i < numCols; i++ {
-		fieldName := schema.Field(i).Name
-		if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-			// Kingbase 使用双引号
-			columns = append(columns, fmt.Sprintf("\"%s\"", fieldName))
-		} else {
-			// MySQL 及其他数据库使用反引号
-			columns = append(columns, fmt.Sprintf("`%s`", fieldName))
-		}
+		columns[i] = schema.Field(i).Name
 	}
-	columnsStr := strings.Join(columns, ", ")
-
-	// 构建占位符与插入值
-	var placeholders []string
-	var values []string
-	rowCount := len(rowData) / numCols
-	var sql string
-	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-		// Kingbase 占位符从 $1 开始递增
-		for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
-			var singleRowPlaceholders []string
-			for colIdx := 0; colIdx < numCols; colIdx++ {
-				singleRowPlaceholders = append(singleRowPlaceholders, fmt.Sprintf("$%d", rowIdx*numCols+colIdx+1))
-				values = append(values, fmt.Sprintf("%v", rowData[rowIdx*numCols+colIdx]))
-			}
-			placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(singleRowPlaceholders, ", ")))
-		}
-		placeholdersStr := strings.Join(placeholders, ", ")
-
-		// 构建 SQL 语句
-		sql = fmt.Sprintf("INSERT INTO \"%s\" (%s) VALUES %s", tableName, columnsStr, placeholdersStr)
-	} else if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL {
-		// MySQL 占位符使用问号
-		for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
-			var singleRowPlaceholders []string
-			for colIdx := 0; colIdx < numCols; colIdx++ {
-				singleRowPlaceholders = append(singleRowPlaceholders, "?")
-				values = append(values, fmt.Sprintf("%v", rowData[rowIdx*numCols+colIdx]))
-			}
-			placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(singleRowPlaceholders, ", ")))
-		}
-		placeholdersStr := strings.Join(placeholders, ", ")
 
-		// 构建 SQL 语句
-		sql = fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", tableName, columnsStr, placeholdersStr)
+	sql, err := BuildWriteSQL(dbType, tableName, columns, len(rowData)/numCols, opts)
+	if err != nil {
+		return "", err
 	}
 	log.Logger.Debugf("Generated SQL: %s", sql)
 	return sql, nil
 }
 
+// dorisPropertyEscaper 转义双引号字符串中的反斜杠、双引号和换行符，一次替换，不会重复转义
+var dorisPropertyEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`)
+
+// dorisPropertyValue 将 PROPERTIES / WITH s3 中的取值转为双引号字符串，转义其中的反斜杠、双引号和换行符
+func dorisPropertyValue(value string) string {
+	return `"` + dorisPropertyEscaper.Replace(value) + `"`
+}
+
 // buildExportSQL 构建用于从Doris导出CSV文件的EXPORT SQL语句
 //
 // 该方法根据导出请求参数和系统配置，构建完整的EXPORT SQL语句，用于将Doris表中的数据
@@ -128,7 +113,7 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 	// 构建列选择
 	columnsClause := ""
 	if len(request.Columns) > 0 {
-		columnsClause = fmt.Sprintf(`"columns" = "%s"`, strings.Join(request.Columns, ","))
+		columnsClause = `"columns" = ` + dorisPropertyValue(strings.Join(request.Columns, ","))
 	}
 
 	// 构建分隔符配置
@@ -137,21 +122,18 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 		columnSeparator = ","
 	}
 
+	// 换行符由 dorisPropertyValue 转义
 	lineDelimiter := request.LineDelimiter
 	if lineDelimiter == "" {
-		lineDelimiter = "\\n"
-	} else {
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\n", "\\n")
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r", "\\r")
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r\n", "\\r\\n")
+		lineDelimiter = "\n"
 	}
 
 	// 构建PROPERTIES部分
 	properties := []string{
-		fmt.Sprintf(`"label" = "%s"`, labelName),
+		`"label" = ` + dorisPropertyValue(labelName),
 		`"format" = "csv_with_names"`,
-		fmt.Sprintf(`"column_separator" = "%s"`, columnSeparator),
-		fmt.Sprintf(`"line_delimiter" = "%s"`, lineDelimiter),
+		`"column_separator" = ` + dorisPropertyValue(columnSeparator),
+		`"line_delimiter" = ` + dorisPropertyValue(lineDelimiter),
 	}
 
 	if columnsClause != "" {
@@ -159,27 +141,25 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 	}
 
 	// 构建S3配置
-	config := config.GetConfigMap()
 	s3Config := []string{
-		fmt.Sprintf(`"s3.endpoint" = "http://%s:%d"`, config.OSSConfig.Host, config.OSSConfig.Port),
+		fmt.Sprintf(`"s3.endpoint" = "http://%s:%d"`, conf.OSSConfig.Host, conf.OSSConfig.Port),
 		`"s3.region" = "us-east-l"`,
-		fmt.Sprintf(`"s3.secret_key" = "%s"`, config.OSSConfig.SecretKey),
-		fmt.Sprintf(`"s3.access_key" = "%s"`, config.OSSConfig.AccessKey),
+		`"s3.secret_key" = ` + dorisPropertyValue(conf.OSSConfig.SecretKey),
+		`"s3.access_key" = ` + dorisPropertyValue(conf.OSSConfig.AccessKey),
 		`"use_path_style" = "true"`,
 	}
 
 	// 构建完整的EXPORT SQL
 	exportSQL := fmt.Sprintf(`
-		EXPORT TABLE %s.%s TO "%s" 
+		EXPORT TABLE %s TO %s 
 		PROPERTIES (
 			%s
 		) WITH s3 (
 			%s
 		)
 	`,
-		request.DbName,
-		request.TableName,
-		targetPath,
+		dorisDialect.QualifyTableName(request.DbName, request.TableName),
+		dorisPropertyValue(targetPath),
 		strings.Join(properties, ",\n\t\t"),
 		strings.Join(s3Config, ",\n\t\t"),
 	)
@@ -187,46 +167,55 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 	return exportSQL
 }
 
-// BuildSelectIntoOutfileSQL 构建带排序/过滤的 SELECT ... INTO OUTFILE 导出SQL
-func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) string {
+// BuildExportSelectSQL 构建导出所读取数据的 SELECT 语句，包含列选择、过滤、排序与采样
+func (s *SQLGenerator) BuildExportSelectSQL(request *pb.ExportCsvFileFromDorisRequest) (string, error) {
 	// 列选择
-	columnsClause := "*"
-	if len(request.Columns) > 0 {
-		columnsClause = strings.Join(request.Columns, ", ")
-	}
+	columnsClause := quoteColumnList(dorisDialect, request.Columns)
 
-	// WHERE 子句
-	var whereParts []string
-	for _, fc := range request.FilterConditions {
-		if fc == nil || fc.FieldName == "" {
-			continue
-		}
-		whereParts = append(whereParts, buildSingleCondition(fc))
+	// WHERE 子句，导出语句无法绑定参数，过滤值按 Doris 方言转义后以字面量内联
+	dialect := &FilterDialect{QuoteIdent: dorisDialect.QuoteIdentifier, Literal: dorisDialect.QuoteLiteral, NotEqual: "<>"}
+	whereClause, _, err := dialect.Render(AndFilterExpressions(FilterConditionsToExpression(request.FilterConditions), request.FilterExpression), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build export filter: %v", err)
 	}
-	whereClause := ""
-	if len(whereParts) > 0 {
-		whereClause = " WHERE " + strings.Join(whereParts, " AND ")
+	if whereClause != "" {
+		whereClause = " WHERE " + whereClause
 	}
 
 	// ORDER BY 子句
-	var orderParts []string
-	for _, sr := range request.SortRules {
-		if sr == nil || sr.FieldName == "" {
-			continue
+	orderClause := buildOrderByClause(dorisDialect, request.SortRules)
+
+	source := dorisDialect.QualifyTableName(request.DbName, request.TableName)
+	selectSQL := fmt.Sprintf("SELECT %s FROM %s%s%s", columnsClause, source, whereClause, orderClause)
+	if SampleEnabled(request.Sampling) {
+		var predicates []string
+		if whereClause != "" {
+			predicates = append(predicates, "("+strings.TrimPrefix(whereClause, " WHERE ")+")")
 		}
-		dir := "ASC"
-		if sr.SortOrder == pb.SortOrder_DESC {
-			dir = "DESC"
+		selectSQL, err = buildSampledSelect(dorisDialect, source, request.Columns, predicates, request.SortRules,
+			request.Sampling, request.Keys)
+		if err != nil {
+			return "", fmt.Errorf("failed to build export sample: %v", err)
 		}
-		orderParts = append(orderParts, fmt.Sprintf("%s %s", sr.FieldName, dir))
 	}
-	orderClause := ""
-	if len(orderParts) > 0 {
-		orderClause = " ORDER BY " + strings.Join(orderParts, ", ")
+	return selectSQL, nil
+}
+
+// BuildSelectIntoOutfileSQL 构建带排序/过滤的 SELECT ... INTO OUTFILE 导出SQL
+func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) (string, error) {
+	return s.BuildSelectIntoOutfileSQLWithPrefix(request, conf, ExportObjectPrefix(request.JobInstanceId, ""))
+}
+
+// BuildSelectIntoOutfileSQLWithPrefix 构建 SELECT ... INTO OUTFILE 导出SQL，文件写入批量数据桶中 objectPrefix 开头的对象
+func (s *SQLGenerator) BuildSelectIntoOutfileSQLWithPrefix(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf,
+	objectPrefix string) (string, error) {
+	selectSQL, err := s.BuildExportSelectSQL(request)
+	if err != nil {
+		return "", err
 	}
 
 	// 目标路径
-	targetPath := fmt.Sprintf("s3://%s/%s/export_", common.BATCH_DATA_BUCKET_NAME, request.JobInstanceId)
+	targetPath := fmt.Sprintf("s3://%s/%s", common.BATCH_DATA_BUCKET_NAME, objectPrefix)
 
 	// 添加分隔符配置
 	columnSeparator := request.ColumnSeparator
@@ -264,8 +253,8 @@ func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDo
 	s3Props := []string{
 		fmt.Sprintf(`"s3.endpoint" = "http://%s:%d"`, conf.OSSConfig.Host, conf.OSSConfig.Port),
 		`"s3.region" = "us-east-1"`,
-		fmt.Sprintf(`"s3.secret_key" = "%s"`, conf.OSSConfig.SecretKey),
-		fmt.Sprintf(`"s3.access_key" = "%s"`, conf.OSSConfig.AccessKey),
+		`"s3.secret_key" = ` + dorisPropertyValue(conf.OSSConfig.SecretKey),
+		`"s3.access_key" = ` + dorisPropertyValue(conf.OSSConfig.AccessKey),
 		`"use_path_style" = "true"`,
 		`"max_file_size" = "200MB"`,
 		// `"s3.connection.request.timeout" = "600000"`,
@@ -277,103 +266,16 @@ func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDo
 
 	// 组装 SELECT ... INTO OUTFILE
 	sql := fmt.Sprintf(`
-		SELECT %s FROM %s.%s%s%s
-		INTO OUTFILE "%s"
+		%s
+		INTO OUTFILE %s
 		FORMAT AS parquet
 		PROPERTIES (
 			%s
 		)
 	`,
-		columnsClause,
-		request.DbName, request.TableName,
-		whereClause, orderClause,
-		targetPath,
+		selectSQL,
+		dorisPropertyValue(targetPath),
 		strings.Join(s3Props, ",\n\t\t\t"),
 	)
-	return sql
-}
-
-// 组装单个过滤条件
-func buildSingleCondition(fc *pb.FilterCondition) string {
-	field := fc.FieldName
-	switch fc.Operator {
-	case pb.FilterOperator_EQUAL:
-		return fmt.Sprintf(`%s = %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_NOT_EQUAL:
-		return fmt.Sprintf(`%s <> %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_GREATER_THAN:
-		return fmt.Sprintf(`%s > %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_LESS_THAN:
-		return fmt.Sprintf(`%s < %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_GREATER_THAN_OR_EQUAL:
-		return fmt.Sprintf(`%s >= %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_LESS_THAN_OR_EQUAL:
-		return fmt.Sprintf(`%s <= %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_LIKE_OPERATOR:
-		return fmt.Sprintf(`%s LIKE %s`, field, formatFilterValue(fc.FieldValue))
-	case pb.FilterOperator_IN_OPERATOR:
-		return fmt.Sprintf(`%s IN (%s)`, field, formatInList(fc.FieldValue))
-	default:
-		return "1=1"
-	}
-}
-
-// 将 FilterValue 转为 SQL 字面量
-func formatFilterValue(v *pb.FilterValue) string {
-	if v == nil {
-		return "NULL"
-	}
-	if v.StrValue != "" {
-		return fmt.Sprintf("'%s'", strings.ReplaceAll(v.StrValue, "'", "''"))
-	}
-	if len(v.StrValues) > 0 || len(v.IntValues) > 0 || len(v.FloatValues) > 0 || len(v.BoolValues) > 0 {
-		// 在 IN 子句中处理
-		return fmt.Sprintf("(%s)", formatInList(v))
-	}
-	// 标量数值/布尔
-	if v.BoolValue {
-		return "true"
-	}
-	if v.FloatValue != 0 {
-		return fmt.Sprintf("%v", v.FloatValue)
-	}
-	// int_value 为 0 时与缺省难区分，这里直接输出
-	return fmt.Sprintf("%d", v.IntValue)
-}
-
-// IN 列表
-func formatInList(v *pb.FilterValue) string {
-	if v == nil {
-		return ""
-	}
-	var items []string
-	if len(v.StrValues) > 0 {
-		for _, sv := range v.StrValues {
-			items = append(items, fmt.Sprintf("'%s'", strings.ReplaceAll(sv, "'", "''")))
-		}
-		return strings.Join(items, ", ")
-	}
-	if len(v.IntValues) > 0 {
-		for _, iv := range v.IntValues {
-			items = append(items, fmt.Sprintf("%d", iv))
-		}
-		return strings.Join(items, ", ")
-	}
-	if len(v.FloatValues) > 0 {
-		for _, fv := range v.FloatValues {
-			items = append(items, fmt.Sprintf("%v", fv))
-		}
-		return strings.Join(items, ", ")
-	}
-	if len(v.BoolValues) > 0 {
-		for _, bv := range v.BoolValues {
-			if bv {
-				items = append(items, "true")
-			} else {
-				items = append(items, "false")
-			}
-		}
-		return strings.Join(items, ", ")
-	}
-	return ""
+	return sql, nil
 }
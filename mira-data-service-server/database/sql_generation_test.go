@@ -35,10 +35,13 @@ func TestBuildSelectIntoOutfileSQL_BasicColumnsOnly(t *testing.T) {
 		DbName:        "mall",
 		Columns:       []string{"id", "amount"},
 	}
-	sql := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	sql, err := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
 
 	// 基本结构
-	mustContain(t, sql, `SELECT id, amount FROM mall.orders`)
+	mustContain(t, sql, "SELECT `id`, `amount` FROM `mall`.`orders`")
 	mustContain(t, sql, `INTO OUTFILE "s3://data-service/job_1/export_"`)
 	mustContain(t, sql, `"s3.endpoint" = "http://minio.base1:9000"`)
 	mustContain(t, sql, `"s3.region" = "us-east-1"`)
@@ -71,9 +74,12 @@ func TestBuildSelectIntoOutfileSQL_WithWhereEqualAndIn(t *testing.T) {
 			},
 		},
 	}
-	sql := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	sql, err := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
 
-	mustContain(t, sql, `FROM mall.user_tbl WHERE status = 'active' AND id IN (1, 2, 3)`)
+	mustContain(t, sql, "FROM `mall`.`user_tbl` WHERE `status` = 'active' AND `id` IN (1, 2, 3)")
 }
 
 func TestBuildSelectIntoOutfileSQL_WithOrderByAscDesc(t *testing.T) {
@@ -87,9 +93,12 @@ func TestBuildSelectIntoOutfileSQL_WithOrderByAscDesc(t *testing.T) {
 			{FieldName: "combined_join_column", SortOrder: pb.SortOrder_DESC},
 		},
 	}
-	sql := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	sql, err := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
 
-	mustContain(t, sql, `ORDER BY combined_join_column DESC`)
+	mustContain(t, sql, "ORDER BY `combined_join_column` DESC")
 }
 
 func TestBuildSelectIntoOutfileSQL_WithWhereAndOrder(t *testing.T) {
@@ -112,10 +121,113 @@ func TestBuildSelectIntoOutfileSQL_WithWhereAndOrder(t *testing.T) {
 			{FieldName: "combined_join_column", SortOrder: pb.SortOrder_DESC},
 		},
 	}
-	sql := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	sql, err := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
+
+	mustContain(t, sql, "WHERE `age` >= 18")
+	mustContain(t, sql, "ORDER BY `combined_join_column` DESC")
+}
+
+func TestBuildSelectIntoOutfileSQL_WithFilterExpression(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_5",
+		TableName:     "orders",
+		DbName:        "mall",
+		FilterConditions: []*pb.FilterCondition{
+			{
+				FieldName:  "region",
+				Operator:   pb.FilterOperator_EQUAL,
+				FieldValue: &pb.FilterValue{StrValue: "north"},
+			},
+		},
+		FilterExpression: group(pb.LogicalOperator_LOGICAL_OR,
+			condition("amount", pb.FilterOperator_BETWEEN_OPERATOR, &pb.FilterValue{FloatValues: []float64{10, 99.5}}),
+			not(condition("note", pb.FilterOperator_IS_NULL, nil)),
+		),
+	}
+	sql, err := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
 
-	mustContain(t, sql, `WHERE age >= 18`)
-	mustContain(t, sql, `ORDER BY combined_join_column DESC`)
+	mustContain(t, sql, "FROM `mall`.`orders` WHERE `region` = 'north' AND (`amount` BETWEEN 10 AND 99.5 OR NOT (`note` IS NULL))")
+}
+
+func TestBuildSelectIntoOutfileSQL_WithSampling(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_6",
+		TableName:     "orders",
+		DbName:        "mall",
+		Columns:       []string{"id", "amount"},
+		FilterConditions: []*pb.FilterCondition{
+			{
+				FieldName:  "region",
+				Operator:   pb.FilterOperator_EQUAL,
+				FieldValue: &pb.FilterValue{StrValue: "north"},
+			},
+		},
+		SortRules: []*pb.SortRule{{FieldName: "amount", SortOrder: pb.SortOrder_DESC}},
+		Sampling:  &pb.SampleOptions{Method: pb.SampleMethod_SAMPLE_METHOD_RANDOM_N, Rows: 100},
+	}
+	sql, err := gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
+
+	mustContain(t, sql, "SELECT * FROM (SELECT `id`, `amount` FROM `mall`.`orders` WHERE (`region` = 'north') ORDER BY RAND() LIMIT 100) sample_rows ORDER BY `amount` DESC\n")
+	mustContain(t, sql, `INTO OUTFILE "s3://data-service/job_6/export_"`)
+
+	req.Sampling = &pb.SampleOptions{Method: pb.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 1}
+	sql, err = gen.BuildSelectIntoOutfileSQL(req, testConf())
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQL() error = %v", err)
+	}
+	mustContain(t, sql, "FROM `mall`.`orders` TABLESAMPLE(1 PERCENT) WHERE (`region` = 'north') ORDER BY `amount` DESC")
+}
+
+func TestBuildExportSelectSQL(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_6",
+		TableName:     "orders",
+		DbName:        "mall",
+		Columns:       []string{"id", "amount"},
+		FilterConditions: []*pb.FilterCondition{
+			{FieldName: "id", Operator: pb.FilterOperator_GREATER_THAN_OR_EQUAL, FieldValue: &pb.FilterValue{IntValue: 10}},
+		},
+		SortRules: []*pb.SortRule{{FieldName: "id", SortOrder: pb.SortOrder_DESC}},
+	}
+	sql, err := gen.BuildExportSelectSQL(req)
+	if err != nil {
+		t.Fatalf("BuildExportSelectSQL() error = %v", err)
+	}
+
+	// 只有查询本身，不含导出目标
+	if sql != "SELECT `id`, `amount` FROM `mall`.`orders` WHERE `id` >= 10 ORDER BY `id` DESC" {
+		t.Fatalf("unexpected select SQL: %s", sql)
+	}
+}
+
+func TestBuildExportSQL_EscapesPropertyValues(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_7",
+		TableName:     "orders",
+		DbName:        "mall",
+		LineDelimiter: "\r\n",
+	}
+	conf := testConf()
+	// 以反斜杠结尾的密钥不能吞掉结束引号
+	conf.OSSConfig.SecretKey = `se"cr\et\`
+
+	sql := gen.BuildExportSQL(req, conf, "label_7")
+	mustContain(t, sql, `"s3.secret_key" = "se\"cr\\et\\"`)
+	mustContain(t, sql, `"line_delimiter" = "\r\n"`)
+	mustContain(t, sql, `"column_separator" = ","`)
 }
 
 func mustContain(t *testing.T, sql string, sub string) {
@@ -124,3 +236,20 @@ func mustContain(t *testing.T, sql string, sub string) {
 		t.Fatalf("SQL does not contain expected substring:\nwant: %s\nsql:  %s", sub, sql)
 	}
 }
+
+func TestBuildSelectIntoOutfileSQLWithPrefix(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_7",
+		TableName:     "orders",
+		DbName:        "mall",
+		Columns:       []string{"id"},
+	}
+	sql, err := gen.BuildSelectIntoOutfileSQLWithPrefix(req, testConf(), ExportObjectPrefix(req.JobInstanceId, "0a1b2c3d"))
+	if err != nil {
+		t.Fatalf("BuildSelectIntoOutfileSQLWithPrefix() error = %v", err)
+	}
+
+	// 每次导出的文件带各自的标记，仍在任务目录之下
+	mustContain(t, sql, `INTO OUTFILE "s3://data-service/job_7/export_0a1b2c3d_"`)
+}
@@ -0,0 +1,105 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/12/02
+	@note: 流式写入的事务：数据先写入每个流独立的暂存表，流正常结束后在一个事务中插入目标表，写入 ID 记录在台账表中用于识别重试
+
+*
+*/
+package database
+
+import (
+	"context"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// WriteLedgerTable 已提交写入 ID 的台账表，与目标表位于同一个库
+const WriteLedgerTable = "data_service_write_ledger"
+
+// writeLedgerKey 台账中唯一确定一次提交的列，同一个写入 ID 可以写入多张表
+var writeLedgerKey = []string{"write_id", "table_name"}
+
+var writeLedgerSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "write_id", Type: arrow.BinaryTypes.String},
+	{Name: "table_name", Type: arrow.BinaryTypes.String},
+	{Name: "row_count", Type: arrow.PrimitiveTypes.Int64},
+}, nil)
+
+// StagingTableName 流写入目标表时使用的暂存表名，streamID 区分同时写入同一张表的流
+func StagingTableName(tableName, streamID string) string {
+	return tableName + "_stg_" + streamID
+}
+
+// EnsureWriteLedger 创建写入台账表，并在支持唯一索引的数据库上为 (write_id, table_name) 建唯一索引，
+// 同一写入 ID 并发提交时只有一个事务能写入台账，其余的整体回滚
+func EnsureWriteLedger(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, dbStrategy DatabaseStrategy) error {
+	if err := dbStrategy.CreateTemporaryTableIfNotExists(ctx, WriteLedgerTable, writeLedgerSchema); err != nil &&
+		!strings.Contains(err.Error(), "already exists") {
+		return fmt.Errorf("failed to create write ledger: %v", err)
+	}
+	return EnsureWriteKey(ctx, db, dbType, WriteLedgerTable, NewWriteOptions(pb.WriteMode_WRITE_MODE_UPSERT, writeLedgerKey))
+}
+
+// IsWriteCommitted 写入 ID 是否已经提交到目标表
+func IsWriteCommitted(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, writeID, tableName string) (bool, error) {
+	dialect := DialectFor(dbType)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = %s AND %s = %s", dialect.QuoteTableName(WriteLedgerTable),
+		dialect.QuoteIdentifier(writeLedgerKey[0]), dialect.Placeholder(1),
+		dialect.QuoteIdentifier(writeLedgerKey[1]), dialect.Placeholder(2))
+	var count int64
+	if err := db.QueryRowContext(ctx, query, writeID, tableName).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to query write ledger: %v", err)
+	}
+	return count > 0, nil
+}
+
+// CommitStagedTable 在一个事务中将暂存表的数据插入目标表，writeID 不为空时同时写入台账，返回插入的行数
+// 任一语句失败时整体回滚，目标表中不会留下部分数据
+func CommitStagedTable(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, stagingTable, tableName string,
+	columns []string, writeID string) (int64, error) {
+	dialect := DialectFor(dbType)
+	columnList := quoteColumnList(dialect, columns)
+	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", dialect.QuoteTableName(tableName),
+		columnList, columnList, dialect.QuoteTableName(stagingTable))
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	result, err := tx.ExecContext(ctx, insertSQL)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to insert staged rows into %s: %v", tableName, err)
+	}
+	rowCount, _ := result.RowsAffected()
+	if writeID != "" {
+		ledgerSQL := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s, %s, %s)", dialect.QuoteTableName(WriteLedgerTable),
+			quoteColumnList(dialect, []string{writeLedgerKey[0], writeLedgerKey[1], "row_count"}),
+			dialect.Placeholder(1), dialect.Placeholder(2), dialect.Placeholder(3))
+		if _, err := tx.ExecContext(ctx, ledgerSQL, writeID, tableName, rowCount); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("failed to record write %s for %s: %v", writeID, tableName, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit staged rows into %s: %v", tableName, err)
+	}
+	log.Logger.Infof("Committed %d staged rows from %s into %s", rowCount, stagingTable, tableName)
+	return rowCount, nil
+}
+
+// DropStagingTable 删除暂存表，表不存在时忽略
+func DropStagingTable(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, stagingTable string) error {
+	dropSQL := "DROP TABLE IF EXISTS " + DialectFor(dbType).QuoteTableName(stagingTable)
+	if _, err := db.ExecContext(ctx, dropSQL); err != nil {
+		return fmt.Errorf("failed to drop staging table %s: %v", stagingTable, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitStagedTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `orders` (`id`, `name`) SELECT `id`, `name` FROM `orders_stg_abc`")).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `data_service_write_ledger` (`write_id`, `table_name`, `row_count`) VALUES (?, ?, ?)")).
+		WithArgs("w-1", "orders", int64(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	rows, err := CommitStagedTable(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		StagingTableName("orders", "abc"), "orders", []string{"id", "name"}, "w-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), rows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCommitStagedTableRollsBackOnLedgerConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "orders" ("id") SELECT "id" FROM "orders_stg_abc"`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "data_service_write_ledger" ("write_id", "table_name", "row_count") VALUES ($1, $2, $3)`)).
+		WillReturnError(errors.New("duplicate key value violates unique constraint"))
+	mock.ExpectRollback()
+
+	_, err = CommitStagedTable(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL,
+		"orders_stg_abc", "orders", []string{"id"}, "w-1")
+	assert.ErrorContains(t, err, "failed to record write w-1")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCommitStagedTableWithoutWriteID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// 没有写入 ID 时不写台账
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `orders`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	_, err = CommitStagedTable(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		"orders_stg_abc", "orders", []string{"id"}, "")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsWriteCommittedAndDropStagingTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM `data_service_write_ledger` WHERE `write_id` = ? AND `table_name` = ?")).
+		WithArgs("w-1", "orders").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("DROP TABLE IF EXISTS `orders_stg_abc`")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	committed, err := IsWriteCommitted(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "w-1", "orders")
+	assert.NoError(t, err)
+	assert.True(t, committed)
+	assert.NoError(t, DropStagingTable(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "orders_stg_abc"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -10,8 +10,11 @@
 package database
 
 import (
+	"context"
+	"data-service/config"
 	pb "data-service/generated/datasource"
 	"database/sql"
+	"time"
 
 	"github.com/apache/arrow/go/v15/arrow"
 )
@@ -22,11 +25,11 @@ type DatabaseStrategy interface {
 	// 使用密码连接数据库
 	ConnectToDBWithPass(info *pb.ConnectionInfo) error
 
-	/* 执行select，args为sql查询中的占位符
+	/* 执行select，args为sql查询中的占位符，ctx 取消或超时后查询会被中断并释放连接
 	sqlQuery := "SELECT * FROM users WHERE age > ? AND city = ?"
-	rows, err := mySQLStrategy.Query(sqlQuery, 25, "New York")
+	rows, err := mySQLStrategy.Query(ctx, sqlQuery, 25, "New York")
 	*/
-	Query(sqlQuery string, args ...interface{}) (*sql.Rows, error)
+	Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error)
 	// 执行insert
 
 	// 关闭连接
@@ -34,26 +37,50 @@ type DatabaseStrategy interface {
 
 	GetJdbcUrl() string
 
-	RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.Record, error)
+	// ctx 应与 Query 使用的一致，取消后返回 ctx 的错误而不是 io.EOF
+	RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error)
 
 	// 创建临时表
-	CreateTemporaryTableIfNotExists(tableName string, schema *arrow.Schema) error
+	CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error
 
 	// todo 返回数据资产的数据量，用来决策是否使用spark
-	GetTableInfo(database string, tableName string, isExactQuery bool) (*pb.TableInfoResponse, error)
+	GetTableInfo(ctx context.Context, database string, tableName string, isExactQuery bool) (*pb.TableInfoResponse, error)
+
+	// 获取字段元数据（可空性、长度、精度、默认值、注释）与主键、唯一键、外键和索引，database 与 GetTableInfo 含义相同
+	DescribeTable(ctx context.Context, database string, tableName string) ([]*pb.ColumnItem, []*pb.TableKey, error)
 
 	BuildWithConditionQuery(tableName string,
 		fields []string,
 		filterNames []string,
 		filterOperators []pb.FilterOperator,
 		filterValues []*pb.FilterValue,
-		sortRules []*pb.SortRule) (string, []interface{}, error)
+		sortRules []*pb.SortRule,
+		filterExpr *pb.FilterExpression) (string, []interface{}, error)
+
+	EnsureDatabaseExists(ctx context.Context, dbName string) error
 
-	EnsureDatabaseExists(dbName string) error
+	CleanupOldTables(ctx context.Context, dbName string, retentionDays int) error
 
-	CleanupOldTables(dbName string, retentionDays int) error
+	GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue, filterExpr *pb.FilterExpression) (*pb.GroupCountResponse, error)
 
-	GetGroupCountInfo(tableName string, groupBy []string, filterNames []string, filterOperators []pb.FilterOperator, filterValues []*pb.FilterValue) (*pb.GroupCountResponse, error)
+	CheckTableExists(ctx context.Context, tableName string) (bool, error)
+}
+
+// WithCallTimeout 为元数据查询、建表等单次调用附加超时，未配置 call_timeout 时只继承 ctx 的取消
+// 流式读取的耗时与数据量相关，只跟随 gRPC 流的 ctx，不使用该超时
+func WithCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	conf := config.GetConfigMap()
+	if conf == nil || conf.Dbms.CallTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(conf.Dbms.CallTimeout)*time.Second)
+}
 
-	CheckTableExists(tableName string) (bool, error)
+// batchReadErr 检查批次读取过程中的取消和游标错误
+// ctx 取消后 database/sql 会关闭 rows，此时 rows.Columns() 报错会被当作 io.EOF，需先检查
+func batchReadErr(ctx context.Context, rows *sql.Rows) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return rows.Err()
 }
@@ -10,6 +10,7 @@
 package database
 
 import (
+	"context"
 	"data-service/config"
 	"data-service/log"
 	"database/sql"
@@ -18,8 +19,8 @@ import (
 // TableInfoEstimator 表信息估算器接口
 // 用于统一各个数据库连接器的表信息估算方法
 type TableInfoEstimator interface {
-	GetTableRowCount(database string, tableName string) (int32, error)
-	EstimateTableSize(database string, tableName string, totalRows int32) (int64, error)
+	GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error)
+	EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error)
 }
 
 // TableInfoEstimationHelper 表信息估算辅助结构
@@ -73,6 +74,7 @@ func ShouldUseEstimationOnly() bool {
 
 // FillTableInfoFromEstimation 根据估算结果填充表信息结构
 func FillTableInfoFromEstimation(
+	ctx context.Context,
 	estimator TableInfoEstimator,
 	database string,
 	tableName string,
@@ -85,7 +87,7 @@ func FillTableInfoFromEstimation(
 	result.TableSize = 0
 
 	// 获取行数
-	rowCount, err := estimator.GetTableRowCount(database, tableName)
+	rowCount, err := estimator.GetTableRowCount(ctx, database, tableName)
 	if err != nil {
 		log.Logger.Errorf("Failed to get table row count for size estimation: %v", err)
 		result.TableRows = 0
@@ -97,7 +99,7 @@ func FillTableInfoFromEstimation(
 
 	// 估算表大小
 	if rowCount > 0 {
-		size, err := estimator.EstimateTableSize(database, tableName, rowCount)
+		size, err := estimator.EstimateTableSize(ctx, database, tableName, rowCount)
 		if err != nil {
 			log.Logger.Warnf("Failed to estimate table size: %v", err)
 			// 估算失败不影响返回，size保持为0
@@ -10,6 +10,7 @@
 package database
 
 import (
+	"context"
 	pb "data-service/generated/datasource"
 	log2 "data-service/log"
 	"database/sql"
@@ -23,13 +24,20 @@ import (
 
 // TableOperation 表示一个表操作任务
 type TableOperation struct {
+	Ctx        context.Context
 	TableName  string
 	Schema     *arrow.Schema
 	DbType     pb.DataSourceType
 	DB         *sql.DB
 	IpcReader  *ipc.Reader
 	DbStrategy DatabaseStrategy
-	ResultCh   chan error
+	// 写入模式，零值为追加写入
+	WriteOptions WriteOptions
+	// StagingTable 不为空时将该暂存表的数据提交到 TableName，不读取 IpcReader
+	StagingTable string
+	// WriteID 提交暂存表时记录到写入台账的写入 ID，为空时不记录
+	WriteID  string
+	ResultCh chan error
 }
 
 var (
@@ -110,9 +118,25 @@ func processOperations(queue chan *TableOperation, stopCh chan struct{}) {
 
 // 处理单个操作
 func processOperation(op *TableOperation) {
+	if op.StagingTable != "" {
+		op.ResultCh <- commitStagedOperation(op)
+		return
+	}
 	defer op.IpcReader.Release()
+	if err := prepareTable(op); err != nil {
+		op.ResultCh <- err
+		return
+	}
+
+	// 然后插入数据
+	err := InsertArrowDataInBatches(op.Ctx, op.DB, op.TableName, op.Schema, op.IpcReader, op.DbType, op.WriteOptions)
+	op.ResultCh <- err
+}
+
+// prepareTable 表不存在时按 schema 建表，已存在时比对结构，不一致时按策略演进或在写入前拒绝
+func prepareTable(op *TableOperation) error {
 	// 先创建表（如果不存在）
-	err := createTableIfNeeded(op.TableName, op.Schema, op.DbStrategy)
+	err := createTableIfNeeded(op.Ctx, op.TableName, op.Schema, op.DbStrategy)
 	if err != nil {
 		// 检查错误是否是因为表已存在
 		if strings.Contains(err.Error(), "already exists") {
@@ -122,10 +146,20 @@ func processOperation(op *TableOperation) {
 			log2.Logger.Warnf("Create table warning: %v", err)
 		}
 	}
+	return reconcileTableIfNeeded(op)
+}
 
-	// 然后插入数据
-	err = InsertArrowDataInBatches(op.DB, op.TableName, op.Schema, op.IpcReader, op.DbType)
-	op.ResultCh <- err
+// commitStagedOperation 准备好目标表后，将暂存表的数据在一个事务中插入目标表
+func commitStagedOperation(op *TableOperation) error {
+	if err := prepareTable(op); err != nil {
+		return err
+	}
+	columns := make([]string, len(op.Schema.Fields()))
+	for i, field := range op.Schema.Fields() {
+		columns[i] = field.Name
+	}
+	_, err := CommitStagedTable(op.Ctx, op.DB, op.DbType, op.StagingTable, op.TableName, columns, op.WriteID)
+	return err
 }
 
 // CleanupQueue 清理队列
@@ -160,6 +194,14 @@ func QueueSize() int {
 	return 0
 }
 
-func createTableIfNeeded(tableName string, schema *arrow.Schema, dbStrategy DatabaseStrategy) error {
-	return dbStrategy.CreateTemporaryTableIfNotExists(tableName, schema)
+func reconcileTableIfNeeded(op *TableOperation) error {
+	callCtx, cancel := WithCallTimeout(op.Ctx)
+	defer cancel()
+	return ReconcileTableSchema(callCtx, op.DB, op.DbType, op.TableName, op.Schema, SchemaEvolutionPolicyFromConfig())
+}
+
+func createTableIfNeeded(ctx context.Context, tableName string, schema *arrow.Schema, dbStrategy DatabaseStrategy) error {
+	callCtx, cancel := WithCallTimeout(ctx)
+	defer cancel()
+	return dbStrategy.CreateTemporaryTableIfNotExists(callCtx, tableName, schema)
 }
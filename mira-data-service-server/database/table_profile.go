@@ -0,0 +1,290 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/26
+	@note: 字段统计：空值数、不同值个数、最值与高频值，按方言生成统计 SQL，各数据源共用
+
+*
+*/
+package database
+
+import (
+	"context"
+	pb "data-service/generated/datasource"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProfileOptions 字段统计的参数
+type ProfileOptions struct {
+	// SampleRows 大于 0 时随机抽取 SampleRows 行，样本只查询一次，在内存中统计
+	SampleRows int64
+	// TopK 每个字段返回的高频值个数，<= 0 时不统计高频值
+	TopK int
+}
+
+// unorderedTypeKeywords 无法比较大小或去重的字段类型关键字，这类字段只统计空值
+var unorderedTypeKeywords = []string{"bool", "json", "blob", "clob", "binary", "bytea", "image", "array", "map", "struct"}
+
+// numericTypeKeywords 数值类型关键字，抽样统计时这类字段按数值比较最值
+var numericTypeKeywords = []string{"int", "decimal", "numeric", "number", "float", "double", "real"}
+
+// profileCountAlias 高频值查询中计数列的列名
+const profileCountAlias = "profile_count"
+
+// ProfileTable 统计表中各字段的空值数、不同值个数、最值与高频值，返回参与统计的行数
+// 全表统计时所有字段的计数与最值在一条查询中完成，高频值每个字段一条 GROUP BY 查询；
+// 抽样统计时按随机顺序取样本，只查询一次，各项统计在同一份样本上完成
+func ProfileTable(ctx context.Context, strategy DatabaseStrategy, dbType pb.DataSourceType, tableName string,
+	columns []*pb.ColumnItem, opts ProfileOptions) (int64, []*pb.ColumnProfile, error) {
+	if len(columns) == 0 {
+		return 0, nil, fmt.Errorf("no columns to profile for table %s", tableName)
+	}
+	d := DialectFor(dbType)
+	if opts.SampleRows > 0 {
+		return profileSample(ctx, strategy, d, tableName, columns, opts)
+	}
+	source := d.QuoteTableName(tableName)
+
+	selects := []string{"COUNT(*)"}
+	for _, column := range columns {
+		quoted := d.QuoteIdentifier(column.Name)
+		selects = append(selects, fmt.Sprintf("COUNT(%s)", quoted))
+		if orderedType(column.DataType) {
+			selects = append(selects, fmt.Sprintf("COUNT(DISTINCT %s)", quoted),
+				fmt.Sprintf("MIN(%s)", quoted), fmt.Sprintf("MAX(%s)", quoted))
+		}
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(selects, ", "), source)
+
+	rows, err := strategy.Query(ctx, query)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to profile table %s: %v", tableName, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, nil, fmt.Errorf("failed to profile table %s: %v", tableName, err)
+		}
+		return 0, nil, fmt.Errorf("profile query of table %s returned no rows", tableName)
+	}
+
+	var rowCount int64
+	profiles := make([]*pb.ColumnProfile, len(columns))
+	minValues := make([]sql.NullString, len(columns))
+	maxValues := make([]sql.NullString, len(columns))
+	dest := []interface{}{&rowCount}
+	for i, column := range columns {
+		profiles[i] = &pb.ColumnProfile{Name: column.Name, DataType: column.DataType}
+		dest = append(dest, &profiles[i].NullCount)
+		if orderedType(column.DataType) {
+			dest = append(dest, &profiles[i].DistinctCount, &minValues[i], &maxValues[i])
+		}
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, nil, fmt.Errorf("failed to scan profile of table %s: %v", tableName, err)
+	}
+	rows.Close()
+
+	for i, profile := range profiles {
+		// 扫描得到的是非空行数
+		profile.NullCount = rowCount - profile.NullCount
+		if rowCount > 0 {
+			profile.NullRatio = float64(profile.NullCount) / float64(rowCount)
+		}
+		profile.MinValue, profile.MaxValue = minValues[i].String, maxValues[i].String
+		if opts.TopK <= 0 || !orderedType(profile.DataType) || profile.NullCount == rowCount {
+			continue
+		}
+		if profile.TopValues, err = queryTopValues(ctx, strategy, d, source, profile.Name, opts.TopK); err != nil {
+			return 0, nil, err
+		}
+	}
+	return rowCount, profiles, nil
+}
+
+// profileSample 随机抽取 opts.SampleRows 行后在内存中统计，各字段的计数、最值与高频值来自同一份样本
+func profileSample(ctx context.Context, strategy DatabaseStrategy, d Dialect, tableName string,
+	columns []*pb.ColumnItem, opts ProfileOptions) (int64, []*pb.ColumnProfile, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+	}
+	query, err := buildSampledSelect(d, d.QuoteTableName(tableName), names, nil, nil,
+		&pb.SampleOptions{Method: pb.SampleMethod_SAMPLE_METHOD_RANDOM_N, Rows: opts.SampleRows}, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	rows, err := strategy.Query(ctx, query)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to sample table %s: %v", tableName, err)
+	}
+	defer rows.Close()
+
+	stats := make([]*sampleColumnStats, len(columns))
+	for i, column := range columns {
+		stats[i] = newSampleColumnStats(column)
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	var rowCount int64
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, nil, fmt.Errorf("failed to scan sample of table %s: %v", tableName, err)
+		}
+		rowCount++
+		for i, value := range values {
+			stats[i].add(value)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to sample table %s: %v", tableName, err)
+	}
+
+	profiles := make([]*pb.ColumnProfile, len(columns))
+	for i, stat := range stats {
+		profiles[i] = stat.profile(rowCount, opts.TopK)
+	}
+	return rowCount, profiles, nil
+}
+
+// sampleColumnStats 单个字段在样本上的统计
+type sampleColumnStats struct {
+	column   *pb.ColumnItem
+	ordered  bool
+	numeric  bool
+	nonNull  int64
+	counts   map[string]int64
+	min, max string
+}
+
+func newSampleColumnStats(column *pb.ColumnItem) *sampleColumnStats {
+	stats := &sampleColumnStats{column: column, ordered: orderedType(column.DataType)}
+	if stats.ordered {
+		stats.numeric = numericType(column.DataType)
+		stats.counts = make(map[string]int64)
+	}
+	return stats
+}
+
+// add 累计样本中的一个取值
+func (s *sampleColumnStats) add(value sql.NullString) {
+	if !value.Valid {
+		return
+	}
+	s.nonNull++
+	if !s.ordered {
+		return
+	}
+	if s.nonNull == 1 {
+		s.min, s.max = value.String, value.String
+	} else {
+		if s.less(value.String, s.min) {
+			s.min = value.String
+		}
+		if s.less(s.max, value.String) {
+			s.max = value.String
+		}
+	}
+	s.counts[value.String]++
+}
+
+// less 比较两个取值，数值字段按数值比较，无法解析时按字符串比较
+func (s *sampleColumnStats) less(a, b string) bool {
+	if s.numeric {
+		x, errX := strconv.ParseFloat(a, 64)
+		y, errY := strconv.ParseFloat(b, 64)
+		if errX == nil && errY == nil {
+			return x < y
+		}
+	}
+	return a < b
+}
+
+// profile 生成字段统计结果，高频值按出现次数降序，次数相同时按取值升序
+func (s *sampleColumnStats) profile(rowCount int64, topK int) *pb.ColumnProfile {
+	profile := &pb.ColumnProfile{Name: s.column.Name, DataType: s.column.DataType, NullCount: rowCount - s.nonNull}
+	if rowCount > 0 {
+		profile.NullRatio = float64(profile.NullCount) / float64(rowCount)
+	}
+	if !s.ordered {
+		return profile
+	}
+	profile.DistinctCount = int64(len(s.counts))
+	profile.MinValue, profile.MaxValue = s.min, s.max
+	if topK <= 0 || len(s.counts) == 0 {
+		return profile
+	}
+	values := make([]*pb.ValueCount, 0, len(s.counts))
+	for value, count := range s.counts {
+		values = append(values, &pb.ValueCount{Value: value, Count: count})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Count != values[j].Count {
+			return values[i].Count > values[j].Count
+		}
+		return values[i].Value < values[j].Value
+	})
+	if len(values) > topK {
+		values = values[:topK]
+	}
+	profile.TopValues = values
+	return profile
+}
+
+// queryTopValues 查询字段出现次数最多的 topK 个非空取值
+func queryTopValues(ctx context.Context, strategy DatabaseStrategy, d Dialect, source, column string,
+	topK int) ([]*pb.ValueCount, error) {
+	quoted := d.QuoteIdentifier(column)
+	query := d.LimitOffset(fmt.Sprintf("SELECT %s, COUNT(*) AS %s FROM %s WHERE %s IS NOT NULL GROUP BY %s ORDER BY %s DESC",
+		quoted, d.QuoteIdentifier(profileCountAlias), source, quoted, quoted, d.QuoteIdentifier(profileCountAlias)), topK, 0)
+	rows, err := strategy.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query top values of column %s: %v", column, err)
+	}
+	defer rows.Close()
+
+	var values []*pb.ValueCount
+	for rows.Next() {
+		var value sql.NullString
+		item := &pb.ValueCount{}
+		if err := rows.Scan(&value, &item.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan top values of column %s: %v", column, err)
+		}
+		item.Value = value.String
+		values = append(values, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query top values of column %s: %v", column, err)
+	}
+	return values, nil
+}
+
+// numericType 字段类型是否为数值类型
+func numericType(dataType string) bool {
+	lower := strings.ToLower(dataType)
+	for _, keyword := range numericTypeKeywords {
+		if strings.Contains(lower, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// orderedType 字段类型是否可以比较大小和去重
+func orderedType(dataType string) bool {
+	lower := strings.ToLower(dataType)
+	for _, keyword := range unorderedTypeKeywords {
+		if strings.Contains(lower, keyword) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	columns := []*ds.ColumnItem{{Name: "id", DataType: "BIGINT"}, {Name: "doc", DataType: "JSON"}, {Name: "city", DataType: "VARCHAR"}}
+	source := "`job_db`.`t`"
+	mock.ExpectQuery("^SELECT COUNT\\(\\*\\), COUNT\\(`id`\\), COUNT\\(DISTINCT `id`\\), MIN\\(`id`\\), MAX\\(`id`\\), " +
+		"COUNT\\(`doc`\\), COUNT\\(`city`\\), COUNT\\(DISTINCT `city`\\), MIN\\(`city`\\), MAX\\(`city`\\) FROM " + source + "$").
+		WillReturnRows(sqlmock.NewRows([]string{"c0", "c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9"}).
+			AddRow(int64(4), int64(4), int64(4), "1", "4", int64(1), int64(3), int64(2), "bj", "sh"))
+	mock.ExpectQuery("^SELECT `id`, COUNT\\(\\*\\) AS `profile_count` FROM " + source +
+		" WHERE `id` IS NOT NULL GROUP BY `id` ORDER BY `profile_count` DESC LIMIT 2$").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_count"}).AddRow("1", int64(1)).AddRow("2", int64(1)))
+	mock.ExpectQuery("^SELECT `city`, COUNT\\(\\*\\) AS `profile_count` FROM " + source).
+		WillReturnRows(sqlmock.NewRows([]string{"city", "profile_count"}).AddRow("bj", int64(2)).AddRow("sh", int64(1)))
+
+	rowCount, profiles, err := ProfileTable(context.Background(), &MySQLStrategy{DB: db}, ds.DataSourceType_DATA_SOURCE_TYPE_DORIS,
+		"job_db.t", columns, ProfileOptions{TopK: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), rowCount)
+	assert.Len(t, profiles, 3)
+
+	assert.Equal(t, int64(0), profiles[0].NullCount)
+	assert.Equal(t, int64(4), profiles[0].DistinctCount)
+	assert.Equal(t, "1", profiles[0].MinValue)
+	assert.Equal(t, "4", profiles[0].MaxValue)
+	assert.Len(t, profiles[0].TopValues, 2)
+
+	// JSON 字段只统计空值
+	assert.Equal(t, int64(3), profiles[1].NullCount)
+	assert.Equal(t, 0.75, profiles[1].NullRatio)
+	assert.Empty(t, profiles[1].TopValues)
+
+	assert.Equal(t, int64(1), profiles[2].NullCount)
+	assert.Equal(t, []*ds.ValueCount{{Value: "bj", Count: 2}, {Value: "sh", Count: 1}}, profiles[2].TopValues)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProfileTableSampled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// 样本只查询一次，随机排序后取前 SampleRows 行
+	columns := []*ds.ColumnItem{{Name: "id", DataType: "BIGINT"}, {Name: "doc", DataType: "JSON"}, {Name: "city", DataType: "VARCHAR"}}
+	mock.ExpectQuery("^SELECT `id`, `doc`, `city` FROM `job_db`.`t` ORDER BY RAND\\(\\) LIMIT 100$").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "doc", "city"}).
+			AddRow("9", nil, "sh").AddRow("10", `{"a":1}`, "bj").AddRow("2", nil, "bj").AddRow("10", nil, nil))
+
+	rowCount, profiles, err := ProfileTable(context.Background(), &MySQLStrategy{DB: db}, ds.DataSourceType_DATA_SOURCE_TYPE_DORIS,
+		"job_db.t", columns, ProfileOptions{SampleRows: 100, TopK: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), rowCount)
+	assert.Len(t, profiles, 3)
+
+	// 数值字段按数值比较最值
+	assert.Equal(t, int64(0), profiles[0].NullCount)
+	assert.Equal(t, int64(3), profiles[0].DistinctCount)
+	assert.Equal(t, "2", profiles[0].MinValue)
+	assert.Equal(t, "10", profiles[0].MaxValue)
+	assert.Equal(t, []*ds.ValueCount{{Value: "10", Count: 2}, {Value: "2", Count: 1}}, profiles[0].TopValues)
+
+	assert.Equal(t, int64(3), profiles[1].NullCount)
+	assert.Equal(t, 0.75, profiles[1].NullRatio)
+	assert.Zero(t, profiles[1].DistinctCount)
+	assert.Empty(t, profiles[1].TopValues)
+
+	assert.Equal(t, int64(1), profiles[2].NullCount)
+	assert.Equal(t, "bj", profiles[2].MinValue)
+	assert.Equal(t, "sh", profiles[2].MaxValue)
+	assert.Equal(t, []*ds.ValueCount{{Value: "bj", Count: 2}, {Value: "sh", Count: 1}}, profiles[2].TopValues)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProfileColumnTypes(t *testing.T) {
+	assert.False(t, orderedType("boolean"))
+	assert.True(t, orderedType("numeric(10,2)"))
+	assert.True(t, numericType("DECIMAL(10,2)"))
+	assert.False(t, numericType("VARCHAR(32)"))
+}
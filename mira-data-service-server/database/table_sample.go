@@ -0,0 +1,131 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/27
+	@note: 抽样读取：前 N 行、随机 N 行、按比例与按键哈希抽样，按方言生成抽样 SQL
+
+*
+*/
+package database
+
+import (
+	"context"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// sampleAlias 随机 N 行再排序时子查询的别名
+const sampleAlias = "sample_rows"
+
+// SampleEnabled 是否设置了抽样方式
+func SampleEnabled(sample *pb.SampleOptions) bool {
+	return sample.GetMethod() != pb.SampleMethod_SAMPLE_METHOD_NONE
+}
+
+// BuildSampleQuery 生成抽样读取的查询及绑定参数，哈希抽样未指定键列时依次从 Keys、源表主键中选择单列键
+func BuildSampleQuery(ctx context.Context, strategy DatabaseStrategy, dbType pb.DataSourceType,
+	req PartitionedReadRequest) (string, []interface{}, error) {
+	d := DialectFor(dbType)
+	condition, args, err := renderReadCondition(d, req)
+	if err != nil {
+		return "", nil, err
+	}
+	var predicates []string
+	if condition != "" {
+		predicates = append(predicates, "("+condition+")")
+	}
+
+	keys := req.Keys
+	if req.Sample.GetMethod() == pb.SampleMethod_SAMPLE_METHOD_HASH && strings.TrimSpace(req.Sample.GetKeyColumn()) == "" &&
+		partitionColumnFromKeys(keys) == "" {
+		pk, err := detectPrimaryKeyColumn(ctx, strategy, dbType, req.TableName)
+		if err != nil {
+			log.Logger.Warnf("Failed to detect primary key of %s: %v", req.TableName, err)
+		}
+		if pk != "" {
+			keys = []*pb.TableKey{{KeyType: pb.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{pk}}}
+		}
+	}
+	query, err := buildSampledSelect(d, d.QuoteTableName(req.TableName), req.Fields, predicates, req.SortRules, req.Sample, keys)
+	if err != nil {
+		return "", nil, err
+	}
+	return query, args, nil
+}
+
+// buildSampledSelect 生成抽样的 SELECT 语句，source 为已加引号的表名，predicates 以 AND 连接
+// 按比例抽样优先使用方言的 TABLESAMPLE，不支持时按随机数过滤；随机 N 行按随机数排序后取前 N 行，
+// 有排序规则时在样本上再排序
+func buildSampledSelect(d Dialect, source string, fields []string, predicates []string, sortRules []*pb.SortRule,
+	sample *pb.SampleOptions, keys []*pb.TableKey) (string, error) {
+	switch sample.GetMethod() {
+	case pb.SampleMethod_SAMPLE_METHOD_NONE:
+		return buildSelectQuery(d, source, fields, predicates, sortRules), nil
+	case pb.SampleMethod_SAMPLE_METHOD_FIRST_N:
+		if sample.Rows <= 0 {
+			return "", fmt.Errorf("sample rows must be positive, got %d", sample.Rows)
+		}
+		return d.LimitOffset(buildSelectQuery(d, source, fields, predicates, sortRules), int(sample.Rows), 0), nil
+	case pb.SampleMethod_SAMPLE_METHOD_RANDOM_N:
+		if sample.Rows <= 0 {
+			return "", fmt.Errorf("sample rows must be positive, got %d", sample.Rows)
+		}
+		query := buildSelectQuery(d, source, fields, predicates, nil) + " ORDER BY " + d.RandomFunction()
+		query = d.LimitOffset(query, int(sample.Rows), 0)
+		if orderClause := buildOrderByClause(d, sortRules); orderClause != "" {
+			query = fmt.Sprintf("SELECT * FROM (%s) %s%s", query, sampleAlias, orderClause)
+		}
+		return query, nil
+	case pb.SampleMethod_SAMPLE_METHOD_PERCENT:
+		if err := validateSamplePercent(sample.Percent); err != nil {
+			return "", err
+		}
+		if sample.Percent < 100 {
+			if clause := d.TableSample(sample.Percent); clause != "" {
+				source = source + " " + clause
+			} else {
+				predicates = append(predicates, fmt.Sprintf("%s < %s", d.RandomFunction(),
+					formatSampleRatio(sample.Percent/100)))
+			}
+		}
+		return buildSelectQuery(d, source, fields, predicates, sortRules), nil
+	case pb.SampleMethod_SAMPLE_METHOD_HASH:
+		if err := validateSamplePercent(sample.Percent); err != nil {
+			return "", err
+		}
+		keyColumn := strings.TrimSpace(sample.KeyColumn)
+		if keyColumn == "" {
+			keyColumn = partitionColumnFromKeys(keys)
+		}
+		if keyColumn == "" {
+			return "", fmt.Errorf("hash sampling requires a key column")
+		}
+		bucket := d.HashBucket(d.QuoteIdentifier(keyColumn), sample.Seed)
+		if bucket == "" {
+			return "", fmt.Errorf("hash sampling is not supported by %s", d.Name())
+		}
+		if sample.Percent < 100 {
+			predicates = append(predicates, fmt.Sprintf("%s < %d", bucket, int(math.Round(sample.Percent*sampleBuckets/100))))
+		}
+		return buildSelectQuery(d, source, fields, predicates, sortRules), nil
+	default:
+		return "", fmt.Errorf("unsupported sample method: %v", sample.GetMethod())
+	}
+}
+
+// validateSamplePercent 抽样比例取值 (0, 100]
+func validateSamplePercent(percent float64) error {
+	if percent <= 0 || percent > 100 || math.IsNaN(percent) {
+		return fmt.Errorf("sample percent must be in (0, 100], got %v", percent)
+	}
+	return nil
+}
+
+// formatSampleRatio 随机数过滤的阈值
+func formatSampleRatio(ratio float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.6f", ratio), "0"), ".")
+}
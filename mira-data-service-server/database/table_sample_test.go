@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSampledSelect(t *testing.T) {
+	keys := []*ds.TableKey{{KeyType: ds.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id"}}}
+	sortRules := []*ds.SortRule{{FieldName: "name", SortOrder: ds.SortOrder_ASC}}
+	tests := []struct {
+		name      string
+		dbType    ds.DataSourceType
+		sample    *ds.SampleOptions
+		sortRules []*ds.SortRule
+		want      string
+	}{
+		{
+			name:   "first n",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_FIRST_N, Rows: 100},
+			want:   "SELECT `id`, `name` FROM `t` WHERE (`age` > 1) LIMIT 100",
+		},
+		{
+			name:   "first n dameng",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_FIRST_N, Rows: 100},
+			want:   `SELECT * FROM (SELECT "id", "name" FROM "t" WHERE ("age" > 1)) WHERE ROWNUM <= 100`,
+		},
+		{
+			name:   "random n",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_RANDOM_N, Rows: 10},
+			want:   "SELECT `id`, `name` FROM `t` WHERE (`age` > 1) ORDER BY RAND() LIMIT 10",
+		},
+		{
+			name:      "random n sorted",
+			dbType:    ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL,
+			sample:    &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_RANDOM_N, Rows: 10},
+			sortRules: sortRules,
+			want:      `SELECT * FROM (SELECT "id", "name" FROM "t" WHERE ("age" > 1) ORDER BY random() LIMIT 10) sample_rows ORDER BY "name" ASC`,
+		},
+		{
+			name:   "percent tablesample",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 1},
+			want:   `SELECT "id", "name" FROM "t" TABLESAMPLE BERNOULLI (1) WHERE ("age" > 1)`,
+		},
+		{
+			name:   "percent doris",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_DORIS,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 2.5},
+			want:   "SELECT `id`, `name` FROM `t` TABLESAMPLE(2.5 PERCENT) WHERE (`age` > 1)",
+		},
+		{
+			name:   "percent by random predicate",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 1},
+			want:   "SELECT `id`, `name` FROM `t` WHERE (`age` > 1) AND RAND() < 0.01",
+		},
+		{
+			name:   "full percent",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 100},
+			want:   "SELECT `id`, `name` FROM `t` WHERE (`age` > 1)",
+		},
+		{
+			name:   "hash by keys",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_HASH, Percent: 10, Seed: 7},
+			want:   "SELECT `id`, `name` FROM `t` WHERE (`age` > 1) AND CRC32(CONCAT(`id`, '#7')) % 10000 < 1000",
+		},
+		{
+			name:   "hash hive",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_HIVE,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_HASH, Percent: 0.5, KeyColumn: "name"},
+			want:   "SELECT `id`, `name` FROM `t` WHERE (`age` > 1) AND pmod(hash(concat(cast(`name` AS STRING), '#0')), 10000) < 50",
+		},
+		{
+			name:   "hash postgres",
+			dbType: ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL,
+			sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_HASH, Percent: 10},
+			want:   `SELECT "id", "name" FROM "t" WHERE ("age" > 1) AND mod(abs(hashtext(CAST("id" AS TEXT) || '#0')::bigint), 10000) < 1000`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DialectFor(tt.dbType)
+			predicate := "(" + d.QuoteIdentifier("age") + " > 1)"
+			got, err := buildSampledSelect(d, d.QuoteTableName("t"), []string{"id", "name"}, []string{predicate},
+				tt.sortRules, tt.sample, keys)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildSampledSelectErrors(t *testing.T) {
+	mysql := DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
+	invalid := []*ds.SampleOptions{
+		{Method: ds.SampleMethod_SAMPLE_METHOD_FIRST_N},
+		{Method: ds.SampleMethod_SAMPLE_METHOD_RANDOM_N, Rows: -1},
+		{Method: ds.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 0},
+		{Method: ds.SampleMethod_SAMPLE_METHOD_PERCENT, Percent: 150},
+		// 没有键列
+		{Method: ds.SampleMethod_SAMPLE_METHOD_HASH, Percent: 10},
+	}
+	for _, sample := range invalid {
+		_, err := buildSampledSelect(mysql, "`t`", nil, nil, nil, sample, nil)
+		assert.Error(t, err, sample.String())
+	}
+
+	// 达梦不支持哈希抽样
+	_, err := buildSampledSelect(DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG), `"t"`, nil, nil, nil,
+		&ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_HASH, Percent: 10, KeyColumn: "id"}, nil)
+	assert.Error(t, err)
+}
+
+func TestBuildSampleQueryDetectsPrimaryKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	strategy := &MySQLStrategy{DB: db}
+
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE").WithArgs("", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+
+	query, args, err := BuildSampleQuery(context.Background(), strategy, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		PartitionedReadRequest{TableName: "t", FilterNames: []string{"name"},
+			FilterOperators: []ds.FilterOperator{ds.FilterOperator_EQUAL}, FilterValues: []*ds.FilterValue{{StrValue: "a"}},
+			Sample: &ds.SampleOptions{Method: ds.SampleMethod_SAMPLE_METHOD_HASH, Percent: 50, Seed: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM `t` WHERE (`name` = ?) AND CRC32(CONCAT(`id`, '#1')) % 10000 < 5000", query)
+	assert.Equal(t, []interface{}{"a"}, args)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
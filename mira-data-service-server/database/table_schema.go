@@ -0,0 +1,217 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/28
+	@note: 表结构元数据：字段的可空性、长度、精度、默认值与注释，以及主键、唯一键、外键和索引
+
+*
+*/
+package database
+
+import (
+	"context"
+	pb "data-service/generated/datasource"
+	"database/sql"
+	"strings"
+)
+
+// keyTypeNames 键信息查询第二列的键类型名
+var keyTypeNames = map[string]pb.KeyType{
+	"PRIMARY": pb.KeyType_KEY_TYPE_PRIMARY,
+	"UNIQUE":  pb.KeyType_KEY_TYPE_UNIQUE,
+	"FOREIGN": pb.KeyType_KEY_TYPE_FOREIGN,
+	"INDEX":   pb.KeyType_KEY_TYPE_INDEX,
+}
+
+// keyQuery 查询表键的语句，结果为键名、键类型名与列名三列，同一个键的列按键内顺序相邻
+type keyQuery struct {
+	query string
+	args  []interface{}
+}
+
+// newColumnItem 由字段元数据查询的一行生成 ColumnItem，nullable 为 NO/N 时视为非空
+func newColumnItem(name, dataType string, length, precision, scale sql.NullInt64,
+	nullable, defaultValue, comment sql.NullString, autoIncrement bool) *pb.ColumnItem {
+	notNull := strings.EqualFold(nullable.String, "NO") || strings.EqualFold(nullable.String, "N")
+	return &pb.ColumnItem{
+		Name:          name,
+		DataType:      dataType,
+		NotNull:       notNull,
+		Length:        length.Int64,
+		Precision:     int32(precision.Int64),
+		Scale:         int32(scale.Int64),
+		HasDefault:    defaultValue.Valid,
+		DefaultValue:  strings.TrimSpace(defaultValue.String),
+		Comment:       comment.String,
+		AutoIncrement: autoIncrement,
+	}
+}
+
+// queryColumnItems 执行字段元数据查询，查询依次返回字段名、类型、字符长度、精度、标度、是否可空、默认值、注释与是否自增
+func queryColumnItems(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]*pb.ColumnItem, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []*pb.ColumnItem
+	for rows.Next() {
+		var name, dataType string
+		var length, precision, scale sql.NullInt64
+		var nullable, defaultValue, comment sql.NullString
+		var autoIncrement sql.NullBool
+		if err := rows.Scan(&name, &dataType, &length, &precision, &scale, &nullable, &defaultValue, &comment, &autoIncrement); err != nil {
+			return nil, err
+		}
+		columns = append(columns, newColumnItem(name, dataType, length, precision, scale, nullable, defaultValue, comment,
+			autoIncrement.Bool))
+	}
+	return columns, rows.Err()
+}
+
+// queryTableKeys 依次执行键信息查询并按键名归并列，未知的键类型按索引处理
+func queryTableKeys(ctx context.Context, db *sql.DB, queries ...keyQuery) ([]*pb.TableKey, error) {
+	var keys []*pb.TableKey
+	for _, q := range queries {
+		rows, err := db.QueryContext(ctx, q.query, q.args...)
+		if err != nil {
+			return nil, err
+		}
+		var last *pb.TableKey
+		for rows.Next() {
+			var name, typeName sql.NullString
+			var column string
+			if err := rows.Scan(&name, &typeName, &column); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			keyType, ok := keyTypeNames[strings.ToUpper(typeName.String)]
+			if !ok {
+				keyType = pb.KeyType_KEY_TYPE_INDEX
+			}
+			if last == nil || last.KeyName != name.String || last.KeyType != keyType {
+				last = &pb.TableKey{KeyName: name.String, KeyType: keyType}
+				keys = append(keys, last)
+			}
+			last.ColumnNames = append(last.ColumnNames, column)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// withAutoIncrementKey 主键只有一列且为自增列时追加 KEY_TYPE_AUTO_INCREMENT 键，供分批导入等按自增主键处理的流程使用
+func withAutoIncrementKey(keys []*pb.TableKey, columns []*pb.ColumnItem) []*pb.TableKey {
+	for _, key := range keys {
+		if key.KeyType != pb.KeyType_KEY_TYPE_PRIMARY || len(key.ColumnNames) != 1 {
+			continue
+		}
+		for _, column := range columns {
+			if column.AutoIncrement && strings.EqualFold(column.Name, key.ColumnNames[0]) {
+				return append(keys, &pb.TableKey{KeyName: key.KeyName, KeyType: pb.KeyType_KEY_TYPE_AUTO_INCREMENT,
+					ColumnNames: []string{column.Name}})
+			}
+		}
+	}
+	return keys
+}
+
+// mysqlColumnsQuery MySQL 系的字段元数据查询，MySQL、GBase 与 Doris 通用
+const mysqlColumnsQuery = `
+	SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, NUMERIC_PRECISION, NUMERIC_SCALE,
+		IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT, LOWER(EXTRA) LIKE '%auto_increment%'
+	FROM INFORMATION_SCHEMA.COLUMNS
+	WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
+	ORDER BY ORDINAL_POSITION`
+
+// mysqlKeyQueries MySQL 系的索引与外键查询，主键排在最前
+func mysqlKeyQueries(database, tableName string) []keyQuery {
+	return []keyQuery{
+		{query: `
+			SELECT INDEX_NAME,
+				CASE WHEN INDEX_NAME = 'PRIMARY' THEN 'PRIMARY' WHEN NON_UNIQUE = 0 THEN 'UNIQUE' ELSE 'INDEX' END,
+				COLUMN_NAME
+			FROM INFORMATION_SCHEMA.STATISTICS
+			WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
+			ORDER BY INDEX_NAME <> 'PRIMARY', INDEX_NAME, SEQ_IN_INDEX`, args: []interface{}{database, tableName}},
+		{query: `
+			SELECT CONSTRAINT_NAME, 'FOREIGN', COLUMN_NAME
+			FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE
+			WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND REFERENCED_TABLE_NAME IS NOT NULL
+			ORDER BY CONSTRAINT_NAME, ORDINAL_POSITION`, args: []interface{}{database, tableName}},
+	}
+}
+
+// postgresColumnsQuery PostgreSQL 系的字段元数据查询，schema 为空时使用当前模式
+// 自定义类型和数组返回 udt_name（如 uuid、_int4）；整数等二进制精度的类型不返回精度；序列默认值与标识列视为自增
+const postgresColumnsQuery = `
+	SELECT c.column_name,
+		CASE WHEN c.data_type IN ('USER-DEFINED', 'ARRAY') THEN c.udt_name ELSE c.data_type END,
+		c.character_maximum_length,
+		CASE WHEN c.numeric_precision_radix = 10 THEN c.numeric_precision END,
+		CASE WHEN c.numeric_precision_radix = 10 THEN c.numeric_scale END,
+		c.is_nullable, c.column_default, d.description,
+		COALESCE(c.is_identity, 'NO') = 'YES' OR COALESCE(c.column_default, '') LIKE 'nextval(%'
+	FROM information_schema.columns c
+	LEFT JOIN pg_catalog.pg_statio_all_tables st ON st.schemaname = c.table_schema AND st.relname = c.table_name
+	LEFT JOIN pg_catalog.pg_description d ON d.objoid = st.relid AND d.objsubid = c.ordinal_position
+	WHERE c.table_schema = COALESCE(NULLIF($1, ''), current_schema()) AND c.table_name = $2
+	ORDER BY c.ordinal_position`
+
+// postgresKeyQueries PostgreSQL 系的索引与外键查询，索引列按 indkey 的顺序展开，表达式索引中的表达式不列出
+func postgresKeyQueries(schemaName, tableName string) []keyQuery {
+	return []keyQuery{
+		{query: `
+			SELECT ic.relname,
+				CASE WHEN ix.indisprimary THEN 'PRIMARY' WHEN ix.indisunique THEN 'UNIQUE' ELSE 'INDEX' END,
+				a.attname
+			FROM pg_index ix
+			JOIN pg_class t ON t.oid = ix.indrelid
+			JOIN pg_namespace n ON n.oid = t.relnamespace
+			JOIN pg_class ic ON ic.oid = ix.indexrelid
+			CROSS JOIN generate_series(0, ix.indnatts - 1) AS k(i)
+			JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = ix.indkey[k.i]
+			WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema()) AND t.relname = $2
+			ORDER BY ix.indisprimary DESC, ic.relname, k.i`, args: []interface{}{schemaName, tableName}},
+		{query: `
+			SELECT tc.constraint_name, 'FOREIGN', kcu.column_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.key_column_usage kcu
+				ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema AND kcu.table_name = tc.table_name
+			WHERE tc.constraint_type = 'FOREIGN KEY'
+				AND tc.table_schema = COALESCE(NULLIF($1, ''), current_schema()) AND tc.table_name = $2
+			ORDER BY tc.constraint_name, kcu.ordinal_position`, args: []interface{}{schemaName, tableName}},
+	}
+}
+
+// describePostgresTable PostgreSQL、Kingbase 与 Vastbase 共用的表结构查询
+func describePostgresTable(ctx context.Context, db *sql.DB, schemaName, tableName string) ([]*pb.ColumnItem, []*pb.TableKey, error) {
+	columns, err := queryColumnItems(ctx, db, postgresColumnsQuery, schemaName, tableName)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := queryTableKeys(ctx, db, postgresKeyQueries(schemaName, tableName)...)
+	if err != nil {
+		return columns, nil, err
+	}
+	return columns, withAutoIncrementKey(keys, columns), nil
+}
+
+// describeMySQLTable MySQL 与 GBase 共用的表结构查询
+func describeMySQLTable(ctx context.Context, db *sql.DB, database, tableName string) ([]*pb.ColumnItem, []*pb.TableKey, error) {
+	columns, err := queryColumnItems(ctx, db, mysqlColumnsQuery, database, tableName)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := queryTableKeys(ctx, db, mysqlKeyQueries(database, tableName)...)
+	if err != nil {
+		return columns, nil, err
+	}
+	return columns, withAutoIncrementKey(keys, columns), nil
+}
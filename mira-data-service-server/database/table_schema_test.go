@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	ds "data-service/generated/datasource"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeMySQLTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.COLUMNS").WithArgs("mall", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE", "CHARACTER_MAXIMUM_LENGTH", "NUMERIC_PRECISION",
+			"NUMERIC_SCALE", "IS_NULLABLE", "COLUMN_DEFAULT", "COLUMN_COMMENT", "AUTO_INCREMENT"}).
+			AddRow("id", "bigint", nil, int64(19), int64(0), "NO", nil, "", int64(1)).
+			AddRow("code", "varchar", int64(32), nil, nil, "NO", nil, "订单号", int64(0)).
+			AddRow("amount", "decimal", nil, int64(10), int64(2), "YES", "0.00", "", int64(0)))
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.STATISTICS").WithArgs("mall", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"INDEX_NAME", "KEY_TYPE", "COLUMN_NAME"}).
+			AddRow("PRIMARY", "PRIMARY", "id").
+			AddRow("uk_code", "UNIQUE", "code").
+			AddRow("idx_code_amount", "INDEX", "code").
+			AddRow("idx_code_amount", "INDEX", "amount"))
+	mock.ExpectQuery("FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE").WithArgs("mall", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_NAME", "KEY_TYPE", "COLUMN_NAME"}))
+
+	columns, keys, err := (&MySQLStrategy{DB: db}).DescribeTable(context.Background(), "mall", "orders")
+	assert.NoError(t, err)
+	assert.Equal(t, []*ds.ColumnItem{
+		{Name: "id", DataType: "bigint", NotNull: true, Precision: 19, AutoIncrement: true},
+		{Name: "code", DataType: "varchar", NotNull: true, Length: 32, Comment: "订单号"},
+		{Name: "amount", DataType: "decimal", Precision: 10, Scale: 2, HasDefault: true, DefaultValue: "0.00"},
+	}, columns)
+	assert.Equal(t, []*ds.TableKey{
+		{KeyName: "PRIMARY", KeyType: ds.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id"}},
+		{KeyName: "uk_code", KeyType: ds.KeyType_KEY_TYPE_UNIQUE, ColumnNames: []string{"code"}},
+		{KeyName: "idx_code_amount", KeyType: ds.KeyType_KEY_TYPE_INDEX, ColumnNames: []string{"code", "amount"}},
+		// 单列自增主键额外列出
+		{KeyName: "PRIMARY", KeyType: ds.KeyType_KEY_TYPE_AUTO_INCREMENT, ColumnNames: []string{"id"}},
+	}, keys)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDescribePostgresTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM information_schema.columns c").WithArgs("sales", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "character_maximum_length", "numeric_precision",
+			"numeric_scale", "is_nullable", "column_default", "description", "auto_increment"}).
+			AddRow("id", "integer", nil, nil, nil, "NO", "nextval('orders_id_seq'::regclass)", nil, true).
+			AddRow("tenant", "integer", nil, nil, nil, "NO", nil, nil, false))
+	mock.ExpectQuery("FROM pg_index ix").WithArgs("sales", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "key_type", "attname"}).
+			AddRow("orders_pkey", "PRIMARY", "id").
+			AddRow("orders_pkey", "PRIMARY", "tenant"))
+	mock.ExpectQuery("constraint_type = 'FOREIGN KEY'").WithArgs("sales", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"constraint_name", "key_type", "column_name"}).
+			AddRow("orders_tenant_fkey", "FOREIGN", "tenant"))
+
+	columns, keys, err := (&PostgresStrategy{DB: db}).DescribeTable(context.Background(), "db", "sales.orders")
+	assert.NoError(t, err)
+	assert.Len(t, columns, 2)
+	assert.True(t, columns[0].AutoIncrement)
+	assert.True(t, columns[0].HasDefault)
+	// 组合主键不作为自增主键
+	assert.Equal(t, []*ds.TableKey{
+		{KeyName: "orders_pkey", KeyType: ds.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id", "tenant"}},
+		{KeyName: "orders_tenant_fkey", KeyType: ds.KeyType_KEY_TYPE_FOREIGN, ColumnNames: []string{"tenant"}},
+	}, keys)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
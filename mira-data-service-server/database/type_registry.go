@@ -0,0 +1,517 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/12/01
+	@note: 类型映射注册表：按（方言, 原生类型）双向映射 Arrow 类型，定点数保留精度与标度，无符号整数映射为无符号或更宽的类型，时间戳保留时区
+
+*
+*/
+package database
+
+import (
+	pb "data-service/generated/datasource"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// NativeType 解析后的数据库原生类型
+type NativeType struct {
+	// Name 大写的基础类型名，不含参数与 UNSIGNED，带时区的时间戳统一为 TIMESTAMPTZ
+	Name string
+	// Precision 定点数的精度、BIT 的位数或浮点数的二进制精度，未声明时为 0
+	Precision int64
+	// Scale 定点数的标度
+	Scale    int64
+	Unsigned bool
+}
+
+// ParseNativeType 解析 information_schema、DESCRIBE 或驱动返回的类型名
+// 支持 decimal(10,2)、int(10) unsigned、UNSIGNED BIGINT、timestamp(6) with time zone、INT_TYPE（Hive 驱动）、array<string> 等写法
+func ParseNativeType(typeName string) NativeType {
+	name := strings.ToUpper(strings.TrimSpace(typeName))
+	name = strings.TrimSuffix(name, "_TYPE")
+	if idx := strings.Index(name, "<"); idx >= 0 {
+		name = name[:idx]
+	}
+
+	var native NativeType
+	if start := strings.Index(name, "("); start >= 0 {
+		end := strings.Index(name[start:], ")")
+		if end < 0 {
+			end = len(name) - start
+		}
+		params := strings.Split(name[start+1:start+end], ",")
+		native.Precision, _ = strconv.ParseInt(strings.TrimSpace(params[0]), 10, 64)
+		if len(params) > 1 {
+			native.Scale, _ = strconv.ParseInt(strings.TrimSpace(params[1]), 10, 64)
+		}
+		name = name[:start] + " " + name[min(start+end+1, len(name)):]
+	}
+
+	withTimeZone := false
+	var words []string
+	for _, word := range strings.Fields(name) {
+		switch word {
+		case "UNSIGNED":
+			native.Unsigned = true
+		case "ZEROFILL", "SIGNED":
+		default:
+			words = append(words, word)
+		}
+	}
+	name = strings.Join(words, " ")
+	for _, suffix := range []string{" WITHOUT TIME ZONE", " WITH LOCAL TIME ZONE", " WITH TIME ZONE"} {
+		if strings.HasSuffix(name, suffix) {
+			name = strings.TrimSuffix(name, suffix)
+			withTimeZone = suffix != " WITHOUT TIME ZONE"
+			break
+		}
+	}
+	if withTimeZone {
+		switch name {
+		case "TIMESTAMP", "DATETIME":
+			name = "TIMESTAMPTZ"
+		case "TIME":
+			name = "TIMETZ"
+		}
+	}
+	native.Name = name
+	return native
+}
+
+// NativeTypeOfColumn 查询结果列的原生类型，类型名未带精度时使用驱动返回的精度和标度
+func NativeTypeOfColumn(colType *sql.ColumnType) NativeType {
+	native := ParseNativeType(colType.DatabaseTypeName())
+	if native.Precision == 0 {
+		if precision, scale, ok := colType.DecimalSize(); ok {
+			native.Precision, native.Scale = precision, scale
+		}
+	}
+	return native
+}
+
+// NativeTypeOfItem 表结构元数据中字段的原生类型，类型名未带精度时使用元数据中的精度和标度
+func NativeTypeOfItem(column *pb.ColumnItem) NativeType {
+	native := ParseNativeType(column.DataType)
+	if native.Precision == 0 && column.Precision > 0 {
+		native.Precision, native.Scale = int64(column.Precision), int64(column.Scale)
+	}
+	return native
+}
+
+// nativeKind 原生类型映射到 Arrow 时参数的使用方式
+type nativeKind int
+
+const (
+	// kindFixed 直接使用登记的 Arrow 类型
+	kindFixed nativeKind = iota
+	// kindInteger 整数，UNSIGNED 时使用同宽度的无符号类型
+	kindInteger
+	// kindFloat FLOAT(p)，声明了二进制精度时按精度区分单双精度
+	kindFloat
+	// kindDecimal 定点数，按精度和标度映射为 Decimal128
+	kindDecimal
+	// kindBit 位类型，BIT(1) 为布尔值，多位按二进制处理
+	kindBit
+	// kindDateTime 不带时区的日期时间
+	kindDateTime
+	// kindTimestampTZ 带时区的时间戳，读取的值为 UTC 时刻
+	kindTimestampTZ
+)
+
+type nativeMapping struct {
+	kind  nativeKind
+	arrow arrow.DataType
+}
+
+// columnTypeNames 各 Arrow 类型对应的建表字段类型
+type columnTypeNames struct {
+	int8, int16, int32, int64     string
+	uint8, uint16, uint32, uint64 string
+	float32, float64, boolean     string
+	str, largeStr, binary, date   string
+	dateTime, timestampTZ         string
+	// decimal 定点数类型名，超出 maxDecimalPrecision 的定点数按 largeStr 存储
+	decimal             string
+	maxDecimalPrecision int32
+}
+
+// typeMapping 一种方言的类型映射
+type typeMapping struct {
+	natives map[string]nativeMapping
+	columns columnTypeNames
+	// unboundedDecimal 未声明精度的定点数可存储任意精度，按字符串读取；否则使用 defaultDecimalType
+	unboundedDecimal bool
+	// jsonType 原生 JSON 类型，不支持时为空，JSON 按长文本存储
+	jsonType string
+}
+
+var (
+	// maxDecimal128Precision Decimal128 支持的最大精度，超出时按字符串读取避免丢失精度
+	maxDecimal128Precision int64 = 38
+	// defaultDecimalType 只知道类型名、不知道精度的定点数使用的类型
+	defaultDecimalType = &arrow.Decimal128Type{Precision: 38, Scale: 10}
+	// timestampType 不带时区的日期时间，各数据库的小数秒位数均不超过微秒
+	timestampType = &arrow.TimestampType{Unit: arrow.Microsecond}
+	// timestampTZType 带时区的时间戳，值为 UTC 时刻
+	timestampTZType = &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+)
+
+var unsignedIntegers = map[arrow.Type]arrow.DataType{
+	arrow.INT8:  arrow.PrimitiveTypes.Uint8,
+	arrow.INT16: arrow.PrimitiveTypes.Uint16,
+	arrow.INT32: arrow.PrimitiveTypes.Uint32,
+	arrow.INT64: arrow.PrimitiveTypes.Uint64,
+}
+
+// natives 将多个类型名登记为同一种映射
+func natives(entries map[nativeMapping][]string) map[string]nativeMapping {
+	result := make(map[string]nativeMapping)
+	for mapping, names := range entries {
+		for _, name := range names {
+			result[name] = mapping
+		}
+	}
+	return result
+}
+
+// mergeNatives 在 base 的基础上增加或覆盖类型名
+func mergeNatives(base map[string]nativeMapping, extra map[string]nativeMapping) map[string]nativeMapping {
+	result := make(map[string]nativeMapping, len(base)+len(extra))
+	for name, mapping := range base {
+		result[name] = mapping
+	}
+	for name, mapping := range extra {
+		result[name] = mapping
+	}
+	return result
+}
+
+var (
+	int8Mapping    = nativeMapping{kind: kindInteger, arrow: arrow.PrimitiveTypes.Int8}
+	int16Mapping   = nativeMapping{kind: kindInteger, arrow: arrow.PrimitiveTypes.Int16}
+	int32Mapping   = nativeMapping{kind: kindInteger, arrow: arrow.PrimitiveTypes.Int32}
+	int64Mapping   = nativeMapping{kind: kindInteger, arrow: arrow.PrimitiveTypes.Int64}
+	float32Mapping = nativeMapping{arrow: arrow.PrimitiveTypes.Float32}
+	float64Mapping = nativeMapping{arrow: arrow.PrimitiveTypes.Float64}
+	// MySQL 与 Hive 的 FLOAT 默认为单精度，PostgreSQL 与达梦的 FLOAT 默认为双精度
+	floatMapping    = nativeMapping{kind: kindFloat, arrow: arrow.PrimitiveTypes.Float32}
+	floatMapping64  = nativeMapping{kind: kindFloat, arrow: arrow.PrimitiveTypes.Float64}
+	decimalMapping  = nativeMapping{kind: kindDecimal}
+	boolMapping     = nativeMapping{arrow: arrow.FixedWidthTypes.Boolean}
+	stringMapping   = nativeMapping{arrow: arrow.BinaryTypes.String}
+	textMapping     = nativeMapping{arrow: arrow.BinaryTypes.LargeString}
+	binaryMapping   = nativeMapping{arrow: arrow.BinaryTypes.Binary}
+	dateMapping     = nativeMapping{arrow: arrow.FixedWidthTypes.Date32}
+	dateTimeMapping = nativeMapping{kind: kindDateTime}
+	tzMapping       = nativeMapping{kind: kindTimestampTZ}
+)
+
+// mysqlNatives MySQL 系的原生类型，GBase 与 Doris 在此基础上调整
+// YEAR 按整数读取，TIME 可超过 24 小时，按字符串读取
+var mysqlNatives = natives(map[nativeMapping][]string{
+	int8Mapping:                         {"TINYINT", "INT1"},
+	int16Mapping:                        {"SMALLINT", "INT2"},
+	int32Mapping:                        {"MEDIUMINT", "INT3", "INT", "INTEGER", "INT4"},
+	int64Mapping:                        {"BIGINT", "INT8"},
+	floatMapping:                        {"FLOAT"},
+	float64Mapping:                      {"DOUBLE", "DOUBLE PRECISION", "REAL"},
+	decimalMapping:                      {"DECIMAL", "NUMERIC", "DEC", "FIXED"},
+	{kind: kindBit}:                     {"BIT"},
+	boolMapping:                         {"BOOL", "BOOLEAN"},
+	stringMapping:                       {"CHAR", "VARCHAR", "ENUM", "SET", "TIME"},
+	textMapping:                         {"TINYTEXT", "TEXT", "MEDIUMTEXT", "LONGTEXT", "JSON"},
+	binaryMapping:                       {"BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB"},
+	dateMapping:                         {"DATE"},
+	dateTimeMapping:                     {"DATETIME"},
+	tzMapping:                           {"TIMESTAMP"},
+	{arrow: arrow.PrimitiveTypes.Int16}: {"YEAR"},
+})
+
+// postgresNatives PostgreSQL 系的原生类型，Kingbase 与 Vastbase 兼容模式下的 MySQL 类型名一并登记
+// BIT/VARBIT 为位串，按字符串读取
+var postgresNatives = natives(map[nativeMapping][]string{
+	int8Mapping:     {"TINYINT"},
+	int16Mapping:    {"INT2", "SMALLINT", "SMALLSERIAL", "SERIAL2"},
+	int32Mapping:    {"INT4", "INT", "INTEGER", "MEDIUMINT", "SERIAL", "SERIAL4"},
+	int64Mapping:    {"INT8", "BIGINT", "BIGSERIAL", "SERIAL8", "OID"},
+	float32Mapping:  {"FLOAT4", "REAL"},
+	float64Mapping:  {"FLOAT8", "DOUBLE PRECISION", "DOUBLE"},
+	floatMapping64:  {"FLOAT"},
+	decimalMapping:  {"NUMERIC", "DECIMAL"},
+	boolMapping:     {"BOOL", "BOOLEAN"},
+	stringMapping:   {"CHAR", "BPCHAR", "CHARACTER", "VARCHAR", "CHARACTER VARYING", "NAME", "UUID", "BIT", "VARBIT", "BIT VARYING", "MONEY", "TIME", "TIMETZ", "INTERVAL"},
+	textMapping:     {"TEXT", "JSON", "JSONB", "XML"},
+	binaryMapping:   {"BYTEA"},
+	dateMapping:     {"DATE"},
+	dateTimeMapping: {"TIMESTAMP", "DATETIME"},
+	tzMapping:       {"TIMESTAMPTZ"},
+})
+
+// damengNatives 达梦的原生类型，BIT 只能存 0/1，按布尔值读取
+var damengNatives = natives(map[nativeMapping][]string{
+	int8Mapping:     {"TINYINT", "BYTE"},
+	int16Mapping:    {"SMALLINT"},
+	int32Mapping:    {"INT", "INTEGER", "PLS_INTEGER"},
+	int64Mapping:    {"BIGINT"},
+	float32Mapping:  {"REAL", "BINARY_FLOAT"},
+	float64Mapping:  {"DOUBLE", "DOUBLE PRECISION", "BINARY_DOUBLE"},
+	floatMapping64:  {"FLOAT"},
+	decimalMapping:  {"NUMBER", "NUMERIC", "DECIMAL", "DEC"},
+	boolMapping:     {"BIT", "BOOL", "BOOLEAN"},
+	stringMapping:   {"CHAR", "CHARACTER", "VARCHAR", "VARCHAR2", "NCHAR", "NVARCHAR2"},
+	textMapping:     {"CLOB", "NCLOB", "TEXT", "LONG", "LONGVARCHAR"},
+	binaryMapping:   {"BLOB", "BINARY", "VARBINARY", "RAW", "IMAGE", "LONGVARBINARY"},
+	dateMapping:     {"DATE"},
+	dateTimeMapping: {"DATETIME", "TIMESTAMP"},
+	tzMapping:       {"TIMESTAMPTZ"},
+})
+
+// hiveNatives Hive 的原生类型，复杂类型按字符串读取
+var hiveNatives = natives(map[nativeMapping][]string{
+	int8Mapping:     {"TINYINT"},
+	int16Mapping:    {"SMALLINT"},
+	int32Mapping:    {"INT", "INTEGER"},
+	int64Mapping:    {"BIGINT"},
+	floatMapping:    {"FLOAT"},
+	float64Mapping:  {"DOUBLE", "DOUBLE PRECISION"},
+	decimalMapping:  {"DECIMAL", "NUMERIC"},
+	boolMapping:     {"BOOLEAN"},
+	stringMapping:   {"CHAR", "VARCHAR"},
+	textMapping:     {"STRING"},
+	binaryMapping:   {"BINARY"},
+	dateMapping:     {"DATE"},
+	dateTimeMapping: {"TIMESTAMP"},
+	tzMapping:       {"TIMESTAMPTZ"},
+})
+
+// mysqlColumnTypes MySQL 的建表类型，带时区的时间戳使用 TIMESTAMP，连接的会话时区为 UTC
+var mysqlColumnTypes = columnTypeNames{
+	int8: "TINYINT", int16: "SMALLINT", int32: "INT", int64: "BIGINT",
+	uint8: "TINYINT UNSIGNED", uint16: "SMALLINT UNSIGNED", uint32: "INT UNSIGNED", uint64: "BIGINT UNSIGNED",
+	float32: "FLOAT", float64: "DOUBLE", boolean: "BOOLEAN",
+	str: "VARCHAR(255)", largeStr: "LONGTEXT", binary: "LONGBLOB", date: "DATE",
+	dateTime: "DATETIME(6)", timestampTZ: "TIMESTAMP(6)",
+	decimal: "DECIMAL", maxDecimalPrecision: 65,
+}
+
+// postgresTypeNames PostgreSQL 系的建表类型，无符号整数使用更宽的有符号类型
+func postgresTypeNames(str, decimal string) columnTypeNames {
+	return columnTypeNames{
+		int8: "SMALLINT", int16: "SMALLINT", int32: "INTEGER", int64: "BIGINT",
+		uint8: "SMALLINT", uint16: "INTEGER", uint32: "BIGINT", uint64: decimal + "(20,0)",
+		float32: "REAL", float64: "DOUBLE PRECISION", boolean: "BOOLEAN",
+		str: str, largeStr: "TEXT", binary: "BYTEA", date: "DATE",
+		dateTime: "TIMESTAMP", timestampTZ: "TIMESTAMPTZ",
+		decimal: decimal, maxDecimalPrecision: 1000,
+	}
+}
+
+// typeRegistry 各方言的类型映射，键为 Dialect.Name()
+var typeRegistry = map[string]*typeMapping{
+	"mysql": {natives: mysqlNatives, columns: mysqlColumnTypes, jsonType: "JSON"},
+	// GBase 未设置会话时区，TIMESTAMP 与 DATETIME 一样按不带时区处理
+	"gbase": {
+		natives: mergeNatives(mysqlNatives, map[string]nativeMapping{"TIMESTAMP": dateTimeMapping}),
+		columns: columnTypeNames{
+			int8: "TINYINT", int16: "SMALLINT", int32: "INT", int64: "BIGINT",
+			uint8: "TINYINT UNSIGNED", uint16: "SMALLINT UNSIGNED", uint32: "INT UNSIGNED", uint64: "BIGINT UNSIGNED",
+			float32: "FLOAT", float64: "DOUBLE", boolean: "BOOLEAN",
+			str: "VARCHAR(255)", largeStr: "TEXT", binary: "BLOB", date: "DATE",
+			dateTime: "DATETIME", timestampTZ: "DATETIME",
+			decimal: "DECIMAL", maxDecimalPrecision: 65,
+		},
+	},
+	// Doris 没有无符号整数与带时区的时间戳，LARGEINT 超出 Decimal128 的范围，按字符串读取
+	"doris": {
+		natives: mergeNatives(mysqlNatives, natives(map[nativeMapping][]string{
+			decimalMapping:  {"DECIMALV2", "DECIMALV3"},
+			textMapping:     {"STRING", "JSONB", "VARIANT"},
+			stringMapping:   {"LARGEINT"},
+			dateMapping:     {"DATEV2"},
+			dateTimeMapping: {"DATETIMEV2", "TIMESTAMP"},
+		})),
+		columns: columnTypeNames{
+			int8: "TINYINT", int16: "SMALLINT", int32: "INT", int64: "BIGINT",
+			uint8: "SMALLINT", uint16: "INT", uint32: "BIGINT", uint64: "LARGEINT",
+			float32: "FLOAT", float64: "DOUBLE", boolean: "BOOLEAN",
+			str: "VARCHAR(65533)", largeStr: "STRING", binary: "STRING", date: "DATE",
+			dateTime: "DATETIME(6)", timestampTZ: "DATETIME(6)",
+			decimal: "DECIMAL", maxDecimalPrecision: 38,
+		},
+		jsonType: "JSON",
+	},
+	// 驱动不返回精度的 DECIMAL 按字符串读取
+	"hive": {
+		natives: hiveNatives,
+		columns: columnTypeNames{
+			int8: "TINYINT", int16: "SMALLINT", int32: "INT", int64: "BIGINT",
+			uint8: "SMALLINT", uint16: "INT", uint32: "BIGINT", uint64: "DECIMAL(20,0)",
+			float32: "FLOAT", float64: "DOUBLE", boolean: "BOOLEAN",
+			str: "STRING", largeStr: "STRING", binary: "BINARY", date: "DATE",
+			dateTime: "TIMESTAMP", timestampTZ: "TIMESTAMP",
+			decimal: "DECIMAL", maxDecimalPrecision: 38,
+		},
+		unboundedDecimal: true,
+	},
+	"kingbase":   {natives: postgresNatives, columns: postgresTypeNames("VARCHAR(255)", "DECIMAL"), unboundedDecimal: true, jsonType: "JSONB"},
+	"vastbase":   {natives: postgresNatives, columns: postgresTypeNames("VARCHAR(255)", "NUMERIC"), unboundedDecimal: true, jsonType: "JSONB"},
+	"postgresql": {natives: postgresNatives, columns: postgresTypeNames("TEXT", "NUMERIC"), unboundedDecimal: true, jsonType: "JSONB"},
+	// 达梦的 VARCHAR 按字节计长，超长字符串使用 CLOB
+	"dameng": {
+		natives: damengNatives,
+		columns: columnTypeNames{
+			int8: "TINYINT", int16: "SMALLINT", int32: "INT", int64: "BIGINT",
+			uint8: "SMALLINT", uint16: "INT", uint32: "BIGINT", uint64: "NUMBER(20,0)",
+			float32: "REAL", float64: "DOUBLE", boolean: "BIT",
+			str: "VARCHAR(4000)", largeStr: "CLOB", binary: "BLOB", date: "DATE",
+			dateTime: "TIMESTAMP(6)", timestampTZ: "TIMESTAMP(6) WITH TIME ZONE",
+			decimal: "NUMBER", maxDecimalPrecision: 38,
+		},
+		unboundedDecimal: true,
+	},
+}
+
+// typeMappingFor 获取数据源类型对应方言的类型映射
+func typeMappingFor(dbType pb.DataSourceType) *typeMapping {
+	return typeRegistry[DialectFor(dbType).Name()]
+}
+
+// ArrowType 原生类型对应的 Arrow 类型，未登记的类型按字符串读取
+func ArrowType(dbType pb.DataSourceType, native NativeType) arrow.DataType {
+	return typeMappingFor(dbType).arrowType(native)
+}
+
+// ColumnArrowType 查询结果列对应的 Arrow 类型
+func ColumnArrowType(dbType pb.DataSourceType, colType *sql.ColumnType) arrow.DataType {
+	return ArrowType(dbType, NativeTypeOfColumn(colType))
+}
+
+// ConvertNativeType 将一种数据库的原生类型转换为另一种数据库的建表类型
+// 经由 Arrow 类型转换，两边都支持 JSON 时保留 JSON 类型
+func ConvertNativeType(from, to pb.DataSourceType, native NativeType) string {
+	target := typeMappingFor(to)
+	if (native.Name == "JSON" || native.Name == "JSONB") && target.jsonType != "" {
+		return target.jsonType
+	}
+	return target.columnType(ArrowType(from, native))
+}
+
+// DorisKeyColumnType 作为 Doris 排序键或分桶列时的字段类型，STRING 不能作为键列，改用最长的 VARCHAR
+func DorisKeyColumnType(arrowType arrow.DataType) string {
+	columnType := dorisDialect.ColumnType(arrowType)
+	if columnType == "STRING" {
+		return typeRegistry["doris"].columns.str
+	}
+	return columnType
+}
+
+func (m *typeMapping) arrowType(native NativeType) arrow.DataType {
+	mapping, ok := m.natives[native.Name]
+	if !ok {
+		return arrow.BinaryTypes.String
+	}
+	switch mapping.kind {
+	case kindInteger:
+		if native.Unsigned {
+			return unsignedIntegers[mapping.arrow.ID()]
+		}
+	case kindFloat:
+		// FLOAT(p) 的 p 为二进制位数，24 位以内为单精度
+		if native.Precision > 24 {
+			return arrow.PrimitiveTypes.Float64
+		}
+		if native.Precision > 0 {
+			return arrow.PrimitiveTypes.Float32
+		}
+	case kindDecimal:
+		return m.decimalType(native)
+	case kindBit:
+		if native.Precision == 1 {
+			return arrow.FixedWidthTypes.Boolean
+		}
+		return arrow.BinaryTypes.Binary
+	case kindDateTime:
+		return timestampType
+	case kindTimestampTZ:
+		return timestampTZType
+	}
+	return mapping.arrow
+}
+
+// decimalType 定点数对应的 Arrow 类型，精度超出 Decimal128 或未声明精度且可存储任意精度时按字符串读取
+func (m *typeMapping) decimalType(native NativeType) arrow.DataType {
+	if native.Precision <= 0 {
+		if m.unboundedDecimal {
+			return arrow.BinaryTypes.String
+		}
+		return defaultDecimalType
+	}
+	if native.Precision > maxDecimal128Precision || native.Scale < 0 || native.Scale > native.Precision {
+		return arrow.BinaryTypes.String
+	}
+	return &arrow.Decimal128Type{Precision: int32(native.Precision), Scale: int32(native.Scale)}
+}
+
+// columnType Arrow 类型对应的建表字段类型，未登记的类型按长文本存储
+func (m *typeMapping) columnType(arrowType arrow.DataType) string {
+	names := m.columns
+	switch t := arrowType.(type) {
+	case *arrow.Decimal128Type:
+		return names.decimalColumnType(t.Precision, t.Scale)
+	case *arrow.Decimal256Type:
+		return names.decimalColumnType(t.Precision, t.Scale)
+	case *arrow.TimestampType:
+		if t.TimeZone != "" {
+			return names.timestampTZ
+		}
+		return names.dateTime
+	}
+	switch arrowType.ID() {
+	case arrow.INT8:
+		return names.int8
+	case arrow.INT16:
+		return names.int16
+	case arrow.INT32:
+		return names.int32
+	case arrow.INT64:
+		return names.int64
+	case arrow.UINT8:
+		return names.uint8
+	case arrow.UINT16:
+		return names.uint16
+	case arrow.UINT32:
+		return names.uint32
+	case arrow.UINT64:
+		return names.uint64
+	case arrow.FLOAT32:
+		return names.float32
+	case arrow.FLOAT64:
+		return names.float64
+	case arrow.BOOL:
+		return names.boolean
+	case arrow.STRING:
+		return names.str
+	case arrow.BINARY, arrow.LARGE_BINARY:
+		return names.binary
+	case arrow.DATE32:
+		return names.date
+	case arrow.DATE64:
+		// Date64 为毫秒时间戳
+		return names.dateTime
+	default:
+		return names.largeStr
+	}
+}
+
+func (n columnTypeNames) decimalColumnType(precision, scale int32) string {
+	if precision > n.maxDecimalPrecision {
+		return n.largeStr
+	}
+	return fmt.Sprintf("%s(%d,%d)", n.decimal, precision, scale)
+}
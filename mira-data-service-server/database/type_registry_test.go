@@ -0,0 +1,170 @@
+package database
+
+import (
+	ds "data-service/generated/datasource"
+	"testing"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNativeType(t *testing.T) {
+	assert.Equal(t, NativeType{Name: "DECIMAL", Precision: 10, Scale: 2}, ParseNativeType("decimal(10, 2)"))
+	assert.Equal(t, NativeType{Name: "INT", Precision: 10, Unsigned: true}, ParseNativeType("int(10) unsigned zerofill"))
+	assert.Equal(t, NativeType{Name: "BIGINT", Unsigned: true}, ParseNativeType("UNSIGNED BIGINT"))
+	assert.Equal(t, NativeType{Name: "TIMESTAMPTZ", Precision: 6}, ParseNativeType("timestamp(6) with time zone"))
+	assert.Equal(t, NativeType{Name: "TIMESTAMP"}, ParseNativeType("timestamp without time zone"))
+	assert.Equal(t, NativeType{Name: "TIMESTAMPTZ"}, ParseNativeType("TIMESTAMP WITH LOCAL TIME ZONE"))
+	assert.Equal(t, NativeType{Name: "TIMETZ"}, ParseNativeType("time with time zone"))
+	assert.Equal(t, NativeType{Name: "CHARACTER VARYING", Precision: 32}, ParseNativeType("character varying(32)"))
+	assert.Equal(t, NativeType{Name: "INT"}, ParseNativeType("INT_TYPE"))
+	assert.Equal(t, NativeType{Name: "ARRAY"}, ParseNativeType("array<decimal(10,2)>"))
+}
+
+func TestArrowTypeOfNativeTypes(t *testing.T) {
+	mysql := ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL
+	postgres := ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL
+	dameng := ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG
+
+	assert.Equal(t, &arrow.Decimal128Type{Precision: 10, Scale: 2}, ArrowType(mysql, ParseNativeType("DECIMAL(10,2)")))
+	// 超出 Decimal128 范围或未声明精度的 NUMERIC 按字符串读取
+	assert.Equal(t, arrow.BinaryTypes.String, ArrowType(mysql, ParseNativeType("DECIMAL(65,30)")))
+	assert.Equal(t, arrow.BinaryTypes.String, ArrowType(postgres, ParseNativeType("NUMERIC")))
+	assert.Equal(t, defaultDecimalType, ArrowType(mysql, ParseNativeType("DECIMAL")))
+	assert.Equal(t, &arrow.Decimal128Type{Precision: 10, Scale: 2},
+		ArrowType(postgres, NativeTypeOfItem(&ds.ColumnItem{DataType: "numeric", Precision: 10, Scale: 2})))
+
+	assert.Equal(t, arrow.PrimitiveTypes.Uint32, ArrowType(mysql, ParseNativeType("int unsigned")))
+	assert.Equal(t, arrow.PrimitiveTypes.Uint64, ArrowType(mysql, ParseNativeType("BIGINT UNSIGNED")))
+	assert.Equal(t, arrow.FixedWidthTypes.Boolean, ArrowType(mysql, ParseNativeType("BIT(1)")))
+	assert.Equal(t, arrow.BinaryTypes.Binary, ArrowType(mysql, ParseNativeType("BIT(8)")))
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, ArrowType(mysql, ParseNativeType("FLOAT(53)")))
+	assert.Equal(t, arrow.PrimitiveTypes.Float32, ArrowType(mysql, ParseNativeType("FLOAT")))
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, ArrowType(postgres, ParseNativeType("FLOAT")))
+	assert.Equal(t, arrow.PrimitiveTypes.Float32, ArrowType(postgres, ParseNativeType("FLOAT(10)")))
+
+	assert.Equal(t, arrow.FixedWidthTypes.Date32, ArrowType(mysql, ParseNativeType("DATE")))
+	assert.Equal(t, timestampType, ArrowType(mysql, ParseNativeType("DATETIME(3)")))
+	assert.Equal(t, timestampTZType, ArrowType(mysql, ParseNativeType("TIMESTAMP")))
+	assert.Equal(t, timestampType, ArrowType(postgres, ParseNativeType("timestamp without time zone")))
+	assert.Equal(t, timestampTZType, ArrowType(dameng, ParseNativeType("TIMESTAMP(6) WITH TIME ZONE")))
+	assert.Equal(t, arrow.FixedWidthTypes.Boolean, ArrowType(dameng, ParseNativeType("BIT")))
+	assert.Equal(t, arrow.BinaryTypes.String, ArrowType(mysql, ParseNativeType("GEOMETRY")))
+}
+
+func TestConvertNativeType(t *testing.T) {
+	mysql := ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL
+	postgres := ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL
+	doris := ds.DataSourceType_DATA_SOURCE_TYPE_DORIS
+	hive := ds.DataSourceType_DATA_SOURCE_TYPE_HIVE
+
+	assert.Equal(t, "NUMERIC(10,2)", ConvertNativeType(mysql, postgres, ParseNativeType("decimal(10,2)")))
+	assert.Equal(t, "NUMERIC(20,0)", ConvertNativeType(mysql, postgres, ParseNativeType("bigint unsigned")))
+	assert.Equal(t, "LARGEINT", ConvertNativeType(mysql, doris, ParseNativeType("bigint unsigned")))
+	assert.Equal(t, "TIMESTAMPTZ", ConvertNativeType(mysql, postgres, ParseNativeType("timestamp")))
+	// JSON 两边都支持时保留 JSON 类型，否则按长文本存储
+	assert.Equal(t, "JSONB", ConvertNativeType(mysql, postgres, ParseNativeType("json")))
+	assert.Equal(t, "JSON", ConvertNativeType(postgres, doris, ParseNativeType("jsonb")))
+	assert.Equal(t, "STRING", ConvertNativeType(postgres, hive, ParseNativeType("json")))
+
+	assert.Equal(t, "VARCHAR(65533)", DorisKeyColumnType(arrow.BinaryTypes.LargeString))
+	assert.Equal(t, "BIGINT", DorisKeyColumnType(arrow.PrimitiveTypes.Int64))
+}
+
+// roundTripTypes 各方言往返转换所覆盖的 Arrow 类型
+var roundTripTypes = []arrow.DataType{
+	arrow.PrimitiveTypes.Int8, arrow.PrimitiveTypes.Int16, arrow.PrimitiveTypes.Int32, arrow.PrimitiveTypes.Int64,
+	arrow.PrimitiveTypes.Uint8, arrow.PrimitiveTypes.Uint16, arrow.PrimitiveTypes.Uint32, arrow.PrimitiveTypes.Uint64,
+	arrow.PrimitiveTypes.Float32, arrow.PrimitiveTypes.Float64, arrow.FixedWidthTypes.Boolean,
+	arrow.BinaryTypes.String, arrow.BinaryTypes.LargeString, arrow.BinaryTypes.Binary,
+	arrow.FixedWidthTypes.Date32, timestampType, timestampTZType,
+	&arrow.Decimal128Type{Precision: 10, Scale: 2}, &arrow.Decimal128Type{Precision: 38, Scale: 0},
+}
+
+var uint64Decimal = &arrow.Decimal128Type{Precision: 20, Scale: 0}
+
+// roundTripDegrades 各方言不能原样保存的类型及建表后读回的类型，未列出的类型往返后保持不变
+var roundTripDegrades = map[ds.DataSourceType]map[string]arrow.DataType{
+	ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL: {},
+	ds.DataSourceType_DATA_SOURCE_TYPE_GBASE: {
+		timestampTZType.String(): timestampType,
+	},
+	ds.DataSourceType_DATA_SOURCE_TYPE_DORIS: {
+		arrow.PrimitiveTypes.Uint8.String():  arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint16.String(): arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint32.String(): arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint64.String(): arrow.BinaryTypes.String,
+		arrow.BinaryTypes.Binary.String():    arrow.BinaryTypes.LargeString,
+		timestampTZType.String():             timestampType,
+	},
+	ds.DataSourceType_DATA_SOURCE_TYPE_HIVE: {
+		arrow.PrimitiveTypes.Uint8.String():  arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint16.String(): arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint32.String(): arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint64.String(): uint64Decimal,
+		arrow.BinaryTypes.String.String():    arrow.BinaryTypes.LargeString,
+		timestampTZType.String():             timestampType,
+	},
+	ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL: {
+		arrow.PrimitiveTypes.Int8.String():   arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint8.String():  arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint16.String(): arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint32.String(): arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint64.String(): uint64Decimal,
+		arrow.BinaryTypes.String.String():    arrow.BinaryTypes.LargeString,
+	},
+	ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE: {
+		arrow.PrimitiveTypes.Int8.String():   arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint8.String():  arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint16.String(): arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint32.String(): arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint64.String(): uint64Decimal,
+	},
+	ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE: {
+		arrow.PrimitiveTypes.Int8.String():   arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint8.String():  arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint16.String(): arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint32.String(): arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint64.String(): uint64Decimal,
+	},
+	ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG: {
+		arrow.PrimitiveTypes.Uint8.String():  arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Uint16.String(): arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint32.String(): arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint64.String(): uint64Decimal,
+	},
+}
+
+// roundTrip 按 dbType 的建表类型建表后读回的 Arrow 类型
+func roundTrip(dbType ds.DataSourceType, arrowType arrow.DataType) arrow.DataType {
+	return ArrowType(dbType, ParseNativeType(DialectFor(dbType).ColumnType(arrowType)))
+}
+
+// degrade 期望的往返结果
+func degrade(dbType ds.DataSourceType, arrowType arrow.DataType) arrow.DataType {
+	if degraded, ok := roundTripDegrades[dbType][arrowType.String()]; ok {
+		return degraded
+	}
+	return arrowType
+}
+
+func TestTypeRegistryRoundTrip(t *testing.T) {
+	for dbType := range roundTripDegrades {
+		for _, arrowType := range roundTripTypes {
+			assert.Equal(t, degrade(dbType, arrowType), roundTrip(dbType, arrowType), "%s %s", dbType, arrowType)
+		}
+	}
+}
+
+func TestTypeRegistryPairwiseRoundTrip(t *testing.T) {
+	for from := range roundTripDegrades {
+		for to := range roundTripDegrades {
+			for _, arrowType := range roundTripTypes {
+				// 按 from 的建表类型转换为 to 的建表类型，再从 to 读回
+				native := ParseNativeType(DialectFor(from).ColumnType(arrowType))
+				converted := ArrowType(to, ParseNativeType(ConvertNativeType(from, to, native)))
+				assert.Equal(t, degrade(to, degrade(from, arrowType)), converted, "%s -> %s %s", from, to, arrowType)
+			}
+		}
+	}
+}
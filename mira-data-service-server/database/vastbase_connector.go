@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"data-service/common"
 	"data-service/config"
 	ds "data-service/generated/datasource"
@@ -21,13 +22,13 @@ import (
 )
 
 var (
-	vastbasePoolMap = make(map[string]*sql.DB)
-	vastbaseMutex   sync.Mutex
+	vastbaseMutex sync.Mutex
 )
 
 type VastbaseStrategy struct {
-	info *ds.ConnectionInfo
-	DB   *sql.DB
+	info  *ds.ConnectionInfo
+	DB    *sql.DB
+	lease *PoolLease
 }
 
 func NewVastbaseStrategy(info *ds.ConnectionInfo) *VastbaseStrategy {
@@ -39,18 +40,14 @@ func (v *VastbaseStrategy) ConnectToDB() error {
 }
 
 func (v *VastbaseStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
-	dbType := utils.GetDbTypeName(info.Dbtype)
-	tlsKey := "ssl-disable"
-	if info.TlsConfig != nil && info.TlsConfig.UseTls == 2 {
-		tlsKey = fmt.Sprintf("sslmode-%d-%s", info.TlsConfig.Mode, info.TlsConfig.ServerName)
-	}
-	key := fmt.Sprintf("%s_%s_%d_%s_%s_%s_%s", dbType, info.Host, info.Port, info.DbName, info.User, info.Password, tlsKey)
+	key := PoolFingerprint("vastbase", info)
 
 	vastbaseMutex.Lock()
 	defer vastbaseMutex.Unlock()
 
-	if db, ok := vastbasePoolMap[key]; ok {
-		v.DB = db
+	if db, lease, ok := GetPoolRegistry().Acquire(key); ok {
+		v.lease.Release()
+		v.DB, v.lease = db, lease
 		log.Logger.Debug("Reusing existing Vastbase connection pool")
 		return nil
 	}
@@ -73,15 +70,15 @@ func (v *VastbaseStrategy) ConnectToDBWithPass(info *ds.ConnectionInfo) error {
 	db.SetConnMaxLifetime(10 * time.Minute) // 连接最大生命周期
 	db.SetConnMaxIdleTime(2 * time.Minute)  // 连接最大空闲时间
 
-	v.DB = db
-	vastbasePoolMap[key] = db
+	v.lease.Release()
+	v.DB, v.lease = GetPoolRegistry().Register(key, "vastbase", db)
 	log.Logger.Info("Successfully connected to Vastbase")
 	return nil
 }
 
-func (v *VastbaseStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+func (v *VastbaseStrategy) Query(ctx context.Context, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
 	log.Logger.Debugf("Vastbase query: %s args=%v", sqlQuery, args)
-	rows, err := v.DB.Query(sqlQuery, args...)
+	rows, err := v.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		log.Logger.Errorf("Vastbase query failed: %v", err)
 		return nil, err
@@ -89,15 +86,10 @@ func (v *VastbaseStrategy) Query(sqlQuery string, args ...interface{}) (*sql.Row
 	return rows, nil
 }
 
+// Close 只释放对共享连接池的引用，连接池由 PoolRegistry 统一管理和回收
 func (v *VastbaseStrategy) Close() error {
-	if v.DB == nil {
-		log.Logger.Warn("Attempted to close nil Vastbase connection")
-		return nil
-	}
-	if err := v.DB.Close(); err != nil {
-		return fmt.Errorf("failed to close Vastbase connection: %v", err)
-	}
-	log.Logger.Info("Vastbase connection closed successfully")
+	v.lease.Release()
+	v.DB, v.lease = nil, nil
 	return nil
 }
 
@@ -106,10 +98,14 @@ func (v *VastbaseStrategy) GetJdbcUrl() string {
 		v.info.Host, v.info.Port, v.info.DbName, v.info.User, v.info.Password)
 }
 
-func (v *VastbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arrow.Record, error) {
+func (v *VastbaseStrategy) RowsToArrowBatch(ctx context.Context, rows *sql.Rows, batchSize int) (arrow.Record, error) {
 	if rows == nil {
 		return nil, fmt.Errorf("no rows to convert")
 	}
+	// ctx 已取消时 rows 已被关闭，直接返回取消原因
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
 	pool := memory.NewGoAllocator()
 	cols, err := rows.Columns()
 	if err != nil {
@@ -122,11 +118,7 @@ func (v *VastbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arro
 
 	var fields []arrow.Field
 	for i, col := range cols {
-		arrowType, err := vastbaseTypeToArrowType(colTypes[i])
-		if err != nil {
-			return nil, err
-		}
-		fields = append(fields, arrow.Field{Name: col, Type: arrowType})
+		fields = append(fields, arrow.Field{Name: col, Type: ColumnArrowType(ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE, colTypes[i])})
 	}
 	schema := arrow.NewSchema(fields, nil)
 	builder := array.NewRecordBuilder(pool, schema)
@@ -153,46 +145,19 @@ func (v *VastbaseStrategy) RowsToArrowBatch(rows *sql.Rows, batchSize int) (arro
 			break
 		}
 	}
+	// 区分读取完毕与读取过程中的取消、网络错误
+	if err := batchReadErr(ctx, rows); err != nil {
+		return nil, err
+	}
 	if rowCount == 0 {
 		return nil, io.EOF
 	}
 	return builder.NewRecord(), nil
 }
 
-func vastbaseTypeToArrowType(colType *sql.ColumnType) (arrow.DataType, error) {
-	dbType := strings.ToUpper(colType.DatabaseTypeName())
-	switch {
-	case strings.Contains(dbType, "INT"):
-		return arrow.PrimitiveTypes.Int64, nil
-	case strings.Contains(dbType, "FLOAT") || strings.Contains(dbType, "DOUBLE"):
-		return arrow.PrimitiveTypes.Float64, nil
-	case strings.Contains(dbType, "NUMERIC") || strings.Contains(dbType, "DECIMAL"):
-		precision, scale, ok := colType.DecimalSize()
-		if !ok {
-			return nil, fmt.Errorf("unable to read precision/scale for %s", colType.Name())
-		}
-		return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}, nil
-	case strings.Contains(dbType, "CHAR") || strings.Contains(dbType, "TEXT"):
-		// 使用 LargeString 替代 String，支持超过 2GB 的数据
-		return arrow.BinaryTypes.LargeString, nil
-	case strings.Contains(dbType, "BYTEA"):
-		// 使用 LargeBinary 替代 Binary，支持超过 2GB 的数据
-		return arrow.BinaryTypes.LargeBinary, nil
-	case strings.Contains(dbType, "TIMESTAMP"):
-		// 使用 LargeString 替代 String
-		return arrow.BinaryTypes.LargeString, nil
-	case strings.Contains(dbType, "DATE"):
-		// 使用 LargeString 替代 String
-		return arrow.BinaryTypes.LargeString, nil
-	default:
-		// 使用 LargeString 替代 String
-		return arrow.BinaryTypes.LargeString, nil
-	}
-}
-
-func (v *VastbaseStrategy) CreateTemporaryTableIfNotExists(tableName string, schema *arrow.Schema) error {
+func (v *VastbaseStrategy) CreateTemporaryTableIfNotExists(ctx context.Context, tableName string, schema *arrow.Schema) error {
 	var exists bool
-	err := v.DB.QueryRow(`SELECT EXISTS(
+	err := v.DB.QueryRowContext(ctx, `SELECT EXISTS(
 		SELECT 1 FROM information_schema.tables 
 		WHERE table_schema = current_schema() AND table_name = $1)`, tableName).Scan(&exists)
 	if err != nil {
@@ -202,58 +167,22 @@ func (v *VastbaseStrategy) CreateTemporaryTableIfNotExists(tableName string, sch
 		return nil
 	}
 
-	var columns []string
-	for _, field := range schema.Fields() {
-		columns = append(columns, fmt.Sprintf("\"%s\" %s", field.Name, convertArrowTypeToVastbase(field.Type)))
-	}
-	createSQL := fmt.Sprintf("CREATE TABLE \"%s\" (%s)", tableName, strings.Join(columns, ", "))
-	_, err = v.DB.Exec(createSQL)
+	createSQL := buildCreateTableSQL(vastbaseDialect, tableName, schema, false)
+	_, err = v.DB.ExecContext(ctx, createSQL)
 	if err != nil {
 		return fmt.Errorf("failed to create Vastbase temp table: %v", err)
 	}
 	return nil
 }
 
-func convertArrowTypeToVastbase(t arrow.DataType) string {
-	switch t.ID() {
-	case arrow.INT8, arrow.INT16:
-		return "SMALLINT"
-	case arrow.INT32:
-		return "INTEGER"
-	case arrow.INT64:
-		return "BIGINT"
-	case arrow.FLOAT32:
-		return "REAL"
-	case arrow.FLOAT64:
-		return "DOUBLE PRECISION"
-	case arrow.BOOL:
-		return "BOOLEAN"
-	case arrow.STRING:
-		return "VARCHAR(255)"
-	case arrow.LARGE_STRING:
-		return "TEXT"
-	case arrow.DECIMAL128, arrow.DECIMAL256:
-		d := t.(*arrow.Decimal128Type)
-		return fmt.Sprintf("NUMERIC(%d,%d)", d.Precision, d.Scale)
-	case arrow.BINARY:
-		return "BYTEA"
-	case arrow.TIMESTAMP:
-		return "TIMESTAMP"
-	case arrow.DATE32:
-		return "DATE"
-	default:
-		return "TEXT"
-	}
-}
-
-func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
+func (v *VastbaseStrategy) GetTableInfo(ctx context.Context, database, tableName string, isExactQuery bool) (*ds.TableInfoResponse, error) {
 	// 定义 SQL 查询
 	var sqlQuery string
 
 	if isExactQuery {
 		// 精确查询时，只查询记录数
 		// 参考 kingbase 实现，直接使用表名（表在默认的 public schema 中）
-		sqlQuery = fmt.Sprintf("SELECT COUNT(*) FROM %s", pqQuoteIdentifier(tableName))
+		sqlQuery = fmt.Sprintf("SELECT COUNT(*) FROM %s", vastbaseDialect.QuoteTableName(tableName))
 	} else {
 		// 普通查询，获取表的相关信息（包括表大小）
 		// 使用参数化查询来防止 SQL 注入
@@ -269,7 +198,7 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 
 	if isExactQuery {
 		// 如果是精确查询，只获取记录数
-		if err := v.DB.QueryRow(sqlQuery).Scan(&rowCount); err != nil {
+		if err := v.DB.QueryRowContext(ctx, sqlQuery).Scan(&rowCount); err != nil {
 			log.Logger.Errorf("Error executing query: %v", err)
 			return nil, fmt.Errorf("error executing query: %v", err)
 		}
@@ -283,7 +212,7 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping pg_stat_user_tables query")
 			// 使用临时 TableInfoResponse 结构来调用通用函数
 			var tempResult TableInfoResponse
-			FillTableInfoFromEstimation(v, database, tableName, "public", &tempResult)
+			FillTableInfoFromEstimation(ctx, v, database, tableName, "public", &tempResult)
 			// 将结果转换到 Vastbase 的变量
 			tableSchema = tempResult.TableSchema
 			resultTableName = tempResult.TableName
@@ -291,13 +220,13 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 			recordSize = tempResult.TableSize
 		} else {
 			// 普通查询，先尝试从 pg_stat_user_tables 获取
-			err := v.DB.QueryRow(sqlQuery, tableName, "public").Scan(&tableSchema, &resultTableName, &rowCount, &recordSize)
+			err := v.DB.QueryRowContext(ctx, sqlQuery, tableName, "public").Scan(&tableSchema, &resultTableName, &rowCount, &recordSize)
 			if err != nil || resultTableName == "" || rowCount == 0 {
 				// 查询失败或返回空结果，使用估算方式
 				LogQueryFailure("pg_stat_user_tables", err, resultTableName, int32(rowCount))
 				// 使用临时 TableInfoResponse 结构来调用通用函数
 				var tempResult TableInfoResponse
-				FillTableInfoFromEstimation(v, database, tableName, "public", &tempResult)
+				FillTableInfoFromEstimation(ctx, v, database, tableName, "public", &tempResult)
 				// 将结果转换到 Vastbase 的变量
 				tableSchema = tempResult.TableSchema
 				resultTableName = tempResult.TableName
@@ -308,7 +237,7 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 	}
 
 	// 获取表结构信息
-	columns, err := v.getTableSchema("public", tableName)
+	columns, keys, err := v.DescribeTable(ctx, database, tableName)
 	if err != nil {
 		log.Logger.Warnf("Failed to load Vastbase schema: %v", err)
 	}
@@ -320,30 +249,13 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 		RecordCount: int32(rowCount),
 		RecordSize:  recordSize,
 		Columns:     columns,
+		Keys:        keys,
 	}, nil
 }
 
-func (v *VastbaseStrategy) getTableSchema(schema, table string) ([]*ds.ColumnItem, error) {
-	query := `
-		SELECT column_name, data_type
-		FROM information_schema.columns
-		WHERE table_schema = $1 AND table_name = $2
-		ORDER BY ordinal_position`
-	rows, err := v.DB.Query(query, "public", table)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var columns []*ds.ColumnItem
-	for rows.Next() {
-		var col ds.ColumnItem
-		if err := rows.Scan(&col.Name, &col.DataType); err != nil {
-			return nil, err
-		}
-		columns = append(columns, &col)
-	}
-	return columns, nil
+// DescribeTable 获取字段元数据与表键信息，表位于 public 模式下
+func (v *VastbaseStrategy) DescribeTable(ctx context.Context, _ string, tableName string) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	return describePostgresTable(ctx, v.DB, "public", tableName)
 }
 
 func (v *VastbaseStrategy) BuildWithConditionQuery(
@@ -353,119 +265,18 @@ func (v *VastbaseStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	filterExpr *ds.FilterExpression,
 ) (string, []interface{}, error) {
-	quoteIdentifier := func(identifier string) string {
-		return fmt.Sprintf("\"%s\"", identifier)
-	}
-
-	var queryBuilder strings.Builder
-	queryTools := &QueryBuilder{}
-	args := make([]interface{}, 0)
-	paramIndex := 1
-
-	// 构建 SELECT 子句
-	queryBuilder.WriteString("SELECT ")
-	if len(fields) > 0 {
-		// 先去掉反引号，再加上双引号
-		quotedFields := make([]string, len(fields))
-		for i, f := range fields {
-			trimmed := strings.TrimSpace(f)
-			// 去除两端反引号
-			trimmed = strings.Trim(trimmed, "`")
-			// 如果已经有双引号则不重复加
-			if strings.HasPrefix(trimmed, "\"") && strings.HasSuffix(trimmed, "\"") {
-				quotedFields[i] = trimmed
-			} else {
-				quotedFields[i] = fmt.Sprintf("\"%s\"", trimmed)
-			}
-		}
-		queryBuilder.WriteString(strings.Join(quotedFields, ", "))
-	} else {
-		queryBuilder.WriteString("*")
-	}
-	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(quoteIdentifier(tableName))
-
-	// 构建 WHERE 子句
-	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
-		queryBuilder.WriteString(" WHERE ")
-
-		conditions := make([]string, len(filterNames))
-		for i := range filterNames {
-			operator := filterOperators[i]
-			filterName := filterNames[i]
-			filterValue := filterValues[i]
-
-			// 使用 ExtractQueryArgs 提取参数
-			argsFromFilter := queryTools.ExtractQueryArgs([]*ds.FilterValue{filterValue})
-
-			// 根据运算符类型处理不同的条件
-			switch operator {
-			case ds.FilterOperator_IN_OPERATOR:
-				if len(argsFromFilter) > 0 {
-					// filterName字段加上普通索引，加速查询
-					err := AddIndexToFilterName(v.DB, pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, v.info.DbName, tableName, filterName)
-					if err != nil {
-						return "", nil, err
-					}
-					inPlaceholders := make([]string, len(argsFromFilter))
-					for j := range argsFromFilter {
-						inPlaceholders[j] = fmt.Sprintf("$%d", paramIndex)
-						args = append(args, argsFromFilter[j])
-						paramIndex++
-					}
-					conditions[i] = fmt.Sprintf("%s IN (%s)", filterName, strings.Join(inPlaceholders, ", "))
-				}
-
-			case ds.FilterOperator_GREATER_THAN, ds.FilterOperator_LESS_THAN,
-				ds.FilterOperator_GREATER_THAN_OR_EQUAL, ds.FilterOperator_LESS_THAN_OR_EQUAL,
-				ds.FilterOperator_NOT_EQUAL:
-				if len(argsFromFilter) > 0 {
-					conditions[i] = fmt.Sprintf("%s %s $%d", filterName, queryTools.OperatorToString(operator), paramIndex)
-					args = append(args, argsFromFilter[0]) // 提取第一个参数
-					paramIndex++
-				}
-
-			case ds.FilterOperator_LIKE_OPERATOR:
-				if len(argsFromFilter) > 0 {
-					conditions[i] = fmt.Sprintf("%s LIKE $%d", filterName, paramIndex)
-					args = append(args, argsFromFilter[0]) // 提取第一个参数
-					paramIndex++
-				}
-
-			default:
-				if len(argsFromFilter) > 0 {
-					conditions[i] = fmt.Sprintf("%s = $%d", filterName, paramIndex)
-					args = append(args, argsFromFilter[0]) // 提取第一个参数
-					paramIndex++
-				}
-			}
-		}
-
-		queryBuilder.WriteString(strings.Join(conditions, " AND "))
-	}
-
-	// 构建 ORDER BY 子句
-	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
-		orders := make([]string, len(sortRules))
-		for i, rule := range sortRules {
-			order := "ASC"
-			if rule.SortOrder == ds.SortOrder_DESC {
-				order = "DESC"
-			}
-			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
-		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
+	// IN/NOT IN 过滤字段加上普通索引，加速查询
+	addIndex := func(fieldName string) error {
+		return AddIndexToFilterName(v.DB, pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, v.info.DbName, tableName, fieldName)
 	}
-
-	return queryBuilder.String(), args, nil
+	return buildConditionQuery(vastbaseDialect, tableName, fields, filterNames, filterOperators, filterValues, sortRules, filterExpr, addIndex)
 }
 
-func (v *VastbaseStrategy) EnsureDatabaseExists(dbName string) error {
-	createSQL := fmt.Sprintf("CREATE DATABASE \"%s\"", dbName)
-	if _, err := v.DB.Exec(createSQL); err != nil {
+func (v *VastbaseStrategy) EnsureDatabaseExists(ctx context.Context, dbName string) error {
+	createSQL := fmt.Sprintf("CREATE DATABASE %s", vastbaseDialect.QuoteIdentifier(dbName))
+	if _, err := v.DB.ExecContext(ctx, createSQL); err != nil {
 		if strings.Contains(err.Error(), "already exists") {
 			return nil
 		}
@@ -474,12 +285,12 @@ func (v *VastbaseStrategy) EnsureDatabaseExists(dbName string) error {
 	return nil
 }
 
-func (v *VastbaseStrategy) CleanupOldTables(schema string, retentionDays int) error {
+func (v *VastbaseStrategy) CleanupOldTables(ctx context.Context, schema string, retentionDays int) error {
 	query := `
 		SELECT table_name
 		FROM information_schema.tables
 		WHERE table_schema = $1 AND table_name LIKE '20%'`
-	rows, err := v.DB.Query(query, schema)
+	rows, err := v.DB.QueryContext(ctx, query, schema)
 	if err != nil {
 		return err
 	}
@@ -492,8 +303,8 @@ func (v *VastbaseStrategy) CleanupOldTables(schema string, retentionDays int) er
 			return err
 		}
 		if len(table) >= 8 && table[:8] < cutoff {
-			dropSQL := fmt.Sprintf("DROP TABLE IF EXISTS \"%s\".\"%s\"", schema, table)
-			if _, err := v.DB.Exec(dropSQL); err != nil {
+			dropSQL := fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", vastbaseDialect.QuoteIdentifier(schema), vastbaseDialect.QuoteIdentifier(table))
+			if _, err := v.DB.ExecContext(ctx, dropSQL); err != nil {
 				log.Logger.Warnf("Failed to drop Vastbase table %s.%s: %v", schema, table, err)
 			}
 		}
@@ -501,13 +312,13 @@ func (v *VastbaseStrategy) CleanupOldTables(schema string, retentionDays int) er
 	return nil
 }
 
-func (v *VastbaseStrategy) GetGroupCountInfo(tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue) (*ds.GroupCountResponse, error) {
+func (v *VastbaseStrategy) GetGroupCountInfo(ctx context.Context, tableName string, groupBy []string, filterNames []string, filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, filterExpr *ds.FilterExpression) (*ds.GroupCountResponse, error) {
 	queryTools := &QueryBuilder{}
-	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators, filterValues, ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE)
+	query, args, err := queryTools.BuildGroupCountQuery(tableName, groupBy, filterNames, filterOperators, filterValues, filterExpr, ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE)
 	if err != nil {
 		return nil, err
 	}
-	rows, err := v.Query(query, args...)
+	rows, err := v.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -515,19 +326,15 @@ func (v *VastbaseStrategy) GetGroupCountInfo(tableName string, groupBy []string,
 	return ProcessGroupCountResults(rows, tableName)
 }
 
-func (v *VastbaseStrategy) CheckTableExists(tableName string) (bool, error) {
+func (v *VastbaseStrategy) CheckTableExists(ctx context.Context, tableName string) (bool, error) {
 	var exists bool
-	err := v.DB.QueryRow(`
+	err := v.DB.QueryRowContext(ctx, `
 		SELECT EXISTS(
 			SELECT 1 FROM information_schema.tables 
 			WHERE table_schema = current_schema() AND table_name = $1)`, tableName).Scan(&exists)
 	return exists, err
 }
 
-func pqQuoteIdentifier(id string) string {
-	return "\"" + strings.ReplaceAll(id, "\"", "\"\"") + "\""
-}
-
 // buildVastbaseTLSDSN 构建支持三种 TLS 模式的 DSN
 func buildVastbaseTLSDSN(info *ds.ConnectionInfo, tlsConfig *ds.DatasourceTlsConfig) (string, error) {
 	// 基础 DSN
@@ -585,20 +392,20 @@ func buildVastbaseTLSDSN(info *ds.ConnectionInfo, tlsConfig *ds.DatasourceTlsCon
 }
 
 // getTableRowCount 获取表的总行数
-func (v *VastbaseStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", pqQuoteIdentifier(tableName))
+func (v *VastbaseStrategy) GetTableRowCount(ctx context.Context, database string, tableName string) (int32, error) {
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", vastbaseDialect.QuoteTableName(tableName))
 	var rowCount int32
-	if err := v.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
+	if err := v.DB.QueryRowContext(ctx, countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
 	return rowCount, nil
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (v *VastbaseStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (v *VastbaseStrategy) EstimateTableSize(ctx context.Context, database string, tableName string, totalRows int32) (int64, error) {
 	// 获取100条数据估算平均行大小
-	sampleQuery := fmt.Sprintf("SELECT * FROM %s LIMIT 100", pqQuoteIdentifier(tableName))
-	rows, err := v.DB.Query(sampleQuery)
+	sampleQuery := vastbaseDialect.LimitOffset(fmt.Sprintf("SELECT * FROM %s", vastbaseDialect.QuoteTableName(tableName)), 100, 0)
+	rows, err := v.DB.QueryContext(ctx, sampleQuery)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get sample data for size estimation: %v", err)
 	}
@@ -642,10 +449,10 @@ func (v *VastbaseStrategy) EstimateTableSize(database string, tableName string,
 	return 0, fmt.Errorf("no sample data available for size estimation")
 }
 
-func (v *VastbaseStrategy) getTableRowCount(tableName string) (int32, error) {
-	return v.GetTableRowCount("", tableName)
+func (v *VastbaseStrategy) getTableRowCount(ctx context.Context, tableName string) (int32, error) {
+	return v.GetTableRowCount(ctx, "", tableName)
 }
 
-func (v *VastbaseStrategy) estimateTableSize(tableName string, totalRows int32) (int64, error) {
-	return v.EstimateTableSize("", tableName, totalRows)
+func (v *VastbaseStrategy) estimateTableSize(ctx context.Context, tableName string, totalRows int32) (int64, error) {
+	return v.EstimateTableSize(ctx, "", tableName, totalRows)
 }
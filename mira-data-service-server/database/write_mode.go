@@ -0,0 +1,320 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/6/12
+	@note: 写入模式（追加、覆盖、按键更新、按键忽略）的 SQL 生成
+
+*
+*/
+package database
+
+import (
+	"context"
+	"crypto/md5"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// WriteOptions 写入模式及其键列
+type WriteOptions struct {
+	Mode       pb.WriteMode
+	KeyColumns []string
+}
+
+// NewWriteOptions 由请求中的写入模式和键列构建写入选项
+func NewWriteOptions(mode pb.WriteMode, keyColumns []string) WriteOptions {
+	return WriteOptions{Mode: mode, KeyColumns: keyColumns}
+}
+
+// needsKey 更新和忽略模式按键列判断行是否已存在
+func (o WriteOptions) needsKey() bool {
+	return o.Mode == pb.WriteMode_WRITE_MODE_UPSERT || o.Mode == pb.WriteMode_WRITE_MODE_INSERT_IGNORE
+}
+
+// Validate 校验写入模式在目标库上是否可用，以及键列是否都在写入列中
+func (o WriteOptions) Validate(dbType pb.DataSourceType, columns []string) error {
+	if _, ok := pb.WriteMode_name[int32(o.Mode)]; !ok {
+		return fmt.Errorf("unsupported write mode: %v", o.Mode)
+	}
+	if !o.needsKey() {
+		return nil
+	}
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_HIVE:
+		return fmt.Errorf("write mode %s is not supported for hive", o.Mode)
+	case pb.DataSourceType_DATA_SOURCE_TYPE_DORIS:
+		// Doris 依赖 Unique Key 模型按键覆盖，没有跳过已存在行的写法
+		if o.Mode == pb.WriteMode_WRITE_MODE_INSERT_IGNORE {
+			return fmt.Errorf("write mode %s is not supported for doris", o.Mode)
+		}
+	}
+	if len(o.KeyColumns) == 0 {
+		return fmt.Errorf("write mode %s requires key columns", o.Mode)
+	}
+	existing := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		existing[column] = true
+	}
+	for _, key := range o.KeyColumns {
+		if !existing[key] {
+			return fmt.Errorf("key column %s not found in written columns", key)
+		}
+	}
+	return nil
+}
+
+// BuildWriteSQL 按写入模式生成 rowCount 行数据的写入语句，占位符按行优先顺序排列
+//
+// 追加和覆盖模式生成普通 INSERT，覆盖模式的清表由调用方通过 Dialect.ClearTableSQL 在写入前执行。
+// 更新和忽略模式按数据库生成:
+//   - MySQL/TiDB/TDSQL: ON DUPLICATE KEY UPDATE / INSERT IGNORE
+//   - Kingbase/Vastbase/PostgreSQL/openGauss: ON CONFLICT (键列) DO UPDATE / DO NOTHING
+//   - GBase/达梦: MERGE INTO
+//   - Doris: 普通 INSERT，由 Unique Key 模型按键覆盖旧行
+func BuildWriteSQL(dbType pb.DataSourceType, tableName string, columns []string, rowCount int, opts WriteOptions) (string, error) {
+	if len(columns) == 0 || rowCount <= 0 {
+		return "", fmt.Errorf("no data to write into %s", tableName)
+	}
+	if err := opts.Validate(dbType, columns); err != nil {
+		return "", err
+	}
+	dialect := DialectFor(dbType)
+	rows := placeholderRows(dialect, len(columns), rowCount)
+
+	if opts.needsKey() {
+		switch dbType {
+		case pb.DataSourceType_DATA_SOURCE_TYPE_GBASE, pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG:
+			return buildMergeSQL(dialect, dbType, tableName, columns, rows, opts), nil
+		}
+	}
+
+	quotedTableName := dialect.QuoteTableName(tableName)
+	columnsStr := quoteColumnList(dialect, columns)
+	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG {
+		// Oracle 方言不支持多行 VALUES，使用达梦与 Oracle 均支持的 INSERT ALL 一次写入多行
+		intoClauses := make([]string, rowCount)
+		for i, row := range rows {
+			intoClauses[i] = fmt.Sprintf("INTO %s (%s) VALUES (%s)", quotedTableName, columnsStr, row)
+		}
+		return fmt.Sprintf("INSERT ALL %s SELECT 1 FROM DUAL", strings.Join(intoClauses, " ")), nil
+	}
+
+	insert := "INSERT INTO "
+	if opts.Mode == pb.WriteMode_WRITE_MODE_INSERT_IGNORE && usesDuplicateKeyClause(dbType) {
+		insert = "INSERT IGNORE INTO "
+	}
+	query := fmt.Sprintf("%s%s (%s) VALUES (%s)", insert, quotedTableName, columnsStr, strings.Join(rows, "), ("))
+	if !opts.needsKey() {
+		return query, nil
+	}
+
+	updateColumns := nonKeyColumns(columns, opts.KeyColumns)
+	switch {
+	case usesDuplicateKeyClause(dbType):
+		if opts.Mode == pb.WriteMode_WRITE_MODE_UPSERT {
+			assignments := make([]string, 0, len(updateColumns))
+			for _, column := range updateColumns {
+				quoted := dialect.QuoteIdentifier(column)
+				assignments = append(assignments, fmt.Sprintf("%s = VALUES(%s)", quoted, quoted))
+			}
+			if len(assignments) == 0 {
+				// 所有列都是键列时没有可更新的列，用键列自赋值保持原行不变
+				quoted := dialect.QuoteIdentifier(opts.KeyColumns[0])
+				assignments = append(assignments, fmt.Sprintf("%s = %s", quoted, quoted))
+			}
+			query += " ON DUPLICATE KEY UPDATE " + strings.Join(assignments, ", ")
+		}
+	case usesOnConflictClause(dbType):
+		conflict := fmt.Sprintf(" ON CONFLICT (%s)", quoteColumnList(dialect, opts.KeyColumns))
+		if opts.Mode == pb.WriteMode_WRITE_MODE_INSERT_IGNORE || len(updateColumns) == 0 {
+			query += conflict + " DO NOTHING"
+		} else {
+			assignments := make([]string, len(updateColumns))
+			for i, column := range updateColumns {
+				quoted := dialect.QuoteIdentifier(column)
+				assignments[i] = fmt.Sprintf("%s = EXCLUDED.%s", quoted, quoted)
+			}
+			query += conflict + " DO UPDATE SET " + strings.Join(assignments, ", ")
+		}
+	}
+	return query, nil
+}
+
+// buildMergeSQL 以多行 SELECT 作为源表生成 MERGE INTO，忽略模式只保留 WHEN NOT MATCHED 分支
+func buildMergeSQL(dialect Dialect, dbType pb.DataSourceType, tableName string, columns []string, rows []string, opts WriteOptions) string {
+	fromDual := ""
+	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG {
+		fromDual = " FROM DUAL"
+	}
+	selects := make([]string, len(rows))
+	for i, row := range rows {
+		placeholders := strings.Split(row, ", ")
+		if i == 0 {
+			// 首行带上列别名，后续行按位置对应
+			for j, column := range columns {
+				placeholders[j] = fmt.Sprintf("%s AS %s", placeholders[j], dialect.QuoteIdentifier(column))
+			}
+		}
+		selects[i] = "SELECT " + strings.Join(placeholders, ", ") + fromDual
+	}
+
+	onConditions := make([]string, len(opts.KeyColumns))
+	for i, key := range opts.KeyColumns {
+		quoted := dialect.QuoteIdentifier(key)
+		onConditions[i] = fmt.Sprintf("t.%s = s.%s", quoted, quoted)
+	}
+	sourceColumns := make([]string, len(columns))
+	for i, column := range columns {
+		sourceColumns[i] = "s." + dialect.QuoteIdentifier(column)
+	}
+
+	query := fmt.Sprintf("MERGE INTO %s t USING (%s) s ON (%s)", dialect.QuoteTableName(tableName),
+		strings.Join(selects, " UNION ALL "), strings.Join(onConditions, " AND "))
+	updateColumns := nonKeyColumns(columns, opts.KeyColumns)
+	if opts.Mode == pb.WriteMode_WRITE_MODE_UPSERT && len(updateColumns) > 0 {
+		assignments := make([]string, len(updateColumns))
+		for i, column := range updateColumns {
+			quoted := dialect.QuoteIdentifier(column)
+			assignments[i] = fmt.Sprintf("t.%s = s.%s", quoted, quoted)
+		}
+		query += " WHEN MATCHED THEN UPDATE SET " + strings.Join(assignments, ", ")
+	}
+	query += fmt.Sprintf(" WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)", quoteColumnList(dialect, columns),
+		strings.Join(sourceColumns, ", "))
+	return query
+}
+
+// placeholderRows 生成每行的占位符列表，占位符序号在所有行之间连续递增
+func placeholderRows(dialect Dialect, numCols, rowCount int) []string {
+	rows := make([]string, rowCount)
+	for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
+		singleRowPlaceholders := make([]string, numCols)
+		for colIdx := 0; colIdx < numCols; colIdx++ {
+			singleRowPlaceholders[colIdx] = dialect.Placeholder(rowIdx*numCols + colIdx + 1)
+		}
+		rows[rowIdx] = strings.Join(singleRowPlaceholders, ", ")
+	}
+	return rows
+}
+
+func nonKeyColumns(columns []string, keyColumns []string) []string {
+	keys := make(map[string]bool, len(keyColumns))
+	for _, key := range keyColumns {
+		keys[key] = true
+	}
+	var result []string
+	for _, column := range columns {
+		if !keys[column] {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+func usesDuplicateKeyClause(dbType pb.DataSourceType) bool {
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		pb.DataSourceType_DATA_SOURCE_TYPE_TIDB,
+		pb.DataSourceType_DATA_SOURCE_TYPE_TDSQL:
+		return true
+	}
+	return false
+}
+
+func usesOnConflictClause(dbType pb.DataSourceType) bool {
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL,
+		pb.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		return true
+	}
+	return false
+}
+
+// uniqueKeyIndexName 根据表名和键列生成固定的唯一索引名，重复写入时不会重复建索引
+func uniqueKeyIndexName(tableName string, keyColumns []string) string {
+	sum := md5.Sum([]byte(tableName + "|" + strings.Join(keyColumns, ",")))
+	return "uk_" + hex.EncodeToString(sum[:])[:10]
+}
+
+// EnsureWriteKey 为更新和忽略模式在键列上建立唯一索引
+//
+// ON DUPLICATE KEY 和 ON CONFLICT 都依赖键列上的唯一约束才能识别已存在的行；
+// MERGE 按 ON 条件匹配，无需额外建索引；Doris 依赖建表时的 Unique Key，无法补建，只校验键列与之一致。
+func EnsureWriteKey(ctx context.Context, db *sql.DB, dbType pb.DataSourceType, tableName string, opts WriteOptions) error {
+	if !opts.needsKey() {
+		return nil
+	}
+	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_DORIS {
+		return checkDorisUniqueKey(ctx, db, tableName, opts.KeyColumns)
+	}
+	dialect := DialectFor(dbType)
+	indexName := uniqueKeyIndexName(tableName, opts.KeyColumns)
+	var statement string
+	switch {
+	case usesDuplicateKeyClause(dbType):
+		statement = fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s)", dialect.QuoteIdentifier(indexName),
+			dialect.QuoteTableName(tableName), quoteColumnList(dialect, opts.KeyColumns))
+	case usesOnConflictClause(dbType):
+		statement = fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (%s)", dialect.QuoteIdentifier(indexName),
+			dialect.QuoteTableName(tableName), quoteColumnList(dialect, opts.KeyColumns))
+	default:
+		return nil
+	}
+
+	log.Logger.Infof("Ensuring unique key for write mode %s: %s", opts.Mode, statement)
+	if _, err := db.ExecContext(ctx, statement); err != nil {
+		// MySQL 不支持 IF NOT EXISTS，索引已存在时忽略重名错误
+		if strings.Contains(err.Error(), "Duplicate key name") {
+			return nil
+		}
+		return fmt.Errorf("failed to create unique key %s on %s: %v", indexName, tableName, err)
+	}
+	return nil
+}
+
+// checkDorisUniqueKey 校验 Doris 表是 Unique Key 模型且唯一键列与写入的键列相同
+// 其他模型或唯一键不同（如自增主键）的表上写入会追加重复行而不是覆盖旧行，直接拒绝。
+func checkDorisUniqueKey(ctx context.Context, db *sql.DB, tableName string, keyColumns []string) error {
+	schemaName, table := splitQualifiedTableName(tableName)
+	rows, err := db.QueryContext(ctx, `SELECT COLUMN_NAME, COLUMN_KEY FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ? AND COLUMN_KEY <> ''
+		ORDER BY ORDINAL_POSITION`, schemaName, table)
+	if err != nil {
+		return fmt.Errorf("failed to query unique key of %s: %v", tableName, err)
+	}
+	defer rows.Close()
+	var uniqueKey []string
+	for rows.Next() {
+		var column, key string
+		if err := rows.Scan(&column, &key); err != nil {
+			return err
+		}
+		if key != "UNI" {
+			return fmt.Errorf("table %s is not a unique key table, rows cannot be upserted by %v", tableName, keyColumns)
+		}
+		uniqueKey = append(uniqueKey, column)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	requested := make(map[string]bool, len(keyColumns))
+	for _, key := range keyColumns {
+		requested[key] = true
+	}
+	matched := len(uniqueKey) == len(requested)
+	for _, column := range uniqueKey {
+		matched = matched && requested[column]
+	}
+	if !matched {
+		return fmt.Errorf("unique key %v of table %s does not match key columns %v", uniqueKey, tableName, keyColumns)
+	}
+	return nil
+}
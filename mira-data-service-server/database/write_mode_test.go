@@ -0,0 +1,177 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	ds "data-service/generated/datasource"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWriteSQL(t *testing.T) {
+	columns := []string{"id", "name"}
+	upsert := WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT, KeyColumns: []string{"id"}}
+	ignore := WriteOptions{Mode: ds.WriteMode_WRITE_MODE_INSERT_IGNORE, KeyColumns: []string{"id"}}
+
+	tests := []struct {
+		name   string
+		dbType ds.DataSourceType
+		opts   WriteOptions
+		want   string
+	}{
+		{"mysql append", ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, WriteOptions{},
+			"INSERT INTO `t` (`id`, `name`) VALUES (?, ?), (?, ?)"},
+		{"overwrite renders plain insert", ds.DataSourceType_DATA_SOURCE_TYPE_TIDB, WriteOptions{Mode: ds.WriteMode_WRITE_MODE_OVERWRITE},
+			"INSERT INTO `t` (`id`, `name`) VALUES (?, ?), (?, ?)"},
+		{"mysql upsert", ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, upsert,
+			"INSERT INTO `t` (`id`, `name`) VALUES (?, ?), (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)"},
+		{"tdsql ignore", ds.DataSourceType_DATA_SOURCE_TYPE_TDSQL, ignore,
+			"INSERT IGNORE INTO `t` (`id`, `name`) VALUES (?, ?), (?, ?)"},
+		{"kingbase upsert", ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, upsert,
+			`INSERT INTO "t" ("id", "name") VALUES ($1, $2), ($3, $4) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name"`},
+		{"vastbase ignore", ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE, ignore,
+			`INSERT INTO "t" ("id", "name") VALUES ($1, $2), ($3, $4) ON CONFLICT ("id") DO NOTHING`},
+		{"gbase upsert", ds.DataSourceType_DATA_SOURCE_TYPE_GBASE, upsert,
+			"MERGE INTO `t` t USING (SELECT ? AS `id`, ? AS `name` UNION ALL SELECT ?, ?) s ON (t.`id` = s.`id`)" +
+				" WHEN MATCHED THEN UPDATE SET t.`name` = s.`name`" +
+				" WHEN NOT MATCHED THEN INSERT (`id`, `name`) VALUES (s.`id`, s.`name`)"},
+		{"dameng ignore", ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG, ignore,
+			`MERGE INTO "t" t USING (SELECT ? AS "id", ? AS "name" FROM DUAL UNION ALL SELECT ?, ? FROM DUAL) s ON (t."id" = s."id")` +
+				` WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES (s."id", s."name")`},
+		{"doris upsert relies on unique key table", ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, upsert,
+			"INSERT INTO `t` (`id`, `name`) VALUES (?, ?), (?, ?)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildWriteSQL(tt.dbType, "t", columns, 2, tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildWriteSQLAllKeyColumns(t *testing.T) {
+	opts := WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT, KeyColumns: []string{"id"}}
+
+	got, err := BuildWriteSQL(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "t", []string{"id"}, 1, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `t` (`id`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = `id`", got)
+
+	got, err = BuildWriteSQL(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, "t", []string{"id"}, 1, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "t" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING`, got)
+}
+
+func TestWriteOptionsValidate(t *testing.T) {
+	columns := []string{"id", "name"}
+	assert.NoError(t, WriteOptions{}.Validate(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE, columns))
+
+	err := WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT}.Validate(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, columns)
+	assert.ErrorContains(t, err, "requires key columns")
+
+	err = WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT, KeyColumns: []string{"missing"}}.Validate(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, columns)
+	assert.ErrorContains(t, err, "key column missing not found")
+
+	err = WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT, KeyColumns: []string{"id"}}.Validate(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE, columns)
+	assert.ErrorContains(t, err, "not supported for hive")
+
+	err = WriteOptions{Mode: ds.WriteMode_WRITE_MODE_INSERT_IGNORE, KeyColumns: []string{"id"}}.Validate(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, columns)
+	assert.ErrorContains(t, err, "not supported for doris")
+
+	err = WriteOptions{Mode: ds.WriteMode(42)}.Validate(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, columns)
+	assert.ErrorContains(t, err, "unsupported write mode")
+}
+
+func TestEnsureWriteKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	opts := WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT, KeyColumns: []string{"id"}}
+	indexName := uniqueKeyIndexName("t", opts.KeyColumns)
+
+	// MySQL 索引已存在时忽略重名错误
+	mock.ExpectExec("CREATE UNIQUE INDEX `" + indexName + "` ON `t` \\(`id`\\)").
+		WillReturnError(errors.New("Error 1061: Duplicate key name '" + indexName + "'"))
+	assert.NoError(t, EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "t", opts))
+
+	mock.ExpectExec(`CREATE UNIQUE INDEX IF NOT EXISTS "` + indexName + `" ON "t" \("id"\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.NoError(t, EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, "t", opts))
+
+	// 追加模式与 MERGE 方言不需要建索引
+	assert.NoError(t, EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "t", WriteOptions{}))
+	assert.NoError(t, EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_GBASE, "t", opts))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPerformBatchInsertOverwriteClearsTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `t`").WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("INSERT INTO `t`").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	schema, reader := newInt64Reader(t, "id", []int64{1, 2})
+	defer reader.Release()
+	err = performBatchInsert(context.Background(), db, "t", schema, reader, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+		WriteOptions{Mode: ds.WriteMode_WRITE_MODE_OVERWRITE})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// newInt64Reader 将单列 int64 数据编码为 Arrow IPC 流并返回其读取器
+func newInt64Reader(t *testing.T, column string, values []int64) (*arrow.Schema, *ipc.Reader) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: column, Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).AppendValues(values, nil)
+	record := builder.NewRecord()
+	defer record.Release()
+
+	var buf bytes.Buffer
+	writer := ipc.NewWriter(&buf, ipc.WithSchema(schema))
+	assert.NoError(t, writer.Write(record))
+	assert.NoError(t, writer.Close())
+
+	reader, err := ipc.NewReader(&buf)
+	assert.NoError(t, err)
+	return schema, reader
+}
+
+func TestEnsureWriteKeyDorisUniqueKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	opts := WriteOptions{Mode: ds.WriteMode_WRITE_MODE_UPSERT, KeyColumns: []string{"order_id"}}
+	keyQuery := "SELECT COLUMN_NAME, COLUMN_KEY FROM INFORMATION_SCHEMA.COLUMNS"
+
+	// 唯一键与键列一致
+	mock.ExpectQuery(keyQuery).WithArgs("", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "COLUMN_KEY"}).AddRow("order_id", "UNI"))
+	assert.NoError(t, EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, "t", opts))
+
+	// 自增主键作为唯一键的表上按键列写入会追加重复行
+	mock.ExpectQuery(keyQuery).WithArgs("mall", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "COLUMN_KEY"}).AddRow("pk_0a1b2", "UNI"))
+	err = EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, "mall.t", opts)
+	assert.ErrorContains(t, err, "unique key [pk_0a1b2] of table mall.t does not match key columns [order_id]")
+
+	// Duplicate 模型的表没有唯一键
+	mock.ExpectQuery(keyQuery).WithArgs("", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "COLUMN_KEY"}).AddRow("order_id", "DUP"))
+	err = EnsureWriteKey(context.Background(), db, ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, "t", opts)
+	assert.ErrorContains(t, err, "is not a unique key table")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -21,6 +21,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 抽样方式
+type SampleMethod int32
+
+const (
+	SampleMethod_SAMPLE_METHOD_NONE     SampleMethod = 0 // 不抽样
+	SampleMethod_SAMPLE_METHOD_FIRST_N  SampleMethod = 1 // 前 rows 行，用于预览
+	SampleMethod_SAMPLE_METHOD_RANDOM_N SampleMethod = 2 // 随机 rows 行
+	SampleMethod_SAMPLE_METHOD_PERCENT  SampleMethod = 3 // 按 percent 比例随机抽样，支持 TABLESAMPLE 的数据库按其语义抽样，结果行数为近似值
+	SampleMethod_SAMPLE_METHOD_HASH     SampleMethod = 4 // 按键列哈希确定性抽样，键列、比例与 seed 相同时每次返回相同的行
+)
+
+// Enum value maps for SampleMethod.
+var (
+	SampleMethod_name = map[int32]string{
+		0: "SAMPLE_METHOD_NONE",
+		1: "SAMPLE_METHOD_FIRST_N",
+		2: "SAMPLE_METHOD_RANDOM_N",
+		3: "SAMPLE_METHOD_PERCENT",
+		4: "SAMPLE_METHOD_HASH",
+	}
+	SampleMethod_value = map[string]int32{
+		"SAMPLE_METHOD_NONE":     0,
+		"SAMPLE_METHOD_FIRST_N":  1,
+		"SAMPLE_METHOD_RANDOM_N": 2,
+		"SAMPLE_METHOD_PERCENT":  3,
+		"SAMPLE_METHOD_HASH":     4,
+	}
+)
+
+func (x SampleMethod) Enum() *SampleMethod {
+	p := new(SampleMethod)
+	*p = x
+	return p
+}
+
+func (x SampleMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SampleMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[0].Descriptor()
+}
+
+func (SampleMethod) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[0]
+}
+
+func (x SampleMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SampleMethod.Descriptor instead.
+func (SampleMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{0}
+}
+
 type SortOrder int32
 
 const (
@@ -51,11 +107,11 @@ func (x SortOrder) String() string {
 }
 
 func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[0].Descriptor()
+	return file_proto_data_source_proto_enumTypes[1].Descriptor()
 }
 
 func (SortOrder) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[0]
+	return &file_proto_data_source_proto_enumTypes[1]
 }
 
 func (x SortOrder) Number() protoreflect.EnumNumber {
@@ -64,34 +120,44 @@ func (x SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortOrder.Descriptor instead.
 func (SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{0}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{1}
 }
 
 // FilterOperator 枚举类型
 type FilterOperator int32
 
 const (
-	FilterOperator_EQUAL                 FilterOperator = 0 // "="
-	FilterOperator_NOT_EQUAL             FilterOperator = 1 // "!=" or "<>"
-	FilterOperator_GREATER_THAN          FilterOperator = 2 // ">"
-	FilterOperator_LESS_THAN             FilterOperator = 3 // "<"
-	FilterOperator_GREATER_THAN_OR_EQUAL FilterOperator = 4 // ">="
-	FilterOperator_LESS_THAN_OR_EQUAL    FilterOperator = 5 // "<="
-	FilterOperator_LIKE_OPERATOR         FilterOperator = 6 // "LIKE"
-	FilterOperator_IN_OPERATOR           FilterOperator = 7 // "IN"
+	FilterOperator_EQUAL                 FilterOperator = 0  // "="
+	FilterOperator_NOT_EQUAL             FilterOperator = 1  // "!=" or "<>"
+	FilterOperator_GREATER_THAN          FilterOperator = 2  // ">"
+	FilterOperator_LESS_THAN             FilterOperator = 3  // "<"
+	FilterOperator_GREATER_THAN_OR_EQUAL FilterOperator = 4  // ">="
+	FilterOperator_LESS_THAN_OR_EQUAL    FilterOperator = 5  // "<="
+	FilterOperator_LIKE_OPERATOR         FilterOperator = 6  // "LIKE"
+	FilterOperator_IN_OPERATOR           FilterOperator = 7  // "IN"
+	FilterOperator_BETWEEN_OPERATOR      FilterOperator = 8  // "BETWEEN ... AND ..."，取值数组中的前两个值
+	FilterOperator_IS_NULL               FilterOperator = 9  // "IS NULL"，无需取值
+	FilterOperator_IS_NOT_NULL           FilterOperator = 10 // "IS NOT NULL"，无需取值
+	FilterOperator_NOT_IN_OPERATOR       FilterOperator = 11 // "NOT IN"
+	FilterOperator_NOT_LIKE_OPERATOR     FilterOperator = 12 // "NOT LIKE"
 )
 
 // Enum value maps for FilterOperator.
 var (
 	FilterOperator_name = map[int32]string{
-		0: "EQUAL",
-		1: "NOT_EQUAL",
-		2: "GREATER_THAN",
-		3: "LESS_THAN",
-		4: "GREATER_THAN_OR_EQUAL",
-		5: "LESS_THAN_OR_EQUAL",
-		6: "LIKE_OPERATOR",
-		7: "IN_OPERATOR",
+		0:  "EQUAL",
+		1:  "NOT_EQUAL",
+		2:  "GREATER_THAN",
+		3:  "LESS_THAN",
+		4:  "GREATER_THAN_OR_EQUAL",
+		5:  "LESS_THAN_OR_EQUAL",
+		6:  "LIKE_OPERATOR",
+		7:  "IN_OPERATOR",
+		8:  "BETWEEN_OPERATOR",
+		9:  "IS_NULL",
+		10: "IS_NOT_NULL",
+		11: "NOT_IN_OPERATOR",
+		12: "NOT_LIKE_OPERATOR",
 	}
 	FilterOperator_value = map[string]int32{
 		"EQUAL":                 0,
@@ -102,6 +168,11 @@ var (
 		"LESS_THAN_OR_EQUAL":    5,
 		"LIKE_OPERATOR":         6,
 		"IN_OPERATOR":           7,
+		"BETWEEN_OPERATOR":      8,
+		"IS_NULL":               9,
+		"IS_NOT_NULL":           10,
+		"NOT_IN_OPERATOR":       11,
+		"NOT_LIKE_OPERATOR":     12,
 	}
 )
 
@@ -116,11 +187,11 @@ func (x FilterOperator) String() string {
 }
 
 func (FilterOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[1].Descriptor()
+	return file_proto_data_source_proto_enumTypes[2].Descriptor()
 }
 
 func (FilterOperator) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[1]
+	return &file_proto_data_source_proto_enumTypes[2]
 }
 
 func (x FilterOperator) Number() protoreflect.EnumNumber {
@@ -129,7 +200,54 @@ func (x FilterOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FilterOperator.Descriptor instead.
 func (FilterOperator) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{1}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{2}
+}
+
+// 过滤表达式的逻辑连接符
+type LogicalOperator int32
+
+const (
+	LogicalOperator_LOGICAL_AND LogicalOperator = 0
+	LogicalOperator_LOGICAL_OR  LogicalOperator = 1
+)
+
+// Enum value maps for LogicalOperator.
+var (
+	LogicalOperator_name = map[int32]string{
+		0: "LOGICAL_AND",
+		1: "LOGICAL_OR",
+	}
+	LogicalOperator_value = map[string]int32{
+		"LOGICAL_AND": 0,
+		"LOGICAL_OR":  1,
+	}
+)
+
+func (x LogicalOperator) Enum() *LogicalOperator {
+	p := new(LogicalOperator)
+	*p = x
+	return p
+}
+
+func (x LogicalOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogicalOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[3].Descriptor()
+}
+
+func (LogicalOperator) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[3]
+}
+
+func (x LogicalOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogicalOperator.Descriptor instead.
+func (LogicalOperator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{3}
 }
 
 // 文件类型的枚举定义
@@ -178,11 +296,11 @@ func (x FileType) String() string {
 }
 
 func (FileType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[2].Descriptor()
+	return file_proto_data_source_proto_enumTypes[4].Descriptor()
 }
 
 func (FileType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[2]
+	return &file_proto_data_source_proto_enumTypes[4]
 }
 
 func (x FileType) Number() protoreflect.EnumNumber {
@@ -191,47 +309,56 @@ func (x FileType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FileType.Descriptor instead.
 func (FileType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{2}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{4}
 }
 
 // 数据源类型
 type DataSourceType int32
 
 const (
-	DataSourceType_DATA_SOURCE_TYPE_UNKNOWN  DataSourceType = 0
-	DataSourceType_DATA_SOURCE_TYPE_MYSQL    DataSourceType = 1
-	DataSourceType_DATA_SOURCE_TYPE_KINGBASE DataSourceType = 2
-	DataSourceType_DATA_SOURCE_TYPE_HIVE     DataSourceType = 3
-	DataSourceType_DATA_SOURCE_TYPE_TIDB     DataSourceType = 4
-	DataSourceType_DATA_SOURCE_TYPE_TDSQL    DataSourceType = 5
-	DataSourceType_DATA_SOURCE_TYPE_VASTBASE DataSourceType = 6
-	DataSourceType_DATA_SOURCE_TYPE_GBASE    DataSourceType = 7
-	DataSourceType_DATA_SOURCE_TYPE_DORIS    DataSourceType = 8
+	DataSourceType_DATA_SOURCE_TYPE_UNKNOWN    DataSourceType = 0
+	DataSourceType_DATA_SOURCE_TYPE_MYSQL      DataSourceType = 1
+	DataSourceType_DATA_SOURCE_TYPE_KINGBASE   DataSourceType = 2
+	DataSourceType_DATA_SOURCE_TYPE_HIVE       DataSourceType = 3
+	DataSourceType_DATA_SOURCE_TYPE_TIDB       DataSourceType = 4
+	DataSourceType_DATA_SOURCE_TYPE_TDSQL      DataSourceType = 5
+	DataSourceType_DATA_SOURCE_TYPE_VASTBASE   DataSourceType = 6
+	DataSourceType_DATA_SOURCE_TYPE_GBASE      DataSourceType = 7
+	DataSourceType_DATA_SOURCE_TYPE_DORIS      DataSourceType = 8
+	DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL DataSourceType = 9
+	DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS  DataSourceType = 10
+	DataSourceType_DATA_SOURCE_TYPE_DAMENG     DataSourceType = 11
 )
 
 // Enum value maps for DataSourceType.
 var (
 	DataSourceType_name = map[int32]string{
-		0: "DATA_SOURCE_TYPE_UNKNOWN",
-		1: "DATA_SOURCE_TYPE_MYSQL",
-		2: "DATA_SOURCE_TYPE_KINGBASE",
-		3: "DATA_SOURCE_TYPE_HIVE",
-		4: "DATA_SOURCE_TYPE_TIDB",
-		5: "DATA_SOURCE_TYPE_TDSQL",
-		6: "DATA_SOURCE_TYPE_VASTBASE",
-		7: "DATA_SOURCE_TYPE_GBASE",
-		8: "DATA_SOURCE_TYPE_DORIS",
+		0:  "DATA_SOURCE_TYPE_UNKNOWN",
+		1:  "DATA_SOURCE_TYPE_MYSQL",
+		2:  "DATA_SOURCE_TYPE_KINGBASE",
+		3:  "DATA_SOURCE_TYPE_HIVE",
+		4:  "DATA_SOURCE_TYPE_TIDB",
+		5:  "DATA_SOURCE_TYPE_TDSQL",
+		6:  "DATA_SOURCE_TYPE_VASTBASE",
+		7:  "DATA_SOURCE_TYPE_GBASE",
+		8:  "DATA_SOURCE_TYPE_DORIS",
+		9:  "DATA_SOURCE_TYPE_POSTGRESQL",
+		10: "DATA_SOURCE_TYPE_OPENGAUSS",
+		11: "DATA_SOURCE_TYPE_DAMENG",
 	}
 	DataSourceType_value = map[string]int32{
-		"DATA_SOURCE_TYPE_UNKNOWN":  0,
-		"DATA_SOURCE_TYPE_MYSQL":    1,
-		"DATA_SOURCE_TYPE_KINGBASE": 2,
-		"DATA_SOURCE_TYPE_HIVE":     3,
-		"DATA_SOURCE_TYPE_TIDB":     4,
-		"DATA_SOURCE_TYPE_TDSQL":    5,
-		"DATA_SOURCE_TYPE_VASTBASE": 6,
-		"DATA_SOURCE_TYPE_GBASE":    7,
-		"DATA_SOURCE_TYPE_DORIS":    8,
+		"DATA_SOURCE_TYPE_UNKNOWN":    0,
+		"DATA_SOURCE_TYPE_MYSQL":      1,
+		"DATA_SOURCE_TYPE_KINGBASE":   2,
+		"DATA_SOURCE_TYPE_HIVE":       3,
+		"DATA_SOURCE_TYPE_TIDB":       4,
+		"DATA_SOURCE_TYPE_TDSQL":      5,
+		"DATA_SOURCE_TYPE_VASTBASE":   6,
+		"DATA_SOURCE_TYPE_GBASE":      7,
+		"DATA_SOURCE_TYPE_DORIS":      8,
+		"DATA_SOURCE_TYPE_POSTGRESQL": 9,
+		"DATA_SOURCE_TYPE_OPENGAUSS":  10,
+		"DATA_SOURCE_TYPE_DAMENG":     11,
 	}
 )
 
@@ -246,11 +373,11 @@ func (x DataSourceType) String() string {
 }
 
 func (DataSourceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[3].Descriptor()
+	return file_proto_data_source_proto_enumTypes[5].Descriptor()
 }
 
 func (DataSourceType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[3]
+	return &file_proto_data_source_proto_enumTypes[5]
 }
 
 func (x DataSourceType) Number() protoreflect.EnumNumber {
@@ -259,7 +386,284 @@ func (x DataSourceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataSourceType.Descriptor instead.
 func (DataSourceType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{3}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{5}
+}
+
+// 字段统计方式
+type ProfileMode int32
+
+const (
+	ProfileMode_PROFILE_MODE_EXACT   ProfileMode = 0 // 全表统计
+	ProfileMode_PROFILE_MODE_SAMPLED ProfileMode = 1 // 抽样统计，随机抽取 sampleRows 行统计
+)
+
+// Enum value maps for ProfileMode.
+var (
+	ProfileMode_name = map[int32]string{
+		0: "PROFILE_MODE_EXACT",
+		1: "PROFILE_MODE_SAMPLED",
+	}
+	ProfileMode_value = map[string]int32{
+		"PROFILE_MODE_EXACT":   0,
+		"PROFILE_MODE_SAMPLED": 1,
+	}
+)
+
+func (x ProfileMode) Enum() *ProfileMode {
+	p := new(ProfileMode)
+	*p = x
+	return p
+}
+
+func (x ProfileMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProfileMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[6].Descriptor()
+}
+
+func (ProfileMode) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[6]
+}
+
+func (x ProfileMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProfileMode.Descriptor instead.
+func (ProfileMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{6}
+}
+
+// 聚合函数
+type AggregateFunction int32
+
+const (
+	AggregateFunction_AGGREGATE_FUNCTION_COUNT          AggregateFunction = 0 // COUNT，fieldName 为空时为 COUNT(*)
+	AggregateFunction_AGGREGATE_FUNCTION_COUNT_DISTINCT AggregateFunction = 1 // COUNT(DISTINCT field)
+	AggregateFunction_AGGREGATE_FUNCTION_SUM            AggregateFunction = 2
+	AggregateFunction_AGGREGATE_FUNCTION_AVG            AggregateFunction = 3
+	AggregateFunction_AGGREGATE_FUNCTION_MIN            AggregateFunction = 4
+	AggregateFunction_AGGREGATE_FUNCTION_MAX            AggregateFunction = 5
+)
+
+// Enum value maps for AggregateFunction.
+var (
+	AggregateFunction_name = map[int32]string{
+		0: "AGGREGATE_FUNCTION_COUNT",
+		1: "AGGREGATE_FUNCTION_COUNT_DISTINCT",
+		2: "AGGREGATE_FUNCTION_SUM",
+		3: "AGGREGATE_FUNCTION_AVG",
+		4: "AGGREGATE_FUNCTION_MIN",
+		5: "AGGREGATE_FUNCTION_MAX",
+	}
+	AggregateFunction_value = map[string]int32{
+		"AGGREGATE_FUNCTION_COUNT":          0,
+		"AGGREGATE_FUNCTION_COUNT_DISTINCT": 1,
+		"AGGREGATE_FUNCTION_SUM":            2,
+		"AGGREGATE_FUNCTION_AVG":            3,
+		"AGGREGATE_FUNCTION_MIN":            4,
+		"AGGREGATE_FUNCTION_MAX":            5,
+	}
+)
+
+func (x AggregateFunction) Enum() *AggregateFunction {
+	p := new(AggregateFunction)
+	*p = x
+	return p
+}
+
+func (x AggregateFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[7].Descriptor()
+}
+
+func (AggregateFunction) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[7]
+}
+
+func (x AggregateFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateFunction.Descriptor instead.
+func (AggregateFunction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{7}
+}
+
+// 对账强度
+type ReconcileMode int32
+
+const (
+	ReconcileMode_RECONCILE_MODE_UNSPECIFIED ReconcileMode = 0 // 按配置 dbms.reconcile_mode，未配置时只做默认检查（未过滤的流式读取比对行数）
+	ReconcileMode_RECONCILE_MODE_NONE        ReconcileMode = 1 // 不对账
+	ReconcileMode_RECONCILE_MODE_COUNT       ReconcileMode = 2 // 只比对行数
+	ReconcileMode_RECONCILE_MODE_CHECKSUM    ReconcileMode = 3 // 比对行数和每列的非空值个数、数值列的和、最小值与最大值
+	ReconcileMode_RECONCILE_MODE_ROW_HASH    ReconcileMode = 4 // 比对行数和与行顺序无关的整行哈希
+)
+
+// Enum value maps for ReconcileMode.
+var (
+	ReconcileMode_name = map[int32]string{
+		0: "RECONCILE_MODE_UNSPECIFIED",
+		1: "RECONCILE_MODE_NONE",
+		2: "RECONCILE_MODE_COUNT",
+		3: "RECONCILE_MODE_CHECKSUM",
+		4: "RECONCILE_MODE_ROW_HASH",
+	}
+	ReconcileMode_value = map[string]int32{
+		"RECONCILE_MODE_UNSPECIFIED": 0,
+		"RECONCILE_MODE_NONE":        1,
+		"RECONCILE_MODE_COUNT":       2,
+		"RECONCILE_MODE_CHECKSUM":    3,
+		"RECONCILE_MODE_ROW_HASH":    4,
+	}
+)
+
+func (x ReconcileMode) Enum() *ReconcileMode {
+	p := new(ReconcileMode)
+	*p = x
+	return p
+}
+
+func (x ReconcileMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconcileMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[8].Descriptor()
+}
+
+func (ReconcileMode) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[8]
+}
+
+func (x ReconcileMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconcileMode.Descriptor instead.
+func (ReconcileMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{8}
+}
+
+// 异步作业类型，与同名的同步接口对应
+type AsyncJobType int32
+
+const (
+	AsyncJobType_ASYNC_JOB_TYPE_UNSPECIFIED                                        AsyncJobType = 0
+	AsyncJobType_ASYNC_JOB_TYPE_IMPORT_DATA                                        AsyncJobType = 1 // ImportData
+	AsyncJobType_ASYNC_JOB_TYPE_EXPORT_CSV_FILE_FROM_DORIS                         AsyncJobType = 2 // ExportCsvFileFromDoris
+	AsyncJobType_ASYNC_JOB_TYPE_IMPORT_MIRA_DB_DATA_TO_DORIS                       AsyncJobType = 3 // ImportMiraDBDataToDoris
+	AsyncJobType_ASYNC_JOB_TYPE_CREATE_EXTERNAL_AND_INTERNAL_TABLE_AND_IMPORT_DATA AsyncJobType = 4 // CreateExternalAndInternalTableAndImportData
+)
+
+// Enum value maps for AsyncJobType.
+var (
+	AsyncJobType_name = map[int32]string{
+		0: "ASYNC_JOB_TYPE_UNSPECIFIED",
+		1: "ASYNC_JOB_TYPE_IMPORT_DATA",
+		2: "ASYNC_JOB_TYPE_EXPORT_CSV_FILE_FROM_DORIS",
+		3: "ASYNC_JOB_TYPE_IMPORT_MIRA_DB_DATA_TO_DORIS",
+		4: "ASYNC_JOB_TYPE_CREATE_EXTERNAL_AND_INTERNAL_TABLE_AND_IMPORT_DATA",
+	}
+	AsyncJobType_value = map[string]int32{
+		"ASYNC_JOB_TYPE_UNSPECIFIED":                                        0,
+		"ASYNC_JOB_TYPE_IMPORT_DATA":                                        1,
+		"ASYNC_JOB_TYPE_EXPORT_CSV_FILE_FROM_DORIS":                         2,
+		"ASYNC_JOB_TYPE_IMPORT_MIRA_DB_DATA_TO_DORIS":                       3,
+		"ASYNC_JOB_TYPE_CREATE_EXTERNAL_AND_INTERNAL_TABLE_AND_IMPORT_DATA": 4,
+	}
+)
+
+func (x AsyncJobType) Enum() *AsyncJobType {
+	p := new(AsyncJobType)
+	*p = x
+	return p
+}
+
+func (x AsyncJobType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AsyncJobType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[9].Descriptor()
+}
+
+func (AsyncJobType) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[9]
+}
+
+func (x AsyncJobType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AsyncJobType.Descriptor instead.
+func (AsyncJobType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{9}
+}
+
+// 异步作业状态
+type AsyncJobStatus int32
+
+const (
+	AsyncJobStatus_ASYNC_JOB_STATUS_UNSPECIFIED AsyncJobStatus = 0
+	AsyncJobStatus_ASYNC_JOB_STATUS_PENDING     AsyncJobStatus = 1 // 已提交，等待执行
+	AsyncJobStatus_ASYNC_JOB_STATUS_RUNNING     AsyncJobStatus = 2 // 执行中
+	AsyncJobStatus_ASYNC_JOB_STATUS_SUCCEEDED   AsyncJobStatus = 3 // 执行成功
+	AsyncJobStatus_ASYNC_JOB_STATUS_FAILED      AsyncJobStatus = 4 // 执行失败，执行节点重启或失联时也标记为失败
+	AsyncJobStatus_ASYNC_JOB_STATUS_CANCELLED   AsyncJobStatus = 5 // 已取消
+)
+
+// Enum value maps for AsyncJobStatus.
+var (
+	AsyncJobStatus_name = map[int32]string{
+		0: "ASYNC_JOB_STATUS_UNSPECIFIED",
+		1: "ASYNC_JOB_STATUS_PENDING",
+		2: "ASYNC_JOB_STATUS_RUNNING",
+		3: "ASYNC_JOB_STATUS_SUCCEEDED",
+		4: "ASYNC_JOB_STATUS_FAILED",
+		5: "ASYNC_JOB_STATUS_CANCELLED",
+	}
+	AsyncJobStatus_value = map[string]int32{
+		"ASYNC_JOB_STATUS_UNSPECIFIED": 0,
+		"ASYNC_JOB_STATUS_PENDING":     1,
+		"ASYNC_JOB_STATUS_RUNNING":     2,
+		"ASYNC_JOB_STATUS_SUCCEEDED":   3,
+		"ASYNC_JOB_STATUS_FAILED":      4,
+		"ASYNC_JOB_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x AsyncJobStatus) Enum() *AsyncJobStatus {
+	p := new(AsyncJobStatus)
+	*p = x
+	return p
+}
+
+func (x AsyncJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AsyncJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[10].Descriptor()
+}
+
+func (AsyncJobStatus) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[10]
+}
+
+func (x AsyncJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AsyncJobStatus.Descriptor instead.
+func (AsyncJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{10}
 }
 
 // 数据库常量
@@ -290,11 +694,11 @@ func (x DbConstant) String() string {
 }
 
 func (DbConstant) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[4].Descriptor()
+	return file_proto_data_source_proto_enumTypes[11].Descriptor()
 }
 
 func (DbConstant) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[4]
+	return &file_proto_data_source_proto_enumTypes[11]
 }
 
 func (x DbConstant) Number() protoreflect.EnumNumber {
@@ -303,7 +707,7 @@ func (x DbConstant) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DbConstant.Descriptor instead.
 func (DbConstant) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{4}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{11}
 }
 
 // spark功能
@@ -358,11 +762,11 @@ func (x OperationMode) String() string {
 }
 
 func (OperationMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[5].Descriptor()
+	return file_proto_data_source_proto_enumTypes[12].Descriptor()
 }
 
 func (OperationMode) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[5]
+	return &file_proto_data_source_proto_enumTypes[12]
 }
 
 func (x OperationMode) Number() protoreflect.EnumNumber {
@@ -371,7 +775,7 @@ func (x OperationMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperationMode.Descriptor instead.
 func (OperationMode) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{5}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{12}
 }
 
 // JOIN类型
@@ -414,11 +818,11 @@ func (x JoinType) String() string {
 }
 
 func (JoinType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[6].Descriptor()
+	return file_proto_data_source_proto_enumTypes[13].Descriptor()
 }
 
 func (JoinType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[6]
+	return &file_proto_data_source_proto_enumTypes[13]
 }
 
 func (x JoinType) Number() protoreflect.EnumNumber {
@@ -427,7 +831,7 @@ func (x JoinType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JoinType.Descriptor instead.
 func (JoinType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{6}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{13}
 }
 
 // 作业状态枚举
@@ -470,11 +874,11 @@ func (x JobStatus) String() string {
 }
 
 func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[7].Descriptor()
+	return file_proto_data_source_proto_enumTypes[14].Descriptor()
 }
 
 func (JobStatus) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[7]
+	return &file_proto_data_source_proto_enumTypes[14]
 }
 
 func (x JobStatus) Number() protoreflect.EnumNumber {
@@ -483,7 +887,7 @@ func (x JobStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobStatus.Descriptor instead.
 func (JobStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{7}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{14}
 }
 
 // 存储类型枚举
@@ -520,11 +924,11 @@ func (x StorageType) String() string {
 }
 
 func (StorageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[8].Descriptor()
+	return file_proto_data_source_proto_enumTypes[15].Descriptor()
 }
 
 func (StorageType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[8]
+	return &file_proto_data_source_proto_enumTypes[15]
 }
 
 func (x StorageType) Number() protoreflect.EnumNumber {
@@ -533,38 +937,91 @@ func (x StorageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StorageType.Descriptor instead.
 func (StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{8}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{15}
 }
 
-// 表键类型枚举
-type KeyType int32
+// 写入模式
+type WriteMode int32
 
 const (
-	KeyType_KEY_TYPE_UNKNOWN        KeyType = 0
-	KeyType_KEY_TYPE_PRIMARY        KeyType = 1 // 主键
-	KeyType_KEY_TYPE_FOREIGN        KeyType = 2 // 外键
-	KeyType_KEY_TYPE_UNIQUE         KeyType = 3 // 唯一键
-	KeyType_KEY_TYPE_INDEX          KeyType = 4 // 索引键
-	KeyType_KEY_TYPE_AUTO_INCREMENT KeyType = 5 // 自增主键
+	WriteMode_WRITE_MODE_APPEND        WriteMode = 0 // 追加写入
+	WriteMode_WRITE_MODE_OVERWRITE     WriteMode = 1 // 清空目标表后写入
+	WriteMode_WRITE_MODE_UPSERT        WriteMode = 2 // 键列相同的行更新，不存在则插入
+	WriteMode_WRITE_MODE_INSERT_IGNORE WriteMode = 3 // 键列相同的行跳过
 )
 
-// Enum value maps for KeyType.
+// Enum value maps for WriteMode.
 var (
-	KeyType_name = map[int32]string{
-		0: "KEY_TYPE_UNKNOWN",
-		1: "KEY_TYPE_PRIMARY",
-		2: "KEY_TYPE_FOREIGN",
-		3: "KEY_TYPE_UNIQUE",
-		4: "KEY_TYPE_INDEX",
-		5: "KEY_TYPE_AUTO_INCREMENT",
+	WriteMode_name = map[int32]string{
+		0: "WRITE_MODE_APPEND",
+		1: "WRITE_MODE_OVERWRITE",
+		2: "WRITE_MODE_UPSERT",
+		3: "WRITE_MODE_INSERT_IGNORE",
+	}
+	WriteMode_value = map[string]int32{
+		"WRITE_MODE_APPEND":        0,
+		"WRITE_MODE_OVERWRITE":     1,
+		"WRITE_MODE_UPSERT":        2,
+		"WRITE_MODE_INSERT_IGNORE": 3,
 	}
-	KeyType_value = map[string]int32{
-		"KEY_TYPE_UNKNOWN":        0,
-		"KEY_TYPE_PRIMARY":        1,
-		"KEY_TYPE_FOREIGN":        2,
-		"KEY_TYPE_UNIQUE":         3,
-		"KEY_TYPE_INDEX":          4,
-		"KEY_TYPE_AUTO_INCREMENT": 5,
+)
+
+func (x WriteMode) Enum() *WriteMode {
+	p := new(WriteMode)
+	*p = x
+	return p
+}
+
+func (x WriteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WriteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[16].Descriptor()
+}
+
+func (WriteMode) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[16]
+}
+
+func (x WriteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WriteMode.Descriptor instead.
+func (WriteMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{16}
+}
+
+// 表键类型枚举
+type KeyType int32
+
+const (
+	KeyType_KEY_TYPE_UNKNOWN        KeyType = 0
+	KeyType_KEY_TYPE_PRIMARY        KeyType = 1 // 主键
+	KeyType_KEY_TYPE_FOREIGN        KeyType = 2 // 外键
+	KeyType_KEY_TYPE_UNIQUE         KeyType = 3 // 唯一键
+	KeyType_KEY_TYPE_INDEX          KeyType = 4 // 索引键
+	KeyType_KEY_TYPE_AUTO_INCREMENT KeyType = 5 // 自增主键
+)
+
+// Enum value maps for KeyType.
+var (
+	KeyType_name = map[int32]string{
+		0: "KEY_TYPE_UNKNOWN",
+		1: "KEY_TYPE_PRIMARY",
+		2: "KEY_TYPE_FOREIGN",
+		3: "KEY_TYPE_UNIQUE",
+		4: "KEY_TYPE_INDEX",
+		5: "KEY_TYPE_AUTO_INCREMENT",
+	}
+	KeyType_value = map[string]int32{
+		"KEY_TYPE_UNKNOWN":        0,
+		"KEY_TYPE_PRIMARY":        1,
+		"KEY_TYPE_FOREIGN":        2,
+		"KEY_TYPE_UNIQUE":         3,
+		"KEY_TYPE_INDEX":          4,
+		"KEY_TYPE_AUTO_INCREMENT": 5,
 	}
 )
 
@@ -579,11 +1036,11 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[9].Descriptor()
+	return file_proto_data_source_proto_enumTypes[17].Descriptor()
 }
 
 func (KeyType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[9]
+	return &file_proto_data_source_proto_enumTypes[17]
 }
 
 func (x KeyType) Number() protoreflect.EnumNumber {
@@ -592,7 +1049,7 @@ func (x KeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyType.Descriptor instead.
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{9}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{17}
 }
 
 // 连接响应，返回连接成功与否的信息
@@ -601,8 +1058,9 @@ type Response struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功连接
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 错误或成功信息
+	Success        bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`              // 是否成功连接
+	Message        string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`               // 错误或成功信息
+	Reconciliation *ReconcileReport `protobuf:"bytes,3,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"` // 源端与目标端的对账结果，未对账时为空
 }
 
 func (x *Response) Reset() {
@@ -651,6 +1109,13 @@ func (x *Response) GetMessage() string {
 	return ""
 }
 
+func (x *Response) GetReconciliation() *ReconcileReport {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
 type BatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -782,7 +1247,8 @@ type ArrowResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	ArrowBatch  []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 续传令牌，断流后在读取请求中携带该令牌可从本批次之后继续读取；为空表示本次读取不支持续传
 }
 
 func (x *ArrowResponse) Reset() {
@@ -824,6 +1290,13 @@ func (x *ArrowResponse) GetArrowBatch() []byte {
 	return nil
 }
 
+func (x *ArrowResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 type WriterDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -951,6 +1424,7 @@ type WriterInternalDataRequest struct {
 	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
 	TableName     string `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
 	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	WriteId       string `protobuf:"bytes,5,opt,name=writeId,proto3" json:"writeId,omitempty"` // 客户端生成的写入 ID，只在流的第一个请求中生效；同一 ID 已提交过的表在重试时不再写入
 }
 
 func (x *WriterInternalDataRequest) Reset() {
@@ -1013,17 +1487,26 @@ func (x *WriterInternalDataRequest) GetJobInstanceId() string {
 	return ""
 }
 
+func (x *WriterInternalDataRequest) GetWriteId() string {
+	if x != nil {
+		return x.WriteId
+	}
+	return ""
+}
+
 type WriterExternalDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch  []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
-	AssetName   string `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"`
-	ChainInfoId string `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	RequestId   string `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	TableName   string `protobuf:"bytes,5,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	Alias       string `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	ArrowBatch  []byte    `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	AssetName   string    `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"`
+	ChainInfoId string    `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	RequestId   string    `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	TableName   string    `protobuf:"bytes,5,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	Alias       string    `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	WriteMode   WriteMode `protobuf:"varint,7,opt,name=writeMode,proto3,enum=datasource.WriteMode" json:"writeMode,omitempty"` // 写入模式，默认追加
+	KeyColumns  []string  `protobuf:"bytes,8,rep,name=keyColumns,proto3" json:"keyColumns,omitempty"`                          // upsert/忽略冲突时判断行是否已存在的键列
 }
 
 func (x *WriterExternalDataRequest) Reset() {
@@ -1100,19 +1583,36 @@ func (x *WriterExternalDataRequest) GetAlias() string {
 	return ""
 }
 
+func (x *WriterExternalDataRequest) GetWriteMode() WriteMode {
+	if x != nil {
+		return x.WriteMode
+	}
+	return WriteMode_WRITE_MODE_APPEND
+}
+
+func (x *WriterExternalDataRequest) GetKeyColumns() []string {
+	if x != nil {
+		return x.KeyColumns
+	}
+	return nil
+}
+
 type InternalReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbFields        []string         `protobuf:"bytes,2,rep,name=dbFields,proto3" json:"dbFields,omitempty"`
-	DbName          string           `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,4,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                // 过滤字段
-	FilterValues    []*FilterValue   `protobuf:"bytes,5,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                              // 过滤字段值
-	SortRules       []*SortRule      `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                    // 排序规则
-	FilterOperators []FilterOperator `protobuf:"varint,7,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
-	JobInstanceId   string           `protobuf:"bytes,8,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`                                            // 作业实例ID
+	TableName        string               `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbFields         []string             `protobuf:"bytes,2,rep,name=dbFields,proto3" json:"dbFields,omitempty"`
+	DbName           string               `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	FilterNames      []string             `protobuf:"bytes,4,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                // 过滤字段
+	FilterValues     []*FilterValue       `protobuf:"bytes,5,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                              // 过滤字段值
+	SortRules        []*SortRule          `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                    // 排序规则
+	FilterOperators  []FilterOperator     `protobuf:"varint,7,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
+	JobInstanceId    string               `protobuf:"bytes,8,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`                                            // 作业实例ID
+	FilterExpression *FilterExpression    `protobuf:"bytes,9,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"`                                      // 过滤表达式，与 filterNames 等平铺条件以 AND 组合
+	ParallelRead     *ParallelReadOptions `protobuf:"bytes,10,opt,name=parallelRead,proto3" json:"parallelRead,omitempty"`                                             // 分片并发读取参数，不设置时按单条查询读取
+	ResumeToken      string               `protobuf:"bytes,11,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                            // 续传令牌，取自上次读取流中最后收到的 ArrowResponse；携带时不使用分片并发读取
 }
 
 func (x *InternalReadRequest) Reset() {
@@ -1203,6 +1703,27 @@ func (x *InternalReadRequest) GetJobInstanceId() string {
 	return ""
 }
 
+func (x *InternalReadRequest) GetFilterExpression() *FilterExpression {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return nil
+}
+
+func (x *InternalReadRequest) GetParallelRead() *ParallelReadOptions {
+	if x != nil {
+		return x.ParallelRead
+	}
+	return nil
+}
+
+func (x *InternalReadRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 // 批处理请求，包含查询条件或读取参数
 type BatchReadRequest struct {
 	state         protoimpl.MessageState
@@ -2230,17 +2751,22 @@ type StreamReadRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetName       string           `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"` // 资产名称
-	ChainInfoId     string           `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	DbFields        []string         `protobuf:"bytes,3,rep,name=dbFields,proto3" json:"dbFields,omitempty"` // 数据库字段名的字符串数组
-	RequestId       string           `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	FileType        FileType         `protobuf:"varint,5,opt,name=fileType,proto3,enum=datasource.FileType" json:"fileType,omitempty"`
-	FilePath        string           `protobuf:"bytes,6,opt,name=filePath,proto3" json:"filePath,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,7,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                 // 过滤字段
-	FilterValues    []*FilterValue   `protobuf:"bytes,8,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                               // 过滤字段值
-	SortRules       []*SortRule      `protobuf:"bytes,9,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                     // 排序规则
-	FilterOperators []FilterOperator `protobuf:"varint,10,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
-	Alias           string           `protobuf:"bytes,11,opt,name=alias,proto3" json:"alias,omitempty"`                                                            // 链账户别名
+	AssetName        string               `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"` // 资产名称
+	ChainInfoId      string               `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	DbFields         []string             `protobuf:"bytes,3,rep,name=dbFields,proto3" json:"dbFields,omitempty"` // 数据库字段名的字符串数组
+	RequestId        string               `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	FileType         FileType             `protobuf:"varint,5,opt,name=fileType,proto3,enum=datasource.FileType" json:"fileType,omitempty"`
+	FilePath         string               `protobuf:"bytes,6,opt,name=filePath,proto3" json:"filePath,omitempty"`
+	FilterNames      []string             `protobuf:"bytes,7,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                 // 过滤字段
+	FilterValues     []*FilterValue       `protobuf:"bytes,8,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                               // 过滤字段值
+	SortRules        []*SortRule          `protobuf:"bytes,9,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                     // 排序规则
+	FilterOperators  []FilterOperator     `protobuf:"varint,10,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
+	Alias            string               `protobuf:"bytes,11,opt,name=alias,proto3" json:"alias,omitempty"`                                                            // 链账户别名
+	FilterExpression *FilterExpression    `protobuf:"bytes,12,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"`                                      // 过滤表达式，与 filterNames 等平铺条件以 AND 组合
+	ParallelRead     *ParallelReadOptions `protobuf:"bytes,13,opt,name=parallelRead,proto3" json:"parallelRead,omitempty"`                                              // 分片并发读取参数，不设置时按单条查询读取
+	ResumeToken      string               `protobuf:"bytes,14,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                             // 续传令牌，取自上次读取流中最后收到的 ArrowResponse；携带时不使用分片并发读取
+	Sampling         *SampleOptions       `protobuf:"bytes,15,opt,name=sampling,proto3" json:"sampling,omitempty"`                                                      // 抽样读取，设置时只返回样本且不使用分片并发读取和续传令牌
+	ReconcileMode    ReconcileMode        `protobuf:"varint,16,opt,name=reconcileMode,proto3,enum=datasource.ReconcileMode" json:"reconcileMode,omitempty"`             // 发送的数据与源表的额外对账强度，未过滤时始终比对总行数，抽样读取时不对账
 }
 
 func (x *StreamReadRequest) Reset() {
@@ -2352,6 +2878,185 @@ func (x *StreamReadRequest) GetAlias() string {
 	return ""
 }
 
+func (x *StreamReadRequest) GetFilterExpression() *FilterExpression {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return nil
+}
+
+func (x *StreamReadRequest) GetParallelRead() *ParallelReadOptions {
+	if x != nil {
+		return x.ParallelRead
+	}
+	return nil
+}
+
+func (x *StreamReadRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StreamReadRequest) GetSampling() *SampleOptions {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *StreamReadRequest) GetReconcileMode() ReconcileMode {
+	if x != nil {
+		return x.ReconcileMode
+	}
+	return ReconcileMode_RECONCILE_MODE_UNSPECIFIED
+}
+
+type SampleOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method    SampleMethod `protobuf:"varint,1,opt,name=method,proto3,enum=datasource.SampleMethod" json:"method,omitempty"`
+	Rows      int64        `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`          // FIRST_N、RANDOM_N 的行数
+	Percent   float64      `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`   // PERCENT、HASH 的抽样比例，取值 (0, 100]
+	KeyColumn string       `protobuf:"bytes,4,opt,name=keyColumn,proto3" json:"keyColumn,omitempty"` // HASH 使用的键列，为空时从表键信息中选择单列主键
+	Seed      int64        `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`          // HASH 的种子，改变种子得到另一份样本
+}
+
+func (x *SampleOptions) Reset() {
+	*x = SampleOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampleOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleOptions) ProtoMessage() {}
+
+func (x *SampleOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleOptions.ProtoReflect.Descriptor instead.
+func (*SampleOptions) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SampleOptions) GetMethod() SampleMethod {
+	if x != nil {
+		return x.Method
+	}
+	return SampleMethod_SAMPLE_METHOD_NONE
+}
+
+func (x *SampleOptions) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SampleOptions) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *SampleOptions) GetKeyColumn() string {
+	if x != nil {
+		return x.KeyColumn
+	}
+	return ""
+}
+
+func (x *SampleOptions) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// 分片并发读取参数：按分片字段的取值区间拆成多条范围查询并发执行
+// 指定 sortRules 时按第一个排序字段分片并保持顺序，否则各分片的批次交错返回
+type ParallelReadOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parallelism     int32       `protobuf:"varint,1,opt,name=parallelism,proto3" json:"parallelism,omitempty"`        // 并发查询数，小于等于 1 时不分片
+	PartitionColumn string      `protobuf:"bytes,2,opt,name=partitionColumn,proto3" json:"partitionColumn,omitempty"` // 分片字段，需为数值列；为空时依次从 keys、源表主键中选择单列键
+	Keys            []*TableKey `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`                       // 表键信息，优先使用单列自增主键
+}
+
+func (x *ParallelReadOptions) Reset() {
+	*x = ParallelReadOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParallelReadOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParallelReadOptions) ProtoMessage() {}
+
+func (x *ParallelReadOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParallelReadOptions.ProtoReflect.Descriptor instead.
+func (*ParallelReadOptions) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ParallelReadOptions) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *ParallelReadOptions) GetPartitionColumn() string {
+	if x != nil {
+		return x.PartitionColumn
+	}
+	return ""
+}
+
+func (x *ParallelReadOptions) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type FilterValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2370,7 +3075,7 @@ type FilterValue struct {
 func (x *FilterValue) Reset() {
 	*x = FilterValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[22]
+		mi := &file_proto_data_source_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2383,7 +3088,7 @@ func (x *FilterValue) String() string {
 func (*FilterValue) ProtoMessage() {}
 
 func (x *FilterValue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[22]
+	mi := &file_proto_data_source_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2396,7 +3101,7 @@ func (x *FilterValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterValue.ProtoReflect.Descriptor instead.
 func (*FilterValue) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{22}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *FilterValue) GetStrValue() string {
@@ -2467,7 +3172,7 @@ type SortRule struct {
 func (x *SortRule) Reset() {
 	*x = SortRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[23]
+		mi := &file_proto_data_source_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2480,7 +3185,7 @@ func (x *SortRule) String() string {
 func (*SortRule) ProtoMessage() {}
 
 func (x *SortRule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[23]
+	mi := &file_proto_data_source_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2493,7 +3198,7 @@ func (x *SortRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortRule.ProtoReflect.Descriptor instead.
 func (*SortRule) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{23}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SortRule) GetFieldName() string {
@@ -2510,39 +3215,37 @@ func (x *SortRule) GetSortOrder() SortOrder {
 	return SortOrder_ASC
 }
 
-type ConnectionInfo struct {
+// 过滤表达式树，支持 AND/OR/NOT 任意嵌套，例如 (a = 1 OR b > 2) AND NOT c LIKE 'x%'
+type FilterExpression struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dbtype    int32                `protobuf:"varint,1,opt,name=dbtype,proto3" json:"dbtype,omitempty"`      //数据库类型
-	Host      string               `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`           // 数据库地址
-	Port      int32                `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`          // 数据库端口
-	TableName string               `protobuf:"bytes,4,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
-	TlsConfig *DatasourceTlsConfig `protobuf:"bytes,5,opt,name=tlsConfig,proto3" json:"tlsConfig,omitempty"` // TLS配置
-	User      string               `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
-	Password  string               `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
-	DbName    string               `protobuf:"bytes,10,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	Columns   []*ColumnItem        `protobuf:"bytes,11,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Types that are assignable to Node:
+	//
+	//	*FilterExpression_Condition
+	//	*FilterExpression_Group
+	//	*FilterExpression_Not
+	Node isFilterExpression_Node `protobuf_oneof:"node"`
 }
 
-func (x *ConnectionInfo) Reset() {
-	*x = ConnectionInfo{}
+func (x *FilterExpression) Reset() {
+	*x = FilterExpression{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[24]
+		mi := &file_proto_data_source_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectionInfo) String() string {
+func (x *FilterExpression) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionInfo) ProtoMessage() {}
+func (*FilterExpression) ProtoMessage() {}
 
-func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[24]
+func (x *FilterExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,9 +3256,162 @@ func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
+// Deprecated: Use FilterExpression.ProtoReflect.Descriptor instead.
+func (*FilterExpression) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{26}
+}
+
+func (m *FilterExpression) GetNode() isFilterExpression_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetCondition() *FilterCondition {
+	if x, ok := x.GetNode().(*FilterExpression_Condition); ok {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetGroup() *FilterGroup {
+	if x, ok := x.GetNode().(*FilterExpression_Group); ok {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetNot() *FilterExpression {
+	if x, ok := x.GetNode().(*FilterExpression_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+type isFilterExpression_Node interface {
+	isFilterExpression_Node()
+}
+
+type FilterExpression_Condition struct {
+	Condition *FilterCondition `protobuf:"bytes,1,opt,name=condition,proto3,oneof"` // 叶子节点：单个过滤条件
+}
+
+type FilterExpression_Group struct {
+	Group *FilterGroup `protobuf:"bytes,2,opt,name=group,proto3,oneof"` // 逻辑组：多个子表达式以 AND/OR 连接
+}
+
+type FilterExpression_Not struct {
+	Not *FilterExpression `protobuf:"bytes,3,opt,name=not,proto3,oneof"` // 对子表达式取反
+}
+
+func (*FilterExpression_Condition) isFilterExpression_Node() {}
+
+func (*FilterExpression_Group) isFilterExpression_Node() {}
+
+func (*FilterExpression_Not) isFilterExpression_Node() {}
+
+type FilterGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logic    LogicalOperator     `protobuf:"varint,1,opt,name=logic,proto3,enum=datasource.LogicalOperator" json:"logic,omitempty"`
+	Children []*FilterExpression `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *FilterGroup) Reset() {
+	*x = FilterGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterGroup) ProtoMessage() {}
+
+func (x *FilterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterGroup.ProtoReflect.Descriptor instead.
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FilterGroup) GetLogic() LogicalOperator {
+	if x != nil {
+		return x.Logic
+	}
+	return LogicalOperator_LOGICAL_AND
+}
+
+func (x *FilterGroup) GetChildren() []*FilterExpression {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ConnectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dbtype    int32                `protobuf:"varint,1,opt,name=dbtype,proto3" json:"dbtype,omitempty"`      //数据库类型
+	Host      string               `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`           // 数据库地址
+	Port      int32                `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`          // 数据库端口
+	TableName string               `protobuf:"bytes,4,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
+	TlsConfig *DatasourceTlsConfig `protobuf:"bytes,5,opt,name=tlsConfig,proto3" json:"tlsConfig,omitempty"` // TLS配置
+	User      string               `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
+	Password  string               `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
+	DbName    string               `protobuf:"bytes,10,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	Columns   []*ColumnItem        `protobuf:"bytes,11,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *ConnectionInfo) Reset() {
+	*x = ConnectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionInfo) ProtoMessage() {}
+
+func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
 func (*ConnectionInfo) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{24}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ConnectionInfo) GetDbtype() int32 {
@@ -2626,14 +3482,22 @@ type ColumnItem struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // 字段名称
-	DataType string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"` // 字段类型
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // 字段名称
+	DataType      string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"` // 字段类型
+	NotNull       bool   `protobuf:"varint,3,opt,name=notNull,proto3" json:"notNull,omitempty"`                  // 是否声明了非空约束，数据源未提供时为 false
+	Length        int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`                    // 字符类型的最大长度，未知时为 0
+	Precision     int32  `protobuf:"varint,5,opt,name=precision,proto3" json:"precision,omitempty"`              // 数值类型的精度，未知时为 0
+	Scale         int32  `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`                      // 数值类型的标度
+	HasDefault    bool   `protobuf:"varint,7,opt,name=hasDefault,proto3" json:"hasDefault,omitempty"`            // 是否有默认值
+	DefaultValue  string `protobuf:"bytes,8,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`         // 默认值，为数据源返回的原始表达式
+	Comment       string `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`                   // 字段注释
+	AutoIncrement bool   `protobuf:"varint,10,opt,name=autoIncrement,proto3" json:"autoIncrement,omitempty"`     // 是否自增（含序列默认值与标识列）
 }
 
 func (x *ColumnItem) Reset() {
 	*x = ColumnItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[25]
+		mi := &file_proto_data_source_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2646,7 +3510,7 @@ func (x *ColumnItem) String() string {
 func (*ColumnItem) ProtoMessage() {}
 
 func (x *ColumnItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[25]
+	mi := &file_proto_data_source_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,7 +3523,7 @@ func (x *ColumnItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
 func (*ColumnItem) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{25}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ColumnItem) GetName() string {
@@ -2676,6 +3540,62 @@ func (x *ColumnItem) GetDataType() string {
 	return ""
 }
 
+func (x *ColumnItem) GetNotNull() bool {
+	if x != nil {
+		return x.NotNull
+	}
+	return false
+}
+
+func (x *ColumnItem) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ColumnItem) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *ColumnItem) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *ColumnItem) GetHasDefault() bool {
+	if x != nil {
+		return x.HasDefault
+	}
+	return false
+}
+
+func (x *ColumnItem) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *ColumnItem) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ColumnItem) GetAutoIncrement() bool {
+	if x != nil {
+		return x.AutoIncrement
+	}
+	return false
+}
+
 type ServerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2689,7 +3609,7 @@ type ServerInfo struct {
 func (x *ServerInfo) Reset() {
 	*x = ServerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[26]
+		mi := &file_proto_data_source_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2702,7 +3622,7 @@ func (x *ServerInfo) String() string {
 func (*ServerInfo) ProtoMessage() {}
 
 func (x *ServerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[26]
+	mi := &file_proto_data_source_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,7 +3635,7 @@ func (x *ServerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
 func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{26}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ServerInfo) GetNamespace() string {
@@ -2752,7 +3672,7 @@ type OSSWriteRequest struct {
 func (x *OSSWriteRequest) Reset() {
 	*x = OSSWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[27]
+		mi := &file_proto_data_source_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2765,7 +3685,7 @@ func (x *OSSWriteRequest) String() string {
 func (*OSSWriteRequest) ProtoMessage() {}
 
 func (x *OSSWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[27]
+	mi := &file_proto_data_source_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2778,7 +3698,7 @@ func (x *OSSWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSSWriteRequest.ProtoReflect.Descriptor instead.
 func (*OSSWriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{27}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *OSSWriteRequest) GetBucketName() string {
@@ -2814,7 +3734,7 @@ type OSSReadRequest struct {
 func (x *OSSReadRequest) Reset() {
 	*x = OSSReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[28]
+		mi := &file_proto_data_source_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2827,7 +3747,7 @@ func (x *OSSReadRequest) String() string {
 func (*OSSReadRequest) ProtoMessage() {}
 
 func (x *OSSReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[28]
+	mi := &file_proto_data_source_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,7 +3760,7 @@ func (x *OSSReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSSReadRequest.ProtoReflect.Descriptor instead.
 func (*OSSReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{28}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *OSSReadRequest) GetBucketName() string {
@@ -2870,7 +3790,7 @@ type OSSReadResponse struct {
 func (x *OSSReadResponse) Reset() {
 	*x = OSSReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[29]
+		mi := &file_proto_data_source_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2883,7 +3803,7 @@ func (x *OSSReadResponse) String() string {
 func (*OSSReadResponse) ProtoMessage() {}
 
 func (x *OSSReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[29]
+	mi := &file_proto_data_source_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2896,7 +3816,7 @@ func (x *OSSReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSSReadResponse.ProtoReflect.Descriptor instead.
 func (*OSSReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{29}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *OSSReadResponse) GetSuccess() bool {
@@ -2950,7 +3870,7 @@ type SparkDBConnInfo struct {
 func (x *SparkDBConnInfo) Reset() {
 	*x = SparkDBConnInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[30]
+		mi := &file_proto_data_source_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2963,7 +3883,7 @@ func (x *SparkDBConnInfo) String() string {
 func (*SparkDBConnInfo) ProtoMessage() {}
 
 func (x *SparkDBConnInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[30]
+	mi := &file_proto_data_source_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,7 +3896,7 @@ func (x *SparkDBConnInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SparkDBConnInfo.ProtoReflect.Descriptor instead.
 func (*SparkDBConnInfo) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{30}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SparkDBConnInfo) GetDbType() string {
@@ -3138,7 +4058,7 @@ type SparkConfig struct {
 func (x *SparkConfig) Reset() {
 	*x = SparkConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[31]
+		mi := &file_proto_data_source_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3151,7 +4071,7 @@ func (x *SparkConfig) String() string {
 func (*SparkConfig) ProtoMessage() {}
 
 func (x *SparkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[31]
+	mi := &file_proto_data_source_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3164,7 +4084,7 @@ func (x *SparkConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SparkConfig.ProtoReflect.Descriptor instead.
 func (*SparkConfig) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{31}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SparkConfig) GetDynamicAllocationEnabled() bool {
@@ -3245,7 +4165,7 @@ type TableInfoRequest struct {
 func (x *TableInfoRequest) Reset() {
 	*x = TableInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[32]
+		mi := &file_proto_data_source_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3258,7 +4178,7 @@ func (x *TableInfoRequest) String() string {
 func (*TableInfoRequest) ProtoMessage() {}
 
 func (x *TableInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[32]
+	mi := &file_proto_data_source_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3271,7 +4191,7 @@ func (x *TableInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableInfoRequest.ProtoReflect.Descriptor instead.
 func (*TableInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{32}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TableInfoRequest) GetAssetName() string {
@@ -3318,12 +4238,13 @@ type TableInfoResponse struct {
 	RecordCount int32         `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"` // 表数据条数
 	RecordSize  int64         `protobuf:"varint,3,opt,name=recordSize,proto3" json:"recordSize,omitempty"`   // 表数据大小（字节）
 	Columns     []*ColumnItem `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`          // 表结构信息
+	Keys        []*TableKey   `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`                // 主键、唯一键、外键与索引，单列自增主键额外以 KEY_TYPE_AUTO_INCREMENT 列出
 }
 
 func (x *TableInfoResponse) Reset() {
 	*x = TableInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[33]
+		mi := &file_proto_data_source_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3336,7 +4257,7 @@ func (x *TableInfoResponse) String() string {
 func (*TableInfoResponse) ProtoMessage() {}
 
 func (x *TableInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[33]
+	mi := &file_proto_data_source_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3349,7 +4270,7 @@ func (x *TableInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableInfoResponse.ProtoReflect.Descriptor instead.
 func (*TableInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{33}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TableInfoResponse) GetTableName() string {
@@ -3380,36 +4301,48 @@ func (x *TableInfoResponse) GetColumns() []*ColumnItem {
 	return nil
 }
 
-type GroupCountRequest struct {
+func (x *TableInfoResponse) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ProfileTableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`   // 过滤字段
-	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"` // 过滤字段值
-	GroupByFields   []string         `protobuf:"bytes,4,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"`
-	DbName          string           `protobuf:"bytes,5,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	FilterOperators []FilterOperator `protobuf:"varint,6,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
-}
-
-func (x *GroupCountRequest) Reset() {
-	*x = GroupCountRequest{}
+	// Types that are assignable to DataSource:
+	//
+	//	*ProfileTableRequest_External
+	//	*ProfileTableRequest_Doris
+	DataSource isProfileTableRequest_DataSource `protobuf_oneof:"data_source"`
+	RequestId  string                           `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Columns    []string                         `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"` // 需要统计的字段，为空时统计全部字段
+	Mode       ProfileMode                      `protobuf:"varint,5,opt,name=mode,proto3,enum=datasource.ProfileMode" json:"mode,omitempty"`
+	SampleRows int64                            `protobuf:"varint,6,opt,name=sampleRows,proto3" json:"sampleRows,omitempty"` // 抽样行数，为 0 时使用默认值 100000，最多 1000000
+	TopK       int32                            `protobuf:"varint,7,opt,name=topK,proto3" json:"topK,omitempty"`             // 每个字段返回的高频值个数，为 0 时使用默认值 10
+	Refresh    bool                             `protobuf:"varint,8,opt,name=refresh,proto3" json:"refresh,omitempty"`       // 忽略缓存重新统计
+}
+
+func (x *ProfileTableRequest) Reset() {
+	*x = ProfileTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[34]
+		mi := &file_proto_data_source_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupCountRequest) String() string {
+func (x *ProfileTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupCountRequest) ProtoMessage() {}
+func (*ProfileTableRequest) ProtoMessage() {}
 
-func (x *GroupCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[34]
+func (x *ProfileTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,79 +4353,117 @@ func (x *GroupCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupCountRequest.ProtoReflect.Descriptor instead.
-func (*GroupCountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ProfileTableRequest.ProtoReflect.Descriptor instead.
+func (*ProfileTableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GroupCountRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
+func (m *ProfileTableRequest) GetDataSource() isProfileTableRequest_DataSource {
+	if m != nil {
+		return m.DataSource
 	}
-	return ""
+	return nil
 }
 
-func (x *GroupCountRequest) GetFilterNames() []string {
-	if x != nil {
-		return x.FilterNames
+func (x *ProfileTableRequest) GetExternal() *ExternalDataSource {
+	if x, ok := x.GetDataSource().(*ProfileTableRequest_External); ok {
+		return x.External
 	}
 	return nil
 }
 
-func (x *GroupCountRequest) GetFilterValues() []*FilterValue {
-	if x != nil {
-		return x.FilterValues
+func (x *ProfileTableRequest) GetDoris() *DorisDataSource {
+	if x, ok := x.GetDataSource().(*ProfileTableRequest_Doris); ok {
+		return x.Doris
 	}
 	return nil
 }
 
-func (x *GroupCountRequest) GetGroupByFields() []string {
+func (x *ProfileTableRequest) GetRequestId() string {
 	if x != nil {
-		return x.GroupByFields
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupCountRequest) GetDbName() string {
+func (x *ProfileTableRequest) GetColumns() []string {
 	if x != nil {
-		return x.DbName
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-func (x *GroupCountRequest) GetFilterOperators() []FilterOperator {
+func (x *ProfileTableRequest) GetMode() ProfileMode {
 	if x != nil {
-		return x.FilterOperators
+		return x.Mode
 	}
-	return nil
+	return ProfileMode_PROFILE_MODE_EXACT
 }
 
-type GroupCountResponse struct {
+func (x *ProfileTableRequest) GetSampleRows() int64 {
+	if x != nil {
+		return x.SampleRows
+	}
+	return 0
+}
+
+func (x *ProfileTableRequest) GetTopK() int32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *ProfileTableRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type isProfileTableRequest_DataSource interface {
+	isProfileTableRequest_DataSource()
+}
+
+type ProfileTableRequest_External struct {
+	External *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3,oneof"` // 外部数据资产
+}
+
+type ProfileTableRequest_Doris struct {
+	Doris *DorisDataSource `protobuf:"bytes,2,opt,name=doris,proto3,oneof"` // doris内部表
+}
+
+func (*ProfileTableRequest_External) isProfileTableRequest_DataSource() {}
+
+func (*ProfileTableRequest_Doris) isProfileTableRequest_DataSource() {}
+
+// 取值及其出现次数
+type ValueCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName   string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	RecordCount int64  `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GroupCountResponse) Reset() {
-	*x = GroupCountResponse{}
+func (x *ValueCount) Reset() {
+	*x = ValueCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[35]
+		mi := &file_proto_data_source_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupCountResponse) String() string {
+func (x *ValueCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupCountResponse) ProtoMessage() {}
+func (*ValueCount) ProtoMessage() {}
 
-func (x *GroupCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[35]
+func (x *ValueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3503,50 +4474,57 @@ func (x *GroupCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupCountResponse.ProtoReflect.Descriptor instead.
-func (*GroupCountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ValueCount.ProtoReflect.Descriptor instead.
+func (*ValueCount) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GroupCountResponse) GetTableName() string {
+func (x *ValueCount) GetValue() string {
 	if x != nil {
-		return x.TableName
+		return x.Value
 	}
 	return ""
 }
 
-func (x *GroupCountResponse) GetRecordCount() int64 {
+func (x *ValueCount) GetCount() int64 {
 	if x != nil {
-		return x.RecordCount
+		return x.Count
 	}
 	return 0
 }
 
-type TruncateTableRequest struct {
+type ColumnProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataType      string        `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	NullCount     int64         `protobuf:"varint,3,opt,name=nullCount,proto3" json:"nullCount,omitempty"`
+	NullRatio     float64       `protobuf:"fixed64,4,opt,name=nullRatio,proto3" json:"nullRatio,omitempty"`        // 空值行数 / 统计行数
+	DistinctCount int64         `protobuf:"varint,5,opt,name=distinctCount,proto3" json:"distinctCount,omitempty"` // 不同的非空取值个数，不可比较的类型（如 JSON、二进制）不统计
+	MinValue      string        `protobuf:"bytes,6,opt,name=minValue,proto3" json:"minValue,omitempty"`            // 最小值的字符串形式，全为空或不可比较时为空串
+	MaxValue      string        `protobuf:"bytes,7,opt,name=maxValue,proto3" json:"maxValue,omitempty"`
+	TopValues     []*ValueCount `protobuf:"bytes,8,rep,name=topValues,proto3" json:"topValues,omitempty"` // 出现次数最多的非空取值，按次数降序
 }
 
-func (x *TruncateTableRequest) Reset() {
-	*x = TruncateTableRequest{}
+func (x *ColumnProfile) Reset() {
+	*x = ColumnProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[36]
+		mi := &file_proto_data_source_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TruncateTableRequest) String() string {
+func (x *ColumnProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TruncateTableRequest) ProtoMessage() {}
+func (*ColumnProfile) ProtoMessage() {}
 
-func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[36]
+func (x *ColumnProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,104 +4535,98 @@ func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TruncateTableRequest.ProtoReflect.Descriptor instead.
-func (*TruncateTableRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ColumnProfile.ProtoReflect.Descriptor instead.
+func (*ColumnProfile) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *TruncateTableRequest) GetTableName() string {
+func (x *ColumnProfile) GetName() string {
 	if x != nil {
-		return x.TableName
+		return x.Name
 	}
 	return ""
 }
 
-type TruncateTableResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *ColumnProfile) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
 }
 
-func (x *TruncateTableResponse) Reset() {
-	*x = TruncateTableResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ColumnProfile) GetNullCount() int64 {
+	if x != nil {
+		return x.NullCount
 	}
+	return 0
 }
 
-func (x *TruncateTableResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ColumnProfile) GetNullRatio() float64 {
+	if x != nil {
+		return x.NullRatio
+	}
+	return 0
 }
 
-func (*TruncateTableResponse) ProtoMessage() {}
-
-func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ColumnProfile) GetDistinctCount() int64 {
+	if x != nil {
+		return x.DistinctCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TruncateTableResponse.ProtoReflect.Descriptor instead.
-func (*TruncateTableResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{37}
+func (x *ColumnProfile) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
 }
 
-func (x *TruncateTableResponse) GetSuccess() bool {
+func (x *ColumnProfile) GetMaxValue() string {
 	if x != nil {
-		return x.Success
+		return x.MaxValue
 	}
-	return false
+	return ""
 }
 
-func (x *TruncateTableResponse) GetMessage() string {
+func (x *ColumnProfile) GetTopValues() []*ValueCount {
 	if x != nil {
-		return x.Message
+		return x.TopValues
 	}
-	return ""
+	return nil
 }
 
-// 推送任务结果请求
-type PushJobResultRequest struct {
+type ProfileTableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
-	ChainInfoId   string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	PartyId       string `protobuf:"bytes,3,opt,name=partyId,proto3" json:"partyId,omitempty"`
-	DataId        string `protobuf:"bytes,4,opt,name=dataId,proto3" json:"dataId,omitempty"`
-	IsEncrypted   bool   `protobuf:"varint,5,opt,name=isEncrypted,proto3" json:"isEncrypted,omitempty"`
-	PubKey        string `protobuf:"bytes,6,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	TableName     string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	RowCount      int64            `protobuf:"varint,2,opt,name=rowCount,proto3" json:"rowCount,omitempty"` // 参与统计的行数，抽样统计时为样本行数
+	Mode          ProfileMode      `protobuf:"varint,3,opt,name=mode,proto3,enum=datasource.ProfileMode" json:"mode,omitempty"`
+	SchemaVersion string           `protobuf:"bytes,4,opt,name=schemaVersion,proto3" json:"schemaVersion,omitempty"` // 表结构摘要，字段名或类型变化后随之变化
+	ProfiledAt    int64            `protobuf:"varint,5,opt,name=profiledAt,proto3" json:"profiledAt,omitempty"`      // 统计时间，Unix 时间戳（秒）
+	Cached        bool             `protobuf:"varint,6,opt,name=cached,proto3" json:"cached,omitempty"`              // 是否为缓存的结果
+	Columns       []*ColumnProfile `protobuf:"bytes,7,rep,name=columns,proto3" json:"columns,omitempty"`
 }
 
-func (x *PushJobResultRequest) Reset() {
-	*x = PushJobResultRequest{}
+func (x *ProfileTableResponse) Reset() {
+	*x = ProfileTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[38]
+		mi := &file_proto_data_source_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushJobResultRequest) String() string {
+func (x *ProfileTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushJobResultRequest) ProtoMessage() {}
+func (*ProfileTableResponse) ProtoMessage() {}
 
-func (x *PushJobResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[38]
+func (x *ProfileTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3665,79 +4637,91 @@ func (x *PushJobResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushJobResultRequest.ProtoReflect.Descriptor instead.
-func (*PushJobResultRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ProfileTableResponse.ProtoReflect.Descriptor instead.
+func (*ProfileTableResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *PushJobResultRequest) GetJobInstanceId() string {
+func (x *ProfileTableResponse) GetTableName() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *PushJobResultRequest) GetChainInfoId() string {
+func (x *ProfileTableResponse) GetRowCount() int64 {
 	if x != nil {
-		return x.ChainInfoId
+		return x.RowCount
 	}
-	return ""
+	return 0
 }
 
-func (x *PushJobResultRequest) GetPartyId() string {
+func (x *ProfileTableResponse) GetMode() ProfileMode {
 	if x != nil {
-		return x.PartyId
+		return x.Mode
 	}
-	return ""
+	return ProfileMode_PROFILE_MODE_EXACT
 }
 
-func (x *PushJobResultRequest) GetDataId() string {
+func (x *ProfileTableResponse) GetSchemaVersion() string {
 	if x != nil {
-		return x.DataId
+		return x.SchemaVersion
 	}
 	return ""
 }
 
-func (x *PushJobResultRequest) GetIsEncrypted() bool {
+func (x *ProfileTableResponse) GetProfiledAt() int64 {
 	if x != nil {
-		return x.IsEncrypted
+		return x.ProfiledAt
+	}
+	return 0
+}
+
+func (x *ProfileTableResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
 	}
 	return false
 }
 
-func (x *PushJobResultRequest) GetPubKey() string {
+func (x *ProfileTableResponse) GetColumns() []*ColumnProfile {
 	if x != nil {
-		return x.PubKey
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-type PushJobResultResponse struct {
+type GroupCountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TableName        string            `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	FilterNames      []string          `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`   // 过滤字段
+	FilterValues     []*FilterValue    `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"` // 过滤字段值
+	GroupByFields    []string          `protobuf:"bytes,4,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"`
+	DbName           string            `protobuf:"bytes,5,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	FilterOperators  []FilterOperator  `protobuf:"varint,6,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
+	FilterExpression *FilterExpression `protobuf:"bytes,7,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"`                                      // 过滤表达式，与 filterNames 等平铺条件以 AND 组合
 }
 
-func (x *PushJobResultResponse) Reset() {
-	*x = PushJobResultResponse{}
+func (x *GroupCountRequest) Reset() {
+	*x = GroupCountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[39]
+		mi := &file_proto_data_source_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushJobResultResponse) String() string {
+func (x *GroupCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushJobResultResponse) ProtoMessage() {}
+func (*GroupCountRequest) ProtoMessage() {}
 
-func (x *PushJobResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[39]
+func (x *GroupCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3748,55 +4732,86 @@ func (x *PushJobResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushJobResultResponse.ProtoReflect.Descriptor instead.
-func (*PushJobResultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GroupCountRequest.ProtoReflect.Descriptor instead.
+func (*GroupCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PushJobResultResponse) GetSuccess() bool {
+func (x *GroupCountRequest) GetTableName() string {
 	if x != nil {
-		return x.Success
+		return x.TableName
 	}
-	return false
+	return ""
 }
 
-func (x *PushJobResultResponse) GetMessage() string {
+func (x *GroupCountRequest) GetFilterNames() []string {
 	if x != nil {
-		return x.Message
+		return x.FilterNames
+	}
+	return nil
+}
+
+func (x *GroupCountRequest) GetFilterValues() []*FilterValue {
+	if x != nil {
+		return x.FilterValues
+	}
+	return nil
+}
+
+func (x *GroupCountRequest) GetGroupByFields() []string {
+	if x != nil {
+		return x.GroupByFields
+	}
+	return nil
+}
+
+func (x *GroupCountRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
 	}
 	return ""
 }
 
-type JobResultExternalDBInfo struct {
+func (x *GroupCountRequest) GetFilterOperators() []FilterOperator {
+	if x != nil {
+		return x.FilterOperators
+	}
+	return nil
+}
+
+func (x *GroupCountRequest) GetFilterExpression() *FilterExpression {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return nil
+}
+
+type GroupCountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResultStorageType int32  `protobuf:"varint,1,opt,name=resultStorageType,proto3" json:"resultStorageType,omitempty"`
-	Host              string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Port              int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	DB                string `protobuf:"bytes,4,opt,name=DB,proto3" json:"DB,omitempty"`
-	User              string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
-	Password          string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	TableName   string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	RecordCount int64  `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
 }
 
-func (x *JobResultExternalDBInfo) Reset() {
-	*x = JobResultExternalDBInfo{}
+func (x *GroupCountResponse) Reset() {
+	*x = GroupCountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[40]
+		mi := &file_proto_data_source_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobResultExternalDBInfo) String() string {
+func (x *GroupCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResultExternalDBInfo) ProtoMessage() {}
+func (*GroupCountResponse) ProtoMessage() {}
 
-func (x *JobResultExternalDBInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[40]
+func (x *GroupCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,95 +4822,125 @@ func (x *JobResultExternalDBInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResultExternalDBInfo.ProtoReflect.Descriptor instead.
-func (*JobResultExternalDBInfo) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GroupCountResponse.ProtoReflect.Descriptor instead.
+func (*GroupCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *JobResultExternalDBInfo) GetResultStorageType() int32 {
+func (x *GroupCountResponse) GetTableName() string {
 	if x != nil {
-		return x.ResultStorageType
+		return x.TableName
 	}
-	return 0
+	return ""
 }
 
-func (x *JobResultExternalDBInfo) GetHost() string {
+func (x *GroupCountResponse) GetRecordCount() int64 {
 	if x != nil {
-		return x.Host
+		return x.RecordCount
 	}
-	return ""
+	return 0
 }
 
-func (x *JobResultExternalDBInfo) GetPort() int32 {
-	if x != nil {
-		return x.Port
+type AggregateField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function  AggregateFunction `protobuf:"varint,1,opt,name=function,proto3,enum=datasource.AggregateFunction" json:"function,omitempty"`
+	FieldName string            `protobuf:"bytes,2,opt,name=fieldName,proto3" json:"fieldName,omitempty"` // 聚合的字段，只有 COUNT 可以为空
+	Alias     string            `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`         // 结果列名，为空时为 函数名_字段名，如 sum_amount；COUNT(*) 为 count
+}
+
+func (x *AggregateField) Reset() {
+	*x = AggregateField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *JobResultExternalDBInfo) GetDB() string {
+func (x *AggregateField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateField) ProtoMessage() {}
+
+func (x *AggregateField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateField.ProtoReflect.Descriptor instead.
+func (*AggregateField) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AggregateField) GetFunction() AggregateFunction {
 	if x != nil {
-		return x.DB
+		return x.Function
 	}
-	return ""
+	return AggregateFunction_AGGREGATE_FUNCTION_COUNT
 }
 
-func (x *JobResultExternalDBInfo) GetUser() string {
+func (x *AggregateField) GetFieldName() string {
 	if x != nil {
-		return x.User
+		return x.FieldName
 	}
 	return ""
 }
 
-func (x *JobResultExternalDBInfo) GetPassword() string {
+func (x *AggregateField) GetAlias() string {
 	if x != nil {
-		return x.Password
+		return x.Alias
 	}
 	return ""
 }
 
-type DatasourceTlsConfig struct {
+type AggregateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 是否开启 tls，1-不开启，2-开启
-	UseTls int32 `protobuf:"varint,1,opt,name=useTls,proto3" json:"useTls,omitempty"`
-	// tls 模式，1-Require，2-Verify CA，3-Full Verification
-	Mode int32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
-	// tls CA 证书 base64 内容
-	CaCert string `protobuf:"bytes,3,opt,name=caCert,proto3" json:"caCert,omitempty"`
-	// tls CA 证书文件名
-	CaCertFilename string `protobuf:"bytes,4,opt,name=caCertFilename,proto3" json:"caCertFilename,omitempty"`
-	// 服务器主机名
-	ServerName string `protobuf:"bytes,5,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	// tls 客户端证书 base64 内容
-	ClientCert string `protobuf:"bytes,6,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
-	// tls 客户端证书文件名
-	ClientCertFilename string `protobuf:"bytes,7,opt,name=clientCertFilename,proto3" json:"clientCertFilename,omitempty"`
-	// tls 客户端私钥 base64 内容
-	ClientKey string `protobuf:"bytes,8,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
-	// tls 客户端私钥文件名
-	ClientKeyFilename string `protobuf:"bytes,9,opt,name=clientKeyFilename,proto3" json:"clientKeyFilename,omitempty"`
-}
-
-func (x *DatasourceTlsConfig) Reset() {
-	*x = DatasourceTlsConfig{}
+	// Types that are assignable to DataSource:
+	//
+	//	*AggregateRequest_Internal
+	//	*AggregateRequest_Doris
+	DataSource       isAggregateRequest_DataSource `protobuf_oneof:"data_source"`
+	GroupByFields    []string                      `protobuf:"bytes,3,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"` // 分组字段，为空时整表聚合为一行
+	Aggregates       []*AggregateField             `protobuf:"bytes,4,rep,name=aggregates,proto3" json:"aggregates,omitempty"`       // 聚合列，为空时只返回每组的 COUNT(*)
+	FilterNames      []string                      `protobuf:"bytes,5,rep,name=filterNames,proto3" json:"filterNames,omitempty"`     // 分组前的过滤条件
+	FilterOperators  []FilterOperator              `protobuf:"varint,6,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
+	FilterValues     []*FilterValue                `protobuf:"bytes,7,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
+	FilterExpression *FilterExpression             `protobuf:"bytes,8,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"` // 与 filterNames 等平铺条件以 AND 组合
+	Having           *FilterExpression             `protobuf:"bytes,9,opt,name=having,proto3" json:"having,omitempty"`                     // 分组后的过滤条件，字段名为聚合列的结果列名或分组字段
+	SortRules        []*SortRule                   `protobuf:"bytes,10,rep,name=sortRules,proto3" json:"sortRules,omitempty"`              // 排序规则，字段名为聚合列的结果列名或分组字段
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[41]
+		mi := &file_proto_data_source_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DatasourceTlsConfig) String() string {
+func (x *AggregateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatasourceTlsConfig) ProtoMessage() {}
+func (*AggregateRequest) ProtoMessage() {}
 
-func (x *DatasourceTlsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[41]
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3906,100 +4951,129 @@ func (x *DatasourceTlsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatasourceTlsConfig.ProtoReflect.Descriptor instead.
-func (*DatasourceTlsConfig) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DatasourceTlsConfig) GetUseTls() int32 {
-	if x != nil {
-		return x.UseTls
+func (m *AggregateRequest) GetDataSource() isAggregateRequest_DataSource {
+	if m != nil {
+		return m.DataSource
 	}
-	return 0
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetMode() int32 {
+func (x *AggregateRequest) GetInternal() *InternalDataSource {
+	if x, ok := x.GetDataSource().(*AggregateRequest_Internal); ok {
+		return x.Internal
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetDoris() *DorisDataSource {
+	if x, ok := x.GetDataSource().(*AggregateRequest_Doris); ok {
+		return x.Doris
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetGroupByFields() []string {
 	if x != nil {
-		return x.Mode
+		return x.GroupByFields
 	}
-	return 0
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetCaCert() string {
+func (x *AggregateRequest) GetAggregates() []*AggregateField {
 	if x != nil {
-		return x.CaCert
+		return x.Aggregates
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetCaCertFilename() string {
+func (x *AggregateRequest) GetFilterNames() []string {
 	if x != nil {
-		return x.CaCertFilename
+		return x.FilterNames
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetServerName() string {
+func (x *AggregateRequest) GetFilterOperators() []FilterOperator {
 	if x != nil {
-		return x.ServerName
+		return x.FilterOperators
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetClientCert() string {
+func (x *AggregateRequest) GetFilterValues() []*FilterValue {
 	if x != nil {
-		return x.ClientCert
+		return x.FilterValues
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetClientCertFilename() string {
+func (x *AggregateRequest) GetFilterExpression() *FilterExpression {
 	if x != nil {
-		return x.ClientCertFilename
+		return x.FilterExpression
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetClientKey() string {
+func (x *AggregateRequest) GetHaving() *FilterExpression {
 	if x != nil {
-		return x.ClientKey
+		return x.Having
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetClientKeyFilename() string {
+func (x *AggregateRequest) GetSortRules() []*SortRule {
 	if x != nil {
-		return x.ClientKeyFilename
+		return x.SortRules
 	}
-	return ""
+	return nil
 }
 
-type ExecuteDorisSQLRequest struct {
+type isAggregateRequest_DataSource interface {
+	isAggregateRequest_DataSource()
+}
+
+type AggregateRequest_Internal struct {
+	Internal *InternalDataSource `protobuf:"bytes,1,opt,name=internal,proto3,oneof"` // 内置数据库表
+}
+
+type AggregateRequest_Doris struct {
+	Doris *DorisDataSource `protobuf:"bytes,2,opt,name=doris,proto3,oneof"` // doris内部表
+}
+
+func (*AggregateRequest_Internal) isAggregateRequest_DataSource() {}
+
+func (*AggregateRequest_Doris) isAggregateRequest_DataSource() {}
+
+type TruncateTableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sql  string   `protobuf:"bytes,4,opt,name=sql,proto3" json:"sql,omitempty"`   // 要执行的Doris SQL语句
-	Args []string `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"` // SQL参数（全部转为字符串传递）
+	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
 }
 
-func (x *ExecuteDorisSQLRequest) Reset() {
-	*x = ExecuteDorisSQLRequest{}
+func (x *TruncateTableRequest) Reset() {
+	*x = TruncateTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[42]
+		mi := &file_proto_data_source_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecuteDorisSQLRequest) String() string {
+func (x *TruncateTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteDorisSQLRequest) ProtoMessage() {}
+func (*TruncateTableRequest) ProtoMessage() {}
 
-func (x *ExecuteDorisSQLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[42]
+func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4010,53 +5084,44 @@ func (x *ExecuteDorisSQLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteDorisSQLRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteDorisSQLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use TruncateTableRequest.ProtoReflect.Descriptor instead.
+func (*TruncateTableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ExecuteDorisSQLRequest) GetSql() string {
+func (x *TruncateTableRequest) GetTableName() string {
 	if x != nil {
-		return x.Sql
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *ExecuteDorisSQLRequest) GetArgs() []string {
-	if x != nil {
-		return x.Args
-	}
-	return nil
-}
-
-type ExecuteDorisSQLResponse struct {
+type TruncateTableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// 如果是查询，返回结果集；如果是更新等，返回受影响行数
-	Rows []*DorisSQLRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"` // 查询结果（每行是一个map）
 }
 
-func (x *ExecuteDorisSQLResponse) Reset() {
-	*x = ExecuteDorisSQLResponse{}
+func (x *TruncateTableResponse) Reset() {
+	*x = TruncateTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[43]
+		mi := &file_proto_data_source_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecuteDorisSQLResponse) String() string {
+func (x *TruncateTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteDorisSQLResponse) ProtoMessage() {}
+func (*TruncateTableResponse) ProtoMessage() {}
 
-func (x *ExecuteDorisSQLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[43]
+func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4067,57 +5132,59 @@ func (x *ExecuteDorisSQLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteDorisSQLResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteDorisSQLResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use TruncateTableResponse.ProtoReflect.Descriptor instead.
+func (*TruncateTableResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ExecuteDorisSQLResponse) GetSuccess() bool {
+func (x *TruncateTableResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ExecuteDorisSQLResponse) GetMessage() string {
+func (x *TruncateTableResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ExecuteDorisSQLResponse) GetRows() []*DorisSQLRow {
-	if x != nil {
-		return x.Rows
-	}
-	return nil
-}
-
-type DorisSQLRow struct {
+// 推送任务结果请求
+type PushJobResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Columns map[string]string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 列名到字符串值的映射
+	JobInstanceId string        `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
+	ChainInfoId   string        `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	PartyId       string        `protobuf:"bytes,3,opt,name=partyId,proto3" json:"partyId,omitempty"`
+	DataId        string        `protobuf:"bytes,4,opt,name=dataId,proto3" json:"dataId,omitempty"`
+	IsEncrypted   bool          `protobuf:"varint,5,opt,name=isEncrypted,proto3" json:"isEncrypted,omitempty"`
+	PubKey        string        `protobuf:"bytes,6,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	WriteMode     WriteMode     `protobuf:"varint,7,opt,name=writeMode,proto3,enum=datasource.WriteMode" json:"writeMode,omitempty"`             // 写入模式，默认追加
+	KeyColumns    []string      `protobuf:"bytes,8,rep,name=keyColumns,proto3" json:"keyColumns,omitempty"`                                      // upsert/忽略冲突时判断行是否已存在的键列
+	ReconcileMode ReconcileMode `protobuf:"varint,9,opt,name=reconcileMode,proto3,enum=datasource.ReconcileMode" json:"reconcileMode,omitempty"` // 结果文件与结果表的对账强度，按键去重的写入模式和追加到非空表时不对账
 }
 
-func (x *DorisSQLRow) Reset() {
-	*x = DorisSQLRow{}
+func (x *PushJobResultRequest) Reset() {
+	*x = PushJobResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[44]
+		mi := &file_proto_data_source_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DorisSQLRow) String() string {
+func (x *PushJobResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DorisSQLRow) ProtoMessage() {}
+func (*PushJobResultRequest) ProtoMessage() {}
 
-func (x *DorisSQLRow) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[44]
+func (x *PushJobResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4128,114 +5195,101 @@ func (x *DorisSQLRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DorisSQLRow.ProtoReflect.Descriptor instead.
-func (*DorisSQLRow) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use PushJobResultRequest.ProtoReflect.Descriptor instead.
+func (*PushJobResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DorisSQLRow) GetColumns() map[string]string {
+func (x *PushJobResultRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.Columns
+		return x.JobInstanceId
 	}
-	return nil
+	return ""
 }
 
-type CreateExternalAndInternalTableAndImportDataRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AssetName     string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`
-	ChainInfoId   string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	Alias         string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
-}
-
-func (x *CreateExternalAndInternalTableAndImportDataRequest) Reset() {
-	*x = CreateExternalAndInternalTableAndImportDataRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PushJobResultRequest) GetChainInfoId() string {
+	if x != nil {
+		return x.ChainInfoId
 	}
+	return ""
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PushJobResultRequest) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
 }
 
-func (*CreateExternalAndInternalTableAndImportDataRequest) ProtoMessage() {}
-
-func (x *CreateExternalAndInternalTableAndImportDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PushJobResultRequest) GetDataId() string {
+	if x != nil {
+		return x.DataId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateExternalAndInternalTableAndImportDataRequest.ProtoReflect.Descriptor instead.
-func (*CreateExternalAndInternalTableAndImportDataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{45}
+func (x *PushJobResultRequest) GetIsEncrypted() bool {
+	if x != nil {
+		return x.IsEncrypted
+	}
+	return false
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAssetName() string {
+func (x *PushJobResultRequest) GetPubKey() string {
 	if x != nil {
-		return x.AssetName
+		return x.PubKey
 	}
 	return ""
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetChainInfoId() string {
+func (x *PushJobResultRequest) GetWriteMode() WriteMode {
 	if x != nil {
-		return x.ChainInfoId
+		return x.WriteMode
 	}
-	return ""
+	return WriteMode_WRITE_MODE_APPEND
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAlias() string {
+func (x *PushJobResultRequest) GetKeyColumns() []string {
 	if x != nil {
-		return x.Alias
+		return x.KeyColumns
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetJobInstanceId() string {
+func (x *PushJobResultRequest) GetReconcileMode() ReconcileMode {
 	if x != nil {
-		return x.JobInstanceId
+		return x.ReconcileMode
 	}
-	return ""
+	return ReconcileMode_RECONCILE_MODE_UNSPECIFIED
 }
 
-type CreateExternalAndInternalTableAndImportDataResponse struct {
+type PushJobResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 存储在doris中的表名
+	Success        bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reconciliation *ReconcileReport `protobuf:"bytes,3,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"` // 结果文件与结果表的对账结果，未对账时为空
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) Reset() {
-	*x = CreateExternalAndInternalTableAndImportDataResponse{}
+func (x *PushJobResultResponse) Reset() {
+	*x = PushJobResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[46]
+		mi := &file_proto_data_source_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) String() string {
+func (x *PushJobResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExternalAndInternalTableAndImportDataResponse) ProtoMessage() {}
+func (*PushJobResultResponse) ProtoMessage() {}
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[46]
+func (x *PushJobResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4246,48 +5300,62 @@ func (x *CreateExternalAndInternalTableAndImportDataResponse) ProtoReflect() pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExternalAndInternalTableAndImportDataResponse.ProtoReflect.Descriptor instead.
-func (*CreateExternalAndInternalTableAndImportDataResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use PushJobResultResponse.ProtoReflect.Descriptor instead.
+func (*PushJobResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) GetTableName() string {
+func (x *PushJobResultResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TableName
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushJobResultResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type ImportCsvFileToDorisRequest struct {
+func (x *PushJobResultResponse) GetReconciliation() *ReconcileReport {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+type JobResultExternalDBInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BucketName      string   `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	ObjectName      string   `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"`
-	TableName       string   `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbName          string   `protobuf:"bytes,4,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	Columns         []string `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
-	ColumnSeparator string   `protobuf:"bytes,6,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
+	ResultStorageType int32  `protobuf:"varint,1,opt,name=resultStorageType,proto3" json:"resultStorageType,omitempty"`
+	Host              string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port              int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	DB                string `protobuf:"bytes,4,opt,name=DB,proto3" json:"DB,omitempty"`
+	User              string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Password          string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *ImportCsvFileToDorisRequest) Reset() {
-	*x = ImportCsvFileToDorisRequest{}
+func (x *JobResultExternalDBInfo) Reset() {
+	*x = JobResultExternalDBInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[47]
+		mi := &file_proto_data_source_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportCsvFileToDorisRequest) String() string {
+func (x *JobResultExternalDBInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportCsvFileToDorisRequest) ProtoMessage() {}
+func (*JobResultExternalDBInfo) ProtoMessage() {}
 
-func (x *ImportCsvFileToDorisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[47]
+func (x *JobResultExternalDBInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4298,85 +5366,95 @@ func (x *ImportCsvFileToDorisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportCsvFileToDorisRequest.ProtoReflect.Descriptor instead.
-func (*ImportCsvFileToDorisRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use JobResultExternalDBInfo.ProtoReflect.Descriptor instead.
+func (*JobResultExternalDBInfo) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ImportCsvFileToDorisRequest) GetBucketName() string {
+func (x *JobResultExternalDBInfo) GetResultStorageType() int32 {
 	if x != nil {
-		return x.BucketName
+		return x.ResultStorageType
 	}
-	return ""
+	return 0
 }
 
-func (x *ImportCsvFileToDorisRequest) GetObjectName() string {
+func (x *JobResultExternalDBInfo) GetHost() string {
 	if x != nil {
-		return x.ObjectName
+		return x.Host
 	}
 	return ""
 }
 
-func (x *ImportCsvFileToDorisRequest) GetTableName() string {
+func (x *JobResultExternalDBInfo) GetPort() int32 {
 	if x != nil {
-		return x.TableName
+		return x.Port
 	}
-	return ""
+	return 0
 }
 
-func (x *ImportCsvFileToDorisRequest) GetDbName() string {
+func (x *JobResultExternalDBInfo) GetDB() string {
 	if x != nil {
-		return x.DbName
+		return x.DB
 	}
 	return ""
 }
 
-func (x *ImportCsvFileToDorisRequest) GetColumns() []string {
+func (x *JobResultExternalDBInfo) GetUser() string {
 	if x != nil {
-		return x.Columns
+		return x.User
 	}
-	return nil
+	return ""
 }
 
-func (x *ImportCsvFileToDorisRequest) GetColumnSeparator() string {
+func (x *JobResultExternalDBInfo) GetPassword() string {
 	if x != nil {
-		return x.ColumnSeparator
+		return x.Password
 	}
 	return ""
 }
 
-type ExportCsvFileFromDorisRequest struct {
+type DatasourceTlsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId    string             `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
-	TableName        string             `protobuf:"bytes,2,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbName           string             `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	Columns          []string           `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
-	ColumnSeparator  string             `protobuf:"bytes,5,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
-	LineDelimiter    string             `protobuf:"bytes,6,opt,name=lineDelimiter,proto3" json:"lineDelimiter,omitempty"`
-	SortRules        []*SortRule        `protobuf:"bytes,7,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
-	FilterConditions []*FilterCondition `protobuf:"bytes,8,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	// 是否开启 tls，1-不开启，2-开启
+	UseTls int32 `protobuf:"varint,1,opt,name=useTls,proto3" json:"useTls,omitempty"`
+	// tls 模式，1-Require，2-Verify CA，3-Full Verification
+	Mode int32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// tls CA 证书 base64 内容
+	CaCert string `protobuf:"bytes,3,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// tls CA 证书文件名
+	CaCertFilename string `protobuf:"bytes,4,opt,name=caCertFilename,proto3" json:"caCertFilename,omitempty"`
+	// 服务器主机名
+	ServerName string `protobuf:"bytes,5,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// tls 客户端证书 base64 内容
+	ClientCert string `protobuf:"bytes,6,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
+	// tls 客户端证书文件名
+	ClientCertFilename string `protobuf:"bytes,7,opt,name=clientCertFilename,proto3" json:"clientCertFilename,omitempty"`
+	// tls 客户端私钥 base64 内容
+	ClientKey string `protobuf:"bytes,8,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	// tls 客户端私钥文件名
+	ClientKeyFilename string `protobuf:"bytes,9,opt,name=clientKeyFilename,proto3" json:"clientKeyFilename,omitempty"`
 }
 
-func (x *ExportCsvFileFromDorisRequest) Reset() {
-	*x = ExportCsvFileFromDorisRequest{}
+func (x *DatasourceTlsConfig) Reset() {
+	*x = DatasourceTlsConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[48]
+		mi := &file_proto_data_source_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportCsvFileFromDorisRequest) String() string {
+func (x *DatasourceTlsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportCsvFileFromDorisRequest) ProtoMessage() {}
+func (*DatasourceTlsConfig) ProtoMessage() {}
 
-func (x *ExportCsvFileFromDorisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[48]
+func (x *DatasourceTlsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4387,149 +5465,100 @@ func (x *ExportCsvFileFromDorisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportCsvFileFromDorisRequest.ProtoReflect.Descriptor instead.
-func (*ExportCsvFileFromDorisRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DatasourceTlsConfig.ProtoReflect.Descriptor instead.
+func (*DatasourceTlsConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetJobInstanceId() string {
+func (x *DatasourceTlsConfig) GetUseTls() int32 {
 	if x != nil {
-		return x.JobInstanceId
+		return x.UseTls
 	}
-	return ""
+	return 0
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetTableName() string {
+func (x *DatasourceTlsConfig) GetMode() int32 {
 	if x != nil {
-		return x.TableName
+		return x.Mode
 	}
-	return ""
+	return 0
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetDbName() string {
+func (x *DatasourceTlsConfig) GetCaCert() string {
 	if x != nil {
-		return x.DbName
+		return x.CaCert
 	}
 	return ""
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetColumns() []string {
+func (x *DatasourceTlsConfig) GetCaCertFilename() string {
 	if x != nil {
-		return x.Columns
+		return x.CaCertFilename
 	}
-	return nil
+	return ""
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetColumnSeparator() string {
+func (x *DatasourceTlsConfig) GetServerName() string {
 	if x != nil {
-		return x.ColumnSeparator
+		return x.ServerName
 	}
 	return ""
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetLineDelimiter() string {
+func (x *DatasourceTlsConfig) GetClientCert() string {
 	if x != nil {
-		return x.LineDelimiter
+		return x.ClientCert
 	}
 	return ""
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetSortRules() []*SortRule {
+func (x *DatasourceTlsConfig) GetClientCertFilename() string {
 	if x != nil {
-		return x.SortRules
+		return x.ClientCertFilename
 	}
-	return nil
+	return ""
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetFilterConditions() []*FilterCondition {
+func (x *DatasourceTlsConfig) GetClientKey() string {
 	if x != nil {
-		return x.FilterConditions
-	}
-	return nil
-}
-
-type ExportCsvFileFromDorisResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BucketName    string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
-}
-
-func (x *ExportCsvFileFromDorisResponse) Reset() {
-	*x = ExportCsvFileFromDorisResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[49]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ExportCsvFileFromDorisResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ExportCsvFileFromDorisResponse) ProtoMessage() {}
-
-func (x *ExportCsvFileFromDorisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[49]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ExportCsvFileFromDorisResponse.ProtoReflect.Descriptor instead.
-func (*ExportCsvFileFromDorisResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *ExportCsvFileFromDorisResponse) GetBucketName() string {
-	if x != nil {
-		return x.BucketName
+		return x.ClientKey
 	}
 	return ""
 }
 
-func (x *ExportCsvFileFromDorisResponse) GetJobInstanceId() string {
+func (x *DatasourceTlsConfig) GetClientKeyFilename() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.ClientKeyFilename
 	}
 	return ""
 }
 
-type ExportDorisDataToMiraDBRequest struct {
+type ExecuteDorisSQLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string        `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`             // 存储到mira数据库表名
-	SourceTableName string        `protobuf:"bytes,2,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 从doris导出数据表
-	Columns         []*ColumnItem `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`                 // 导出字段
+	Sql  string   `protobuf:"bytes,4,opt,name=sql,proto3" json:"sql,omitempty"`   // 要执行的Doris SQL语句
+	Args []string `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"` // SQL参数（全部转为字符串传递）
 }
 
-func (x *ExportDorisDataToMiraDBRequest) Reset() {
-	*x = ExportDorisDataToMiraDBRequest{}
+func (x *ExecuteDorisSQLRequest) Reset() {
+	*x = ExecuteDorisSQLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[50]
+		mi := &file_proto_data_source_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportDorisDataToMiraDBRequest) String() string {
+func (x *ExecuteDorisSQLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportDorisDataToMiraDBRequest) ProtoMessage() {}
+func (*ExecuteDorisSQLRequest) ProtoMessage() {}
 
-func (x *ExportDorisDataToMiraDBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[50]
+func (x *ExecuteDorisSQLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4540,58 +5569,53 @@ func (x *ExportDorisDataToMiraDBRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportDorisDataToMiraDBRequest.ProtoReflect.Descriptor instead.
-func (*ExportDorisDataToMiraDBRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *ExportDorisDataToMiraDBRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
+// Deprecated: Use ExecuteDorisSQLRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteDorisSQLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ExportDorisDataToMiraDBRequest) GetSourceTableName() string {
+func (x *ExecuteDorisSQLRequest) GetSql() string {
 	if x != nil {
-		return x.SourceTableName
+		return x.Sql
 	}
 	return ""
 }
 
-func (x *ExportDorisDataToMiraDBRequest) GetColumns() []*ColumnItem {
+func (x *ExecuteDorisSQLRequest) GetArgs() []string {
 	if x != nil {
-		return x.Columns
+		return x.Args
 	}
 	return nil
 }
 
-type ImportMiraDBDataToDorisRequest struct {
+type ExecuteDorisSQLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MiraTableName string `protobuf:"bytes,1,opt,name=miraTableName,proto3" json:"miraTableName,omitempty"` // mira中间表
-	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 如果是查询，返回结果集；如果是更新等，返回受影响行数
+	Rows []*DorisSQLRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"` // 查询结果（每行是一个map）
 }
 
-func (x *ImportMiraDBDataToDorisRequest) Reset() {
-	*x = ImportMiraDBDataToDorisRequest{}
+func (x *ExecuteDorisSQLResponse) Reset() {
+	*x = ExecuteDorisSQLResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[51]
+		mi := &file_proto_data_source_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportMiraDBDataToDorisRequest) String() string {
+func (x *ExecuteDorisSQLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportMiraDBDataToDorisRequest) ProtoMessage() {}
+func (*ExecuteDorisSQLResponse) ProtoMessage() {}
 
-func (x *ImportMiraDBDataToDorisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[51]
+func (x *ExecuteDorisSQLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4602,50 +5626,57 @@ func (x *ImportMiraDBDataToDorisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportMiraDBDataToDorisRequest.ProtoReflect.Descriptor instead.
-func (*ImportMiraDBDataToDorisRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ExecuteDorisSQLResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteDorisSQLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ImportMiraDBDataToDorisRequest) GetMiraTableName() string {
+func (x *ExecuteDorisSQLResponse) GetSuccess() bool {
 	if x != nil {
-		return x.MiraTableName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ImportMiraDBDataToDorisRequest) GetJobInstanceId() string {
+func (x *ExecuteDorisSQLResponse) GetMessage() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.Message
 	}
 	return ""
 }
 
-type ImportMiraDBDataToDorisResponse struct {
+func (x *ExecuteDorisSQLResponse) GetRows() []*DorisSQLRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type DorisSQLRow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DorisTableName string `protobuf:"bytes,1,opt,name=dorisTableName,proto3" json:"dorisTableName,omitempty"` // 存储在doris中的表名
+	Columns map[string]string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 列名到字符串值的映射
 }
 
-func (x *ImportMiraDBDataToDorisResponse) Reset() {
-	*x = ImportMiraDBDataToDorisResponse{}
+func (x *DorisSQLRow) Reset() {
+	*x = DorisSQLRow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[52]
+		mi := &file_proto_data_source_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportMiraDBDataToDorisResponse) String() string {
+func (x *DorisSQLRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportMiraDBDataToDorisResponse) ProtoMessage() {}
+func (*DorisSQLRow) ProtoMessage() {}
 
-func (x *ImportMiraDBDataToDorisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[52]
+func (x *DorisSQLRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4656,46 +5687,46 @@ func (x *ImportMiraDBDataToDorisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportMiraDBDataToDorisResponse.ProtoReflect.Descriptor instead.
-func (*ImportMiraDBDataToDorisResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use DorisSQLRow.ProtoReflect.Descriptor instead.
+func (*DorisSQLRow) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ImportMiraDBDataToDorisResponse) GetDorisTableName() string {
+func (x *DorisSQLRow) GetColumns() map[string]string {
 	if x != nil {
-		return x.DorisTableName
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-type InternalTableInfoRequest struct {
+type CreateExternalAndInternalTableAndImportDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName     string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	IsExactQuery  bool   `protobuf:"varint,3,opt,name=isExactQuery,proto3" json:"isExactQuery,omitempty"`
+	AssetName     string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`
+	ChainInfoId   string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	Alias         string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
 	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *InternalTableInfoRequest) Reset() {
-	*x = InternalTableInfoRequest{}
+func (x *CreateExternalAndInternalTableAndImportDataRequest) Reset() {
+	*x = CreateExternalAndInternalTableAndImportDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[53]
+		mi := &file_proto_data_source_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InternalTableInfoRequest) String() string {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalTableInfoRequest) ProtoMessage() {}
+func (*CreateExternalAndInternalTableAndImportDataRequest) ProtoMessage() {}
 
-func (x *InternalTableInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[53]
+func (x *CreateExternalAndInternalTableAndImportDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4706,64 +5737,64 @@ func (x *InternalTableInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalTableInfoRequest.ProtoReflect.Descriptor instead.
-func (*InternalTableInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use CreateExternalAndInternalTableAndImportDataRequest.ProtoReflect.Descriptor instead.
+func (*CreateExternalAndInternalTableAndImportDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *InternalTableInfoRequest) GetTableName() string {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAssetName() string {
 	if x != nil {
-		return x.TableName
+		return x.AssetName
 	}
 	return ""
 }
 
-func (x *InternalTableInfoRequest) GetDbName() string {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetChainInfoId() string {
 	if x != nil {
-		return x.DbName
+		return x.ChainInfoId
 	}
 	return ""
 }
 
-func (x *InternalTableInfoRequest) GetIsExactQuery() bool {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAlias() string {
 	if x != nil {
-		return x.IsExactQuery
+		return x.Alias
 	}
-	return false
+	return ""
 }
 
-func (x *InternalTableInfoRequest) GetJobInstanceId() string {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetJobInstanceId() string {
 	if x != nil {
 		return x.JobInstanceId
 	}
 	return ""
 }
 
-type CleanTmpDataRequest struct {
+type CreateExternalAndInternalTableAndImportDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 存储在doris中的表名
 }
 
-func (x *CleanTmpDataRequest) Reset() {
-	*x = CleanTmpDataRequest{}
+func (x *CreateExternalAndInternalTableAndImportDataResponse) Reset() {
+	*x = CreateExternalAndInternalTableAndImportDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[54]
+		mi := &file_proto_data_source_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanTmpDataRequest) String() string {
+func (x *CreateExternalAndInternalTableAndImportDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanTmpDataRequest) ProtoMessage() {}
+func (*CreateExternalAndInternalTableAndImportDataResponse) ProtoMessage() {}
 
-func (x *CleanTmpDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[54]
+func (x *CreateExternalAndInternalTableAndImportDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4774,44 +5805,49 @@ func (x *CleanTmpDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanTmpDataRequest.ProtoReflect.Descriptor instead.
-func (*CleanTmpDataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CreateExternalAndInternalTableAndImportDataResponse.ProtoReflect.Descriptor instead.
+func (*CreateExternalAndInternalTableAndImportDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CleanTmpDataRequest) GetJobInstanceId() string {
+func (x *CreateExternalAndInternalTableAndImportDataResponse) GetTableName() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.TableName
 	}
 	return ""
 }
 
-type GetRetryCleanupTasksRequest struct {
+type ImportCsvFileToDorisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	BucketName      string   `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	ObjectName      string   `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	TableName       string   `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbName          string   `protobuf:"bytes,4,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	Columns         []string `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnSeparator string   `protobuf:"bytes,6,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
+	Label           string   `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"` // Stream Load 标签，相同标签的导入只会成功一次，为空时随机生成
 }
 
-func (x *GetRetryCleanupTasksRequest) Reset() {
-	*x = GetRetryCleanupTasksRequest{}
+func (x *ImportCsvFileToDorisRequest) Reset() {
+	*x = ImportCsvFileToDorisRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[55]
+		mi := &file_proto_data_source_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRetryCleanupTasksRequest) String() string {
+func (x *ImportCsvFileToDorisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRetryCleanupTasksRequest) ProtoMessage() {}
+func (*ImportCsvFileToDorisRequest) ProtoMessage() {}
 
-func (x *GetRetryCleanupTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[55]
+func (x *ImportCsvFileToDorisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4822,53 +5858,95 @@ func (x *GetRetryCleanupTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRetryCleanupTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetRetryCleanupTasksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ImportCsvFileToDorisRequest.ProtoReflect.Descriptor instead.
+func (*ImportCsvFileToDorisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetRetryCleanupTasksRequest) GetPage() int32 {
+func (x *ImportCsvFileToDorisRequest) GetBucketName() string {
 	if x != nil {
-		return x.Page
+		return x.BucketName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRetryCleanupTasksRequest) GetPageSize() int32 {
+func (x *ImportCsvFileToDorisRequest) GetObjectName() string {
 	if x != nil {
-		return x.PageSize
+		return x.ObjectName
 	}
-	return 0
+	return ""
 }
 
-type GetRetryCleanupTasksResponse struct {
+func (x *ImportCsvFileToDorisRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *ImportCsvFileToDorisRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ImportCsvFileToDorisRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportCsvFileToDorisRequest) GetColumnSeparator() string {
+	if x != nil {
+		return x.ColumnSeparator
+	}
+	return ""
+}
+
+func (x *ImportCsvFileToDorisRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type ExportCsvFileFromDorisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tasks      []*CleanupTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	TotalCount int32              `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page       int32              `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize   int32              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	JobInstanceId    string             `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	TableName        string             `protobuf:"bytes,2,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbName           string             `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	Columns          []string           `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnSeparator  string             `protobuf:"bytes,5,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
+	LineDelimiter    string             `protobuf:"bytes,6,opt,name=lineDelimiter,proto3" json:"lineDelimiter,omitempty"`
+	SortRules        []*SortRule        `protobuf:"bytes,7,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
+	FilterConditions []*FilterCondition `protobuf:"bytes,8,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	FilterExpression *FilterExpression  `protobuf:"bytes,9,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"` // 过滤表达式，与 filterConditions 以 AND 组合
+	Sampling         *SampleOptions     `protobuf:"bytes,10,opt,name=sampling,proto3" json:"sampling,omitempty"`                // 抽样导出
+	Keys             []*TableKey        `protobuf:"bytes,11,rep,name=keys,proto3" json:"keys,omitempty"`                        // 表键信息，哈希抽样未指定键列时使用
 }
 
-func (x *GetRetryCleanupTasksResponse) Reset() {
-	*x = GetRetryCleanupTasksResponse{}
+func (x *ExportCsvFileFromDorisRequest) Reset() {
+	*x = ExportCsvFileFromDorisRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[56]
+		mi := &file_proto_data_source_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRetryCleanupTasksResponse) String() string {
+func (x *ExportCsvFileFromDorisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRetryCleanupTasksResponse) ProtoMessage() {}
+func (*ExportCsvFileFromDorisRequest) ProtoMessage() {}
 
-func (x *GetRetryCleanupTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[56]
+func (x *ExportCsvFileFromDorisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4879,66 +5957,114 @@ func (x *GetRetryCleanupTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRetryCleanupTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetRetryCleanupTasksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ExportCsvFileFromDorisRequest.ProtoReflect.Descriptor instead.
+func (*ExportCsvFileFromDorisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetRetryCleanupTasksResponse) GetTasks() []*CleanupTaskInfo {
+func (x *ExportCsvFileFromDorisRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.Tasks
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
 	}
 	return nil
 }
 
-func (x *GetRetryCleanupTasksResponse) GetTotalCount() int32 {
+func (x *ExportCsvFileFromDorisRequest) GetColumnSeparator() string {
 	if x != nil {
-		return x.TotalCount
+		return x.ColumnSeparator
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRetryCleanupTasksResponse) GetPage() int32 {
+func (x *ExportCsvFileFromDorisRequest) GetLineDelimiter() string {
 	if x != nil {
-		return x.Page
+		return x.LineDelimiter
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRetryCleanupTasksResponse) GetPageSize() int32 {
+func (x *ExportCsvFileFromDorisRequest) GetSortRules() []*SortRule {
 	if x != nil {
-		return x.PageSize
+		return x.SortRules
 	}
-	return 0
+	return nil
 }
 
-type CleanupTaskInfo struct {
+func (x *ExportCsvFileFromDorisRequest) GetFilterConditions() []*FilterCondition {
+	if x != nil {
+		return x.FilterConditions
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetFilterExpression() *FilterExpression {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetSampling() *SampleOptions {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ExportCsvFileFromDorisResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	JobInstanceId string `protobuf:"bytes,2,opt,name=job_instance_id,json=jobInstanceId,proto3" json:"job_instance_id,omitempty"`
-	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	BucketName    string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *CleanupTaskInfo) Reset() {
-	*x = CleanupTaskInfo{}
+func (x *ExportCsvFileFromDorisResponse) Reset() {
+	*x = ExportCsvFileFromDorisResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[57]
+		mi := &file_proto_data_source_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanupTaskInfo) String() string {
+func (x *ExportCsvFileFromDorisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupTaskInfo) ProtoMessage() {}
+func (*ExportCsvFileFromDorisResponse) ProtoMessage() {}
 
-func (x *CleanupTaskInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[57]
+func (x *ExportCsvFileFromDorisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4949,66 +6075,53 @@ func (x *CleanupTaskInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupTaskInfo.ProtoReflect.Descriptor instead.
-func (*CleanupTaskInfo) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *CleanupTaskInfo) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ExportCsvFileFromDorisResponse.ProtoReflect.Descriptor instead.
+func (*ExportCsvFileFromDorisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CleanupTaskInfo) GetJobInstanceId() string {
+func (x *ExportCsvFileFromDorisResponse) GetBucketName() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.BucketName
 	}
 	return ""
 }
 
-func (x *CleanupTaskInfo) GetStatus() string {
+func (x *ExportCsvFileFromDorisResponse) GetJobInstanceId() string {
 	if x != nil {
-		return x.Status
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-type ReadDataSourceStreamingRequest struct {
+type ExportDorisDataToMiraDBRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
-	// Types that are assignable to DataSource:
-	//
-	//	*ReadDataSourceStreamingRequest_External
-	//	*ReadDataSourceStreamingRequest_Internal
-	//	*ReadDataSourceStreamingRequest_Doris
-	DataSource       isReadDataSourceStreamingRequest_DataSource `protobuf_oneof:"data_source"`
-	Columns          []string                                    `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
-	SortRules        []*SortRule                                 `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
-	FilterConditions []*FilterCondition                          `protobuf:"bytes,7,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	TableName       string        `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`                                        // 存储到mira数据库表名
+	SourceTableName string        `protobuf:"bytes,2,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"`                            // 从doris导出数据表
+	Columns         []*ColumnItem `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`                                            // 导出字段
+	ReconcileMode   ReconcileMode `protobuf:"varint,4,opt,name=reconcileMode,proto3,enum=datasource.ReconcileMode" json:"reconcileMode,omitempty"` // Doris 表与导出表的对账强度
 }
 
-func (x *ReadDataSourceStreamingRequest) Reset() {
-	*x = ReadDataSourceStreamingRequest{}
+func (x *ExportDorisDataToMiraDBRequest) Reset() {
+	*x = ExportDorisDataToMiraDBRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[58]
+		mi := &file_proto_data_source_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDataSourceStreamingRequest) String() string {
+func (x *ExportDorisDataToMiraDBRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDataSourceStreamingRequest) ProtoMessage() {}
+func (*ExportDorisDataToMiraDBRequest) ProtoMessage() {}
 
-func (x *ReadDataSourceStreamingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[58]
+func (x *ExportDorisDataToMiraDBRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5019,116 +6132,119 @@ func (x *ReadDataSourceStreamingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDataSourceStreamingRequest.ProtoReflect.Descriptor instead.
-func (*ReadDataSourceStreamingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ExportDorisDataToMiraDBRequest.ProtoReflect.Descriptor instead.
+func (*ExportDorisDataToMiraDBRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ReadDataSourceStreamingRequest) GetJobInstanceId() string {
+func (x *ExportDorisDataToMiraDBRequest) GetTableName() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.TableName
 	}
 	return ""
 }
 
-func (m *ReadDataSourceStreamingRequest) GetDataSource() isReadDataSourceStreamingRequest_DataSource {
-	if m != nil {
-		return m.DataSource
+func (x *ExportDorisDataToMiraDBRequest) GetSourceTableName() string {
+	if x != nil {
+		return x.SourceTableName
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadDataSourceStreamingRequest) GetExternal() *ExternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_External); ok {
-		return x.External
+func (x *ExportDorisDataToMiraDBRequest) GetColumns() []*ColumnItem {
+	if x != nil {
+		return x.Columns
 	}
 	return nil
 }
 
-func (x *ReadDataSourceStreamingRequest) GetInternal() *InternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Internal); ok {
-		return x.Internal
+func (x *ExportDorisDataToMiraDBRequest) GetReconcileMode() ReconcileMode {
+	if x != nil {
+		return x.ReconcileMode
 	}
-	return nil
+	return ReconcileMode_RECONCILE_MODE_UNSPECIFIED
 }
 
-func (x *ReadDataSourceStreamingRequest) GetDoris() *DorisDataSource {
-	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Doris); ok {
-		return x.Doris
-	}
-	return nil
-}
+type ImportMiraDBDataToDorisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ReadDataSourceStreamingRequest) GetColumns() []string {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
+	MiraTableName string `protobuf:"bytes,1,opt,name=miraTableName,proto3" json:"miraTableName,omitempty"` // mira中间表
+	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *ReadDataSourceStreamingRequest) GetSortRules() []*SortRule {
-	if x != nil {
-		return x.SortRules
+func (x *ImportMiraDBDataToDorisRequest) Reset() {
+	*x = ImportMiraDBDataToDorisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ReadDataSourceStreamingRequest) GetFilterConditions() []*FilterCondition {
-	if x != nil {
-		return x.FilterConditions
-	}
-	return nil
+func (x *ImportMiraDBDataToDorisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isReadDataSourceStreamingRequest_DataSource interface {
-	isReadDataSourceStreamingRequest_DataSource()
-}
+func (*ImportMiraDBDataToDorisRequest) ProtoMessage() {}
 
-type ReadDataSourceStreamingRequest_External struct {
-	External *ExternalDataSource `protobuf:"bytes,2,opt,name=external,proto3,oneof"` // 外部数据源
+func (x *ImportMiraDBDataToDorisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ReadDataSourceStreamingRequest_Internal struct {
-	Internal *InternalDataSource `protobuf:"bytes,3,opt,name=internal,proto3,oneof"` // 内部数据源
+// Deprecated: Use ImportMiraDBDataToDorisRequest.ProtoReflect.Descriptor instead.
+func (*ImportMiraDBDataToDorisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{61}
 }
 
-type ReadDataSourceStreamingRequest_Doris struct {
-	Doris *DorisDataSource `protobuf:"bytes,4,opt,name=doris,proto3,oneof"` // doris数据源
+func (x *ImportMiraDBDataToDorisRequest) GetMiraTableName() string {
+	if x != nil {
+		return x.MiraTableName
+	}
+	return ""
 }
 
-func (*ReadDataSourceStreamingRequest_External) isReadDataSourceStreamingRequest_DataSource() {}
-
-func (*ReadDataSourceStreamingRequest_Internal) isReadDataSourceStreamingRequest_DataSource() {}
-
-func (*ReadDataSourceStreamingRequest_Doris) isReadDataSourceStreamingRequest_DataSource() {}
+func (x *ImportMiraDBDataToDorisRequest) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
 
-type ExecuteSqlRequest struct {
+type ImportMiraDBDataToDorisResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sql             string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`                         // 要执行的SQL语句，必填
-	DbName          string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名（传入jobInstanceId），必填
-	TargetTableName string `protobuf:"bytes,3,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 查询结果写入的目标表名
+	DorisTableName string `protobuf:"bytes,1,opt,name=dorisTableName,proto3" json:"dorisTableName,omitempty"` // 存储在doris中的表名
 }
 
-func (x *ExecuteSqlRequest) Reset() {
-	*x = ExecuteSqlRequest{}
+func (x *ImportMiraDBDataToDorisResponse) Reset() {
+	*x = ImportMiraDBDataToDorisResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[59]
+		mi := &file_proto_data_source_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecuteSqlRequest) String() string {
+func (x *ImportMiraDBDataToDorisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteSqlRequest) ProtoMessage() {}
+func (*ImportMiraDBDataToDorisResponse) ProtoMessage() {}
 
-func (x *ExecuteSqlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[59]
+func (x *ImportMiraDBDataToDorisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5139,66 +6255,46 @@ func (x *ExecuteSqlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteSqlRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteSqlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *ExecuteSqlRequest) GetSql() string {
-	if x != nil {
-		return x.Sql
-	}
-	return ""
-}
-
-func (x *ExecuteSqlRequest) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
+// Deprecated: Use ImportMiraDBDataToDorisResponse.ProtoReflect.Descriptor instead.
+func (*ImportMiraDBDataToDorisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ExecuteSqlRequest) GetTargetTableName() string {
+func (x *ImportMiraDBDataToDorisResponse) GetDorisTableName() string {
 	if x != nil {
-		return x.TargetTableName
+		return x.DorisTableName
 	}
 	return ""
 }
 
-type ExecuteSqlResponse struct {
+type InternalTableInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 通用字段
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 执行是否成功
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 执行结果消息
-	// SELECT查询结果（流式数据）
-	//
-	// Types that are assignable to Result:
-	//
-	//	*ExecuteSqlResponse_ArrowBatch
-	//	*ExecuteSqlResponse_DmlResult
-	Result isExecuteSqlResponse_Result `protobuf_oneof:"result"`
+	TableName     string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	IsExactQuery  bool   `protobuf:"varint,3,opt,name=isExactQuery,proto3" json:"isExactQuery,omitempty"`
+	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *ExecuteSqlResponse) Reset() {
-	*x = ExecuteSqlResponse{}
+func (x *InternalTableInfoRequest) Reset() {
+	*x = InternalTableInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[60]
+		mi := &file_proto_data_source_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecuteSqlResponse) String() string {
+func (x *InternalTableInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteSqlResponse) ProtoMessage() {}
+func (*InternalTableInfoRequest) ProtoMessage() {}
 
-func (x *ExecuteSqlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[60]
+func (x *InternalTableInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5209,88 +6305,64 @@ func (x *ExecuteSqlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteSqlResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteSqlResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use InternalTableInfoRequest.ProtoReflect.Descriptor instead.
+func (*InternalTableInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ExecuteSqlResponse) GetSuccess() bool {
+func (x *InternalTableInfoRequest) GetTableName() string {
 	if x != nil {
-		return x.Success
+		return x.TableName
 	}
-	return false
+	return ""
 }
 
-func (x *ExecuteSqlResponse) GetMessage() string {
+func (x *InternalTableInfoRequest) GetDbName() string {
 	if x != nil {
-		return x.Message
+		return x.DbName
 	}
 	return ""
 }
 
-func (m *ExecuteSqlResponse) GetResult() isExecuteSqlResponse_Result {
-	if m != nil {
-		return m.Result
-	}
-	return nil
-}
-
-func (x *ExecuteSqlResponse) GetArrowBatch() []byte {
-	if x, ok := x.GetResult().(*ExecuteSqlResponse_ArrowBatch); ok {
-		return x.ArrowBatch
+func (x *InternalTableInfoRequest) GetIsExactQuery() bool {
+	if x != nil {
+		return x.IsExactQuery
 	}
-	return nil
+	return false
 }
 
-func (x *ExecuteSqlResponse) GetDmlResult() *DmlResult {
-	if x, ok := x.GetResult().(*ExecuteSqlResponse_DmlResult); ok {
-		return x.DmlResult
+func (x *InternalTableInfoRequest) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
 	}
-	return nil
-}
-
-type isExecuteSqlResponse_Result interface {
-	isExecuteSqlResponse_Result()
-}
-
-type ExecuteSqlResponse_ArrowBatch struct {
-	ArrowBatch []byte `protobuf:"bytes,4,opt,name=arrow_batch,json=arrowBatch,proto3,oneof"` // Arrow格式的数据批次（SELECT时使用）
-}
-
-type ExecuteSqlResponse_DmlResult struct {
-	DmlResult *DmlResult `protobuf:"bytes,5,opt,name=dmlResult,proto3,oneof"` // DML操作结果（INSERT/UPDATE/DELETE时使用）
+	return ""
 }
 
-func (*ExecuteSqlResponse_ArrowBatch) isExecuteSqlResponse_Result() {}
-
-func (*ExecuteSqlResponse_DmlResult) isExecuteSqlResponse_Result() {}
-
-type DmlResult struct {
+type CleanTmpDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AffectedRows  int64  `protobuf:"varint,1,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`  // 受影响的行数
-	ExecutionTime string `protobuf:"bytes,2,opt,name=executionTime,proto3" json:"executionTime,omitempty"` // 执行耗时
+	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *DmlResult) Reset() {
-	*x = DmlResult{}
+func (x *CleanTmpDataRequest) Reset() {
+	*x = CleanTmpDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[61]
+		mi := &file_proto_data_source_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DmlResult) String() string {
+func (x *CleanTmpDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DmlResult) ProtoMessage() {}
+func (*CleanTmpDataRequest) ProtoMessage() {}
 
-func (x *DmlResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[61]
+func (x *CleanTmpDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5301,59 +6373,44 @@ func (x *DmlResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DmlResult.ProtoReflect.Descriptor instead.
-func (*DmlResult) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *DmlResult) GetAffectedRows() int64 {
-	if x != nil {
-		return x.AffectedRows
-	}
-	return 0
+// Deprecated: Use CleanTmpDataRequest.ProtoReflect.Descriptor instead.
+func (*CleanTmpDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DmlResult) GetExecutionTime() string {
+func (x *CleanTmpDataRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.ExecutionTime
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-type ReadRequest struct {
+type GetRetryCleanupTasksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to DataSource:
-	//
-	//	*ReadRequest_External
-	//	*ReadRequest_Internal
-	//	*ReadRequest_Doris
-	DataSource       isReadRequest_DataSource `protobuf_oneof:"data_source"`
-	Columns          []string                 `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
-	SortRules        []*SortRule              `protobuf:"bytes,5,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
-	FilterConditions []*FilterCondition       `protobuf:"bytes,6,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
-	Keys             []*TableKey              `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`                         // 表键信息
+	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *ReadRequest) Reset() {
-	*x = ReadRequest{}
+func (x *GetRetryCleanupTasksRequest) Reset() {
+	*x = GetRetryCleanupTasksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[62]
+		mi := &file_proto_data_source_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadRequest) String() string {
+func (x *GetRetryCleanupTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadRequest) ProtoMessage() {}
+func (*GetRetryCleanupTasksRequest) ProtoMessage() {}
 
-func (x *ReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[62]
+func (x *GetRetryCleanupTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5364,117 +6421,53 @@ func (x *ReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
-func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{62}
-}
-
-func (m *ReadRequest) GetDataSource() isReadRequest_DataSource {
-	if m != nil {
-		return m.DataSource
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetExternal() *ExternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadRequest_External); ok {
-		return x.External
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetInternal() *InternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadRequest_Internal); ok {
-		return x.Internal
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetDoris() *DorisDataSource {
-	if x, ok := x.GetDataSource().(*ReadRequest_Doris); ok {
-		return x.Doris
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetColumns() []string {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetSortRules() []*SortRule {
-	if x != nil {
-		return x.SortRules
-	}
-	return nil
+// Deprecated: Use GetRetryCleanupTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetRetryCleanupTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ReadRequest) GetFilterConditions() []*FilterCondition {
+func (x *GetRetryCleanupTasksRequest) GetPage() int32 {
 	if x != nil {
-		return x.FilterConditions
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *ReadRequest) GetKeys() []*TableKey {
+func (x *GetRetryCleanupTasksRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Keys
+		return x.PageSize
 	}
-	return nil
-}
-
-type isReadRequest_DataSource interface {
-	isReadRequest_DataSource()
-}
-
-type ReadRequest_External struct {
-	External *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3,oneof"` // 外部数据源
-}
-
-type ReadRequest_Internal struct {
-	Internal *InternalDataSource `protobuf:"bytes,2,opt,name=internal,proto3,oneof"` // 内部数据源
-}
-
-type ReadRequest_Doris struct {
-	Doris *DorisDataSource `protobuf:"bytes,3,opt,name=doris,proto3,oneof"` // doris数据源
+	return 0
 }
 
-func (*ReadRequest_External) isReadRequest_DataSource() {}
-
-func (*ReadRequest_Internal) isReadRequest_DataSource() {}
-
-func (*ReadRequest_Doris) isReadRequest_DataSource() {}
-
-// FilterCondition 过滤条件结构体
-type FilterCondition struct {
+type GetRetryCleanupTasksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FieldName  string         `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`                               // 过滤字段名
-	FieldValue *FilterValue   `protobuf:"bytes,2,opt,name=fieldValue,proto3" json:"fieldValue,omitempty"`                             // 过滤字段值
-	Operator   FilterOperator `protobuf:"varint,3,opt,name=operator,proto3,enum=datasource.FilterOperator" json:"operator,omitempty"` // 过滤操作符
+	Tasks      []*CleanupTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount int32              `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32              `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *FilterCondition) Reset() {
-	*x = FilterCondition{}
+func (x *GetRetryCleanupTasksResponse) Reset() {
+	*x = GetRetryCleanupTasksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[63]
+		mi := &file_proto_data_source_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterCondition) String() string {
+func (x *GetRetryCleanupTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterCondition) ProtoMessage() {}
+func (*GetRetryCleanupTasksResponse) ProtoMessage() {}
 
-func (x *FilterCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[63]
+func (x *GetRetryCleanupTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5485,59 +6478,66 @@ func (x *FilterCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterCondition.ProtoReflect.Descriptor instead.
-func (*FilterCondition) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use GetRetryCleanupTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetRetryCleanupTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *FilterCondition) GetFieldName() string {
+func (x *GetRetryCleanupTasksResponse) GetTasks() []*CleanupTaskInfo {
 	if x != nil {
-		return x.FieldName
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-func (x *FilterCondition) GetFieldValue() *FilterValue {
+func (x *GetRetryCleanupTasksResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.FieldValue
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (x *FilterCondition) GetOperator() FilterOperator {
+func (x *GetRetryCleanupTasksResponse) GetPage() int32 {
 	if x != nil {
-		return x.Operator
+		return x.Page
 	}
-	return FilterOperator_EQUAL
+	return 0
 }
 
-type WriteRequest struct {
+func (x *GetRetryCleanupTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CleanupTaskInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
-	DbName     string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	TableName  string `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"` // 写入的目标表名
+	Id            uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobInstanceId string `protobuf:"bytes,2,opt,name=job_instance_id,json=jobInstanceId,proto3" json:"job_instance_id,omitempty"`
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *WriteRequest) Reset() {
-	*x = WriteRequest{}
+func (x *CleanupTaskInfo) Reset() {
+	*x = CleanupTaskInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[64]
+		mi := &file_proto_data_source_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteRequest) String() string {
+func (x *CleanupTaskInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteRequest) ProtoMessage() {}
+func (*CleanupTaskInfo) ProtoMessage() {}
 
-func (x *WriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[64]
+func (x *CleanupTaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5548,58 +6548,69 @@ func (x *WriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
-func (*WriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use CleanupTaskInfo.ProtoReflect.Descriptor instead.
+func (*CleanupTaskInfo) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *WriteRequest) GetArrowBatch() []byte {
+func (x *CleanupTaskInfo) GetId() uint32 {
 	if x != nil {
-		return x.ArrowBatch
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *WriteRequest) GetDbName() string {
+func (x *CleanupTaskInfo) GetJobInstanceId() string {
 	if x != nil {
-		return x.DbName
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-func (x *WriteRequest) GetTableName() string {
+func (x *CleanupTaskInfo) GetStatus() string {
 	if x != nil {
-		return x.TableName
+		return x.Status
 	}
 	return ""
 }
 
-type WriteResponse struct {
+type ReadDataSourceStreamingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	// Types that are assignable to DataSource:
+	//
+	//	*ReadDataSourceStreamingRequest_External
+	//	*ReadDataSourceStreamingRequest_Internal
+	//	*ReadDataSourceStreamingRequest_Doris
+	DataSource       isReadDataSourceStreamingRequest_DataSource `protobuf_oneof:"data_source"`
+	Columns          []string                                    `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	SortRules        []*SortRule                                 `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
+	FilterConditions []*FilterCondition                          `protobuf:"bytes,7,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	FilterExpression *FilterExpression                           `protobuf:"bytes,8,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"` // 过滤表达式，与 filterConditions 以 AND 组合
+	Sampling         *SampleOptions                              `protobuf:"bytes,9,opt,name=sampling,proto3" json:"sampling,omitempty"`                 // 抽样读取
+	Keys             []*TableKey                                 `protobuf:"bytes,10,rep,name=keys,proto3" json:"keys,omitempty"`                        // 表键信息，哈希抽样未指定键列时使用
 }
 
-func (x *WriteResponse) Reset() {
-	*x = WriteResponse{}
+func (x *ReadDataSourceStreamingRequest) Reset() {
+	*x = ReadDataSourceStreamingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[65]
+		mi := &file_proto_data_source_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteResponse) String() string {
+func (x *ReadDataSourceStreamingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteResponse) ProtoMessage() {}
+func (*ReadDataSourceStreamingRequest) ProtoMessage() {}
 
-func (x *WriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[65]
+func (x *ReadDataSourceStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5610,102 +6621,137 @@ func (x *WriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
-func (*WriteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ReadDataSourceStreamingRequest.ProtoReflect.Descriptor instead.
+func (*ReadDataSourceStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *WriteResponse) GetSuccess() bool {
+func (x *ReadDataSourceStreamingRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.Success
+		return x.JobInstanceId
 	}
-	return false
+	return ""
 }
 
-func (x *WriteResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (m *ReadDataSourceStreamingRequest) GetDataSource() isReadDataSourceStreamingRequest_DataSource {
+	if m != nil {
+		return m.DataSource
 	}
-	return ""
+	return nil
 }
 
-type ImportDataRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReadDataSourceStreamingRequest) GetExternal() *ExternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
 
-	Targets []*ImportTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"` // 导入目标列表
+func (x *ReadDataSourceStreamingRequest) GetInternal() *InternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Internal); ok {
+		return x.Internal
+	}
+	return nil
 }
 
-func (x *ImportDataRequest) Reset() {
-	*x = ImportDataRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadDataSourceStreamingRequest) GetDoris() *DorisDataSource {
+	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Doris); ok {
+		return x.Doris
 	}
+	return nil
 }
 
-func (x *ImportDataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReadDataSourceStreamingRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
 }
 
-func (*ImportDataRequest) ProtoMessage() {}
+func (x *ReadDataSourceStreamingRequest) GetSortRules() []*SortRule {
+	if x != nil {
+		return x.SortRules
+	}
+	return nil
+}
 
-func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[66]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReadDataSourceStreamingRequest) GetFilterConditions() []*FilterCondition {
+	if x != nil {
+		return x.FilterConditions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ImportDataRequest.ProtoReflect.Descriptor instead.
-func (*ImportDataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{66}
+func (x *ReadDataSourceStreamingRequest) GetFilterExpression() *FilterExpression {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return nil
 }
 
-func (x *ImportDataRequest) GetTargets() []*ImportTarget {
+func (x *ReadDataSourceStreamingRequest) GetSampling() *SampleOptions {
 	if x != nil {
-		return x.Targets
+		return x.Sampling
 	}
 	return nil
 }
 
-// 数据导入目标配置
-type ImportTarget struct {
+func (x *ReadDataSourceStreamingRequest) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type isReadDataSourceStreamingRequest_DataSource interface {
+	isReadDataSourceStreamingRequest_DataSource()
+}
+
+type ReadDataSourceStreamingRequest_External struct {
+	External *ExternalDataSource `protobuf:"bytes,2,opt,name=external,proto3,oneof"` // 外部数据源
+}
+
+type ReadDataSourceStreamingRequest_Internal struct {
+	Internal *InternalDataSource `protobuf:"bytes,3,opt,name=internal,proto3,oneof"` // 内部数据源
+}
+
+type ReadDataSourceStreamingRequest_Doris struct {
+	Doris *DorisDataSource `protobuf:"bytes,4,opt,name=doris,proto3,oneof"` // doris数据源
+}
+
+func (*ReadDataSourceStreamingRequest_External) isReadDataSourceStreamingRequest_DataSource() {}
+
+func (*ReadDataSourceStreamingRequest_Internal) isReadDataSourceStreamingRequest_DataSource() {}
+
+func (*ReadDataSourceStreamingRequest_Doris) isReadDataSourceStreamingRequest_DataSource() {}
+
+type ExecuteSqlRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	External        *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3" json:"external,omitempty"`               // 外部数据源
-	TargetTableName string              `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名
-	DbName          string              `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名
-	Columns         []string            `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                 // 导入字段
-	Keys            []*TableKey         `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`                       // 表键信息
+	Sql             string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`                         // 要执行的SQL语句，必填
+	DbName          string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名（传入jobInstanceId），必填
+	TargetTableName string `protobuf:"bytes,3,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 查询结果写入的目标表名
 }
 
-func (x *ImportTarget) Reset() {
-	*x = ImportTarget{}
+func (x *ExecuteSqlRequest) Reset() {
+	*x = ExecuteSqlRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[67]
+		mi := &file_proto_data_source_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportTarget) String() string {
+func (x *ExecuteSqlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportTarget) ProtoMessage() {}
+func (*ExecuteSqlRequest) ProtoMessage() {}
 
-func (x *ImportTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[67]
+func (x *ExecuteSqlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5716,75 +6762,66 @@ func (x *ImportTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportTarget.ProtoReflect.Descriptor instead.
-func (*ImportTarget) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *ImportTarget) GetExternal() *ExternalDataSource {
-	if x != nil {
-		return x.External
-	}
-	return nil
+// Deprecated: Use ExecuteSqlRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteSqlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ImportTarget) GetTargetTableName() string {
+func (x *ExecuteSqlRequest) GetSql() string {
 	if x != nil {
-		return x.TargetTableName
+		return x.Sql
 	}
 	return ""
 }
 
-func (x *ImportTarget) GetDbName() string {
+func (x *ExecuteSqlRequest) GetDbName() string {
 	if x != nil {
 		return x.DbName
 	}
 	return ""
 }
 
-func (x *ImportTarget) GetColumns() []string {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-func (x *ImportTarget) GetKeys() []*TableKey {
+func (x *ExecuteSqlRequest) GetTargetTableName() string {
 	if x != nil {
-		return x.Keys
+		return x.TargetTableName
 	}
-	return nil
+	return ""
 }
 
-// 表键信息
-type TableKey struct {
+type ExecuteSqlResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyName     string            `protobuf:"bytes,1,opt,name=keyName,proto3" json:"keyName,omitempty"`                                                                                               // 键名称（可选）
-	KeyType     KeyType           `protobuf:"varint,2,opt,name=keyType,proto3,enum=datasource.KeyType" json:"keyType,omitempty"`                                                                      // 键类型
-	ColumnNames []string          `protobuf:"bytes,3,rep,name=columnNames,proto3" json:"columnNames,omitempty"`                                                                                       // 键包含的列名（支持组合键）
-	Attributes  map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 扩展属性（可选，可扩展）
+	// 通用字段
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 执行是否成功
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 执行结果消息
+	// SELECT查询结果（流式数据）
+	//
+	// Types that are assignable to Result:
+	//
+	//	*ExecuteSqlResponse_ArrowBatch
+	//	*ExecuteSqlResponse_DmlResult
+	Result isExecuteSqlResponse_Result `protobuf_oneof:"result"`
 }
 
-func (x *TableKey) Reset() {
-	*x = TableKey{}
+func (x *ExecuteSqlResponse) Reset() {
+	*x = ExecuteSqlResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[68]
+		mi := &file_proto_data_source_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableKey) String() string {
+func (x *ExecuteSqlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableKey) ProtoMessage() {}
+func (*ExecuteSqlResponse) ProtoMessage() {}
 
-func (x *TableKey) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[68]
+func (x *ExecuteSqlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5795,66 +6832,88 @@ func (x *TableKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableKey.ProtoReflect.Descriptor instead.
-func (*TableKey) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ExecuteSqlResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteSqlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *TableKey) GetKeyName() string {
+func (x *ExecuteSqlResponse) GetSuccess() bool {
 	if x != nil {
-		return x.KeyName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *TableKey) GetKeyType() KeyType {
+func (x *ExecuteSqlResponse) GetMessage() string {
 	if x != nil {
-		return x.KeyType
+		return x.Message
 	}
-	return KeyType_KEY_TYPE_UNKNOWN
+	return ""
 }
 
-func (x *TableKey) GetColumnNames() []string {
-	if x != nil {
-		return x.ColumnNames
+func (m *ExecuteSqlResponse) GetResult() isExecuteSqlResponse_Result {
+	if m != nil {
+		return m.Result
 	}
 	return nil
 }
 
-func (x *TableKey) GetAttributes() map[string]string {
-	if x != nil {
-		return x.Attributes
+func (x *ExecuteSqlResponse) GetArrowBatch() []byte {
+	if x, ok := x.GetResult().(*ExecuteSqlResponse_ArrowBatch); ok {
+		return x.ArrowBatch
 	}
 	return nil
 }
 
-type ImportDataResponse struct {
+func (x *ExecuteSqlResponse) GetDmlResult() *DmlResult {
+	if x, ok := x.GetResult().(*ExecuteSqlResponse_DmlResult); ok {
+		return x.DmlResult
+	}
+	return nil
+}
+
+type isExecuteSqlResponse_Result interface {
+	isExecuteSqlResponse_Result()
+}
+
+type ExecuteSqlResponse_ArrowBatch struct {
+	ArrowBatch []byte `protobuf:"bytes,4,opt,name=arrow_batch,json=arrowBatch,proto3,oneof"` // Arrow格式的数据批次（SELECT时使用）
+}
+
+type ExecuteSqlResponse_DmlResult struct {
+	DmlResult *DmlResult `protobuf:"bytes,5,opt,name=dmlResult,proto3,oneof"` // DML操作结果（INSERT/UPDATE/DELETE时使用）
+}
+
+func (*ExecuteSqlResponse_ArrowBatch) isExecuteSqlResponse_Result() {}
+
+func (*ExecuteSqlResponse_DmlResult) isExecuteSqlResponse_Result() {}
+
+type DmlResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Results []*ImportResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // 多个导入结果
+	AffectedRows  int64  `protobuf:"varint,1,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`  // 受影响的行数
+	ExecutionTime string `protobuf:"bytes,2,opt,name=executionTime,proto3" json:"executionTime,omitempty"` // 执行耗时
 }
 
-func (x *ImportDataResponse) Reset() {
-	*x = ImportDataResponse{}
+func (x *DmlResult) Reset() {
+	*x = DmlResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[69]
+		mi := &file_proto_data_source_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportDataResponse) String() string {
+func (x *DmlResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDataResponse) ProtoMessage() {}
+func (*DmlResult) ProtoMessage() {}
 
-func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[69]
+func (x *DmlResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5865,64 +6924,62 @@ func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDataResponse.ProtoReflect.Descriptor instead.
-func (*ImportDataResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use DmlResult.ProtoReflect.Descriptor instead.
+func (*DmlResult) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ImportDataResponse) GetSuccess() bool {
+func (x *DmlResult) GetAffectedRows() int64 {
 	if x != nil {
-		return x.Success
+		return x.AffectedRows
 	}
-	return false
+	return 0
 }
 
-func (x *ImportDataResponse) GetMessage() string {
+func (x *DmlResult) GetExecutionTime() string {
 	if x != nil {
-		return x.Message
+		return x.ExecutionTime
 	}
 	return ""
 }
 
-func (x *ImportDataResponse) GetResults() []*ImportResult {
-	if x != nil {
-		return x.Results
-	}
-	return nil
-}
-
-// 单个导入结果
-type ImportResult struct {
+type ReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceTableName string `protobuf:"bytes,1,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 源表名
-	TargetTableName string `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名（写入的表）
-	SourceDatabase  string `protobuf:"bytes,3,opt,name=sourceDatabase,proto3" json:"sourceDatabase,omitempty"`   // 源数据库名
-	TargetDatabase  string `protobuf:"bytes,4,opt,name=targetDatabase,proto3" json:"targetDatabase,omitempty"`   // 目标数据库名
-	AffectedRows    int64  `protobuf:"varint,5,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`      // 影响的行数
-	Success         bool   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`                // 该目标是否导入成功
-	ErrorMessage    string `protobuf:"bytes,7,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`       // 如果失败，错误信息
+	// Types that are assignable to DataSource:
+	//
+	//	*ReadRequest_External
+	//	*ReadRequest_Internal
+	//	*ReadRequest_Doris
+	DataSource       isReadRequest_DataSource `protobuf_oneof:"data_source"`
+	Columns          []string                 `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	SortRules        []*SortRule              `protobuf:"bytes,5,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                        // 排序规则
+	FilterConditions []*FilterCondition       `protobuf:"bytes,6,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"`          // 过滤条件
+	Keys             []*TableKey              `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`                                  // 表键信息
+	FilterExpression *FilterExpression        `protobuf:"bytes,8,opt,name=filterExpression,proto3" json:"filterExpression,omitempty"`          // 过滤表达式，与 filterConditions 以 AND 组合
+	ResumeToken      string                   `protobuf:"bytes,9,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 续传令牌，取自上次读取流中最后收到的 ArrowResponse，携带时直接从已导出的分片继续读取
+	Sampling         *SampleOptions           `protobuf:"bytes,10,opt,name=sampling,proto3" json:"sampling,omitempty"`                         // 抽样读取，在 Doris 导出时完成抽样
 }
 
-func (x *ImportResult) Reset() {
-	*x = ImportResult{}
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[70]
+		mi := &file_proto_data_source_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportResult) String() string {
+func (x *ReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportResult) ProtoMessage() {}
+func (*ReadRequest) ProtoMessage() {}
 
-func (x *ImportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[70]
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5933,1350 +6990,3576 @@ func (x *ImportResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
-func (*ImportResult) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ImportResult) GetSourceTableName() string {
-	if x != nil {
-		return x.SourceTableName
+func (m *ReadRequest) GetDataSource() isReadRequest_DataSource {
+	if m != nil {
+		return m.DataSource
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportResult) GetTargetTableName() string {
+func (x *ReadRequest) GetExternal() *ExternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetInternal() *InternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadRequest_Internal); ok {
+		return x.Internal
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetDoris() *DorisDataSource {
+	if x, ok := x.GetDataSource().(*ReadRequest_Doris); ok {
+		return x.Doris
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetColumns() []string {
 	if x != nil {
-		return x.TargetTableName
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportResult) GetSourceDatabase() string {
+func (x *ReadRequest) GetSortRules() []*SortRule {
 	if x != nil {
-		return x.SourceDatabase
+		return x.SortRules
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportResult) GetTargetDatabase() string {
+func (x *ReadRequest) GetFilterConditions() []*FilterCondition {
 	if x != nil {
-		return x.TargetDatabase
+		return x.FilterConditions
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportResult) GetAffectedRows() int64 {
+func (x *ReadRequest) GetKeys() []*TableKey {
 	if x != nil {
-		return x.AffectedRows
+		return x.Keys
 	}
-	return 0
+	return nil
 }
 
-func (x *ImportResult) GetSuccess() bool {
+func (x *ReadRequest) GetFilterExpression() *FilterExpression {
 	if x != nil {
-		return x.Success
+		return x.FilterExpression
 	}
-	return false
+	return nil
 }
 
-func (x *ImportResult) GetErrorMessage() string {
+func (x *ReadRequest) GetResumeToken() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.ResumeToken
 	}
 	return ""
 }
 
-var File_proto_data_source_proto protoreflect.FileDescriptor
-
-var file_proto_data_source_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x28,
-	0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x0d, 0x41, 0x72, 0x72, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72,
-	0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x74, 0x0a, 0x11, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
-	0x22, 0x71, 0x0a, 0x18, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xce,
-	0x01, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22,
-	0xe6, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xd4, 0x05, 0x0a, 0x10, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x73,
-	0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x70,
-	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x01, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x48, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0c, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x62, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x69,
-	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x64,
-	0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x41, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x48, 0x61,
-	0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x73, 0x69, 0x5f,
-	0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x53, 0x49, 0x4a, 0x6f, 0x69, 0x6e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x07, 0x70, 0x73, 0x69, 0x4a,
-	0x6f, 0x69, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x6a, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x44, 0x6f, 0x72, 0x69, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xe9, 0x02, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44,
-	0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
-	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x0e,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x22, 0x77, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22,
-	0x80, 0x02, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d,
-	0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
-	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a,
-	0x16, 0x41, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x50, 0x53, 0x49, 0x4a,
-	0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f,
-	0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24,
-	0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xca, 0x03, 0x0a, 0x11, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09,
-	0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x22, 0x5d, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6e, 0x0a, 0x0a, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x4f, 0x53, 0x53,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x22, 0x50, 0x0a, 0x0e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0xab, 0x05, 0x0a, 0x0f, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x44, 0x42, 0x43, 0x6f, 0x6e,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f,
-	0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65,
-	0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22,
-	0xb9, 0x03, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x3a, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x1d, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x44, 0x0a, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
-	0x42, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
-	0x73, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
-	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75,
-	0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x10,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22,
-	0x0a, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30,
-	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x22, 0x94, 0x02, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x34, 0x0a,
-	0x14, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x15, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0xca, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x61, 0x74, 0x61, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74,
-	0x61, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x4b, 0x0a,
-	0x15, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x44, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xbd, 0x02, 0x0a,
-	0x13, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x43, 0x65,
-	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
-	0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c,
-	0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x16,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x17,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72,
-	0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x72,
-	0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x33, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01, 0x0a,
-	0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f,
-	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xe2, 0x02, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
-	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x65,
-	0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x32,
-	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x66, 0x0a, 0x1e, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f,
-	0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30,
-	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x22, 0x6c, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x49,
-	0x0a, 0x1f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61,
-	0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54,
-	0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x61, 0x0a, 0x0f, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f,
-	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9d, 0x03, 0x0a,
-	0x1e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f,
-	0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x11,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x21, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x44, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
-	0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x44, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x0b,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x0d, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a,
-	0x0f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37,
-	0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22,
-	0x65, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x32, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a,
-	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65,
-	0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
-	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
-	0x44, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32,
-	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
-	0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x1e, 0x0a, 0x09, 0x53, 0x6f, 0x72,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05,
-	0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
-	0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x53, 0x53,
-	0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c,
-	0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f,
-	0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49,
-	0x4b, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x0f, 0x0a,
-	0x0b, 0x49, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x07, 0x2a, 0x9a,
-	0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03,
-	0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x56,
-	0x52, 0x4f, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52,
-	0x45, 0x41, 0x4d, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x06, 0x2a, 0x92, 0x02, 0x0a, 0x0e,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
-	0x47, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x56, 0x45,
-	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x44, 0x42, 0x10, 0x04, 0x12, 0x1a, 0x0a,
-	0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x54, 0x44, 0x53, 0x51, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41,
-	0x53, 0x54, 0x42, 0x41, 0x53, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x42, 0x41,
-	0x53, 0x45, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x49, 0x53, 0x10, 0x08,
-	0x2a, 0x22, 0x0a, 0x0a, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x10, 0x4d, 0x49, 0x52, 0x41, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x45,
-	0x4d, 0x50, 0x10, 0x00, 0x2a, 0x8e, 0x02, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
-	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12,
-	0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55,
-	0x50, 0x42, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4f,
-	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x4f,
-	0x49, 0x4e, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x53, 0x49, 0x5f, 0x4a,
-	0x4f, 0x49, 0x4e, 0x10, 0x08, 0x2a, 0x74, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a,
-	0x0e, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10,
-	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x84, 0x01, 0x0a, 0x09,
-	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4a,
-	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x04, 0x2a, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53,
-	0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x42, 0x10, 0x01,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4f, 0x10, 0x02, 0x2a, 0x91, 0x01, 0x0a, 0x07, 0x4b, 0x65, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45,
-	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01,
-	0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x52,
-	0x45, 0x49, 0x47, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4b,
-	0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x04, 0x12,
-	0x1b, 0x0a, 0x17, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f,
-	0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x32, 0xfb, 0x13, 0x0a,
-	0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
-	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4d,
-	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x43, 0x0a,
-	0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x28, 0x01, 0x12, 0x47, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x46, 0x69,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x52,
-	0x65, 0x61, 0x64, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x65, 0x61,
-	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e,
-	0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
-	0x0a, 0x19, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54,
-	0x6f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x42, 0x12, 0x20, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73,
-	0x53, 0x51, 0x4c, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69,
-	0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xae, 0x01, 0x0a,
-	0x2b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41,
-	0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
-	0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f,
-	0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65,
-	0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73,
-	0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x29,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72,
-	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76,
-	0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42,
-	0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d,
-	0x69, 0x72, 0x61, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61,
-	0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x2a, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72,
-	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72,
-	0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x6e,
-	0x54, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54,
-	0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x97, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x27,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76,
-	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74,
-	0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x4d, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x12, 0x1d,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
-	0x3c, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72,
-	0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a,
-	0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4b, 0x0a,
-	0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *ReadRequest) GetSampling() *SampleOptions {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
 }
 
-var (
-	file_proto_data_source_proto_rawDescOnce sync.Once
-	file_proto_data_source_proto_rawDescData = file_proto_data_source_proto_rawDesc
-)
-
-func file_proto_data_source_proto_rawDescGZIP() []byte {
-	file_proto_data_source_proto_rawDescOnce.Do(func() {
-		file_proto_data_source_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_data_source_proto_rawDescData)
-	})
-	return file_proto_data_source_proto_rawDescData
+type isReadRequest_DataSource interface {
+	isReadRequest_DataSource()
 }
 
-var file_proto_data_source_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_proto_data_source_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
-var file_proto_data_source_proto_goTypes = []any{
-	(SortOrder)(0),                    // 0: datasource.SortOrder
-	(FilterOperator)(0),               // 1: datasource.FilterOperator
-	(FileType)(0),                     // 2: datasource.FileType
-	(DataSourceType)(0),               // 3: datasource.DataSourceType
-	(DbConstant)(0),                   // 4: datasource.DbConstant
-	(OperationMode)(0),                // 5: datasource.OperationMode
-	(JoinType)(0),                     // 6: datasource.JoinType
-	(JobStatus)(0),                    // 7: datasource.JobStatus
-	(StorageType)(0),                  // 8: datasource.StorageType
-	(KeyType)(0),                      // 9: datasource.KeyType
-	(*Response)(nil),                  // 10: datasource.Response
-	(*BatchResponse)(nil),             // 11: datasource.BatchResponse
-	(*JobStatusRequest)(nil),          // 12: datasource.JobStatusRequest
-	(*ArrowResponse)(nil),             // 13: datasource.ArrowResponse
-	(*WriterDataRequest)(nil),         // 14: datasource.WriterDataRequest
-	(*WrappedWriterDataRequest)(nil),  // 15: datasource.WrappedWriterDataRequest
-	(*WriterInternalDataRequest)(nil), // 16: datasource.WriterInternalDataRequest
-	(*WriterExternalDataRequest)(nil), // 17: datasource.WriterExternalDataRequest
-	(*InternalReadRequest)(nil),       // 18: datasource.InternalReadRequest
-	(*BatchReadRequest)(nil),          // 19: datasource.BatchReadRequest
-	(*ExternalDataSource)(nil),        // 20: datasource.ExternalDataSource
-	(*InternalDataSource)(nil),        // 21: datasource.InternalDataSource
-	(*DorisDataSource)(nil),           // 22: datasource.DorisDataSource
-	(*QueryOperation)(nil),            // 23: datasource.QueryOperation
-	(*WriteOperation)(nil),            // 24: datasource.WriteOperation
-	(*SortOperation)(nil),             // 25: datasource.SortOperation
-	(*CountOperation)(nil),            // 26: datasource.CountOperation
-	(*GroupByCountOperation)(nil),     // 27: datasource.GroupByCountOperation
-	(*JoinOperation)(nil),             // 28: datasource.JoinOperation
-	(*AddHashColumnOperation)(nil),    // 29: datasource.AddHashColumnOperation
-	(*PSIJoinOperation)(nil),          // 30: datasource.PSIJoinOperation
-	(*StreamReadRequest)(nil),         // 31: datasource.StreamReadRequest
-	(*FilterValue)(nil),               // 32: datasource.FilterValue
-	(*SortRule)(nil),                  // 33: datasource.SortRule
-	(*ConnectionInfo)(nil),            // 34: datasource.ConnectionInfo
-	(*ColumnItem)(nil),                // 35: datasource.ColumnItem
-	(*ServerInfo)(nil),                // 36: datasource.ServerInfo
-	(*OSSWriteRequest)(nil),           // 37: datasource.OSSWriteRequest
-	(*OSSReadRequest)(nil),            // 38: datasource.OSSReadRequest
-	(*OSSReadResponse)(nil),           // 39: datasource.OSSReadResponse
-	(*SparkDBConnInfo)(nil),           // 40: datasource.SparkDBConnInfo
-	(*SparkConfig)(nil),               // 41: datasource.SparkConfig
-	(*TableInfoRequest)(nil),          // 42: datasource.TableInfoRequest
-	(*TableInfoResponse)(nil),         // 43: datasource.TableInfoResponse
-	(*GroupCountRequest)(nil),         // 44: datasource.GroupCountRequest
-	(*GroupCountResponse)(nil),        // 45: datasource.GroupCountResponse
-	(*TruncateTableRequest)(nil),      // 46: datasource.TruncateTableRequest
-	(*TruncateTableResponse)(nil),     // 47: datasource.TruncateTableResponse
-	(*PushJobResultRequest)(nil),      // 48: datasource.PushJobResultRequest
-	(*PushJobResultResponse)(nil),     // 49: datasource.PushJobResultResponse
-	(*JobResultExternalDBInfo)(nil),   // 50: datasource.JobResultExternalDBInfo
-	(*DatasourceTlsConfig)(nil),       // 51: datasource.DatasourceTlsConfig
-	(*ExecuteDorisSQLRequest)(nil),    // 52: datasource.ExecuteDorisSQLRequest
-	(*ExecuteDorisSQLResponse)(nil),   // 53: datasource.ExecuteDorisSQLResponse
-	(*DorisSQLRow)(nil),               // 54: datasource.DorisSQLRow
-	(*CreateExternalAndInternalTableAndImportDataRequest)(nil),  // 55: datasource.CreateExternalAndInternalTableAndImportDataRequest
-	(*CreateExternalAndInternalTableAndImportDataResponse)(nil), // 56: datasource.CreateExternalAndInternalTableAndImportDataResponse
-	(*ImportCsvFileToDorisRequest)(nil),                         // 57: datasource.ImportCsvFileToDorisRequest
-	(*ExportCsvFileFromDorisRequest)(nil),                       // 58: datasource.ExportCsvFileFromDorisRequest
-	(*ExportCsvFileFromDorisResponse)(nil),                      // 59: datasource.ExportCsvFileFromDorisResponse
-	(*ExportDorisDataToMiraDBRequest)(nil),                      // 60: datasource.ExportDorisDataToMiraDBRequest
-	(*ImportMiraDBDataToDorisRequest)(nil),                      // 61: datasource.ImportMiraDBDataToDorisRequest
-	(*ImportMiraDBDataToDorisResponse)(nil),                     // 62: datasource.ImportMiraDBDataToDorisResponse
-	(*InternalTableInfoRequest)(nil),                            // 63: datasource.InternalTableInfoRequest
-	(*CleanTmpDataRequest)(nil),                                 // 64: datasource.CleanTmpDataRequest
-	(*GetRetryCleanupTasksRequest)(nil),                         // 65: datasource.GetRetryCleanupTasksRequest
-	(*GetRetryCleanupTasksResponse)(nil),                        // 66: datasource.GetRetryCleanupTasksResponse
-	(*CleanupTaskInfo)(nil),                                     // 67: datasource.CleanupTaskInfo
-	(*ReadDataSourceStreamingRequest)(nil),                      // 68: datasource.ReadDataSourceStreamingRequest
-	(*ExecuteSqlRequest)(nil),                                   // 69: datasource.ExecuteSqlRequest
-	(*ExecuteSqlResponse)(nil),                                  // 70: datasource.ExecuteSqlResponse
-	(*DmlResult)(nil),                                           // 71: datasource.DmlResult
-	(*ReadRequest)(nil),                                         // 72: datasource.ReadRequest
-	(*FilterCondition)(nil),                                     // 73: datasource.FilterCondition
-	(*WriteRequest)(nil),                                        // 74: datasource.WriteRequest
-	(*WriteResponse)(nil),                                       // 75: datasource.WriteResponse
-	(*ImportDataRequest)(nil),                                   // 76: datasource.ImportDataRequest
-	(*ImportTarget)(nil),                                        // 77: datasource.ImportTarget
-	(*TableKey)(nil),                                            // 78: datasource.TableKey
-	(*ImportDataResponse)(nil),                                  // 79: datasource.ImportDataResponse
-	(*ImportResult)(nil),                                        // 80: datasource.ImportResult
-	nil,                                                         // 81: datasource.DorisSQLRow.ColumnsEntry
-	nil,                                                         // 82: datasource.TableKey.AttributesEntry
+type ReadRequest_External struct {
+	External *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3,oneof"` // 外部数据源
 }
-var file_proto_data_source_proto_depIdxs = []int32{
-	7,  // 0: datasource.BatchResponse.status:type_name -> datasource.JobStatus
-	14, // 1: datasource.WrappedWriterDataRequest.request:type_name -> datasource.WriterDataRequest
-	32, // 2: datasource.InternalReadRequest.filterValues:type_name -> datasource.FilterValue
-	33, // 3: datasource.InternalReadRequest.sortRules:type_name -> datasource.SortRule
-	1,  // 4: datasource.InternalReadRequest.filterOperators:type_name -> datasource.FilterOperator
-	41, // 5: datasource.BatchReadRequest.sparkConfig:type_name -> datasource.SparkConfig
-	20, // 6: datasource.BatchReadRequest.external:type_name -> datasource.ExternalDataSource
-	21, // 7: datasource.BatchReadRequest.internal:type_name -> datasource.InternalDataSource
-	23, // 8: datasource.BatchReadRequest.query:type_name -> datasource.QueryOperation
-	24, // 9: datasource.BatchReadRequest.write:type_name -> datasource.WriteOperation
-	25, // 10: datasource.BatchReadRequest.sort:type_name -> datasource.SortOperation
-	26, // 11: datasource.BatchReadRequest.count:type_name -> datasource.CountOperation
-	27, // 12: datasource.BatchReadRequest.groupby_count:type_name -> datasource.GroupByCountOperation
-	28, // 13: datasource.BatchReadRequest.join:type_name -> datasource.JoinOperation
-	29, // 14: datasource.BatchReadRequest.add_hash_column:type_name -> datasource.AddHashColumnOperation
-	30, // 15: datasource.BatchReadRequest.psi_join:type_name -> datasource.PSIJoinOperation
-	32, // 16: datasource.QueryOperation.filterValues:type_name -> datasource.FilterValue
-	1,  // 17: datasource.QueryOperation.filterOperators:type_name -> datasource.FilterOperator
-	33, // 18: datasource.QueryOperation.sortRules:type_name -> datasource.SortRule
-	32, // 19: datasource.CountOperation.filterValues:type_name -> datasource.FilterValue
-	1,  // 20: datasource.CountOperation.filterOperators:type_name -> datasource.FilterOperator
-	32, // 21: datasource.GroupByCountOperation.filterValues:type_name -> datasource.FilterValue
-	1,  // 22: datasource.GroupByCountOperation.filterOperators:type_name -> datasource.FilterOperator
-	6,  // 23: datasource.JoinOperation.joinType:type_name -> datasource.JoinType
-	2,  // 24: datasource.StreamReadRequest.fileType:type_name -> datasource.FileType
-	32, // 25: datasource.StreamReadRequest.filterValues:type_name -> datasource.FilterValue
-	33, // 26: datasource.StreamReadRequest.sortRules:type_name -> datasource.SortRule
-	1,  // 27: datasource.StreamReadRequest.filterOperators:type_name -> datasource.FilterOperator
-	0,  // 28: datasource.SortRule.sortOrder:type_name -> datasource.SortOrder
-	51, // 29: datasource.ConnectionInfo.tlsConfig:type_name -> datasource.DatasourceTlsConfig
-	35, // 30: datasource.ConnectionInfo.columns:type_name -> datasource.ColumnItem
-	5,  // 31: datasource.SparkDBConnInfo.mode:type_name -> datasource.OperationMode
-	6,  // 32: datasource.SparkDBConnInfo.joinType:type_name -> datasource.JoinType
-	8,  // 33: datasource.SparkDBConnInfo.storageType:type_name -> datasource.StorageType
-	33, // 34: datasource.SparkDBConnInfo.sortRules:type_name -> datasource.SortRule
-	35, // 35: datasource.TableInfoResponse.columns:type_name -> datasource.ColumnItem
-	32, // 36: datasource.GroupCountRequest.filterValues:type_name -> datasource.FilterValue
-	1,  // 37: datasource.GroupCountRequest.filterOperators:type_name -> datasource.FilterOperator
-	54, // 38: datasource.ExecuteDorisSQLResponse.rows:type_name -> datasource.DorisSQLRow
-	81, // 39: datasource.DorisSQLRow.columns:type_name -> datasource.DorisSQLRow.ColumnsEntry
-	33, // 40: datasource.ExportCsvFileFromDorisRequest.sortRules:type_name -> datasource.SortRule
-	73, // 41: datasource.ExportCsvFileFromDorisRequest.filterConditions:type_name -> datasource.FilterCondition
-	35, // 42: datasource.ExportDorisDataToMiraDBRequest.columns:type_name -> datasource.ColumnItem
-	67, // 43: datasource.GetRetryCleanupTasksResponse.tasks:type_name -> datasource.CleanupTaskInfo
-	20, // 44: datasource.ReadDataSourceStreamingRequest.external:type_name -> datasource.ExternalDataSource
-	21, // 45: datasource.ReadDataSourceStreamingRequest.internal:type_name -> datasource.InternalDataSource
-	22, // 46: datasource.ReadDataSourceStreamingRequest.doris:type_name -> datasource.DorisDataSource
-	33, // 47: datasource.ReadDataSourceStreamingRequest.sortRules:type_name -> datasource.SortRule
-	73, // 48: datasource.ReadDataSourceStreamingRequest.filterConditions:type_name -> datasource.FilterCondition
-	71, // 49: datasource.ExecuteSqlResponse.dmlResult:type_name -> datasource.DmlResult
-	20, // 50: datasource.ReadRequest.external:type_name -> datasource.ExternalDataSource
-	21, // 51: datasource.ReadRequest.internal:type_name -> datasource.InternalDataSource
-	22, // 52: datasource.ReadRequest.doris:type_name -> datasource.DorisDataSource
-	33, // 53: datasource.ReadRequest.sortRules:type_name -> datasource.SortRule
-	73, // 54: datasource.ReadRequest.filterConditions:type_name -> datasource.FilterCondition
-	78, // 55: datasource.ReadRequest.keys:type_name -> datasource.TableKey
-	32, // 56: datasource.FilterCondition.fieldValue:type_name -> datasource.FilterValue
-	1,  // 57: datasource.FilterCondition.operator:type_name -> datasource.FilterOperator
-	77, // 58: datasource.ImportDataRequest.targets:type_name -> datasource.ImportTarget
-	20, // 59: datasource.ImportTarget.external:type_name -> datasource.ExternalDataSource
-	78, // 60: datasource.ImportTarget.keys:type_name -> datasource.TableKey
-	9,  // 61: datasource.TableKey.keyType:type_name -> datasource.KeyType
-	82, // 62: datasource.TableKey.attributes:type_name -> datasource.TableKey.AttributesEntry
-	80, // 63: datasource.ImportDataResponse.results:type_name -> datasource.ImportResult
-	19, // 64: datasource.DataSourceService.SubmitBatchJob:input_type -> datasource.BatchReadRequest
-	31, // 65: datasource.DataSourceService.ReadStreamingData:input_type -> datasource.StreamReadRequest
-	15, // 66: datasource.DataSourceService.SendArrowData:input_type -> datasource.WrappedWriterDataRequest
-	37, // 67: datasource.DataSourceService.WriteOSSData:input_type -> datasource.OSSWriteRequest
-	37, // 68: datasource.DataSourceService.WriteOSSFileData:input_type -> datasource.OSSWriteRequest
-	38, // 69: datasource.DataSourceService.ReadOSSData:input_type -> datasource.OSSReadRequest
-	16, // 70: datasource.DataSourceService.WriteInternalData:input_type -> datasource.WriterInternalDataRequest
-	18, // 71: datasource.DataSourceService.ReadInternalData:input_type -> datasource.InternalReadRequest
-	17, // 72: datasource.DataSourceService.WriterExternalData:input_type -> datasource.WriterExternalDataRequest
-	42, // 73: datasource.DataSourceService.GetTableInfo:input_type -> datasource.TableInfoRequest
-	44, // 74: datasource.DataSourceService.GetGroupCountInfo:input_type -> datasource.GroupCountRequest
-	12, // 75: datasource.DataSourceService.GetJobStatus:input_type -> datasource.JobStatusRequest
-	46, // 76: datasource.DataSourceService.TruncateTable:input_type -> datasource.TruncateTableRequest
-	48, // 77: datasource.DataSourceService.PushJobResultToExternalDB:input_type -> datasource.PushJobResultRequest
-	52, // 78: datasource.DataSourceService.ExecuteDorisSQL:input_type -> datasource.ExecuteDorisSQLRequest
-	55, // 79: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:input_type -> datasource.CreateExternalAndInternalTableAndImportDataRequest
-	57, // 80: datasource.DataSourceService.ImportCsvFileToDoris:input_type -> datasource.ImportCsvFileToDorisRequest
-	58, // 81: datasource.DataSourceService.ExportCsvFileFromDoris:input_type -> datasource.ExportCsvFileFromDorisRequest
-	60, // 82: datasource.DataSourceService.ExportDorisDataToMiraDB:input_type -> datasource.ExportDorisDataToMiraDBRequest
-	61, // 83: datasource.DataSourceService.ImportMiraDBDataToDoris:input_type -> datasource.ImportMiraDBDataToDorisRequest
-	63, // 84: datasource.DataSourceService.GetInternalTableInfo:input_type -> datasource.InternalTableInfoRequest
-	68, // 85: datasource.DataSourceService.ReadDataSourceStreaming:input_type -> datasource.ReadDataSourceStreamingRequest
-	64, // 86: datasource.DataSourceService.CleanTmpData:input_type -> datasource.CleanTmpDataRequest
-	65, // 87: datasource.DataSourceService.GetRetryCleanupTask:input_type -> datasource.GetRetryCleanupTasksRequest
-	69, // 88: datasource.DataSourceService.ExecuteSql:input_type -> datasource.ExecuteSqlRequest
-	72, // 89: datasource.DataSourceService.Read:input_type -> datasource.ReadRequest
-	74, // 90: datasource.DataSourceService.Write:input_type -> datasource.WriteRequest
-	76, // 91: datasource.DataSourceService.ImportData:input_type -> datasource.ImportDataRequest
-	11, // 92: datasource.DataSourceService.SubmitBatchJob:output_type -> datasource.BatchResponse
-	13, // 93: datasource.DataSourceService.ReadStreamingData:output_type -> datasource.ArrowResponse
-	10, // 94: datasource.DataSourceService.SendArrowData:output_type -> datasource.Response
-	10, // 95: datasource.DataSourceService.WriteOSSData:output_type -> datasource.Response
-	10, // 96: datasource.DataSourceService.WriteOSSFileData:output_type -> datasource.Response
-	39, // 97: datasource.DataSourceService.ReadOSSData:output_type -> datasource.OSSReadResponse
-	10, // 98: datasource.DataSourceService.WriteInternalData:output_type -> datasource.Response
-	13, // 99: datasource.DataSourceService.ReadInternalData:output_type -> datasource.ArrowResponse
-	10, // 100: datasource.DataSourceService.WriterExternalData:output_type -> datasource.Response
-	43, // 101: datasource.DataSourceService.GetTableInfo:output_type -> datasource.TableInfoResponse
-	45, // 102: datasource.DataSourceService.GetGroupCountInfo:output_type -> datasource.GroupCountResponse
-	11, // 103: datasource.DataSourceService.GetJobStatus:output_type -> datasource.BatchResponse
-	47, // 104: datasource.DataSourceService.TruncateTable:output_type -> datasource.TruncateTableResponse
-	49, // 105: datasource.DataSourceService.PushJobResultToExternalDB:output_type -> datasource.PushJobResultResponse
-	53, // 106: datasource.DataSourceService.ExecuteDorisSQL:output_type -> datasource.ExecuteDorisSQLResponse
-	56, // 107: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:output_type -> datasource.CreateExternalAndInternalTableAndImportDataResponse
-	10, // 108: datasource.DataSourceService.ImportCsvFileToDoris:output_type -> datasource.Response
-	59, // 109: datasource.DataSourceService.ExportCsvFileFromDoris:output_type -> datasource.ExportCsvFileFromDorisResponse
-	10, // 110: datasource.DataSourceService.ExportDorisDataToMiraDB:output_type -> datasource.Response
-	62, // 111: datasource.DataSourceService.ImportMiraDBDataToDoris:output_type -> datasource.ImportMiraDBDataToDorisResponse
-	43, // 112: datasource.DataSourceService.GetInternalTableInfo:output_type -> datasource.TableInfoResponse
-	13, // 113: datasource.DataSourceService.ReadDataSourceStreaming:output_type -> datasource.ArrowResponse
-	10, // 114: datasource.DataSourceService.CleanTmpData:output_type -> datasource.Response
-	66, // 115: datasource.DataSourceService.GetRetryCleanupTask:output_type -> datasource.GetRetryCleanupTasksResponse
-	70, // 116: datasource.DataSourceService.ExecuteSql:output_type -> datasource.ExecuteSqlResponse
-	13, // 117: datasource.DataSourceService.Read:output_type -> datasource.ArrowResponse
-	75, // 118: datasource.DataSourceService.Write:output_type -> datasource.WriteResponse
-	79, // 119: datasource.DataSourceService.ImportData:output_type -> datasource.ImportDataResponse
-	92, // [92:120] is the sub-list for method output_type
-	64, // [64:92] is the sub-list for method input_type
-	64, // [64:64] is the sub-list for extension type_name
-	64, // [64:64] is the sub-list for extension extendee
-	0,  // [0:64] is the sub-list for field type_name
+
+type ReadRequest_Internal struct {
+	Internal *InternalDataSource `protobuf:"bytes,2,opt,name=internal,proto3,oneof"` // 内部数据源
+}
+
+type ReadRequest_Doris struct {
+	Doris *DorisDataSource `protobuf:"bytes,3,opt,name=doris,proto3,oneof"` // doris数据源
+}
+
+func (*ReadRequest_External) isReadRequest_DataSource() {}
+
+func (*ReadRequest_Internal) isReadRequest_DataSource() {}
+
+func (*ReadRequest_Doris) isReadRequest_DataSource() {}
+
+// FilterCondition 过滤条件结构体
+type FilterCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldName  string         `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`                               // 过滤字段名
+	FieldValue *FilterValue   `protobuf:"bytes,2,opt,name=fieldValue,proto3" json:"fieldValue,omitempty"`                             // 过滤字段值
+	Operator   FilterOperator `protobuf:"varint,3,opt,name=operator,proto3,enum=datasource.FilterOperator" json:"operator,omitempty"` // 过滤操作符
+}
+
+func (x *FilterCondition) Reset() {
+	*x = FilterCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterCondition) ProtoMessage() {}
+
+func (x *FilterCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterCondition.ProtoReflect.Descriptor instead.
+func (*FilterCondition) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FilterCondition) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *FilterCondition) GetFieldValue() *FilterValue {
+	if x != nil {
+		return x.FieldValue
+	}
+	return nil
+}
+
+func (x *FilterCondition) GetOperator() FilterOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return FilterOperator_EQUAL
+}
+
+type WriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArrowBatch []byte    `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	DbName     string    `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	TableName  string    `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`                            // 写入的目标表名
+	WriteMode  WriteMode `protobuf:"varint,4,opt,name=writeMode,proto3,enum=datasource.WriteMode" json:"writeMode,omitempty"` // 写入模式，只在流的第一个请求中生效
+	KeyColumns []string  `protobuf:"bytes,5,rep,name=keyColumns,proto3" json:"keyColumns,omitempty"`                          // upsert 时作为 Doris Unique Key 的键列
+	Label      string    `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`                                    // Stream Load 标签，只在流的第一个请求中生效，相同标签的写入只会成功一次，为空时随机生成
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *WriteRequest) GetArrowBatch() []byte {
+	if x != nil {
+		return x.ArrowBatch
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetWriteMode() WriteMode {
+	if x != nil {
+		return x.WriteMode
+	}
+	return WriteMode_WRITE_MODE_APPEND
+}
+
+func (x *WriteRequest) GetKeyColumns() []string {
+	if x != nil {
+		return x.KeyColumns
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *WriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Targets []*ImportTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"` // 导入目标列表
+}
+
+func (x *ImportDataRequest) Reset() {
+	*x = ImportDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataRequest) ProtoMessage() {}
+
+func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ImportDataRequest) GetTargets() []*ImportTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+// 数据导入目标配置
+type ImportTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	External        *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3" json:"external,omitempty"`                                          // 外部数据源
+	TargetTableName string              `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"`                            // 目标表名
+	DbName          string              `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`                                              // 数据库名
+	Columns         []string            `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                                            // 导入字段
+	Keys            []*TableKey         `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`                                                  // 表键信息
+	Incremental     *IncrementalImport  `protobuf:"bytes,6,opt,name=incremental,proto3" json:"incremental,omitempty"`                                    // 增量导入配置，为空时每次全量导入
+	ReconcileMode   ReconcileMode       `protobuf:"varint,7,opt,name=reconcileMode,proto3,enum=datasource.ReconcileMode" json:"reconcileMode,omitempty"` // 源表与导入表的对账强度，增量导入时只比对本次导入的水位区间
+}
+
+func (x *ImportTarget) Reset() {
+	*x = ImportTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTarget) ProtoMessage() {}
+
+func (x *ImportTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTarget.ProtoReflect.Descriptor instead.
+func (*ImportTarget) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ImportTarget) GetExternal() *ExternalDataSource {
+	if x != nil {
+		return x.External
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetTargetTableName() string {
+	if x != nil {
+		return x.TargetTableName
+	}
+	return ""
+}
+
+func (x *ImportTarget) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ImportTarget) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetIncremental() *IncrementalImport {
+	if x != nil {
+		return x.Incremental
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetReconcileMode() ReconcileMode {
+	if x != nil {
+		return x.ReconcileMode
+	}
+	return ReconcileMode_RECONCILE_MODE_UNSPECIFIED
+}
+
+// 增量导入配置，水位保存在服务的元数据库中，按 dbName + targetTableName 区分
+type IncrementalImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WatermarkColumn string `protobuf:"bytes,1,opt,name=watermarkColumn,proto3" json:"watermarkColumn,omitempty"` // 水位列：更新时间戳或单调递增的 id，再次导入时只拉取该列大于上次水位的行
+	FullRefresh     bool   `protobuf:"varint,2,opt,name=fullRefresh,proto3" json:"fullRefresh,omitempty"`        // 忽略已保存的水位，重建目标表并全量导入
+}
+
+func (x *IncrementalImport) Reset() {
+	*x = IncrementalImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrementalImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementalImport) ProtoMessage() {}
+
+func (x *IncrementalImport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementalImport.ProtoReflect.Descriptor instead.
+func (*IncrementalImport) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IncrementalImport) GetWatermarkColumn() string {
+	if x != nil {
+		return x.WatermarkColumn
+	}
+	return ""
+}
+
+func (x *IncrementalImport) GetFullRefresh() bool {
+	if x != nil {
+		return x.FullRefresh
+	}
+	return false
+}
+
+// 表键信息
+type TableKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyName     string            `protobuf:"bytes,1,opt,name=keyName,proto3" json:"keyName,omitempty"`                                                                                               // 键名称（可选）
+	KeyType     KeyType           `protobuf:"varint,2,opt,name=keyType,proto3,enum=datasource.KeyType" json:"keyType,omitempty"`                                                                      // 键类型
+	ColumnNames []string          `protobuf:"bytes,3,rep,name=columnNames,proto3" json:"columnNames,omitempty"`                                                                                       // 键包含的列名（支持组合键）
+	Attributes  map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 扩展属性（可选，可扩展）
+}
+
+func (x *TableKey) Reset() {
+	*x = TableKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableKey) ProtoMessage() {}
+
+func (x *TableKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableKey.ProtoReflect.Descriptor instead.
+func (*TableKey) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TableKey) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *TableKey) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_UNKNOWN
+}
+
+func (x *TableKey) GetColumnNames() []string {
+	if x != nil {
+		return x.ColumnNames
+	}
+	return nil
+}
+
+func (x *TableKey) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ImportDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results []*ImportResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // 多个导入结果
+}
+
+func (x *ImportDataResponse) Reset() {
+	*x = ImportDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataResponse) ProtoMessage() {}
+
+func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ImportDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportDataResponse) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 单个导入结果
+type ImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceTableName string           `protobuf:"bytes,1,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 源表名
+	TargetTableName string           `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名（写入的表）
+	SourceDatabase  string           `protobuf:"bytes,3,opt,name=sourceDatabase,proto3" json:"sourceDatabase,omitempty"`   // 源数据库名
+	TargetDatabase  string           `protobuf:"bytes,4,opt,name=targetDatabase,proto3" json:"targetDatabase,omitempty"`   // 目标数据库名
+	AffectedRows    int64            `protobuf:"varint,5,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`      // 影响的行数
+	Success         bool             `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`                // 该目标是否导入成功
+	ErrorMessage    string           `protobuf:"bytes,7,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`       // 如果失败，错误信息
+	Watermark       string           `protobuf:"bytes,8,opt,name=watermark,proto3" json:"watermark,omitempty"`             // 增量导入后保存的水位
+	Incremental     bool             `protobuf:"varint,9,opt,name=incremental,proto3" json:"incremental,omitempty"`        // 本次是否只导入了上次水位之后的数据
+	Reconciliation  *ReconcileReport `protobuf:"bytes,10,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`  // 源表与导入表的对账结果，未对账时为空
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ImportResult) GetSourceTableName() string {
+	if x != nil {
+		return x.SourceTableName
+	}
+	return ""
+}
+
+func (x *ImportResult) GetTargetTableName() string {
+	if x != nil {
+		return x.TargetTableName
+	}
+	return ""
+}
+
+func (x *ImportResult) GetSourceDatabase() string {
+	if x != nil {
+		return x.SourceDatabase
+	}
+	return ""
+}
+
+func (x *ImportResult) GetTargetDatabase() string {
+	if x != nil {
+		return x.TargetDatabase
+	}
+	return ""
+}
+
+func (x *ImportResult) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *ImportResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ImportResult) GetWatermark() string {
+	if x != nil {
+		return x.Watermark
+	}
+	return ""
+}
+
+func (x *ImportResult) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *ImportResult) GetReconciliation() *ReconcileReport {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+// 对账结果
+type ReconcileReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode       ReconcileMode        `protobuf:"varint,1,opt,name=mode,proto3,enum=datasource.ReconcileMode" json:"mode,omitempty"` // 实际使用的对账强度
+	Matched    bool                 `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`                         // 两端是否一致
+	SourceRows int64                `protobuf:"varint,3,opt,name=sourceRows,proto3" json:"sourceRows,omitempty"`                   // 源端行数
+	TargetRows int64                `protobuf:"varint,4,opt,name=targetRows,proto3" json:"targetRows,omitempty"`                   // 目标端行数
+	Mismatches []*ReconcileMismatch `protobuf:"bytes,5,rep,name=mismatches,proto3" json:"mismatches,omitempty"`                    // 不一致的指标
+}
+
+func (x *ReconcileReport) Reset() {
+	*x = ReconcileReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReport) ProtoMessage() {}
+
+func (x *ReconcileReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReport.ProtoReflect.Descriptor instead.
+func (*ReconcileReport) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReconcileReport) GetMode() ReconcileMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ReconcileMode_RECONCILE_MODE_UNSPECIFIED
+}
+
+func (x *ReconcileReport) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *ReconcileReport) GetSourceRows() int64 {
+	if x != nil {
+		return x.SourceRows
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetTargetRows() int64 {
+	if x != nil {
+		return x.TargetRows
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetMismatches() []*ReconcileMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+// 不一致的对账指标
+type ReconcileMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Column      string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`           // 列名，行数和整行哈希不一致时为空
+	Metric      string `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`           // 指标：rows、row_hash、non_null、sum、min、max
+	SourceValue string `protobuf:"bytes,3,opt,name=sourceValue,proto3" json:"sourceValue,omitempty"` // 源端的值
+	TargetValue string `protobuf:"bytes,4,opt,name=targetValue,proto3" json:"targetValue,omitempty"` // 目标端的值
+}
+
+func (x *ReconcileMismatch) Reset() {
+	*x = ReconcileMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileMismatch) ProtoMessage() {}
+
+func (x *ReconcileMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileMismatch.ProtoReflect.Descriptor instead.
+func (*ReconcileMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ReconcileMismatch) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ReconcileMismatch) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *ReconcileMismatch) GetSourceValue() string {
+	if x != nil {
+		return x.SourceValue
+	}
+	return ""
+}
+
+func (x *ReconcileMismatch) GetTargetValue() string {
+	if x != nil {
+		return x.TargetValue
+	}
+	return ""
+}
+
+type SubmitAsyncJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//
+	//	*SubmitAsyncJobRequest_ImportData
+	//	*SubmitAsyncJobRequest_ExportCsvFileFromDoris
+	//	*SubmitAsyncJobRequest_ImportMiraDBDataToDoris
+	//	*SubmitAsyncJobRequest_CreateExternalAndInternalTableAndImportData
+	Request isSubmitAsyncJobRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *SubmitAsyncJobRequest) Reset() {
+	*x = SubmitAsyncJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAsyncJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAsyncJobRequest) ProtoMessage() {}
+
+func (x *SubmitAsyncJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAsyncJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAsyncJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{84}
+}
+
+func (m *SubmitAsyncJobRequest) GetRequest() isSubmitAsyncJobRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *SubmitAsyncJobRequest) GetImportData() *ImportDataRequest {
+	if x, ok := x.GetRequest().(*SubmitAsyncJobRequest_ImportData); ok {
+		return x.ImportData
+	}
+	return nil
+}
+
+func (x *SubmitAsyncJobRequest) GetExportCsvFileFromDoris() *ExportCsvFileFromDorisRequest {
+	if x, ok := x.GetRequest().(*SubmitAsyncJobRequest_ExportCsvFileFromDoris); ok {
+		return x.ExportCsvFileFromDoris
+	}
+	return nil
+}
+
+func (x *SubmitAsyncJobRequest) GetImportMiraDBDataToDoris() *ImportMiraDBDataToDorisRequest {
+	if x, ok := x.GetRequest().(*SubmitAsyncJobRequest_ImportMiraDBDataToDoris); ok {
+		return x.ImportMiraDBDataToDoris
+	}
+	return nil
+}
+
+func (x *SubmitAsyncJobRequest) GetCreateExternalAndInternalTableAndImportData() *CreateExternalAndInternalTableAndImportDataRequest {
+	if x, ok := x.GetRequest().(*SubmitAsyncJobRequest_CreateExternalAndInternalTableAndImportData); ok {
+		return x.CreateExternalAndInternalTableAndImportData
+	}
+	return nil
+}
+
+type isSubmitAsyncJobRequest_Request interface {
+	isSubmitAsyncJobRequest_Request()
+}
+
+type SubmitAsyncJobRequest_ImportData struct {
+	ImportData *ImportDataRequest `protobuf:"bytes,1,opt,name=importData,proto3,oneof"`
+}
+
+type SubmitAsyncJobRequest_ExportCsvFileFromDoris struct {
+	ExportCsvFileFromDoris *ExportCsvFileFromDorisRequest `protobuf:"bytes,2,opt,name=exportCsvFileFromDoris,proto3,oneof"`
+}
+
+type SubmitAsyncJobRequest_ImportMiraDBDataToDoris struct {
+	ImportMiraDBDataToDoris *ImportMiraDBDataToDorisRequest `protobuf:"bytes,3,opt,name=importMiraDBDataToDoris,proto3,oneof"`
+}
+
+type SubmitAsyncJobRequest_CreateExternalAndInternalTableAndImportData struct {
+	CreateExternalAndInternalTableAndImportData *CreateExternalAndInternalTableAndImportDataRequest `protobuf:"bytes,4,opt,name=createExternalAndInternalTableAndImportData,proto3,oneof"`
+}
+
+func (*SubmitAsyncJobRequest_ImportData) isSubmitAsyncJobRequest_Request() {}
+
+func (*SubmitAsyncJobRequest_ExportCsvFileFromDoris) isSubmitAsyncJobRequest_Request() {}
+
+func (*SubmitAsyncJobRequest_ImportMiraDBDataToDoris) isSubmitAsyncJobRequest_Request() {}
+
+func (*SubmitAsyncJobRequest_CreateExternalAndInternalTableAndImportData) isSubmitAsyncJobRequest_Request() {
+}
+
+// 异步作业的执行进度
+type AsyncJobProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase   string  `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`       // 当前阶段
+	Rows    int64   `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`        // 已处理的行数
+	Bytes   int64   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`      // 已处理的字节数
+	Percent float64 `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"` // 完成百分比（0-100）
+}
+
+func (x *AsyncJobProgress) Reset() {
+	*x = AsyncJobProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncJobProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncJobProgress) ProtoMessage() {}
+
+func (x *AsyncJobProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncJobProgress.ProtoReflect.Descriptor instead.
+func (*AsyncJobProgress) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AsyncJobProgress) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AsyncJobProgress) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *AsyncJobProgress) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *AsyncJobProgress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// 异步作业的结果，与同步接口的返回值相同
+type AsyncJobResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*AsyncJobResult_ImportData
+	//	*AsyncJobResult_ExportCsvFileFromDoris
+	//	*AsyncJobResult_ImportMiraDBDataToDoris
+	//	*AsyncJobResult_CreateExternalAndInternalTableAndImportData
+	Result isAsyncJobResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *AsyncJobResult) Reset() {
+	*x = AsyncJobResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncJobResult) ProtoMessage() {}
+
+func (x *AsyncJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncJobResult.ProtoReflect.Descriptor instead.
+func (*AsyncJobResult) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{86}
+}
+
+func (m *AsyncJobResult) GetResult() isAsyncJobResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *AsyncJobResult) GetImportData() *ImportDataResponse {
+	if x, ok := x.GetResult().(*AsyncJobResult_ImportData); ok {
+		return x.ImportData
+	}
+	return nil
+}
+
+func (x *AsyncJobResult) GetExportCsvFileFromDoris() *ExportCsvFileFromDorisResponse {
+	if x, ok := x.GetResult().(*AsyncJobResult_ExportCsvFileFromDoris); ok {
+		return x.ExportCsvFileFromDoris
+	}
+	return nil
+}
+
+func (x *AsyncJobResult) GetImportMiraDBDataToDoris() *ImportMiraDBDataToDorisResponse {
+	if x, ok := x.GetResult().(*AsyncJobResult_ImportMiraDBDataToDoris); ok {
+		return x.ImportMiraDBDataToDoris
+	}
+	return nil
+}
+
+func (x *AsyncJobResult) GetCreateExternalAndInternalTableAndImportData() *CreateExternalAndInternalTableAndImportDataResponse {
+	if x, ok := x.GetResult().(*AsyncJobResult_CreateExternalAndInternalTableAndImportData); ok {
+		return x.CreateExternalAndInternalTableAndImportData
+	}
+	return nil
+}
+
+type isAsyncJobResult_Result interface {
+	isAsyncJobResult_Result()
+}
+
+type AsyncJobResult_ImportData struct {
+	ImportData *ImportDataResponse `protobuf:"bytes,1,opt,name=importData,proto3,oneof"`
+}
+
+type AsyncJobResult_ExportCsvFileFromDoris struct {
+	ExportCsvFileFromDoris *ExportCsvFileFromDorisResponse `protobuf:"bytes,2,opt,name=exportCsvFileFromDoris,proto3,oneof"`
+}
+
+type AsyncJobResult_ImportMiraDBDataToDoris struct {
+	ImportMiraDBDataToDoris *ImportMiraDBDataToDorisResponse `protobuf:"bytes,3,opt,name=importMiraDBDataToDoris,proto3,oneof"`
+}
+
+type AsyncJobResult_CreateExternalAndInternalTableAndImportData struct {
+	CreateExternalAndInternalTableAndImportData *CreateExternalAndInternalTableAndImportDataResponse `protobuf:"bytes,4,opt,name=createExternalAndInternalTableAndImportData,proto3,oneof"`
+}
+
+func (*AsyncJobResult_ImportData) isAsyncJobResult_Result() {}
+
+func (*AsyncJobResult_ExportCsvFileFromDoris) isAsyncJobResult_Result() {}
+
+func (*AsyncJobResult_ImportMiraDBDataToDoris) isAsyncJobResult_Result() {}
+
+func (*AsyncJobResult_CreateExternalAndInternalTableAndImportData) isAsyncJobResult_Result() {}
+
+type AsyncJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId           string            `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	JobType         AsyncJobType      `protobuf:"varint,2,opt,name=jobType,proto3,enum=datasource.AsyncJobType" json:"jobType,omitempty"`
+	Status          AsyncJobStatus    `protobuf:"varint,3,opt,name=status,proto3,enum=datasource.AsyncJobStatus" json:"status,omitempty"`
+	Progress        *AsyncJobProgress `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Result          *AsyncJobResult   `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`                    // 执行成功后的结果
+	ErrorMessage    string            `protobuf:"bytes,6,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`        // 失败或取消的原因
+	CancelRequested bool              `protobuf:"varint,7,opt,name=cancelRequested,proto3" json:"cancelRequested,omitempty"` // 已请求取消，执行节点尚未中止
+	CreatedAt       int64             `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`             // 提交时间，Unix 时间戳（秒）
+	StartedAt       int64             `protobuf:"varint,9,opt,name=startedAt,proto3" json:"startedAt,omitempty"`             // 开始执行时间，未开始时为 0
+	FinishedAt      int64             `protobuf:"varint,10,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`          // 结束时间，未结束时为 0
+}
+
+func (x *AsyncJob) Reset() {
+	*x = AsyncJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncJob) ProtoMessage() {}
+
+func (x *AsyncJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncJob.ProtoReflect.Descriptor instead.
+func (*AsyncJob) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AsyncJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AsyncJob) GetJobType() AsyncJobType {
+	if x != nil {
+		return x.JobType
+	}
+	return AsyncJobType_ASYNC_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *AsyncJob) GetStatus() AsyncJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AsyncJobStatus_ASYNC_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *AsyncJob) GetProgress() *AsyncJobProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *AsyncJob) GetResult() *AsyncJobResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *AsyncJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AsyncJob) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *AsyncJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AsyncJob) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *AsyncJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+type GetAsyncJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (x *GetAsyncJobRequest) Reset() {
+	*x = GetAsyncJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAsyncJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAsyncJobRequest) ProtoMessage() {}
+
+func (x *GetAsyncJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAsyncJobRequest.ProtoReflect.Descriptor instead.
+func (*GetAsyncJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetAsyncJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ListAsyncJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobType  AsyncJobType   `protobuf:"varint,1,opt,name=jobType,proto3,enum=datasource.AsyncJobType" json:"jobType,omitempty"` // 为 UNSPECIFIED 时不过滤
+	Status   AsyncJobStatus `protobuf:"varint,2,opt,name=status,proto3,enum=datasource.AsyncJobStatus" json:"status,omitempty"` // 为 UNSPECIFIED 时不过滤
+	Page     int32          `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                    // 从 1 开始
+	PageSize int32          `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`                            // 为 0 时使用默认值 20，最大 100
+}
+
+func (x *ListAsyncJobsRequest) Reset() {
+	*x = ListAsyncJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAsyncJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAsyncJobsRequest) ProtoMessage() {}
+
+func (x *ListAsyncJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAsyncJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListAsyncJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListAsyncJobsRequest) GetJobType() AsyncJobType {
+	if x != nil {
+		return x.JobType
+	}
+	return AsyncJobType_ASYNC_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *ListAsyncJobsRequest) GetStatus() AsyncJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AsyncJobStatus_ASYNC_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ListAsyncJobsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAsyncJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAsyncJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs       []*AsyncJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	TotalCount int32       `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Page       int32       `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32       `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+}
+
+func (x *ListAsyncJobsResponse) Reset() {
+	*x = ListAsyncJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAsyncJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAsyncJobsResponse) ProtoMessage() {}
+
+func (x *ListAsyncJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAsyncJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListAsyncJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListAsyncJobsResponse) GetJobs() []*AsyncJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListAsyncJobsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListAsyncJobsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAsyncJobsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CancelAsyncJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (x *CancelAsyncJobRequest) Reset() {
+	*x = CancelAsyncJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelAsyncJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAsyncJobRequest) ProtoMessage() {}
+
+func (x *CancelAsyncJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAsyncJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelAsyncJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CancelAsyncJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type WaitAsyncJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId          string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"` // 最长等待时间，为 0 时使用默认值 30 秒，最大 300 秒
+}
+
+func (x *WaitAsyncJobRequest) Reset() {
+	*x = WaitAsyncJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitAsyncJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitAsyncJobRequest) ProtoMessage() {}
+
+func (x *WaitAsyncJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitAsyncJobRequest.ProtoReflect.Descriptor instead.
+func (*WaitAsyncJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *WaitAsyncJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *WaitAsyncJobRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+var File_proto_data_source_proto protoreflect.FileDescriptor
+
+var file_proto_data_source_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x01, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x92, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x53,
+	0x0a, 0x0d, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f,
+	0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61,
+	0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x18, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb2, 0x01, 0x0a,
+	0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72,
+	0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x22, 0xa3, 0x02, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x98, 0x04, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f,
+	0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x48, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xd4, 0x05, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x32, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04,
+	0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x01, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x73, 0x69, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x50, 0x53, 0x49, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x01, 0x52, 0x07, 0x70, 0x73, 0x69, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x0d, 0x0a,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x12, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x47, 0x0a, 0x0f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe9, 0x02, 0x0a, 0x0e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x0e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61,
+	0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x77, 0x0a, 0x0d, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x15, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xc7, 0x01, 0x0a,
+	0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x50, 0x53, 0x49, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0xf4, 0x05, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x48, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x61, 0x72,
+	0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0c, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6b,
+	0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x8b, 0x01,
+	0x0a, 0x13, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x69, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61,
+	0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x0b,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x03, 0x6e, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22,
+	0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x3d, 0x0a, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x74,
+	0x4e, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x4f, 0x53, 0x53, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x50, 0x0a, 0x0e,
+	0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b,
+	0x0a, 0x0f, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xab, 0x05, 0x0a, 0x0f,
+	0x53, 0x70, 0x61, 0x72, 0x6b, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xb9, 0x03, 0x0a, 0x0b, 0x53, 0x70,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x18, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1d, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x45, 0x78,
+	0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x6f,
+	0x72, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f,
+	0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x38, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x75, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x90,
+	0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x22, 0xde, 0x02, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xcf, 0x04, 0x0a, 0x10, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05,
+	0x64, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72, 0x69,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x68, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x54,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x4b, 0x0a, 0x15, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe0,
+	0x02, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x74,
+	0x61, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x43, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x43, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65,
+	0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x43, 0x65,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x71, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
+	0x6f, 0x77, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0,
+	0x01, 0x0a, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a,
+	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x53, 0x0a, 0x33, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x8d, 0x04, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x65,
+	0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x32,
+	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x66, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xdb,
+	0x01, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x6c, 0x0a, 0x1e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1f, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f,
+	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x45, 0x78,
+	0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d,
+	0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x4e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0xa3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x61, 0x0a, 0x0f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc8, 0x04, 0x0a, 0x1e, 0x52, 0x65, 0x61,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a,
+	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12,
+	0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a,
+	0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72,
+	0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xac, 0x01, 0x0a,
+	0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a,
+	0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x6d,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6d, 0x6c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x44,
+	0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xb2, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33,
+	0x0a, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f,
+	0x72, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x32, 0x0a,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x43, 0x0a,
+	0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xd2, 0x02, 0x0a, 0x0c,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x3f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12,
+	0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x22, 0x5f, 0x0a, 0x11, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x22, 0xfa, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x4b, 0x65, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c,
+	0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x99, 0x03, 0x0a,
+	0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd5,
+	0x03, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x63, 0x0a, 0x16, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f,
+	0x72, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76,
+	0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73,
+	0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x66,
+	0x0a, 0x17, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x2b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x2b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e,
+	0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x10, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a,
+	0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x22, 0xd1, 0x03, 0x0a, 0x0e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x64, 0x0a, 0x16, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f,
+	0x72, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76,
+	0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12,
+	0x67, 0x0a, 0x17, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f,
+	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x17, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x2b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x2b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x08, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6a,
+	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a,
+	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2d, 0x0a, 0x15,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x57,
+	0x61, 0x69, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x2a, 0x90, 0x01, 0x0a, 0x0c, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x4d,
+	0x50, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54,
+	0x5f, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x4e, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x48, 0x41, 0x53,
+	0x48, 0x10, 0x04, 0x2a, 0x1e, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53,
+	0x43, 0x10, 0x01, 0x2a, 0x82, 0x02, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10,
+	0x03, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41,
+	0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12,
+	0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55,
+	0x41, 0x4c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4b, 0x45, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x45, 0x54, 0x57,
+	0x45, 0x45, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x08, 0x12, 0x0b,
+	0x0a, 0x07, 0x49, 0x53, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
+	0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10,
+	0x0b, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x54, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x0c, 0x2a, 0x32, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69,
+	0x63, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x4c,
+	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x9a, 0x01, 0x0a,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x53,
+	0x56, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x12, 0x12,
+	0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x56, 0x52, 0x4f,
+	0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x06, 0x2a, 0xf0, 0x02, 0x0a, 0x0e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x47, 0x42,
+	0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x56, 0x45, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x44, 0x42, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x54, 0x44, 0x53, 0x51, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x53, 0x54,
+	0x42, 0x41, 0x53, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x42, 0x41, 0x53, 0x45,
+	0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x49, 0x53, 0x10, 0x08, 0x12, 0x1f,
+	0x0a, 0x1b, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x51, 0x4c, 0x10, 0x09, 0x12,
+	0x1e, 0x0a, 0x1a, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x47, 0x41, 0x55, 0x53, 0x53, 0x10, 0x0a, 0x12,
+	0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x4d, 0x45, 0x4e, 0x47, 0x10, 0x0b, 0x2a, 0x3f, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x43,
+	0x54, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x2a, 0xc8, 0x01,
+	0x0a, 0x11, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45,
+	0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46,
+	0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x44, 0x49,
+	0x53, 0x54, 0x49, 0x4e, 0x43, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x55, 0x4d, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x56, 0x47, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55,
+	0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x05, 0x2a, 0x9c, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45,
+	0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45,
+	0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45,
+	0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4f, 0x57,
+	0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x04, 0x2a, 0xf5, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x41, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f,
+	0x44, 0x4f, 0x52, 0x49, 0x53, 0x10, 0x02, 0x12, 0x2f, 0x0a, 0x2b, 0x41, 0x53, 0x59, 0x4e, 0x43,
+	0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x4d, 0x49, 0x52, 0x41, 0x5f, 0x44, 0x42, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x4f,
+	0x5f, 0x44, 0x4f, 0x52, 0x49, 0x53, 0x10, 0x03, 0x12, 0x45, 0x0a, 0x41, 0x41, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x41, 0x4e,
+	0x44, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x04, 0x2a,
+	0xcb, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4a, 0x4f,
+	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e, 0x0a,
+	0x1a, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x22, 0x0a,
+	0x0a, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x4d,
+	0x49, 0x52, 0x41, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x10,
+	0x00, 0x2a, 0x8e, 0x02, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x42, 0x59,
+	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10,
+	0x06, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x43, 0x4f, 0x4c,
+	0x55, 0x4d, 0x4e, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x53, 0x49, 0x5f, 0x4a, 0x4f, 0x49, 0x4e,
+	0x10, 0x08, 0x2a, 0x74, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f,
+	0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x13,
+	0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x49, 0x47, 0x48,
+	0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x84, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0x54, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52,
+	0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x42, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49,
+	0x4e, 0x49, 0x4f, 0x10, 0x02, 0x2a, 0x71, 0x0a, 0x09, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x5f,
+	0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x2a, 0x91, 0x01, 0x0a, 0x07, 0x4b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x45, 0x49, 0x47, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4b,
+	0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x04, 0x12,
+	0x1b, 0x0a, 0x17, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f,
+	0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x32, 0x8e, 0x18, 0x0a,
+	0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
+	0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
+	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4d,
+	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x43, 0x0a,
+	0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x12, 0x47, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x46, 0x69,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x65, 0x61,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x19, 0x50, 0x75, 0x73,
+	0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x42, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x12, 0x22,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xae, 0x01, 0x0a, 0x2b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73,
+	0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x44, 0x6f, 0x72,
+	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6f, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76,
+	0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x12, 0x2a, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
+	0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61,
+	0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x6d, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x97, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4d, 0x0a, 0x0a, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x04, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62,
+	0x12, 0x43, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12,
+	0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12, 0x21, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12, 0x45, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x42, 0x0e, 0x5a,
+	0x0c, 0x2e, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_data_source_proto_rawDescOnce sync.Once
+	file_proto_data_source_proto_rawDescData = file_proto_data_source_proto_rawDesc
+)
+
+func file_proto_data_source_proto_rawDescGZIP() []byte {
+	file_proto_data_source_proto_rawDescOnce.Do(func() {
+		file_proto_data_source_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_data_source_proto_rawDescData)
+	})
+	return file_proto_data_source_proto_rawDescData
+}
+
+var file_proto_data_source_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_proto_data_source_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_proto_data_source_proto_goTypes = []any{
+	(SampleMethod)(0),                 // 0: datasource.SampleMethod
+	(SortOrder)(0),                    // 1: datasource.SortOrder
+	(FilterOperator)(0),               // 2: datasource.FilterOperator
+	(LogicalOperator)(0),              // 3: datasource.LogicalOperator
+	(FileType)(0),                     // 4: datasource.FileType
+	(DataSourceType)(0),               // 5: datasource.DataSourceType
+	(ProfileMode)(0),                  // 6: datasource.ProfileMode
+	(AggregateFunction)(0),            // 7: datasource.AggregateFunction
+	(ReconcileMode)(0),                // 8: datasource.ReconcileMode
+	(AsyncJobType)(0),                 // 9: datasource.AsyncJobType
+	(AsyncJobStatus)(0),               // 10: datasource.AsyncJobStatus
+	(DbConstant)(0),                   // 11: datasource.DbConstant
+	(OperationMode)(0),                // 12: datasource.OperationMode
+	(JoinType)(0),                     // 13: datasource.JoinType
+	(JobStatus)(0),                    // 14: datasource.JobStatus
+	(StorageType)(0),                  // 15: datasource.StorageType
+	(WriteMode)(0),                    // 16: datasource.WriteMode
+	(KeyType)(0),                      // 17: datasource.KeyType
+	(*Response)(nil),                  // 18: datasource.Response
+	(*BatchResponse)(nil),             // 19: datasource.BatchResponse
+	(*JobStatusRequest)(nil),          // 20: datasource.JobStatusRequest
+	(*ArrowResponse)(nil),             // 21: datasource.ArrowResponse
+	(*WriterDataRequest)(nil),         // 22: datasource.WriterDataRequest
+	(*WrappedWriterDataRequest)(nil),  // 23: datasource.WrappedWriterDataRequest
+	(*WriterInternalDataRequest)(nil), // 24: datasource.WriterInternalDataRequest
+	(*WriterExternalDataRequest)(nil), // 25: datasource.WriterExternalDataRequest
+	(*InternalReadRequest)(nil),       // 26: datasource.InternalReadRequest
+	(*BatchReadRequest)(nil),          // 27: datasource.BatchReadRequest
+	(*ExternalDataSource)(nil),        // 28: datasource.ExternalDataSource
+	(*InternalDataSource)(nil),        // 29: datasource.InternalDataSource
+	(*DorisDataSource)(nil),           // 30: datasource.DorisDataSource
+	(*QueryOperation)(nil),            // 31: datasource.QueryOperation
+	(*WriteOperation)(nil),            // 32: datasource.WriteOperation
+	(*SortOperation)(nil),             // 33: datasource.SortOperation
+	(*CountOperation)(nil),            // 34: datasource.CountOperation
+	(*GroupByCountOperation)(nil),     // 35: datasource.GroupByCountOperation
+	(*JoinOperation)(nil),             // 36: datasource.JoinOperation
+	(*AddHashColumnOperation)(nil),    // 37: datasource.AddHashColumnOperation
+	(*PSIJoinOperation)(nil),          // 38: datasource.PSIJoinOperation
+	(*StreamReadRequest)(nil),         // 39: datasource.StreamReadRequest
+	(*SampleOptions)(nil),             // 40: datasource.SampleOptions
+	(*ParallelReadOptions)(nil),       // 41: datasource.ParallelReadOptions
+	(*FilterValue)(nil),               // 42: datasource.FilterValue
+	(*SortRule)(nil),                  // 43: datasource.SortRule
+	(*FilterExpression)(nil),          // 44: datasource.FilterExpression
+	(*FilterGroup)(nil),               // 45: datasource.FilterGroup
+	(*ConnectionInfo)(nil),            // 46: datasource.ConnectionInfo
+	(*ColumnItem)(nil),                // 47: datasource.ColumnItem
+	(*ServerInfo)(nil),                // 48: datasource.ServerInfo
+	(*OSSWriteRequest)(nil),           // 49: datasource.OSSWriteRequest
+	(*OSSReadRequest)(nil),            // 50: datasource.OSSReadRequest
+	(*OSSReadResponse)(nil),           // 51: datasource.OSSReadResponse
+	(*SparkDBConnInfo)(nil),           // 52: datasource.SparkDBConnInfo
+	(*SparkConfig)(nil),               // 53: datasource.SparkConfig
+	(*TableInfoRequest)(nil),          // 54: datasource.TableInfoRequest
+	(*TableInfoResponse)(nil),         // 55: datasource.TableInfoResponse
+	(*ProfileTableRequest)(nil),       // 56: datasource.ProfileTableRequest
+	(*ValueCount)(nil),                // 57: datasource.ValueCount
+	(*ColumnProfile)(nil),             // 58: datasource.ColumnProfile
+	(*ProfileTableResponse)(nil),      // 59: datasource.ProfileTableResponse
+	(*GroupCountRequest)(nil),         // 60: datasource.GroupCountRequest
+	(*GroupCountResponse)(nil),        // 61: datasource.GroupCountResponse
+	(*AggregateField)(nil),            // 62: datasource.AggregateField
+	(*AggregateRequest)(nil),          // 63: datasource.AggregateRequest
+	(*TruncateTableRequest)(nil),      // 64: datasource.TruncateTableRequest
+	(*TruncateTableResponse)(nil),     // 65: datasource.TruncateTableResponse
+	(*PushJobResultRequest)(nil),      // 66: datasource.PushJobResultRequest
+	(*PushJobResultResponse)(nil),     // 67: datasource.PushJobResultResponse
+	(*JobResultExternalDBInfo)(nil),   // 68: datasource.JobResultExternalDBInfo
+	(*DatasourceTlsConfig)(nil),       // 69: datasource.DatasourceTlsConfig
+	(*ExecuteDorisSQLRequest)(nil),    // 70: datasource.ExecuteDorisSQLRequest
+	(*ExecuteDorisSQLResponse)(nil),   // 71: datasource.ExecuteDorisSQLResponse
+	(*DorisSQLRow)(nil),               // 72: datasource.DorisSQLRow
+	(*CreateExternalAndInternalTableAndImportDataRequest)(nil),  // 73: datasource.CreateExternalAndInternalTableAndImportDataRequest
+	(*CreateExternalAndInternalTableAndImportDataResponse)(nil), // 74: datasource.CreateExternalAndInternalTableAndImportDataResponse
+	(*ImportCsvFileToDorisRequest)(nil),                         // 75: datasource.ImportCsvFileToDorisRequest
+	(*ExportCsvFileFromDorisRequest)(nil),                       // 76: datasource.ExportCsvFileFromDorisRequest
+	(*ExportCsvFileFromDorisResponse)(nil),                      // 77: datasource.ExportCsvFileFromDorisResponse
+	(*ExportDorisDataToMiraDBRequest)(nil),                      // 78: datasource.ExportDorisDataToMiraDBRequest
+	(*ImportMiraDBDataToDorisRequest)(nil),                      // 79: datasource.ImportMiraDBDataToDorisRequest
+	(*ImportMiraDBDataToDorisResponse)(nil),                     // 80: datasource.ImportMiraDBDataToDorisResponse
+	(*InternalTableInfoRequest)(nil),                            // 81: datasource.InternalTableInfoRequest
+	(*CleanTmpDataRequest)(nil),                                 // 82: datasource.CleanTmpDataRequest
+	(*GetRetryCleanupTasksRequest)(nil),                         // 83: datasource.GetRetryCleanupTasksRequest
+	(*GetRetryCleanupTasksResponse)(nil),                        // 84: datasource.GetRetryCleanupTasksResponse
+	(*CleanupTaskInfo)(nil),                                     // 85: datasource.CleanupTaskInfo
+	(*ReadDataSourceStreamingRequest)(nil),                      // 86: datasource.ReadDataSourceStreamingRequest
+	(*ExecuteSqlRequest)(nil),                                   // 87: datasource.ExecuteSqlRequest
+	(*ExecuteSqlResponse)(nil),                                  // 88: datasource.ExecuteSqlResponse
+	(*DmlResult)(nil),                                           // 89: datasource.DmlResult
+	(*ReadRequest)(nil),                                         // 90: datasource.ReadRequest
+	(*FilterCondition)(nil),                                     // 91: datasource.FilterCondition
+	(*WriteRequest)(nil),                                        // 92: datasource.WriteRequest
+	(*WriteResponse)(nil),                                       // 93: datasource.WriteResponse
+	(*ImportDataRequest)(nil),                                   // 94: datasource.ImportDataRequest
+	(*ImportTarget)(nil),                                        // 95: datasource.ImportTarget
+	(*IncrementalImport)(nil),                                   // 96: datasource.IncrementalImport
+	(*TableKey)(nil),                                            // 97: datasource.TableKey
+	(*ImportDataResponse)(nil),                                  // 98: datasource.ImportDataResponse
+	(*ImportResult)(nil),                                        // 99: datasource.ImportResult
+	(*ReconcileReport)(nil),                                     // 100: datasource.ReconcileReport
+	(*ReconcileMismatch)(nil),                                   // 101: datasource.ReconcileMismatch
+	(*SubmitAsyncJobRequest)(nil),                               // 102: datasource.SubmitAsyncJobRequest
+	(*AsyncJobProgress)(nil),                                    // 103: datasource.AsyncJobProgress
+	(*AsyncJobResult)(nil),                                      // 104: datasource.AsyncJobResult
+	(*AsyncJob)(nil),                                            // 105: datasource.AsyncJob
+	(*GetAsyncJobRequest)(nil),                                  // 106: datasource.GetAsyncJobRequest
+	(*ListAsyncJobsRequest)(nil),                                // 107: datasource.ListAsyncJobsRequest
+	(*ListAsyncJobsResponse)(nil),                               // 108: datasource.ListAsyncJobsResponse
+	(*CancelAsyncJobRequest)(nil),                               // 109: datasource.CancelAsyncJobRequest
+	(*WaitAsyncJobRequest)(nil),                                 // 110: datasource.WaitAsyncJobRequest
+	nil,                                                         // 111: datasource.DorisSQLRow.ColumnsEntry
+	nil,                                                         // 112: datasource.TableKey.AttributesEntry
+}
+var file_proto_data_source_proto_depIdxs = []int32{
+	100, // 0: datasource.Response.reconciliation:type_name -> datasource.ReconcileReport
+	14,  // 1: datasource.BatchResponse.status:type_name -> datasource.JobStatus
+	22,  // 2: datasource.WrappedWriterDataRequest.request:type_name -> datasource.WriterDataRequest
+	16,  // 3: datasource.WriterExternalDataRequest.writeMode:type_name -> datasource.WriteMode
+	42,  // 4: datasource.InternalReadRequest.filterValues:type_name -> datasource.FilterValue
+	43,  // 5: datasource.InternalReadRequest.sortRules:type_name -> datasource.SortRule
+	2,   // 6: datasource.InternalReadRequest.filterOperators:type_name -> datasource.FilterOperator
+	44,  // 7: datasource.InternalReadRequest.filterExpression:type_name -> datasource.FilterExpression
+	41,  // 8: datasource.InternalReadRequest.parallelRead:type_name -> datasource.ParallelReadOptions
+	53,  // 9: datasource.BatchReadRequest.sparkConfig:type_name -> datasource.SparkConfig
+	28,  // 10: datasource.BatchReadRequest.external:type_name -> datasource.ExternalDataSource
+	29,  // 11: datasource.BatchReadRequest.internal:type_name -> datasource.InternalDataSource
+	31,  // 12: datasource.BatchReadRequest.query:type_name -> datasource.QueryOperation
+	32,  // 13: datasource.BatchReadRequest.write:type_name -> datasource.WriteOperation
+	33,  // 14: datasource.BatchReadRequest.sort:type_name -> datasource.SortOperation
+	34,  // 15: datasource.BatchReadRequest.count:type_name -> datasource.CountOperation
+	35,  // 16: datasource.BatchReadRequest.groupby_count:type_name -> datasource.GroupByCountOperation
+	36,  // 17: datasource.BatchReadRequest.join:type_name -> datasource.JoinOperation
+	37,  // 18: datasource.BatchReadRequest.add_hash_column:type_name -> datasource.AddHashColumnOperation
+	38,  // 19: datasource.BatchReadRequest.psi_join:type_name -> datasource.PSIJoinOperation
+	42,  // 20: datasource.QueryOperation.filterValues:type_name -> datasource.FilterValue
+	2,   // 21: datasource.QueryOperation.filterOperators:type_name -> datasource.FilterOperator
+	43,  // 22: datasource.QueryOperation.sortRules:type_name -> datasource.SortRule
+	42,  // 23: datasource.CountOperation.filterValues:type_name -> datasource.FilterValue
+	2,   // 24: datasource.CountOperation.filterOperators:type_name -> datasource.FilterOperator
+	42,  // 25: datasource.GroupByCountOperation.filterValues:type_name -> datasource.FilterValue
+	2,   // 26: datasource.GroupByCountOperation.filterOperators:type_name -> datasource.FilterOperator
+	13,  // 27: datasource.JoinOperation.joinType:type_name -> datasource.JoinType
+	4,   // 28: datasource.StreamReadRequest.fileType:type_name -> datasource.FileType
+	42,  // 29: datasource.StreamReadRequest.filterValues:type_name -> datasource.FilterValue
+	43,  // 30: datasource.StreamReadRequest.sortRules:type_name -> datasource.SortRule
+	2,   // 31: datasource.StreamReadRequest.filterOperators:type_name -> datasource.FilterOperator
+	44,  // 32: datasource.StreamReadRequest.filterExpression:type_name -> datasource.FilterExpression
+	41,  // 33: datasource.StreamReadRequest.parallelRead:type_name -> datasource.ParallelReadOptions
+	40,  // 34: datasource.StreamReadRequest.sampling:type_name -> datasource.SampleOptions
+	8,   // 35: datasource.StreamReadRequest.reconcileMode:type_name -> datasource.ReconcileMode
+	0,   // 36: datasource.SampleOptions.method:type_name -> datasource.SampleMethod
+	97,  // 37: datasource.ParallelReadOptions.keys:type_name -> datasource.TableKey
+	1,   // 38: datasource.SortRule.sortOrder:type_name -> datasource.SortOrder
+	91,  // 39: datasource.FilterExpression.condition:type_name -> datasource.FilterCondition
+	45,  // 40: datasource.FilterExpression.group:type_name -> datasource.FilterGroup
+	44,  // 41: datasource.FilterExpression.not:type_name -> datasource.FilterExpression
+	3,   // 42: datasource.FilterGroup.logic:type_name -> datasource.LogicalOperator
+	44,  // 43: datasource.FilterGroup.children:type_name -> datasource.FilterExpression
+	69,  // 44: datasource.ConnectionInfo.tlsConfig:type_name -> datasource.DatasourceTlsConfig
+	47,  // 45: datasource.ConnectionInfo.columns:type_name -> datasource.ColumnItem
+	12,  // 46: datasource.SparkDBConnInfo.mode:type_name -> datasource.OperationMode
+	13,  // 47: datasource.SparkDBConnInfo.joinType:type_name -> datasource.JoinType
+	15,  // 48: datasource.SparkDBConnInfo.storageType:type_name -> datasource.StorageType
+	43,  // 49: datasource.SparkDBConnInfo.sortRules:type_name -> datasource.SortRule
+	47,  // 50: datasource.TableInfoResponse.columns:type_name -> datasource.ColumnItem
+	97,  // 51: datasource.TableInfoResponse.keys:type_name -> datasource.TableKey
+	28,  // 52: datasource.ProfileTableRequest.external:type_name -> datasource.ExternalDataSource
+	30,  // 53: datasource.ProfileTableRequest.doris:type_name -> datasource.DorisDataSource
+	6,   // 54: datasource.ProfileTableRequest.mode:type_name -> datasource.ProfileMode
+	57,  // 55: datasource.ColumnProfile.topValues:type_name -> datasource.ValueCount
+	6,   // 56: datasource.ProfileTableResponse.mode:type_name -> datasource.ProfileMode
+	58,  // 57: datasource.ProfileTableResponse.columns:type_name -> datasource.ColumnProfile
+	42,  // 58: datasource.GroupCountRequest.filterValues:type_name -> datasource.FilterValue
+	2,   // 59: datasource.GroupCountRequest.filterOperators:type_name -> datasource.FilterOperator
+	44,  // 60: datasource.GroupCountRequest.filterExpression:type_name -> datasource.FilterExpression
+	7,   // 61: datasource.AggregateField.function:type_name -> datasource.AggregateFunction
+	29,  // 62: datasource.AggregateRequest.internal:type_name -> datasource.InternalDataSource
+	30,  // 63: datasource.AggregateRequest.doris:type_name -> datasource.DorisDataSource
+	62,  // 64: datasource.AggregateRequest.aggregates:type_name -> datasource.AggregateField
+	2,   // 65: datasource.AggregateRequest.filterOperators:type_name -> datasource.FilterOperator
+	42,  // 66: datasource.AggregateRequest.filterValues:type_name -> datasource.FilterValue
+	44,  // 67: datasource.AggregateRequest.filterExpression:type_name -> datasource.FilterExpression
+	44,  // 68: datasource.AggregateRequest.having:type_name -> datasource.FilterExpression
+	43,  // 69: datasource.AggregateRequest.sortRules:type_name -> datasource.SortRule
+	16,  // 70: datasource.PushJobResultRequest.writeMode:type_name -> datasource.WriteMode
+	8,   // 71: datasource.PushJobResultRequest.reconcileMode:type_name -> datasource.ReconcileMode
+	100, // 72: datasource.PushJobResultResponse.reconciliation:type_name -> datasource.ReconcileReport
+	72,  // 73: datasource.ExecuteDorisSQLResponse.rows:type_name -> datasource.DorisSQLRow
+	111, // 74: datasource.DorisSQLRow.columns:type_name -> datasource.DorisSQLRow.ColumnsEntry
+	43,  // 75: datasource.ExportCsvFileFromDorisRequest.sortRules:type_name -> datasource.SortRule
+	91,  // 76: datasource.ExportCsvFileFromDorisRequest.filterConditions:type_name -> datasource.FilterCondition
+	44,  // 77: datasource.ExportCsvFileFromDorisRequest.filterExpression:type_name -> datasource.FilterExpression
+	40,  // 78: datasource.ExportCsvFileFromDorisRequest.sampling:type_name -> datasource.SampleOptions
+	97,  // 79: datasource.ExportCsvFileFromDorisRequest.keys:type_name -> datasource.TableKey
+	47,  // 80: datasource.ExportDorisDataToMiraDBRequest.columns:type_name -> datasource.ColumnItem
+	8,   // 81: datasource.ExportDorisDataToMiraDBRequest.reconcileMode:type_name -> datasource.ReconcileMode
+	85,  // 82: datasource.GetRetryCleanupTasksResponse.tasks:type_name -> datasource.CleanupTaskInfo
+	28,  // 83: datasource.ReadDataSourceStreamingRequest.external:type_name -> datasource.ExternalDataSource
+	29,  // 84: datasource.ReadDataSourceStreamingRequest.internal:type_name -> datasource.InternalDataSource
+	30,  // 85: datasource.ReadDataSourceStreamingRequest.doris:type_name -> datasource.DorisDataSource
+	43,  // 86: datasource.ReadDataSourceStreamingRequest.sortRules:type_name -> datasource.SortRule
+	91,  // 87: datasource.ReadDataSourceStreamingRequest.filterConditions:type_name -> datasource.FilterCondition
+	44,  // 88: datasource.ReadDataSourceStreamingRequest.filterExpression:type_name -> datasource.FilterExpression
+	40,  // 89: datasource.ReadDataSourceStreamingRequest.sampling:type_name -> datasource.SampleOptions
+	97,  // 90: datasource.ReadDataSourceStreamingRequest.keys:type_name -> datasource.TableKey
+	89,  // 91: datasource.ExecuteSqlResponse.dmlResult:type_name -> datasource.DmlResult
+	28,  // 92: datasource.ReadRequest.external:type_name -> datasource.ExternalDataSource
+	29,  // 93: datasource.ReadRequest.internal:type_name -> datasource.InternalDataSource
+	30,  // 94: datasource.ReadRequest.doris:type_name -> datasource.DorisDataSource
+	43,  // 95: datasource.ReadRequest.sortRules:type_name -> datasource.SortRule
+	91,  // 96: datasource.ReadRequest.filterConditions:type_name -> datasource.FilterCondition
+	97,  // 97: datasource.ReadRequest.keys:type_name -> datasource.TableKey
+	44,  // 98: datasource.ReadRequest.filterExpression:type_name -> datasource.FilterExpression
+	40,  // 99: datasource.ReadRequest.sampling:type_name -> datasource.SampleOptions
+	42,  // 100: datasource.FilterCondition.fieldValue:type_name -> datasource.FilterValue
+	2,   // 101: datasource.FilterCondition.operator:type_name -> datasource.FilterOperator
+	16,  // 102: datasource.WriteRequest.writeMode:type_name -> datasource.WriteMode
+	95,  // 103: datasource.ImportDataRequest.targets:type_name -> datasource.ImportTarget
+	28,  // 104: datasource.ImportTarget.external:type_name -> datasource.ExternalDataSource
+	97,  // 105: datasource.ImportTarget.keys:type_name -> datasource.TableKey
+	96,  // 106: datasource.ImportTarget.incremental:type_name -> datasource.IncrementalImport
+	8,   // 107: datasource.ImportTarget.reconcileMode:type_name -> datasource.ReconcileMode
+	17,  // 108: datasource.TableKey.keyType:type_name -> datasource.KeyType
+	112, // 109: datasource.TableKey.attributes:type_name -> datasource.TableKey.AttributesEntry
+	99,  // 110: datasource.ImportDataResponse.results:type_name -> datasource.ImportResult
+	100, // 111: datasource.ImportResult.reconciliation:type_name -> datasource.ReconcileReport
+	8,   // 112: datasource.ReconcileReport.mode:type_name -> datasource.ReconcileMode
+	101, // 113: datasource.ReconcileReport.mismatches:type_name -> datasource.ReconcileMismatch
+	94,  // 114: datasource.SubmitAsyncJobRequest.importData:type_name -> datasource.ImportDataRequest
+	76,  // 115: datasource.SubmitAsyncJobRequest.exportCsvFileFromDoris:type_name -> datasource.ExportCsvFileFromDorisRequest
+	79,  // 116: datasource.SubmitAsyncJobRequest.importMiraDBDataToDoris:type_name -> datasource.ImportMiraDBDataToDorisRequest
+	73,  // 117: datasource.SubmitAsyncJobRequest.createExternalAndInternalTableAndImportData:type_name -> datasource.CreateExternalAndInternalTableAndImportDataRequest
+	98,  // 118: datasource.AsyncJobResult.importData:type_name -> datasource.ImportDataResponse
+	77,  // 119: datasource.AsyncJobResult.exportCsvFileFromDoris:type_name -> datasource.ExportCsvFileFromDorisResponse
+	80,  // 120: datasource.AsyncJobResult.importMiraDBDataToDoris:type_name -> datasource.ImportMiraDBDataToDorisResponse
+	74,  // 121: datasource.AsyncJobResult.createExternalAndInternalTableAndImportData:type_name -> datasource.CreateExternalAndInternalTableAndImportDataResponse
+	9,   // 122: datasource.AsyncJob.jobType:type_name -> datasource.AsyncJobType
+	10,  // 123: datasource.AsyncJob.status:type_name -> datasource.AsyncJobStatus
+	103, // 124: datasource.AsyncJob.progress:type_name -> datasource.AsyncJobProgress
+	104, // 125: datasource.AsyncJob.result:type_name -> datasource.AsyncJobResult
+	9,   // 126: datasource.ListAsyncJobsRequest.jobType:type_name -> datasource.AsyncJobType
+	10,  // 127: datasource.ListAsyncJobsRequest.status:type_name -> datasource.AsyncJobStatus
+	105, // 128: datasource.ListAsyncJobsResponse.jobs:type_name -> datasource.AsyncJob
+	27,  // 129: datasource.DataSourceService.SubmitBatchJob:input_type -> datasource.BatchReadRequest
+	39,  // 130: datasource.DataSourceService.ReadStreamingData:input_type -> datasource.StreamReadRequest
+	23,  // 131: datasource.DataSourceService.SendArrowData:input_type -> datasource.WrappedWriterDataRequest
+	49,  // 132: datasource.DataSourceService.WriteOSSData:input_type -> datasource.OSSWriteRequest
+	49,  // 133: datasource.DataSourceService.WriteOSSFileData:input_type -> datasource.OSSWriteRequest
+	50,  // 134: datasource.DataSourceService.ReadOSSData:input_type -> datasource.OSSReadRequest
+	24,  // 135: datasource.DataSourceService.WriteInternalData:input_type -> datasource.WriterInternalDataRequest
+	26,  // 136: datasource.DataSourceService.ReadInternalData:input_type -> datasource.InternalReadRequest
+	25,  // 137: datasource.DataSourceService.WriterExternalData:input_type -> datasource.WriterExternalDataRequest
+	54,  // 138: datasource.DataSourceService.GetTableInfo:input_type -> datasource.TableInfoRequest
+	56,  // 139: datasource.DataSourceService.ProfileTable:input_type -> datasource.ProfileTableRequest
+	60,  // 140: datasource.DataSourceService.GetGroupCountInfo:input_type -> datasource.GroupCountRequest
+	63,  // 141: datasource.DataSourceService.Aggregate:input_type -> datasource.AggregateRequest
+	20,  // 142: datasource.DataSourceService.GetJobStatus:input_type -> datasource.JobStatusRequest
+	64,  // 143: datasource.DataSourceService.TruncateTable:input_type -> datasource.TruncateTableRequest
+	66,  // 144: datasource.DataSourceService.PushJobResultToExternalDB:input_type -> datasource.PushJobResultRequest
+	70,  // 145: datasource.DataSourceService.ExecuteDorisSQL:input_type -> datasource.ExecuteDorisSQLRequest
+	73,  // 146: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:input_type -> datasource.CreateExternalAndInternalTableAndImportDataRequest
+	75,  // 147: datasource.DataSourceService.ImportCsvFileToDoris:input_type -> datasource.ImportCsvFileToDorisRequest
+	76,  // 148: datasource.DataSourceService.ExportCsvFileFromDoris:input_type -> datasource.ExportCsvFileFromDorisRequest
+	78,  // 149: datasource.DataSourceService.ExportDorisDataToMiraDB:input_type -> datasource.ExportDorisDataToMiraDBRequest
+	79,  // 150: datasource.DataSourceService.ImportMiraDBDataToDoris:input_type -> datasource.ImportMiraDBDataToDorisRequest
+	81,  // 151: datasource.DataSourceService.GetInternalTableInfo:input_type -> datasource.InternalTableInfoRequest
+	86,  // 152: datasource.DataSourceService.ReadDataSourceStreaming:input_type -> datasource.ReadDataSourceStreamingRequest
+	82,  // 153: datasource.DataSourceService.CleanTmpData:input_type -> datasource.CleanTmpDataRequest
+	83,  // 154: datasource.DataSourceService.GetRetryCleanupTask:input_type -> datasource.GetRetryCleanupTasksRequest
+	87,  // 155: datasource.DataSourceService.ExecuteSql:input_type -> datasource.ExecuteSqlRequest
+	90,  // 156: datasource.DataSourceService.Read:input_type -> datasource.ReadRequest
+	92,  // 157: datasource.DataSourceService.Write:input_type -> datasource.WriteRequest
+	94,  // 158: datasource.DataSourceService.ImportData:input_type -> datasource.ImportDataRequest
+	102, // 159: datasource.DataSourceService.SubmitAsyncJob:input_type -> datasource.SubmitAsyncJobRequest
+	106, // 160: datasource.DataSourceService.GetAsyncJob:input_type -> datasource.GetAsyncJobRequest
+	107, // 161: datasource.DataSourceService.ListAsyncJobs:input_type -> datasource.ListAsyncJobsRequest
+	109, // 162: datasource.DataSourceService.CancelAsyncJob:input_type -> datasource.CancelAsyncJobRequest
+	110, // 163: datasource.DataSourceService.WaitAsyncJob:input_type -> datasource.WaitAsyncJobRequest
+	19,  // 164: datasource.DataSourceService.SubmitBatchJob:output_type -> datasource.BatchResponse
+	21,  // 165: datasource.DataSourceService.ReadStreamingData:output_type -> datasource.ArrowResponse
+	18,  // 166: datasource.DataSourceService.SendArrowData:output_type -> datasource.Response
+	18,  // 167: datasource.DataSourceService.WriteOSSData:output_type -> datasource.Response
+	18,  // 168: datasource.DataSourceService.WriteOSSFileData:output_type -> datasource.Response
+	51,  // 169: datasource.DataSourceService.ReadOSSData:output_type -> datasource.OSSReadResponse
+	18,  // 170: datasource.DataSourceService.WriteInternalData:output_type -> datasource.Response
+	21,  // 171: datasource.DataSourceService.ReadInternalData:output_type -> datasource.ArrowResponse
+	18,  // 172: datasource.DataSourceService.WriterExternalData:output_type -> datasource.Response
+	55,  // 173: datasource.DataSourceService.GetTableInfo:output_type -> datasource.TableInfoResponse
+	59,  // 174: datasource.DataSourceService.ProfileTable:output_type -> datasource.ProfileTableResponse
+	61,  // 175: datasource.DataSourceService.GetGroupCountInfo:output_type -> datasource.GroupCountResponse
+	21,  // 176: datasource.DataSourceService.Aggregate:output_type -> datasource.ArrowResponse
+	19,  // 177: datasource.DataSourceService.GetJobStatus:output_type -> datasource.BatchResponse
+	65,  // 178: datasource.DataSourceService.TruncateTable:output_type -> datasource.TruncateTableResponse
+	67,  // 179: datasource.DataSourceService.PushJobResultToExternalDB:output_type -> datasource.PushJobResultResponse
+	71,  // 180: datasource.DataSourceService.ExecuteDorisSQL:output_type -> datasource.ExecuteDorisSQLResponse
+	74,  // 181: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:output_type -> datasource.CreateExternalAndInternalTableAndImportDataResponse
+	18,  // 182: datasource.DataSourceService.ImportCsvFileToDoris:output_type -> datasource.Response
+	77,  // 183: datasource.DataSourceService.ExportCsvFileFromDoris:output_type -> datasource.ExportCsvFileFromDorisResponse
+	18,  // 184: datasource.DataSourceService.ExportDorisDataToMiraDB:output_type -> datasource.Response
+	80,  // 185: datasource.DataSourceService.ImportMiraDBDataToDoris:output_type -> datasource.ImportMiraDBDataToDorisResponse
+	55,  // 186: datasource.DataSourceService.GetInternalTableInfo:output_type -> datasource.TableInfoResponse
+	21,  // 187: datasource.DataSourceService.ReadDataSourceStreaming:output_type -> datasource.ArrowResponse
+	18,  // 188: datasource.DataSourceService.CleanTmpData:output_type -> datasource.Response
+	84,  // 189: datasource.DataSourceService.GetRetryCleanupTask:output_type -> datasource.GetRetryCleanupTasksResponse
+	88,  // 190: datasource.DataSourceService.ExecuteSql:output_type -> datasource.ExecuteSqlResponse
+	21,  // 191: datasource.DataSourceService.Read:output_type -> datasource.ArrowResponse
+	93,  // 192: datasource.DataSourceService.Write:output_type -> datasource.WriteResponse
+	98,  // 193: datasource.DataSourceService.ImportData:output_type -> datasource.ImportDataResponse
+	105, // 194: datasource.DataSourceService.SubmitAsyncJob:output_type -> datasource.AsyncJob
+	105, // 195: datasource.DataSourceService.GetAsyncJob:output_type -> datasource.AsyncJob
+	108, // 196: datasource.DataSourceService.ListAsyncJobs:output_type -> datasource.ListAsyncJobsResponse
+	105, // 197: datasource.DataSourceService.CancelAsyncJob:output_type -> datasource.AsyncJob
+	105, // 198: datasource.DataSourceService.WaitAsyncJob:output_type -> datasource.AsyncJob
+	164, // [164:199] is the sub-list for method output_type
+	129, // [129:164] is the sub-list for method input_type
+	129, // [129:129] is the sub-list for extension type_name
+	129, // [129:129] is the sub-list for extension extendee
+	0,   // [0:129] is the sub-list for field type_name
 }
 
 func init() { file_proto_data_source_proto_init() }
@@ -7297,8 +10580,260 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchResponse); i {
+		file_proto_data_source_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*JobStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ArrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*WriterDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*WrappedWriterDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*WriterInternalDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*WriterExternalDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*InternalReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ExternalDataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*InternalDataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DorisDataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SortOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CountOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupByCountOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*AddHashColumnOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*PSIJoinOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SampleOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7309,8 +10844,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*JobStatusRequest); i {
+		file_proto_data_source_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ParallelReadOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7321,8 +10856,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ArrowResponse); i {
+		file_proto_data_source_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7333,8 +10868,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*WriterDataRequest); i {
+		file_proto_data_source_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*SortRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7345,8 +10880,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*WrappedWriterDataRequest); i {
+		file_proto_data_source_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterExpression); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7357,8 +10892,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*WriterInternalDataRequest); i {
+		file_proto_data_source_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7369,8 +10904,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*WriterExternalDataRequest); i {
+		file_proto_data_source_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ConnectionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7381,8 +10916,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*InternalReadRequest); i {
+		file_proto_data_source_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ColumnItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7393,8 +10928,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchReadRequest); i {
+		file_proto_data_source_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7405,8 +10940,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ExternalDataSource); i {
+		file_proto_data_source_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*OSSWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7417,8 +10952,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*InternalDataSource); i {
+		file_proto_data_source_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*OSSReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7429,8 +10964,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*DorisDataSource); i {
+		file_proto_data_source_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*OSSReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7441,8 +10976,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*QueryOperation); i {
+		file_proto_data_source_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*SparkDBConnInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7453,8 +10988,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteOperation); i {
+		file_proto_data_source_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*SparkConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7465,8 +11000,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SortOperation); i {
+		file_proto_data_source_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*TableInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7477,8 +11012,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*CountOperation); i {
+		file_proto_data_source_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*TableInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7489,8 +11024,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupByCountOperation); i {
+		file_proto_data_source_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7501,8 +11036,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*JoinOperation); i {
+		file_proto_data_source_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7513,8 +11048,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*AddHashColumnOperation); i {
+		file_proto_data_source_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ColumnProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7525,8 +11060,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*PSIJoinOperation); i {
+		file_proto_data_source_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7537,8 +11072,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*StreamReadRequest); i {
+		file_proto_data_source_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7549,8 +11084,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterValue); i {
+		file_proto_data_source_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7561,8 +11096,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*SortRule); i {
+		file_proto_data_source_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7573,8 +11108,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*ConnectionInfo); i {
+		file_proto_data_source_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7585,8 +11120,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*ColumnItem); i {
+		file_proto_data_source_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*TruncateTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7597,8 +11132,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*ServerInfo); i {
+		file_proto_data_source_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*TruncateTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7609,8 +11144,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*OSSWriteRequest); i {
+		file_proto_data_source_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*PushJobResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7621,8 +11156,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*OSSReadRequest); i {
+		file_proto_data_source_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*PushJobResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7633,8 +11168,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*OSSReadResponse); i {
+		file_proto_data_source_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*JobResultExternalDBInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7645,8 +11180,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*SparkDBConnInfo); i {
+		file_proto_data_source_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*DatasourceTlsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7657,8 +11192,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*SparkConfig); i {
+		file_proto_data_source_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteDorisSQLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7669,8 +11204,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*TableInfoRequest); i {
+		file_proto_data_source_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteDorisSQLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7681,8 +11216,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*TableInfoResponse); i {
+		file_proto_data_source_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*DorisSQLRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7693,8 +11228,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupCountRequest); i {
+		file_proto_data_source_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExternalAndInternalTableAndImportDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7705,8 +11240,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupCountResponse); i {
+		file_proto_data_source_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExternalAndInternalTableAndImportDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7717,8 +11252,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*TruncateTableRequest); i {
+		file_proto_data_source_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportCsvFileToDorisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7729,8 +11264,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*TruncateTableResponse); i {
+		file_proto_data_source_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvFileFromDorisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7741,8 +11276,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*PushJobResultRequest); i {
+		file_proto_data_source_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvFileFromDorisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7753,8 +11288,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*PushJobResultResponse); i {
+		file_proto_data_source_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportDorisDataToMiraDBRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7765,8 +11300,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*JobResultExternalDBInfo); i {
+		file_proto_data_source_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportMiraDBDataToDorisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7777,8 +11312,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*DatasourceTlsConfig); i {
+		file_proto_data_source_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportMiraDBDataToDorisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7789,8 +11324,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteDorisSQLRequest); i {
+		file_proto_data_source_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*InternalTableInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7801,8 +11336,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteDorisSQLResponse); i {
+		file_proto_data_source_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanTmpDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7813,8 +11348,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*DorisSQLRow); i {
+		file_proto_data_source_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRetryCleanupTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7825,8 +11360,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateExternalAndInternalTableAndImportDataRequest); i {
+		file_proto_data_source_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRetryCleanupTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7837,8 +11372,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateExternalAndInternalTableAndImportDataResponse); i {
+		file_proto_data_source_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanupTaskInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7849,8 +11384,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportCsvFileToDorisRequest); i {
+		file_proto_data_source_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadDataSourceStreamingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7861,8 +11396,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*ExportCsvFileFromDorisRequest); i {
+		file_proto_data_source_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteSqlRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7873,8 +11408,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*ExportCsvFileFromDorisResponse); i {
+		file_proto_data_source_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteSqlResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7885,8 +11420,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*ExportDorisDataToMiraDBRequest); i {
+		file_proto_data_source_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*DmlResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7897,8 +11432,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportMiraDBDataToDorisRequest); i {
+		file_proto_data_source_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7909,8 +11444,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportMiraDBDataToDorisResponse); i {
+		file_proto_data_source_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7921,8 +11456,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*InternalTableInfoRequest); i {
+		file_proto_data_source_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7933,8 +11468,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*CleanTmpDataRequest); i {
+		file_proto_data_source_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7945,8 +11480,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRetryCleanupTasksRequest); i {
+		file_proto_data_source_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7957,8 +11492,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRetryCleanupTasksResponse); i {
+		file_proto_data_source_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7969,8 +11504,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*CleanupTaskInfo); i {
+		file_proto_data_source_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrementalImport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7981,8 +11516,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*ReadDataSourceStreamingRequest); i {
+		file_proto_data_source_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*TableKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7993,8 +11528,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteSqlRequest); i {
+		file_proto_data_source_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8005,8 +11540,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteSqlResponse); i {
+		file_proto_data_source_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8017,8 +11552,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*DmlResult); i {
+		file_proto_data_source_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8029,8 +11564,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*ReadRequest); i {
+		file_proto_data_source_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileMismatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8041,8 +11576,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterCondition); i {
+		file_proto_data_source_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitAsyncJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8053,8 +11588,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteRequest); i {
+		file_proto_data_source_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*AsyncJobProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8065,8 +11600,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[65].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteResponse); i {
+		file_proto_data_source_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*AsyncJobResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8077,8 +11612,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[66].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportDataRequest); i {
+		file_proto_data_source_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*AsyncJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8089,8 +11624,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[67].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportTarget); i {
+		file_proto_data_source_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAsyncJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8101,8 +11636,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[68].Exporter = func(v any, i int) any {
-			switch v := v.(*TableKey); i {
+		file_proto_data_source_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAsyncJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8113,8 +11648,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[69].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportDataResponse); i {
+		file_proto_data_source_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAsyncJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8125,8 +11660,20 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[70].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportResult); i {
+		file_proto_data_source_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelAsyncJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*WaitAsyncJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8150,27 +11697,52 @@ func file_proto_data_source_proto_init() {
 		(*BatchReadRequest_AddHashColumn)(nil),
 		(*BatchReadRequest_PsiJoin)(nil),
 	}
-	file_proto_data_source_proto_msgTypes[58].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[26].OneofWrappers = []any{
+		(*FilterExpression_Condition)(nil),
+		(*FilterExpression_Group)(nil),
+		(*FilterExpression_Not)(nil),
+	}
+	file_proto_data_source_proto_msgTypes[38].OneofWrappers = []any{
+		(*ProfileTableRequest_External)(nil),
+		(*ProfileTableRequest_Doris)(nil),
+	}
+	file_proto_data_source_proto_msgTypes[45].OneofWrappers = []any{
+		(*AggregateRequest_Internal)(nil),
+		(*AggregateRequest_Doris)(nil),
+	}
+	file_proto_data_source_proto_msgTypes[68].OneofWrappers = []any{
 		(*ReadDataSourceStreamingRequest_External)(nil),
 		(*ReadDataSourceStreamingRequest_Internal)(nil),
 		(*ReadDataSourceStreamingRequest_Doris)(nil),
 	}
-	file_proto_data_source_proto_msgTypes[60].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[70].OneofWrappers = []any{
 		(*ExecuteSqlResponse_ArrowBatch)(nil),
 		(*ExecuteSqlResponse_DmlResult)(nil),
 	}
-	file_proto_data_source_proto_msgTypes[62].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[72].OneofWrappers = []any{
 		(*ReadRequest_External)(nil),
 		(*ReadRequest_Internal)(nil),
 		(*ReadRequest_Doris)(nil),
 	}
+	file_proto_data_source_proto_msgTypes[84].OneofWrappers = []any{
+		(*SubmitAsyncJobRequest_ImportData)(nil),
+		(*SubmitAsyncJobRequest_ExportCsvFileFromDoris)(nil),
+		(*SubmitAsyncJobRequest_ImportMiraDBDataToDoris)(nil),
+		(*SubmitAsyncJobRequest_CreateExternalAndInternalTableAndImportData)(nil),
+	}
+	file_proto_data_source_proto_msgTypes[86].OneofWrappers = []any{
+		(*AsyncJobResult_ImportData)(nil),
+		(*AsyncJobResult_ExportCsvFileFromDoris)(nil),
+		(*AsyncJobResult_ImportMiraDBDataToDoris)(nil),
+		(*AsyncJobResult_CreateExternalAndInternalTableAndImportData)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_data_source_proto_rawDesc,
-			NumEnums:      10,
-			NumMessages:   73,
+			NumEnums:      18,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,7 +29,9 @@ const (
 	DataSourceService_ReadInternalData_FullMethodName                            = "/datasource.DataSourceService/ReadInternalData"
 	DataSourceService_WriterExternalData_FullMethodName                          = "/datasource.DataSourceService/WriterExternalData"
 	DataSourceService_GetTableInfo_FullMethodName                                = "/datasource.DataSourceService/GetTableInfo"
+	DataSourceService_ProfileTable_FullMethodName                                = "/datasource.DataSourceService/ProfileTable"
 	DataSourceService_GetGroupCountInfo_FullMethodName                           = "/datasource.DataSourceService/GetGroupCountInfo"
+	DataSourceService_Aggregate_FullMethodName                                   = "/datasource.DataSourceService/Aggregate"
 	DataSourceService_GetJobStatus_FullMethodName                                = "/datasource.DataSourceService/GetJobStatus"
 	DataSourceService_TruncateTable_FullMethodName                               = "/datasource.DataSourceService/TruncateTable"
 	DataSourceService_PushJobResultToExternalDB_FullMethodName                   = "/datasource.DataSourceService/PushJobResultToExternalDB"
@@ -47,6 +49,11 @@ const (
 	DataSourceService_Read_FullMethodName                                        = "/datasource.DataSourceService/Read"
 	DataSourceService_Write_FullMethodName                                       = "/datasource.DataSourceService/Write"
 	DataSourceService_ImportData_FullMethodName                                  = "/datasource.DataSourceService/ImportData"
+	DataSourceService_SubmitAsyncJob_FullMethodName                              = "/datasource.DataSourceService/SubmitAsyncJob"
+	DataSourceService_GetAsyncJob_FullMethodName                                 = "/datasource.DataSourceService/GetAsyncJob"
+	DataSourceService_ListAsyncJobs_FullMethodName                               = "/datasource.DataSourceService/ListAsyncJobs"
+	DataSourceService_CancelAsyncJob_FullMethodName                              = "/datasource.DataSourceService/CancelAsyncJob"
+	DataSourceService_WaitAsyncJob_FullMethodName                                = "/datasource.DataSourceService/WaitAsyncJob"
 )
 
 // DataSourceServiceClient is the client API for DataSourceService service.
@@ -75,8 +82,12 @@ type DataSourceServiceClient interface {
 	WriterExternalData(ctx context.Context, in *WriterExternalDataRequest, opts ...grpc.CallOption) (*Response, error)
 	// 获取数据源表信息
 	GetTableInfo(ctx context.Context, in *TableInfoRequest, opts ...grpc.CallOption) (*TableInfoResponse, error)
+	// 统计数据资产或doris内部表各字段的空值率、不同值个数、最值与高频值
+	ProfileTable(ctx context.Context, in *ProfileTableRequest, opts ...grpc.CallOption) (*ProfileTableResponse, error)
 	// 获取聚合数据量信息
 	GetGroupCountInfo(ctx context.Context, in *GroupCountRequest, opts ...grpc.CallOption) (*GroupCountResponse, error)
+	// 分组聚合，每个分组返回一行，包含分组字段与请求的聚合列
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArrowResponse], error)
 	// 查询作业状态
 	GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 清空表数据
@@ -111,6 +122,16 @@ type DataSourceServiceClient interface {
 	Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteRequest, WriteResponse], error)
 	// 导入数据
 	ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error)
+	// 提交异步作业，立即返回作业 ID，作业在服务端后台执行，不受客户端断开影响
+	SubmitAsyncJob(ctx context.Context, in *SubmitAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error)
+	// 查询异步作业的状态、进度与结果
+	GetAsyncJob(ctx context.Context, in *GetAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error)
+	// 分页列出异步作业，按提交时间倒序
+	ListAsyncJobs(ctx context.Context, in *ListAsyncJobsRequest, opts ...grpc.CallOption) (*ListAsyncJobsResponse, error)
+	// 取消异步作业，中止正在执行的 Doris 语句并清理临时数据
+	CancelAsyncJob(ctx context.Context, in *CancelAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error)
+	// 等待异步作业结束，超时后返回作业的当前状态
+	WaitAsyncJob(ctx context.Context, in *WaitAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error)
 }
 
 type dataSourceServiceClient struct {
@@ -260,6 +281,16 @@ func (c *dataSourceServiceClient) GetTableInfo(ctx context.Context, in *TableInf
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) ProfileTable(ctx context.Context, in *ProfileTableRequest, opts ...grpc.CallOption) (*ProfileTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileTableResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_ProfileTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataSourceServiceClient) GetGroupCountInfo(ctx context.Context, in *GroupCountRequest, opts ...grpc.CallOption) (*GroupCountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GroupCountResponse)
@@ -270,6 +301,25 @@ func (c *dataSourceServiceClient) GetGroupCountInfo(ctx context.Context, in *Gro
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArrowResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[7], DataSourceService_Aggregate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AggregateRequest, ArrowResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataSourceService_AggregateClient = grpc.ServerStreamingClient[ArrowResponse]
+
 func (c *dataSourceServiceClient) GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchResponse)
@@ -372,7 +422,7 @@ func (c *dataSourceServiceClient) GetInternalTableInfo(ctx context.Context, in *
 
 func (c *dataSourceServiceClient) ReadDataSourceStreaming(ctx context.Context, in *ReadDataSourceStreamingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArrowResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[7], DataSourceService_ReadDataSourceStreaming_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[8], DataSourceService_ReadDataSourceStreaming_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +461,7 @@ func (c *dataSourceServiceClient) GetRetryCleanupTask(ctx context.Context, in *G
 
 func (c *dataSourceServiceClient) ExecuteSql(ctx context.Context, in *ExecuteSqlRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecuteSqlResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[8], DataSourceService_ExecuteSql_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[9], DataSourceService_ExecuteSql_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +480,7 @@ type DataSourceService_ExecuteSqlClient = grpc.ServerStreamingClient[ExecuteSqlR
 
 func (c *dataSourceServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArrowResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[9], DataSourceService_Read_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[10], DataSourceService_Read_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,7 +499,7 @@ type DataSourceService_ReadClient = grpc.ServerStreamingClient[ArrowResponse]
 
 func (c *dataSourceServiceClient) Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteRequest, WriteResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[10], DataSourceService_Write_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[11], DataSourceService_Write_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -470,6 +520,56 @@ func (c *dataSourceServiceClient) ImportData(ctx context.Context, in *ImportData
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) SubmitAsyncJob(ctx context.Context, in *SubmitAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AsyncJob)
+	err := c.cc.Invoke(ctx, DataSourceService_SubmitAsyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) GetAsyncJob(ctx context.Context, in *GetAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AsyncJob)
+	err := c.cc.Invoke(ctx, DataSourceService_GetAsyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) ListAsyncJobs(ctx context.Context, in *ListAsyncJobsRequest, opts ...grpc.CallOption) (*ListAsyncJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAsyncJobsResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_ListAsyncJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) CancelAsyncJob(ctx context.Context, in *CancelAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AsyncJob)
+	err := c.cc.Invoke(ctx, DataSourceService_CancelAsyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) WaitAsyncJob(ctx context.Context, in *WaitAsyncJobRequest, opts ...grpc.CallOption) (*AsyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AsyncJob)
+	err := c.cc.Invoke(ctx, DataSourceService_WaitAsyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataSourceServiceServer is the server API for DataSourceService service.
 // All implementations must embed UnimplementedDataSourceServiceServer
 // for forward compatibility.
@@ -496,8 +596,12 @@ type DataSourceServiceServer interface {
 	WriterExternalData(context.Context, *WriterExternalDataRequest) (*Response, error)
 	// 获取数据源表信息
 	GetTableInfo(context.Context, *TableInfoRequest) (*TableInfoResponse, error)
+	// 统计数据资产或doris内部表各字段的空值率、不同值个数、最值与高频值
+	ProfileTable(context.Context, *ProfileTableRequest) (*ProfileTableResponse, error)
 	// 获取聚合数据量信息
 	GetGroupCountInfo(context.Context, *GroupCountRequest) (*GroupCountResponse, error)
+	// 分组聚合，每个分组返回一行，包含分组字段与请求的聚合列
+	Aggregate(*AggregateRequest, grpc.ServerStreamingServer[ArrowResponse]) error
 	// 查询作业状态
 	GetJobStatus(context.Context, *JobStatusRequest) (*BatchResponse, error)
 	// 清空表数据
@@ -532,6 +636,16 @@ type DataSourceServiceServer interface {
 	Write(grpc.ClientStreamingServer[WriteRequest, WriteResponse]) error
 	// 导入数据
 	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
+	// 提交异步作业，立即返回作业 ID，作业在服务端后台执行，不受客户端断开影响
+	SubmitAsyncJob(context.Context, *SubmitAsyncJobRequest) (*AsyncJob, error)
+	// 查询异步作业的状态、进度与结果
+	GetAsyncJob(context.Context, *GetAsyncJobRequest) (*AsyncJob, error)
+	// 分页列出异步作业，按提交时间倒序
+	ListAsyncJobs(context.Context, *ListAsyncJobsRequest) (*ListAsyncJobsResponse, error)
+	// 取消异步作业，中止正在执行的 Doris 语句并清理临时数据
+	CancelAsyncJob(context.Context, *CancelAsyncJobRequest) (*AsyncJob, error)
+	// 等待异步作业结束，超时后返回作业的当前状态
+	WaitAsyncJob(context.Context, *WaitAsyncJobRequest) (*AsyncJob, error)
 	mustEmbedUnimplementedDataSourceServiceServer()
 }
 
@@ -572,9 +686,15 @@ func (UnimplementedDataSourceServiceServer) WriterExternalData(context.Context,
 func (UnimplementedDataSourceServiceServer) GetTableInfo(context.Context, *TableInfoRequest) (*TableInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTableInfo not implemented")
 }
+func (UnimplementedDataSourceServiceServer) ProfileTable(context.Context, *ProfileTableRequest) (*ProfileTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileTable not implemented")
+}
 func (UnimplementedDataSourceServiceServer) GetGroupCountInfo(context.Context, *GroupCountRequest) (*GroupCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupCountInfo not implemented")
 }
+func (UnimplementedDataSourceServiceServer) Aggregate(*AggregateRequest, grpc.ServerStreamingServer[ArrowResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
 func (UnimplementedDataSourceServiceServer) GetJobStatus(context.Context, *JobStatusRequest) (*BatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
 }
@@ -626,6 +746,21 @@ func (UnimplementedDataSourceServiceServer) Write(grpc.ClientStreamingServer[Wri
 func (UnimplementedDataSourceServiceServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
 }
+func (UnimplementedDataSourceServiceServer) SubmitAsyncJob(context.Context, *SubmitAsyncJobRequest) (*AsyncJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAsyncJob not implemented")
+}
+func (UnimplementedDataSourceServiceServer) GetAsyncJob(context.Context, *GetAsyncJobRequest) (*AsyncJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAsyncJob not implemented")
+}
+func (UnimplementedDataSourceServiceServer) ListAsyncJobs(context.Context, *ListAsyncJobsRequest) (*ListAsyncJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAsyncJobs not implemented")
+}
+func (UnimplementedDataSourceServiceServer) CancelAsyncJob(context.Context, *CancelAsyncJobRequest) (*AsyncJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAsyncJob not implemented")
+}
+func (UnimplementedDataSourceServiceServer) WaitAsyncJob(context.Context, *WaitAsyncJobRequest) (*AsyncJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitAsyncJob not implemented")
+}
 func (UnimplementedDataSourceServiceServer) mustEmbedUnimplementedDataSourceServiceServer() {}
 func (UnimplementedDataSourceServiceServer) testEmbeddedByValue()                           {}
 
@@ -762,6 +897,24 @@ func _DataSourceService_GetTableInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_ProfileTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).ProfileTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_ProfileTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).ProfileTable(ctx, req.(*ProfileTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataSourceService_GetGroupCountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GroupCountRequest)
 	if err := dec(in); err != nil {
@@ -780,6 +933,17 @@ func _DataSourceService_GetGroupCountInfo_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_Aggregate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AggregateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataSourceServiceServer).Aggregate(m, &grpc.GenericServerStream[AggregateRequest, ArrowResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataSourceService_AggregateServer = grpc.ServerStreamingServer[ArrowResponse]
+
 func _DataSourceService_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JobStatusRequest)
 	if err := dec(in); err != nil {
@@ -1054,6 +1218,96 @@ func _DataSourceService_ImportData_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_SubmitAsyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAsyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).SubmitAsyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_SubmitAsyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).SubmitAsyncJob(ctx, req.(*SubmitAsyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_GetAsyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAsyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).GetAsyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_GetAsyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).GetAsyncJob(ctx, req.(*GetAsyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_ListAsyncJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAsyncJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).ListAsyncJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_ListAsyncJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).ListAsyncJobs(ctx, req.(*ListAsyncJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_CancelAsyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAsyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).CancelAsyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_CancelAsyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).CancelAsyncJob(ctx, req.(*CancelAsyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_WaitAsyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitAsyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).WaitAsyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_WaitAsyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).WaitAsyncJob(ctx, req.(*WaitAsyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataSourceService_ServiceDesc is the grpc.ServiceDesc for DataSourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1073,6 +1327,10 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTableInfo",
 			Handler:    _DataSourceService_GetTableInfo_Handler,
 		},
+		{
+			MethodName: "ProfileTable",
+			Handler:    _DataSourceService_ProfileTable_Handler,
+		},
 		{
 			MethodName: "GetGroupCountInfo",
 			Handler:    _DataSourceService_GetGroupCountInfo_Handler,
@@ -1129,6 +1387,26 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportData",
 			Handler:    _DataSourceService_ImportData_Handler,
 		},
+		{
+			MethodName: "SubmitAsyncJob",
+			Handler:    _DataSourceService_SubmitAsyncJob_Handler,
+		},
+		{
+			MethodName: "GetAsyncJob",
+			Handler:    _DataSourceService_GetAsyncJob_Handler,
+		},
+		{
+			MethodName: "ListAsyncJobs",
+			Handler:    _DataSourceService_ListAsyncJobs_Handler,
+		},
+		{
+			MethodName: "CancelAsyncJob",
+			Handler:    _DataSourceService_CancelAsyncJob_Handler,
+		},
+		{
+			MethodName: "WaitAsyncJob",
+			Handler:    _DataSourceService_WaitAsyncJob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1166,6 +1444,11 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DataSourceService_ReadInternalData_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Aggregate",
+			Handler:       _DataSourceService_Aggregate_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ReadDataSourceStreaming",
 			Handler:       _DataSourceService_ReadDataSourceStreaming_Handler,
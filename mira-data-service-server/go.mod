@@ -7,6 +7,7 @@ require (
 	chainweaver.org.cn/chainweaver/mira/mira-common v1.2.2-0.20250806033123-7231941566f4
 	chainweaver.org.cn/chainweaver/mira/mira-ida-access-service v0.0.0-20250806095927-2427bfa2520c
 	gitea.com/kingbase/gokb v0.0.0-20201021123113-29bd62a876c3
+	gitee.com/chunanyong/dm v1.8.22
 	gitee.com/opengauss/openGauss-connector-go-pq v1.0.7
 	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/apache/arrow/go/v15 v15.0.2
@@ -17,6 +18,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.22.0
 	github.com/iancoleman/orderedmap v0.3.0
+	github.com/jackc/pgx/v5 v5.7.4
 	github.com/jinzhu/copier v0.4.0
 	github.com/minio/minio-go/v7 v7.0.76
 	github.com/penglongli/gin-metrics v0.1.13
@@ -36,13 +38,14 @@ require (
 	k8s.io/api v0.31.1
 	k8s.io/apimachinery v0.31.1
 	k8s.io/client-go v0.31.1
+	sqlflow.org/gohive v0.0.0-20240730014249-8960223660e2
 )
 
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/JohnCGriffin/overflow v0.0.0-20211019200055-46fa312c352c // indirect
 	github.com/andybalholm/brotli v1.0.5 // indirect
-	github.com/apache/thrift v0.17.0 // indirect
+	github.com/apache/thrift v0.19.0 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/bits-and-blooms/bitset v1.2.0 // indirect
 	github.com/bytedance/sonic v1.11.6 // indirect
@@ -77,7 +80,6 @@ require (
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
-	github.com/jackc/pgx/v5 v5.7.4 // indirect
 	github.com/jackc/puddle/v2 v2.2.2 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
@@ -182,3 +182,17 @@ func (mr *MockClientInterfaceMockRecorder) SetBucketLifecycle(bucketName, lifecy
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketLifecycle", reflect.TypeOf((*MockClientInterface)(nil).SetBucketLifecycle), bucketName, lifecycleConfig)
 }
+
+// SetBucketPolicy mocks base method.
+func (m *MockClientInterface) SetBucketPolicy(bucketName, policy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBucketPolicy", bucketName, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBucketPolicy indicates an expected call of SetBucketPolicy.
+func (mr *MockClientInterfaceMockRecorder) SetBucketPolicy(bucketName, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketPolicy", reflect.TypeOf((*MockClientInterface)(nil).SetBucketPolicy), bucketName, policy)
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	common "data-service/common"
 	datasource "data-service/generated/datasource"
 	sql "database/sql"
 	reflect "reflect"
@@ -80,10 +81,10 @@ func (mr *MockIDorisServiceMockRecorder) ConvertRequestToArrowSchema(arg0 interf
 }
 
 // CreateExternalAndInternalTableAndImportData mocks base method.
-func (m *MockIDorisService) CreateExternalAndInternalTableAndImportData(arg0, arg1, arg2, arg3, arg4, arg5 string, arg6 ...string) (string, error) {
+func (m *MockIDorisService) CreateExternalAndInternalTableAndImportData(arg0, arg1, arg2, arg3, arg4, arg5 string, arg6 *common.DorisTableLayout, arg7 ...string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5}
-	for _, a := range arg6 {
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6}
+	for _, a := range arg7 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateExternalAndInternalTableAndImportData", varargs...)
@@ -93,17 +94,17 @@ func (m *MockIDorisService) CreateExternalAndInternalTableAndImportData(arg0, ar
 }
 
 // CreateExternalAndInternalTableAndImportData indicates an expected call of CreateExternalAndInternalTableAndImportData.
-func (mr *MockIDorisServiceMockRecorder) CreateExternalAndInternalTableAndImportData(arg0, arg1, arg2, arg3, arg4, arg5 interface{}, arg6 ...interface{}) *gomock.Call {
+func (mr *MockIDorisServiceMockRecorder) CreateExternalAndInternalTableAndImportData(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}, arg7 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5}, arg6...)
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6}, arg7...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalAndInternalTableAndImportData", reflect.TypeOf((*MockIDorisService)(nil).CreateExternalAndInternalTableAndImportData), varargs...)
 }
 
 // CreateExternalAndInternalTableAndImportDataBatched mocks base method.
-func (m *MockIDorisService) CreateExternalAndInternalTableAndImportDataBatched(arg0, arg1, arg2, arg3, arg4, arg5, arg6 string, arg7 int, arg8 ...string) (string, error) {
+func (m *MockIDorisService) CreateExternalAndInternalTableAndImportDataBatched(arg0, arg1, arg2, arg3, arg4, arg5 string, arg6 *common.DorisTableLayout, arg7 string, arg8 int, arg9 ...string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7}
-	for _, a := range arg8 {
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8}
+	for _, a := range arg9 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateExternalAndInternalTableAndImportDataBatched", varargs...)
@@ -113,9 +114,9 @@ func (m *MockIDorisService) CreateExternalAndInternalTableAndImportDataBatched(a
 }
 
 // CreateExternalAndInternalTableAndImportDataBatched indicates an expected call of CreateExternalAndInternalTableAndImportDataBatched.
-func (mr *MockIDorisServiceMockRecorder) CreateExternalAndInternalTableAndImportDataBatched(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}, arg8 ...interface{}) *gomock.Call {
+func (mr *MockIDorisServiceMockRecorder) CreateExternalAndInternalTableAndImportDataBatched(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}, arg9 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7}, arg8...)
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8}, arg9...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalAndInternalTableAndImportDataBatched", reflect.TypeOf((*MockIDorisService)(nil).CreateExternalAndInternalTableAndImportDataBatched), varargs...)
 }
 
@@ -224,11 +225,12 @@ func (mr *MockIDorisServiceMockRecorder) ExecuteUpdate(arg0 interface{}, arg1 ..
 }
 
 // ExportDorisDataToMiraDB mocks base method.
-func (m *MockIDorisService) ExportDorisDataToMiraDB(arg0 *datasource.ExportDorisDataToMiraDBRequest) error {
+func (m *MockIDorisService) ExportDorisDataToMiraDB(arg0 *datasource.ExportDorisDataToMiraDBRequest) (*datasource.ReconcileReport, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExportDorisDataToMiraDB", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*datasource.ReconcileReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ExportDorisDataToMiraDB indicates an expected call of ExportDorisDataToMiraDB.
@@ -294,6 +296,21 @@ func (mr *MockIDorisServiceMockRecorder) ImportArrowFileToDoris(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportArrowFileToDoris", reflect.TypeOf((*MockIDorisService)(nil).ImportArrowFileToDoris), arg0, arg1, arg2, arg3)
 }
 
+// ImportAssetIncrementally mocks base method.
+func (m *MockIDorisService) ImportAssetIncrementally(arg0 common.IncrementalImportRequest) (*common.IncrementalImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAssetIncrementally", arg0)
+	ret0, _ := ret[0].(*common.IncrementalImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAssetIncrementally indicates an expected call of ImportAssetIncrementally.
+func (mr *MockIDorisServiceMockRecorder) ImportAssetIncrementally(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAssetIncrementally", reflect.TypeOf((*MockIDorisService)(nil).ImportAssetIncrementally), arg0)
+}
+
 // ImportCsvFileToDoris mocks base method.
 func (m *MockIDorisService) ImportCsvFileToDoris(arg0 *datasource.ImportCsvFileToDorisRequest) error {
 	m.ctrl.T.Helper()
@@ -351,19 +368,20 @@ func (mr *MockIDorisServiceMockRecorder) InitMiraTaskTmpDatabase() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitMiraTaskTmpDatabase", reflect.TypeOf((*MockIDorisService)(nil).InitMiraTaskTmpDatabase))
 }
 
-// ProcessDataSourceAndExport mocks base method.
-func (m *MockIDorisService) ProcessDataSourceAndExport(arg0 *datasource.ReadDataSourceStreamingRequest, arg1 string) (string, error) {
+// PrepareDataSourceInDoris mocks base method.
+func (m *MockIDorisService) PrepareDataSourceInDoris(arg0 *datasource.ReadDataSourceStreamingRequest, arg1 string) (string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessDataSourceAndExport", arg0, arg1)
+	ret := m.ctrl.Call(m, "PrepareDataSourceInDoris", arg0, arg1)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// ProcessDataSourceAndExport indicates an expected call of ProcessDataSourceAndExport.
-func (mr *MockIDorisServiceMockRecorder) ProcessDataSourceAndExport(arg0, arg1 interface{}) *gomock.Call {
+// PrepareDataSourceInDoris indicates an expected call of PrepareDataSourceInDoris.
+func (mr *MockIDorisServiceMockRecorder) PrepareDataSourceInDoris(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDataSourceAndExport", reflect.TypeOf((*MockIDorisService)(nil).ProcessDataSourceAndExport), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareDataSourceInDoris", reflect.TypeOf((*MockIDorisService)(nil).PrepareDataSourceInDoris), arg0, arg1)
 }
 
 // SwitchDatabase mocks base method.
@@ -146,6 +146,7 @@ func (i *Initializer) initDorisResource() error {
 	if err := dorisService.InitGlobalResource(); err != nil {
 		return fmt.Errorf("failed to init Doris resource: %v", err)
 	}
+	service.StartJdbcCatalogJanitor()
 	return nil
 }
 
@@ -153,6 +154,7 @@ func (i *Initializer) initGorm() error {
 	if err := gorm.InitGormConnection(); err != nil {
 		return fmt.Errorf("failed to init gorm connection: %v", err)
 	}
+	service.StartAsyncJobJanitor()
 
 	return nil
 }
@@ -0,0 +1,248 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/12/02
+	@note: WriteInternalData 的流级事务：数据先写入每个流独立的暂存表，流正常结束后逐表提交到目标表，出错时删除暂存表
+		写入多张表的流必须带写入 ID，部分表提交后失败时由客户端用同一 ID 重试补齐剩余的表
+
+*
+*/
+package main
+
+import (
+	"bytes"
+	"context"
+	"data-service/config"
+	"data-service/database"
+	pb "data-service/generated/datasource"
+	log2 "data-service/log"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/google/uuid"
+)
+
+// stagedTable 流内写入的一张目标表
+type stagedTable struct {
+	tableName    string
+	stagingTable string
+	// schema 流内各批次列的并集，提交时按它创建和比对目标表
+	schema     *arrow.Schema
+	db         *sql.DB
+	dbStrategy database.DatabaseStrategy
+	// committed 写入 ID 已经提交过该表，重试的流跳过该表的数据
+	committed bool
+}
+
+// internalWriteStream 一个 WriteInternalData 流的写入状态
+type internalWriteStream struct {
+	ctx      context.Context
+	dbType   pb.DataSourceType
+	streamID string
+	// writeID 客户端提供的写入 ID，取自流的第一个请求
+	writeID string
+	// 同一个流内按库名复用策略，连接池由 PoolRegistry 共享
+	strategies map[string]database.DatabaseStrategy
+	// ledgers 已确认台账表存在的库
+	ledgers map[string]bool
+	tables  map[string]*stagedTable
+	// order 按首次写入的顺序提交
+	order []*stagedTable
+}
+
+func newInternalWriteStream(ctx context.Context, dbType pb.DataSourceType) *internalWriteStream {
+	return &internalWriteStream{
+		ctx:        ctx,
+		dbType:     dbType,
+		streamID:   strings.ReplaceAll(uuid.New().String(), "-", "")[:12],
+		strategies: make(map[string]database.DatabaseStrategy),
+		ledgers:    make(map[string]bool),
+		tables:     make(map[string]*stagedTable),
+	}
+}
+
+// strategy 获取库对应的策略，首次使用时建立连接
+func (w *internalWriteStream) strategy(dbName string) (database.DatabaseStrategy, error) {
+	if dbStrategy, ok := w.strategies[dbName]; ok {
+		return dbStrategy, nil
+	}
+	conf := config.GetConfigMap()
+	connInfo := &pb.ConnectionInfo{Host: conf.Dbms.Host,
+		Port:     conf.Dbms.Port,
+		User:     conf.Dbms.User,
+		DbName:   dbName,
+		Password: conf.Dbms.Password,
+	}
+	log2.Logger.Debugf("Connect to database: %s", connInfo)
+	dbStrategy, err := database.DatabaseFactory(w.dbType, connInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %v", err)
+	}
+	w.strategies[dbName] = dbStrategy
+	return dbStrategy, nil
+}
+
+// table 获取请求写入的目标表，首次写入时分配暂存表，并按写入 ID 检查该表是否已提交过
+func (w *internalWriteStream) table(request *pb.WriterInternalDataRequest) (*stagedTable, error) {
+	tableName := request.TableName
+	if request.JobInstanceId != "" {
+		tableName = request.JobInstanceId + "_" + tableName
+	}
+	key := request.DbName + "." + tableName
+	if table, ok := w.tables[key]; ok {
+		return table, nil
+	}
+	// 各表分别在自己的事务中提交，没有写入 ID 时无法在重试中跳过已提交的表，只能拒绝
+	if w.writeID == "" && len(w.order) > 0 {
+		return nil, fmt.Errorf("stream writes to %s after %s without a write id, streams writing several tables require a write id",
+			tableName, w.order[0].tableName)
+	}
+
+	dbStrategy, err := w.strategy(request.DbName)
+	if err != nil {
+		return nil, err
+	}
+	table := &stagedTable{
+		tableName:    tableName,
+		stagingTable: database.StagingTableName(tableName, w.streamID),
+		db:           database.GetDB(dbStrategy),
+		dbStrategy:   dbStrategy,
+	}
+	if w.writeID != "" {
+		callCtx, cancel := database.WithCallTimeout(w.ctx)
+		defer cancel()
+		if !w.ledgers[request.DbName] {
+			if err := database.EnsureWriteLedger(callCtx, table.db, w.dbType, dbStrategy); err != nil {
+				return nil, err
+			}
+			w.ledgers[request.DbName] = true
+		}
+		if table.committed, err = database.IsWriteCommitted(callCtx, table.db, w.dbType, w.writeID, tableName); err != nil {
+			return nil, err
+		}
+		if table.committed {
+			log2.Logger.Infof("Write %s has already been committed to %s, skipping its data", w.writeID, tableName)
+		}
+	}
+	w.tables[key] = table
+	w.order = append(w.order, table)
+	return table, nil
+}
+
+// stage 将请求中的一批数据写入目标表的暂存表
+func (w *internalWriteStream) stage(request *pb.WriterInternalDataRequest) error {
+	table, err := w.table(request)
+	if err != nil {
+		return err
+	}
+	if table.committed {
+		return nil
+	}
+
+	ipcReader, err := ipc.NewReader(bytes.NewReader(request.ArrowBatch), ipc.WithAllocator(memory.NewGoAllocator()))
+	if err != nil {
+		return fmt.Errorf("failed to create Arrow IPC reader: %v", err)
+	}
+	schema := ipcReader.Schema()
+	log2.Logger.Infof("Staging table %s for %s, schema: %v", table.stagingTable, table.tableName, schema)
+	table.schema = mergeSchema(table.schema, schema)
+
+	return w.submit(&database.TableOperation{
+		TableName:  table.stagingTable,
+		Schema:     schema,
+		IpcReader:  ipcReader,
+		DB:         table.db,
+		DbStrategy: table.dbStrategy,
+	})
+}
+
+// commit 逐表将暂存表的数据提交到目标表，返回提交和因已提交而跳过的表数
+// 每张表在一个事务中提交；后面的表失败时前面已提交的表不会回滚，写入多张表的流都带写入 ID，重试时会跳过这些表
+func (w *internalWriteStream) commit() (int, int, error) {
+	committed, skipped := 0, 0
+	for _, table := range w.order {
+		if table.committed {
+			skipped++
+			continue
+		}
+		err := w.submit(&database.TableOperation{
+			TableName:    table.tableName,
+			Schema:       table.schema,
+			DB:           table.db,
+			DbStrategy:   table.dbStrategy,
+			StagingTable: table.stagingTable,
+			WriteID:      w.writeID,
+		})
+		if err != nil {
+			// 相同写入 ID 的流并发提交时台账的唯一索引会使后提交的事务失败，此时按已提交处理
+			if w.writeID != "" {
+				callCtx, cancel := database.WithCallTimeout(w.ctx)
+				done, checkErr := database.IsWriteCommitted(callCtx, table.db, w.dbType, w.writeID, table.tableName)
+				cancel()
+				if checkErr == nil && done {
+					log2.Logger.Infof("Write %s was committed to %s by another stream", w.writeID, table.tableName)
+					skipped++
+					continue
+				}
+			}
+			return committed, skipped, fmt.Errorf("failed to commit %s: %v", table.tableName, err)
+		}
+		committed++
+	}
+	return committed, skipped, nil
+}
+
+// submit 将表操作提交到全局队列，与其他写入同一张表的操作串行执行
+func (w *internalWriteStream) submit(op *database.TableOperation) error {
+	op.Ctx = w.ctx
+	op.DbType = w.dbType
+	op.ResultCh = make(chan error, 1)
+	database.GetOperationQueue() <- op
+	return <-op.ResultCh
+}
+
+// cleanup 删除流内创建的所有暂存表并关闭流内的策略，流已取消时仍需执行，因此不使用流的 ctx
+func (w *internalWriteStream) cleanup() {
+	for _, table := range w.order {
+		if table.committed {
+			continue
+		}
+		ctx, cancel := database.WithCallTimeout(context.Background())
+		if err := database.DropStagingTable(ctx, table.db, w.dbType, table.stagingTable); err != nil {
+			log2.Logger.Warnf("Failed to drop staging table %s: %v", table.stagingTable, err)
+		}
+		cancel()
+	}
+	for dbName, dbStrategy := range w.strategies {
+		if err := dbStrategy.Close(); err != nil {
+			log2.Logger.Warnf("Failed to close database strategy for %s: %v", dbName, err)
+		}
+	}
+	w.strategies = make(map[string]database.DatabaseStrategy)
+}
+
+// mergeSchema 在 base 之后追加 incoming 中新出现的列，列名不区分大小写
+func mergeSchema(base, incoming *arrow.Schema) *arrow.Schema {
+	if base == nil {
+		return incoming
+	}
+	fields := base.Fields()
+	existing := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		existing[strings.ToLower(field.Name)] = true
+	}
+	for _, field := range incoming.Fields() {
+		if !existing[strings.ToLower(field.Name)] {
+			fields = append(fields, field)
+		}
+	}
+	return arrow.NewSchema(fields, nil)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"data-service/config"
+	"data-service/database"
 	log "data-service/log"
 	"fmt"
 	"net/http"
@@ -16,6 +17,7 @@ const (
 	labelServiceName = "service_name"
 	labelSuccess     = "success"
 	labelHandler     = "handler"
+	labelPool        = "pool"
 
 	serviceNameValue = "data-service"
 )
@@ -42,6 +44,31 @@ func getMetrics() []*ginmetrics.Metric {
 			Description: "Duration of import data processing in seconds (last request)",
 			Labels:      []string{labelServiceName, labelHandler, labelSuccess},
 		},
+		// 连接池（PoolRegistry）
+		{
+			Type:        ginmetrics.Gauge,
+			Name:        "db_pool_open_connections",
+			Description: "Number of established connections in the pool",
+			Labels:      []string{labelServiceName, labelPool},
+		},
+		{
+			Type:        ginmetrics.Gauge,
+			Name:        "db_pool_in_use_connections",
+			Description: "Number of connections currently in use",
+			Labels:      []string{labelServiceName, labelPool},
+		},
+		{
+			Type:        ginmetrics.Gauge,
+			Name:        "db_pool_idle_connections",
+			Description: "Number of idle connections in the pool",
+			Labels:      []string{labelServiceName, labelPool},
+		},
+		{
+			Type:        ginmetrics.Gauge,
+			Name:        "db_pool_wait_count",
+			Description: "Total number of connections waited for",
+			Labels:      []string{labelServiceName, labelPool},
+		},
 	}
 }
 
@@ -69,6 +96,14 @@ func MonitorMetric() {
 			}
 		}
 
+		// 抓取 /metrics 前刷新连接池指标
+		monitor.Use(func(c *gin.Context) {
+			if c.Request.URL.Path == "/metrics" {
+				refreshPoolMetrics()
+			}
+			c.Next()
+		})
+
 		// 使用 gin-metrics 中间件
 		M.Use(monitor)
 
@@ -93,6 +128,11 @@ func InitMonitorRouter() *gin.Engine {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
 
+	// 连接池状态
+	r.GET("/pools", func(c *gin.Context) {
+		c.JSON(http.StatusOK, database.GetPoolRegistry().Stats())
+	})
+
 	return r
 }
 
@@ -113,3 +153,22 @@ func ObserveDuration(handler string, start time.Time, success bool) {
 		}
 	}
 }
+
+// refreshPoolMetrics 将 PoolRegistry 中各连接池的状态写入指标
+func refreshPoolMetrics() {
+	for _, stats := range database.GetPoolRegistry().Stats() {
+		labels := []string{serviceNameValue, stats.Key}
+		if m := M.GetMetric("db_pool_open_connections"); m != nil {
+			m.SetGaugeValue(labels, float64(stats.OpenConnections))
+		}
+		if m := M.GetMetric("db_pool_in_use_connections"); m != nil {
+			m.SetGaugeValue(labels, float64(stats.InUse))
+		}
+		if m := M.GetMetric("db_pool_idle_connections"); m != nil {
+			m.SetGaugeValue(labels, float64(stats.Idle))
+		}
+		if m := M.GetMetric("db_pool_wait_count"); m != nil {
+			m.SetGaugeValue(labels, float64(stats.WaitCount))
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"data-service/server/routes"
 	"data-service/service"
 	"data-service/utils"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -80,9 +81,19 @@ func (s Server) WriterExternalData(ctx context.Context, request *pb.WriterExtern
 	}
 	log2.Logger.Infof("Operate table: %s", tableName)
 	// 检查表是否存在，如果不存在就创建
-	_ = dbStrategy.CreateTemporaryTableIfNotExists(tableName, schema)
+	createCtx, cancel := database.WithCallTimeout(ctx)
+	_ = dbStrategy.CreateTemporaryTableIfNotExists(createCtx, tableName, schema)
+	cancel()
+	// 表已存在时先比对结构，不一致时按策略演进或在写入前拒绝
+	reconcileCtx, cancel := database.WithCallTimeout(ctx)
+	err = database.ReconcileTableSchema(reconcileCtx, db, dbType, tableName, schema, database.SchemaEvolutionPolicyFromConfig())
+	cancel()
+	if err != nil {
+		return nil, err
+	}
 
-	if err := database.InsertArrowDataInBatches(db, tableName, schema, ipcReader, dbType); err != nil {
+	if err := database.InsertArrowDataInBatches(ctx, db, tableName, schema, ipcReader, dbType,
+		database.NewWriteOptions(request.WriteMode, request.KeyColumns)); err != nil {
 		return nil, fmt.Errorf("failed to insert Arrow data: %v", err)
 	}
 
@@ -93,20 +104,30 @@ func (s Server) WriterExternalData(ctx context.Context, request *pb.WriterExtern
 	}, nil
 }
 
+// WriteInternalData 流内的数据先写入暂存表，流正常结束后逐表在事务中提交到目标表，出错时删除暂存表
+// 第一个请求带写入 ID 时，已用该 ID 提交过的表在重试的流中不再写入；不带写入 ID 的流只能写入一张表
 func (s Server) WriteInternalData(g grpc.ClientStreamingServer[pb.WriterInternalDataRequest, pb.Response]) error {
 	conf := config.GetConfigMap()
-	dbType := utils.ConvertDBType(conf.Dbms.Type)
+	stream := newInternalWriteStream(g.Context(), utils.ConvertDBType(conf.Dbms.Type))
+	defer stream.cleanup()
 
-	for {
+	for first := true; ; first = false {
 		request, err := g.Recv()
 		if err != nil {
 			// 区分客户端主动断开连接和网络异常
 			if err == io.EOF {
-				log2.Logger.Infof("Client stream completed normally, sending final response")
-				return g.SendAndClose(&pb.Response{
-					Success: true,
-					Message: "All data processed successfully",
-				})
+				log2.Logger.Infof("Client stream completed normally, committing staged data")
+				committed, skipped, err := stream.commit()
+				if err != nil {
+					log2.Logger.Errorf("Failed to commit staged data: %v", err)
+					return fmt.Errorf("failed to commit staged data: %v", err)
+				}
+				message := "All data processed successfully"
+				if skipped > 0 {
+					message = fmt.Sprintf("Committed %d table(s), skipped %d table(s) already committed by write %s",
+						committed, skipped, stream.writeID)
+				}
+				return g.SendAndClose(&pb.Response{Success: true, Message: message})
 			}
 			log2.Logger.Errorf("Error receiving request: %v", err)
 			return g.SendAndClose(&pb.Response{
@@ -114,74 +135,14 @@ func (s Server) WriteInternalData(g grpc.ClientStreamingServer[pb.WriterInternal
 				Message: fmt.Sprintf("Error processing stream: %v", err),
 			})
 		}
-		connInfo := &pb.ConnectionInfo{Host: conf.Dbms.Host,
-			Port:     conf.Dbms.Port,
-			User:     conf.Dbms.User,
-			DbName:   request.DbName,
-			Password: conf.Dbms.Password,
-		}
-		log2.Logger.Debugf("Connect to database: %s", connInfo)
-		// 处理请求，拿取数据
-		reader := bytes.NewReader(request.ArrowBatch)
-		// 使用 Arrow 的内存分配器
-		pool := memory.NewGoAllocator()
-
-		// 使用 IPC 文件读取器解析数据
-		ipcReader, err := ipc.NewReader(reader, ipc.WithAllocator(pool))
-		if err != nil {
-			log.Fatalf("Failed to create Arrow IPC reader: %v", err)
-		}
-		// defer ipcReader.Release()
-
-		// 获取表结构信息
-		schema := ipcReader.Schema()
-		log2.Logger.Infof("Table schema: %v", schema)
-		dbStrategy, err := database.DatabaseFactory(dbType, connInfo)
-		if err != nil {
-			ipcReader.Release() // 立即释放资源
-			return fmt.Errorf("failed to create database strategy: %v", err)
-		}
-		if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
-			ipcReader.Release() // 立即释放资源
-			return fmt.Errorf("failed to connect to database: %v", err)
-		}
-		db := database.GetDB(dbStrategy)
-
-		tableName := request.TableName
-		if tableName == "" {
-			tableName = connInfo.TableName
-		}
-		if request.JobInstanceId != "" {
-			tableName = request.JobInstanceId + "_" + tableName
-		}
-		log2.Logger.Infof("Operate table: %s", tableName)
-
-		// 创建结果通道
-		resultCh := make(chan error, 1)
-
-		// 创建表操作并提交到队列
-		op := &database.TableOperation{
-			TableName:  tableName,
-			Schema:     schema,
-			DbType:     dbType,
-			DB:         db,
-			IpcReader:  ipcReader,
-			DbStrategy: dbStrategy,
-			ResultCh:   resultCh,
+		if first {
+			stream.writeID = request.WriteId
 		}
-
-		queue := database.GetOperationQueue()
-		queue <- op
-
-		// 等待操作完成
-		err = <-resultCh
-		if err != nil {
+		if err := stream.stage(request); err != nil {
 			log2.Logger.Errorf("Failed to process table operation: %v", err)
 			return fmt.Errorf("failed to process table operation: %v", err)
 		}
-
 	}
-
 }
 
 func (s Server) ReadInternalData(request *pb.InternalReadRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
@@ -202,14 +163,165 @@ func (s Server) ReadInternalData(request *pb.InternalReadRequest, g grpc.ServerS
 	if request.JobInstanceId != "" {
 		tableName = request.JobInstanceId + "_" + tableName
 	}
-	query, args, err := dbStrategy.BuildWithConditionQuery(tableName, request.DbFields,
-		request.FilterNames, request.FilterOperators, request.FilterValues, request.SortRules)
+	readReq := database.NewPartitionedReadRequest(tableName, request.DbFields, request.FilterNames,
+		request.FilterOperators, request.FilterValues, request.SortRules, request.FilterExpression, request.ParallelRead)
+	fingerprint := common.ResumeFingerprint(request, "parallelRead")
+	resume, err := common.DecodeResumeToken(request.ResumeToken, fingerprint)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	var sentData bool
+	totalRecords := int64(0)
+	if resume != nil {
+		totalRecords = resume.Rows
+		s.logger.Infof("Resuming read of %s after %d records", tableName, resume.Rows)
+	}
+	err = s.readTableData(g.Context(), dbStrategy, dbType, readReq, fingerprint, resume, func(record arrow.Record, resumeToken string) error {
+		if err := sendArrowRecord(g, record, resumeToken); err != nil {
+			return err
+		}
+		totalRecords += record.NumRows()
+		sentData = true
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("error building query: %v", err)
+		return err
 	}
+	s.logger.Debugf("query database success, total records: %d", totalRecords)
+
+	if !sentData {
+		// 查询到空集，返回空arrow
+		buf, err := utils.ConvertToEmptyArrowBatch(connInfo.Columns)
+		if err != nil {
+			return fmt.Errorf("failed to convert to empty arrow batch: %v", err)
+		}
+		response := &pb.ArrowResponse{
+			ArrowBatch: buf,
+		}
+		s.logger.Warn("Query returned nil rows")
+		if err := g.Send(response); err != nil {
+			return fmt.Errorf("failed to send response: %v", err)
+		}
+		s.logger.Infof("Send empty arrow batch")
+	}
+
+	// 数据发送完成后，发送 EOF 标志
+	eofResponse := &pb.ArrowResponse{
+		ArrowBatch: []byte("EOF"), // 特殊标志，用于告知客户端结束
+	}
+	if err := g.Send(eofResponse); err != nil {
+		return fmt.Errorf("failed to send EOF response: %v", err)
+	}
+	s.logger.Debug("send EOF response success")
+	s.logger.Debug("Stream processing completed successfully")
+	return nil
+}
+
+// reconcileRead 将发送的数据与源表重新计算的指纹比对
+// count 模式下无过滤条件时已按表信息中的总行数比对，有过滤条件时按相同条件重新计数；其余模式按相同条件和列重新计算指纹
+func (s Server) reconcileRead(ctx context.Context, dbStrategy database.DatabaseStrategy, dbType pb.DataSourceType,
+	req database.PartitionedReadRequest, mode pb.ReconcileMode, filtered bool, totalRecords int64,
+	sent *database.FingerprintAccumulator) error {
+	if mode == pb.ReconcileMode_RECONCILE_MODE_NONE || (mode == pb.ReconcileMode_RECONCILE_MODE_COUNT && !filtered) {
+		return nil
+	}
+	condition, args, err := database.ReadCondition(dbType, req)
+	if err != nil {
+		return fmt.Errorf("failed to build reconcile condition: %v", err)
+	}
+	sentFingerprint := sent.Fingerprint()
+	sentFingerprint.Rows = totalRecords
+	// 没有发送任何数据时无法确定列，只比对行数
+	columns := sent.Columns()
+	if len(columns) == 0 {
+		mode = pb.ReconcileMode_RECONCILE_MODE_COUNT
+	}
+	sourceFingerprint, err := database.ComputeTableFingerprint(ctx, database.ReconcileSource{
+		Strategy: dbStrategy, DbType: dbType, TableName: req.TableName, Condition: condition, Args: args}, columns, mode)
+	if err != nil {
+		return fmt.Errorf("failed to fingerprint table %s: %v", req.TableName, err)
+	}
+	report := database.CompareFingerprints(mode, sourceFingerprint, sentFingerprint)
+	if err := database.ReconcileError(report); err != nil {
+		s.logger.Errorf("Reconciliation of %s failed: %v", req.TableName, err)
+		return err
+	}
+	return nil
+}
+
+// readTableData 读取表数据并把 Arrow 批次及其续传令牌逐个交给 send，send 返回后批次即被释放
+// 请求设置了并发数且不是续传时按分片并发读取，分片读取不返回令牌；无法分片（没有数值键、表为空等）时退回单条查询
+// 单条查询在读取顺序确定时返回令牌，resume 不为空时从令牌记录的位置继续；抽样读取既不分片也不返回令牌
+func (s Server) readTableData(ctx context.Context, dbStrategy database.DatabaseStrategy, dbType pb.DataSourceType,
+	req database.PartitionedReadRequest, fingerprint string, resume *common.ResumeToken,
+	send func(record arrow.Record, resumeToken string) error) error {
+	if database.SampleEnabled(req.Sample) {
+		if resume != nil {
+			return status.Error(codes.InvalidArgument, "resume token is not supported for sampled reads")
+		}
+		query, args, err := database.BuildSampleQuery(ctx, dbStrategy, dbType, req)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("failed to build sample query: %v", err))
+		}
+		s.logger.Infof("Sampling %s by %v", req.TableName, req.Sample.Method)
+		return s.sendQueryResult(ctx, dbStrategy, query, args, func(record arrow.Record) error {
+			return send(record, "")
+		})
+	}
+	if req.Parallelism > 1 && resume == nil {
+		// 并发数不超过连接池的最大连接数
+		if maxConns := config.GetConfigMap().Dbms.MaxOpenConns; maxConns > 0 && req.Parallelism > maxConns {
+			req.Parallelism = maxConns
+		}
+		plan, err := database.PlanPartitionedRead(ctx, dbStrategy, dbType, req)
+		switch {
+		case err == nil:
+			s.logger.Infof("Reading %s in %d partitions by %s, parallelism: %d, ordered: %v",
+				req.TableName, plan.Partitions(), plan.Column, req.Parallelism, plan.Ordered)
+			return plan.Read(ctx, dbStrategy, func(record arrow.Record) error {
+				return send(record, "")
+			})
+		case errors.Is(err, database.ErrPartitionUnavailable):
+			s.logger.Infof("Falling back to single query for %s: %v", req.TableName, err)
+		default:
+			return fmt.Errorf("failed to plan partitioned read: %v", err)
+		}
+	}
+
+	resumable, err := database.PlanResumableRead(ctx, dbStrategy, dbType, req, fingerprint, resume)
+	if err != nil {
+		return fmt.Errorf("failed to plan resumable read: %v", err)
+	}
+	var query string
+	var args []interface{}
+	if resumable != nil {
+		query, args = resumable.Query, resumable.Args
+	} else {
+		s.logger.Infof("Read order of %s is not deterministic, resume tokens disabled", req.TableName)
+		query, args, err = dbStrategy.BuildWithConditionQuery(req.TableName, req.Fields,
+			req.FilterNames, req.FilterOperators, req.FilterValues, req.SortRules, req.FilterExpression)
+		if err != nil {
+			return fmt.Errorf("error building query: %v", err)
+		}
+	}
+	return s.sendQueryResult(ctx, dbStrategy, query, args, func(record arrow.Record) error {
+		if resumable == nil {
+			return send(record, "")
+		}
+		resumeToken, err := resumable.Advance(record)
+		if err != nil {
+			return err
+		}
+		return send(record, resumeToken)
+	})
+}
+
+// sendQueryResult 执行查询并把结果按估算的批次大小转为 Arrow 批次逐个交给 send，send 返回后批次即被释放
+func (s Server) sendQueryResult(ctx context.Context, dbStrategy database.DatabaseStrategy, query string,
+	args []interface{}, send func(arrow.Record) error) error {
 	s.logger.Debugf("query: %s", query)
 	s.logger.Debugf("args: %v", args)
-	rows, err := dbStrategy.Query(query, args...)
+	rows, err := dbStrategy.Query(ctx, query, args...)
 	if err != nil {
 		s.logger.Errorf("error executing query: %v", err)
 		return fmt.Errorf("error executing query: %v", err)
@@ -224,74 +336,48 @@ func (s Server) ReadInternalData(request *pb.InternalReadRequest, g grpc.ServerS
 	}()
 	s.logger.Debug("query database success")
 
-	var sentData bool
-	totalRecords := int64(0)
 	// 在循环前计算批次大小
 	adjustedBatchSize, err := database.CalculateEstimatedBatchSize(rows, common.STREAM_DATA_SIZE)
 	if err != nil {
 		return fmt.Errorf("failed to calculate estimated batch size: %v", err)
 	}
-	// 返回arrow流
 	for {
 		// 使用计算好的批次大小进行循环读取
-		record, err := dbStrategy.RowsToArrowBatch(rows, adjustedBatchSize)
+		record, err := dbStrategy.RowsToArrowBatch(ctx, rows, adjustedBatchSize)
 		if err == io.EOF {
 			s.logger.Debug("All data read, sending EOF marker.")
-			break
+			return nil
 		}
 		if err != nil {
 			s.logger.Errorf("error receiving stream: %v", err)
 			return fmt.Errorf("error reading Arrow batch: %v", err)
 		}
-
-		// 发送数据
-		var buf bytes.Buffer
-		writer := ipc.NewWriter(&buf, ipc.WithSchema(record.Schema()))
-		if err := writer.Write(record); err != nil {
-			return fmt.Errorf("failed to write record: %v", err)
-		}
-		if err := writer.Close(); err != nil {
-			return fmt.Errorf("failed to close writer: %v", err)
-		}
-
-		response := &pb.ArrowResponse{
-			ArrowBatch: buf.Bytes(),
-		}
-		if err := g.Send(response); err != nil {
-			return fmt.Errorf("failed to send response: %v", err)
-		}
-
-		totalRecords += record.NumRows()
-		sentData = true
+		err = send(record)
 		record.Release()
-	}
-	s.logger.Debugf("query database success, total records: %d", totalRecords)
-
-	if !sentData {
-		// 查询到空集，返回空arrow
-		buf, err := utils.ConvertToEmptyArrowBatch(connInfo.Columns)
 		if err != nil {
-			return fmt.Errorf("failed to convert to empty arrow batch: %v", err)
-		}
-		response := &pb.ArrowResponse{
-			ArrowBatch: buf,
-		}
-		s.logger.Warn("Query returned nil rows")
-		if err := g.Send(response); err != nil {
-			return fmt.Errorf("failed to send response: %v", err)
+			return err
 		}
-		s.logger.Infof("Send empty arrow batch")
 	}
+}
 
-	// 数据发送完成后，发送 EOF 标志
-	eofResponse := &pb.ArrowResponse{
-		ArrowBatch: []byte("EOF"), // 特殊标志，用于告知客户端结束
+// sendArrowRecord 将 Arrow 批次编码为 IPC 流后连同续传令牌一起发送
+func sendArrowRecord(g grpc.ServerStreamingServer[pb.ArrowResponse], record arrow.Record, resumeToken string) error {
+	var buf bytes.Buffer
+	writer := ipc.NewWriter(&buf, ipc.WithSchema(record.Schema()))
+	if err := writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write record: %v", err)
 	}
-	if err := g.Send(eofResponse); err != nil {
-		return fmt.Errorf("failed to send EOF response: %v", err)
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to close writer: %v", err)
+	}
+
+	response := &pb.ArrowResponse{
+		ArrowBatch:  buf.Bytes(),
+		ResumeToken: resumeToken,
+	}
+	if err := g.Send(response); err != nil {
+		return fmt.Errorf("failed to send response: %v", err)
 	}
-	s.logger.Debug("send EOF response success")
-	s.logger.Debug("Stream processing completed successfully")
 	return nil
 }
 
@@ -304,6 +390,7 @@ func (s Server) ReadStreamingData(request *pb.StreamReadRequest, g grpc.ServerSt
 
 	// 先通过 TableInfoService 拿到表的总条数
 	tableInfo, err := s.tableInfoService.GetTableInfo(
+		g.Context(),
 		request.GetRequestId(),
 		request.AssetName,
 		request.ChainInfoId,
@@ -337,78 +424,57 @@ func (s Server) ReadStreamingData(request *pb.StreamReadRequest, g grpc.ServerSt
 	if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
 		return fmt.Errorf("faild to connect to database: %v", err)
 	}
-	query, args, err := dbStrategy.BuildWithConditionQuery(connInfo.TableName, request.DbFields,
-		request.FilterNames, request.FilterOperators, request.FilterValues, request.SortRules)
+	readReq := database.NewPartitionedReadRequest(connInfo.TableName, request.DbFields, request.FilterNames,
+		request.FilterOperators, request.FilterValues, request.SortRules, request.FilterExpression, request.ParallelRead)
+	readReq.Sample = request.Sampling
+	fingerprint := common.ResumeFingerprint(request, "requestId", "parallelRead")
+	resume, err := common.DecodeResumeToken(request.ResumeToken, fingerprint)
 	if err != nil {
-		return fmt.Errorf("error building query: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
-	rows, err := dbStrategy.Query(query, args...)
-	if err != nil {
-		s.logger.Errorf("error executing query: %v", err)
-		return fmt.Errorf("error executing query: %v", err)
-	}
-	if rows == nil {
-		s.logger.Warn("Query returned nil rows")
-		return fmt.Errorf("unexpected nil rows")
-	}
-	defer func() {
-		s.logger.Debug("Closing rows...")
-		rows.Close() // 函数结束时关闭游标
-	}()
-	s.logger.Debug("query database success, request: asset=%s", request.AssetName)
 	var sentData bool
 	totalRecords := int64(0)
-	// 在循环前计算批次大小
-	adjustedBatchSize, err := database.CalculateEstimatedBatchSize(rows, common.STREAM_DATA_SIZE)
-	if err != nil {
-		return fmt.Errorf("failed to calculate estimated batch size: %v", err)
-	}
-	// 返回arrow流
-	for {
-		// 使用计算好的批次大小进行循环读取
-		record, err := dbStrategy.RowsToArrowBatch(rows, adjustedBatchSize)
-		if err == io.EOF {
-			s.logger.Debug("All data read, sending EOF marker.")
-			break
+	if resume != nil {
+		totalRecords = resume.Rows
+		s.logger.Infof("Resuming read of asset %s after %d records", request.AssetName, resume.Rows)
+	}
+	// 抽样读取不做额外对账；续传时之前发送的数据无法计入指纹，只比对行数
+	reconcileMode := database.ResolveReconcileMode(request.ReconcileMode)
+	if database.SampleEnabled(request.Sampling) {
+		reconcileMode = pb.ReconcileMode_RECONCILE_MODE_NONE
+	} else if resume != nil && reconcileMode != pb.ReconcileMode_RECONCILE_MODE_NONE {
+		reconcileMode = pb.ReconcileMode_RECONCILE_MODE_COUNT
+	}
+	sentPrint := database.NewFingerprintAccumulator(request.DbFields, reconcileMode)
+	err = s.readTableData(g.Context(), dbStrategy, dbType, readReq, fingerprint, resume, func(record arrow.Record, resumeToken string) error {
+		if err := sentPrint.AddRecord(record); err != nil {
+			return err
 		}
-		if err != nil {
-			s.logger.Errorf("error receiving stream: %v", err)
-			return fmt.Errorf("error reading Arrow batch: %v", err)
+		if err := sendArrowRecord(g, record, resumeToken); err != nil {
+			return err
 		}
-
-		// 发送数据
-		var buf bytes.Buffer
-		writer := ipc.NewWriter(&buf, ipc.WithSchema(record.Schema()))
-		if err := writer.Write(record); err != nil {
-			return fmt.Errorf("failed to write record: %v", err)
-		}
-		if err := writer.Close(); err != nil {
-			return fmt.Errorf("failed to close writer: %v", err)
-		}
-
-		response := &pb.ArrowResponse{
-			ArrowBatch: buf.Bytes(),
-		}
-		if err := g.Send(response); err != nil {
-			return fmt.Errorf("failed to send response: %v", err)
-		}
-
 		totalRecords += record.NumRows()
 		sentData = true
-		record.Release()
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 	s.logger.Infof("query database success, total records: %d, expected: %d", totalRecords, expectedTotal)
 
 	var filterFlag = true
-	if len(request.FilterNames) == 0 {
+	if len(request.FilterNames) == 0 && request.FilterExpression == nil && !database.SampleEnabled(request.Sampling) {
 		s.logger.Debug("FilterNames is empty")
 		filterFlag = false
 	}
-	// 如果不是过滤查询，则对比发送条数和表总条数，不一致则报错
+	// 如果不是过滤查询，则对比发送条数和表总条数，不一致则报错；与对账强度无关，对账强度只决定额外的比对
 	if !filterFlag && expectedTotal > 0 && totalRecords != expectedTotal {
 		s.logger.Errorf("row count mismatch, expected %d, actually sent %d", expectedTotal, totalRecords)
 		return fmt.Errorf("row count mismatch, expected %d, actually sent %d", expectedTotal, totalRecords)
 	}
+	if err := s.reconcileRead(g.Context(), dbStrategy, dbType, readReq, reconcileMode, filterFlag, totalRecords, sentPrint); err != nil {
+		return err
+	}
 
 	if !sentData {
 		// 查询到空集，返回空arrow
@@ -720,7 +786,12 @@ func (s Server) ReadOSSData(request *pb.OSSReadRequest, g grpc.ServerStreamingSe
 }
 
 func (s Server) GetTableInfo(ctx context.Context, request *pb.TableInfoRequest) (*pb.TableInfoResponse, error) {
-	return s.tableInfoService.GetTableInfo(request.RequestId, request.AssetName, request.ChainInfoId, request.IsExactQuery, request.Alias)
+	return s.tableInfoService.GetTableInfo(ctx, request.RequestId, request.AssetName, request.ChainInfoId, request.IsExactQuery, request.Alias)
+}
+
+// ProfileTable 统计数据资产或 doris 内部表各字段的空值率、不同值个数、最值与高频值
+func (s Server) ProfileTable(ctx context.Context, request *pb.ProfileTableRequest) (*pb.ProfileTableResponse, error) {
+	return s.tableInfoService.ProfileTable(ctx, request)
 }
 
 func (s Server) GetInternalTableInfo(ctx context.Context, request *pb.InternalTableInfoRequest) (*pb.TableInfoResponse, error) {
@@ -728,7 +799,7 @@ func (s Server) GetInternalTableInfo(ctx context.Context, request *pb.InternalTa
 	// if request.JobInstanceId != "" {
 	// 	tableName = request.JobInstanceId + "_" + tableName
 	// }
-	return s.tableInfoService.GetInternalTableInfo(tableName, request.DbName, request.IsExactQuery)
+	return s.tableInfoService.GetInternalTableInfo(ctx, tableName, request.DbName, request.IsExactQuery)
 }
 
 func (s Server) GetGroupCountInfo(ctx context.Context, request *pb.GroupCountRequest) (*pb.GroupCountResponse, error) {
@@ -747,7 +818,78 @@ func (s Server) GetGroupCountInfo(ctx context.Context, request *pb.GroupCountReq
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
-	return dbStrategy.GetGroupCountInfo(request.TableName, request.GroupByFields, request.FilterNames, request.FilterOperators, request.FilterValues)
+	callCtx, cancel := database.WithCallTimeout(ctx)
+	defer cancel()
+	return dbStrategy.GetGroupCountInfo(callCtx, request.TableName, request.GroupByFields, request.FilterNames, request.FilterOperators, request.FilterValues, request.FilterExpression)
+}
+
+// Aggregate 对内置数据库表或 Doris 内部表分组聚合，每个分组返回一行，结果以 Arrow 批次流式返回
+func (s Server) Aggregate(request *pb.AggregateRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
+	conf := config.GetConfigMap()
+	var (
+		dbType    pb.DataSourceType
+		connInfo  *pb.ConnectionInfo
+		tableName string
+	)
+	switch {
+	case request.GetInternal() != nil:
+		dbType = utils.ConvertDBType(conf.Dbms.Type)
+		connInfo = &pb.ConnectionInfo{Host: conf.Dbms.Host,
+			Port:     conf.Dbms.Port,
+			User:     conf.Dbms.User,
+			DbName:   request.GetInternal().DbName,
+			Password: conf.Dbms.Password,
+		}
+		tableName = request.GetInternal().TableName
+	case request.GetDoris() != nil:
+		// Doris 连接池共享，库名写在表名中而不是通过 USE 切换
+		dbType = pb.DataSourceType_DATA_SOURCE_TYPE_DORIS
+		connInfo = &pb.ConnectionInfo{Host: conf.DorisConfig.Address,
+			Port:     9030,
+			User:     conf.DorisConfig.User,
+			Password: conf.DorisConfig.Password,
+		}
+		tableName = request.GetDoris().TableName
+		if dbName := request.GetDoris().DbName; dbName != "" {
+			tableName = dbName + "." + tableName
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "data source is required")
+	}
+
+	query, args, err := (&database.QueryBuilder{}).BuildAggregateQuery(tableName, request.GroupByFields, request.Aggregates,
+		request.FilterNames, request.FilterOperators, request.FilterValues, request.FilterExpression, request.Having,
+		request.SortRules, dbType)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dbStrategy, err := database.DatabaseFactory(dbType, connInfo)
+	if err != nil {
+		return fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
+		return fmt.Errorf("failed to connect to database: %v", err)
+	}
+
+	totalGroups := int64(0)
+	err = s.sendQueryResult(g.Context(), dbStrategy, query, args, func(record arrow.Record) error {
+		if err := sendArrowRecord(g, record, ""); err != nil {
+			return err
+		}
+		totalGroups += record.NumRows()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.Infof("Aggregated %s into %d groups", tableName, totalGroups)
+
+	// 数据发送完成后，发送 EOF 标志
+	if err := g.Send(&pb.ArrowResponse{ArrowBatch: []byte("EOF")}); err != nil {
+		return fmt.Errorf("failed to send EOF response: %v", err)
+	}
+	return nil
 }
 
 func (s Server) TruncateTable(ctx context.Context, request *pb.TruncateTableRequest) (*pb.TruncateTableResponse, error) {
@@ -772,7 +914,9 @@ func (s Server) TruncateTable(ctx context.Context, request *pb.TruncateTableRequ
 
 	crudService := service.NewCrudService(log2.Logger, dbStrategy)
 
-	return crudService.TruncateTable(request.TableName)
+	callCtx, cancel := database.WithCallTimeout(ctx)
+	defer cancel()
+	return crudService.TruncateTable(callCtx, request.TableName)
 }
 
 func (s Server) PushJobResultToExternalDB(ctx context.Context, request *pb.PushJobResultRequest) (*pb.PushJobResultResponse, error) {
@@ -860,18 +1004,8 @@ func (s Server) ImportCsvFileToDoris(ctx context.Context, request *pb.ImportCsvF
 }
 
 func (s Server) ExportCsvFileFromDoris(ctx context.Context, request *pb.ExportCsvFileFromDorisRequest) (*pb.ExportCsvFileFromDorisResponse, error) {
-	dorisService, err := service.NewDorisService(common.MIRA_TMP_TASK_DB)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create doris service: %v", err)
-	}
-	err = dorisService.ExportParquetFileFromDoris(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to export parquet file from doris: %v", err)
-	}
-	return &pb.ExportCsvFileFromDorisResponse{
-		BucketName:    common.BATCH_DATA_BUCKET_NAME,
-		JobInstanceId: request.JobInstanceId,
-	}, nil
+	// 客户端断开后继续导出，需要可取消的导出时使用 SubmitAsyncJob
+	return service.ExportCsvFileFromDoris(context.WithoutCancel(ctx), request)
 }
 
 func (s Server) ExportDorisDataToMiraDB(ctx context.Context, request *pb.ExportDorisDataToMiraDBRequest) (*pb.Response, error) {
@@ -879,28 +1013,31 @@ func (s Server) ExportDorisDataToMiraDB(ctx context.Context, request *pb.ExportD
 	if err != nil {
 		return nil, fmt.Errorf("failed to create doris service: %v", err)
 	}
-	err = dorisService.ExportDorisDataToMiraDB(request)
+	report, err := dorisService.ExportDorisDataToMiraDB(request)
 	if err != nil {
+		// 对账不一致时返回对账结果，便于调用方查看差异
+		if report != nil {
+			return &pb.Response{
+				Success:        false,
+				Message:        err.Error(),
+				Reconciliation: report,
+			}, nil
+		}
 		return nil, fmt.Errorf("failed to export doris data to mira db: %v", err)
 	}
 	return &pb.Response{
-		Success: true,
-		Message: "Doris data exported to MiraDB successfully",
+		Success:        true,
+		Message:        "Doris data exported to MiraDB successfully",
+		Reconciliation: report,
 	}, nil
 }
 
 func (s Server) ImportMiraDBDataToDoris(ctx context.Context, request *pb.ImportMiraDBDataToDorisRequest) (*pb.ImportMiraDBDataToDorisResponse, error) {
-	dorisService, err := service.NewDorisService(common.MIRA_TMP_TASK_DB)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create doris service: %v", err)
-	}
-	internalTableName, err := dorisService.ImportMiraDBDataToDoris(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to import mira db data to doris: %v", err)
-	}
-	return &pb.ImportMiraDBDataToDorisResponse{
-		DorisTableName: internalTableName,
-	}, nil
+	return service.ImportMiraDBDataToDoris(context.WithoutCancel(ctx), request)
+}
+
+func (s Server) CreateExternalAndInternalTableAndImportData(ctx context.Context, request *pb.CreateExternalAndInternalTableAndImportDataRequest) (*pb.CreateExternalAndInternalTableAndImportDataResponse, error) {
+	return service.CreateExternalAndInternalTableAndImportData(context.WithoutCancel(ctx), request)
 }
 
 func (s Server) CleanTmpData(ctx context.Context, request *pb.CleanTmpDataRequest) (*pb.Response, error) {
@@ -971,25 +1108,18 @@ func (s Server) ReadDataSourceStreaming(request *pb.ReadDataSourceStreamingReque
 		return fmt.Errorf("failed to create doris service: %v", err)
 	}
 
-	var tableName string
 	randomSuffix, err := common.GenerateRandomString(8)
 	if err != nil {
 		return fmt.Errorf("failed to generate random suffix: %v", err)
 	}
 	enhancedJobInstanceId := request.JobInstanceId + "_" + randomSuffix
-	// 1.从数据源拉取数据到doris并导出到minio
-	tableName, err = dorisService.ProcessDataSourceAndExport(request, enhancedJobInstanceId)
-	if err != nil {
-		return fmt.Errorf("failed to process data source and export: %v", err)
-	}
-
-	// 2.执行导出parquet文件到minio
-	// 3.从minio流式读取arrow文件
+	// 从数据源拉取数据到doris，按配置通过 Flight SQL 直接读取，或导出到minio后流式读取
 	chunkService := service.NewChunkService(s.ossClient)
 	parquetStreamingService := service.NewParquetStreamingService(chunkService, s.ossClient)
 
-	if err := parquetStreamingService.StreamParquetFileFromOSS(tableName, enhancedJobInstanceId, request.Columns, g); err != nil {
-		return fmt.Errorf("failed to stream parquet file from OSS: %v", err)
+	dorisReader := service.NewDorisDataReader(dorisService, parquetStreamingService)
+	if _, err := dorisReader.Stream(request, enhancedJobInstanceId, nil, g); err != nil {
+		return fmt.Errorf("failed to process data source and stream: %v", err)
 	}
 
 	// 清理导出文件
@@ -1036,6 +1166,9 @@ func (s Server) Read(request *pb.ReadRequest, g grpc.ServerStreamingServer[pb.Ar
 	}
 
 	if err := readService.ProcessReadRequest(request, g); err != nil {
+		if errors.Is(err, common.ErrInvalidResumeToken) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
 		code := common.ErrCodeUnknown
 		if c, ok := common.GetErrorCode(err); ok {
 			code = c
@@ -1080,11 +1213,71 @@ func (s Server) Write(stream grpc.ClientStreamingServer[pb.WriteRequest, pb.Writ
 func (s Server) ImportData(ctx context.Context, request *pb.ImportDataRequest) (*pb.ImportDataResponse, error) {
 	start := time.Now()
 	importService := service.NewImportService()
-	response, err := importService.ImportData(ctx, request)
+	response, err := importService.ImportData(context.WithoutCancel(ctx), request)
 	ObserveDuration("ImportData", start, err == nil)
 	return response, err
 }
 
+func (s Server) SubmitAsyncJob(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJob, error) {
+	job, err := service.GetAsyncJobService().Submit(request)
+	if err != nil {
+		return nil, asyncJobError(err)
+	}
+	return job, nil
+}
+
+func (s Server) GetAsyncJob(ctx context.Context, request *pb.GetAsyncJobRequest) (*pb.AsyncJob, error) {
+	job, err := service.GetAsyncJobService().Get(request.JobId)
+	if err != nil {
+		return nil, asyncJobError(err)
+	}
+	return job, nil
+}
+
+func (s Server) ListAsyncJobs(ctx context.Context, request *pb.ListAsyncJobsRequest) (*pb.ListAsyncJobsResponse, error) {
+	jobs, totalCount, page, pageSize, err := service.GetAsyncJobService().List(request.JobType, request.Status, int(request.Page), int(request.PageSize))
+	if err != nil {
+		return nil, asyncJobError(err)
+	}
+	return &pb.ListAsyncJobsResponse{
+		Jobs:       jobs,
+		TotalCount: int32(totalCount),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+func (s Server) CancelAsyncJob(ctx context.Context, request *pb.CancelAsyncJobRequest) (*pb.AsyncJob, error) {
+	job, err := service.GetAsyncJobService().Cancel(request.JobId)
+	if err != nil {
+		return nil, asyncJobError(err)
+	}
+	return job, nil
+}
+
+func (s Server) WaitAsyncJob(ctx context.Context, request *pb.WaitAsyncJobRequest) (*pb.AsyncJob, error) {
+	timeout := time.Duration(request.TimeoutSeconds) * time.Second
+	job, err := service.GetAsyncJobService().Wait(ctx, request.JobId, timeout)
+	if err != nil {
+		return nil, asyncJobError(err)
+	}
+	return job, nil
+}
+
+// asyncJobError 将异步作业的错误转换为 gRPC 状态码
+func asyncJobError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrAsyncJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidAsyncJob):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 func main() {
 	// 程序退出时关闭 IDA 服务
 	defer utils.CloseIDAService()
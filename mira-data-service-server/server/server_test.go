@@ -20,6 +20,7 @@ import (
 
 	"bou.ke/monkey"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -191,3 +192,34 @@ func TestReadBatchData_Success(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, resp.Status)
 }
+
+func TestMergeSchema(t *testing.T) {
+	first := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	second := arrow.NewSchema([]arrow.Field{
+		{Name: "ID", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	assert.Equal(t, first, mergeSchema(nil, first))
+	merged := mergeSchema(first, second)
+	// 列名不区分大小写，新列追加在后面
+	assert.Equal(t, []string{"id", "name"}, []string{merged.Field(0).Name, merged.Field(1).Name})
+	assert.Len(t, merged.Fields(), 2)
+}
+
+func TestInternalWriteStreamRejectsSecondTableWithoutWriteID(t *testing.T) {
+	stream := newInternalWriteStream(context.Background(), pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
+	first := &stagedTable{tableName: "orders", stagingTable: "orders_stage"}
+	stream.tables["mira.orders"] = first
+	stream.order = append(stream.order, first)
+
+	// 同一张表的后续批次复用已分配的暂存表
+	table, err := stream.table(&pb.WriterInternalDataRequest{DbName: "mira", TableName: "orders"})
+	assert.NoError(t, err)
+	assert.Equal(t, first, table)
+
+	// 没有写入 ID 时无法保证多张表一起提交，第二张表在连接数据库前被拒绝
+	_, err = stream.table(&pb.WriterInternalDataRequest{DbName: "mira", TableName: "items"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "require a write id")
+}
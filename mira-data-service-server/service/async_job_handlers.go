@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"data-service/common"
+	pb "data-service/generated/datasource"
+	"data-service/log"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// asyncJobHandlers 各类型作业的执行与清理，执行过程与对应的同步接口相同
+func asyncJobHandlers() map[string]asyncJobHandler {
+	return map[string]asyncJobHandler{
+		AsyncJobTypeImportData: {
+			run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+				response, err := NewImportService().ImportData(ctx, request.GetImportData())
+				if err != nil {
+					return nil, err
+				}
+				return &pb.AsyncJobResult{Result: &pb.AsyncJobResult_ImportData{ImportData: response}}, nil
+			},
+			cleanup: func(request *pb.SubmitAsyncJobRequest, created JobResources) error {
+				return cleanupImportData(request.GetImportData(), created.Tables)
+			},
+		},
+		AsyncJobTypeExportCsvFileFromDoris: {
+			run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+				response, err := ExportCsvFileFromDoris(ctx, request.GetExportCsvFileFromDoris())
+				if err != nil {
+					return nil, err
+				}
+				return &pb.AsyncJobResult{Result: &pb.AsyncJobResult_ExportCsvFileFromDoris{ExportCsvFileFromDoris: response}}, nil
+			},
+			cleanup: func(_ *pb.SubmitAsyncJobRequest, created JobResources) error {
+				return NewCleanupTaskService().cleanupExportObjects(created.ObjectPrefixes)
+			},
+		},
+		AsyncJobTypeImportMiraDBDataToDoris: {
+			run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+				response, err := ImportMiraDBDataToDoris(ctx, request.GetImportMiraDBDataToDoris())
+				if err != nil {
+					return nil, err
+				}
+				return &pb.AsyncJobResult{Result: &pb.AsyncJobResult_ImportMiraDBDataToDoris{ImportMiraDBDataToDoris: response}}, nil
+			},
+			cleanup: func(_ *pb.SubmitAsyncJobRequest, created JobResources) error {
+				return dropJobTables(created.Tables)
+			},
+		},
+		AsyncJobTypeCreateExternalAndInternalTableAndImportData: {
+			run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+				response, err := CreateExternalAndInternalTableAndImportData(ctx, request.GetCreateExternalAndInternalTableAndImportData())
+				if err != nil {
+					return nil, err
+				}
+				return &pb.AsyncJobResult{Result: &pb.AsyncJobResult_CreateExternalAndInternalTableAndImportData{
+					CreateExternalAndInternalTableAndImportData: response,
+				}}, nil
+			},
+			cleanup: func(_ *pb.SubmitAsyncJobRequest, created JobResources) error {
+				return dropJobTables(created.Tables)
+			},
+		},
+	}
+}
+
+// ExportCsvFileFromDoris 将 Doris 表导出为对象存储中的 Parquet 文件，ctx 取消后中止导出语句
+func ExportCsvFileFromDoris(ctx context.Context, request *pb.ExportCsvFileFromDorisRequest) (*pb.ExportCsvFileFromDorisResponse, error) {
+	dorisService, err := NewDorisServiceWithContext(ctx, common.MIRA_TMP_TASK_DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	JobProgressFromContext(ctx).SetPhase("exporting")
+	err = dorisService.ExportParquetFileFromDoris(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export parquet file from doris: %v", err)
+	}
+	return &pb.ExportCsvFileFromDorisResponse{
+		BucketName:    common.BATCH_DATA_BUCKET_NAME,
+		JobInstanceId: request.JobInstanceId,
+	}, nil
+}
+
+// ImportMiraDBDataToDoris 将 Mira 库中的任务表导入 Doris 临时库，ctx 取消后中止导入语句
+func ImportMiraDBDataToDoris(ctx context.Context, request *pb.ImportMiraDBDataToDorisRequest) (*pb.ImportMiraDBDataToDorisResponse, error) {
+	dorisService, err := NewDorisServiceWithContext(ctx, common.MIRA_TMP_TASK_DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	JobProgressFromContext(ctx).SetPhase("importing")
+	internalTableName, err := dorisService.ImportMiraDBDataToDoris(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import mira db data to doris: %v", err)
+	}
+	return &pb.ImportMiraDBDataToDorisResponse{
+		DorisTableName: internalTableName,
+	}, nil
+}
+
+// CreateExternalAndInternalTableAndImportData 将数据资产导入 Doris 临时库，ctx 取消后中止导入语句
+func CreateExternalAndInternalTableAndImportData(ctx context.Context, request *pb.CreateExternalAndInternalTableAndImportDataRequest) (*pb.CreateExternalAndInternalTableAndImportDataResponse, error) {
+	if request.GetAssetName() == "" || request.GetJobInstanceId() == "" {
+		return nil, fmt.Errorf("assetName and jobInstanceId are required")
+	}
+	dorisService, err := NewDorisServiceWithContext(ctx, common.MIRA_TMP_TASK_DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	JobProgressFromContext(ctx).SetPhase("importing")
+	tableName, err := dorisService.CreateExternalAndInternalTableAndImportData(request.AssetName, request.ChainInfoId, request.Alias,
+		request.JobInstanceId, assetImportTableName(request.JobInstanceId), common.MIRA_TMP_TASK_DB, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create external table from asset: %v", err)
+	}
+	return &pb.CreateExternalAndInternalTableAndImportDataResponse{
+		TableName: tableName,
+	}, nil
+}
+
+// assetImportTableName 数据资产导入临时库后的表名
+func assetImportTableName(jobInstanceId string) string {
+	return jobInstanceId + "_internal"
+}
+
+// cleanupImportData 删除取消的导入写入了一部分的目标表，只删除本作业创建的表，尚未开始导入的目标不受影响
+// 增量导入的目标表保留：未完成的导入语句不会提交，水位也不会更新。
+func cleanupImportData(request *pb.ImportDataRequest, created []JobTable) error {
+	return dropJobTables(importTablesToClean(request, created))
+}
+
+// importTablesToClean 作业创建的表中属于非增量导入目标的表
+func importTablesToClean(request *pb.ImportDataRequest, created []JobTable) []JobTable {
+	var tables []JobTable
+	for _, table := range created {
+		for _, target := range request.GetTargets() {
+			if target.DbName != table.DbName || target.TargetTableName != table.TableName {
+				continue
+			}
+			if target.Incremental == nil || target.Incremental.WatermarkColumn == "" {
+				tables = append(tables, table)
+			}
+			break
+		}
+	}
+	return tables
+}
+
+// dropJobTables 删除作业创建的表
+func dropJobTables(tables []JobTable) error {
+	services := make(map[string]IDorisService)
+	var errs []string
+	for _, table := range tables {
+		dorisService, ok := services[table.DbName]
+		if !ok {
+			var err error
+			if dorisService, err = NewDorisService(table.DbName); err != nil {
+				errs = append(errs, fmt.Sprintf("failed to create doris service: %v", err))
+				continue
+			}
+			services[table.DbName] = dorisService
+		}
+		if err := dorisService.DropTable(table.DbName, table.TableName); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	if len(tables) > 0 {
+		log.Logger.Infof("Dropped tables created by the job: %v", tables)
+	}
+	return nil
+}
@@ -0,0 +1,699 @@
+package service
+
+import (
+	"context"
+	"data-service/common"
+	"data-service/database"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+	"data-service/log"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	gormlib "gorm.io/gorm"
+)
+
+// 异步作业类型，保存在元数据库中
+const (
+	AsyncJobTypeImportData                                  = "import_data"
+	AsyncJobTypeExportCsvFileFromDoris                      = "export_csv_file_from_doris"
+	AsyncJobTypeImportMiraDBDataToDoris                     = "import_mira_db_data_to_doris"
+	AsyncJobTypeCreateExternalAndInternalTableAndImportData = "create_external_and_internal_table_and_import_data"
+)
+
+const (
+	// asyncJobHeartbeatInterval 执行节点刷新进度、检查取消请求的间隔
+	asyncJobHeartbeatInterval = 10 * time.Second
+	// asyncJobStaleTimeout 超过该时间没有心跳的作业视为执行节点已失联
+	asyncJobStaleTimeout = 2 * time.Minute
+	// asyncJobJanitorInterval 检查失联作业的间隔
+	asyncJobJanitorInterval = time.Minute
+	// asyncJobPollInterval 等待其他节点上的作业时查询状态的间隔
+	asyncJobPollInterval = time.Second
+	// asyncJobPhaseMaxLength 阶段描述的最大字节数，与 AsyncJob.Phase 列的长度一致
+	asyncJobPhaseMaxLength = 255
+
+	defaultAsyncJobPageSize = 20
+	maxAsyncJobPageSize     = 100
+	defaultAsyncJobWait     = 30 * time.Second
+	maxAsyncJobWait         = 300 * time.Second
+)
+
+var (
+	// ErrAsyncJobNotFound 异步作业不存在
+	ErrAsyncJobNotFound = errors.New("async job not found")
+	// ErrInvalidAsyncJob 提交的异步作业请求无效
+	ErrInvalidAsyncJob = errors.New("invalid async job request")
+)
+
+var asyncJobTypes = map[pb.AsyncJobType]string{
+	pb.AsyncJobType_ASYNC_JOB_TYPE_IMPORT_DATA:                                        AsyncJobTypeImportData,
+	pb.AsyncJobType_ASYNC_JOB_TYPE_EXPORT_CSV_FILE_FROM_DORIS:                         AsyncJobTypeExportCsvFileFromDoris,
+	pb.AsyncJobType_ASYNC_JOB_TYPE_IMPORT_MIRA_DB_DATA_TO_DORIS:                       AsyncJobTypeImportMiraDBDataToDoris,
+	pb.AsyncJobType_ASYNC_JOB_TYPE_CREATE_EXTERNAL_AND_INTERNAL_TABLE_AND_IMPORT_DATA: AsyncJobTypeCreateExternalAndInternalTableAndImportData,
+}
+
+var asyncJobStatuses = map[pb.AsyncJobStatus]common.TaskStatus{
+	pb.AsyncJobStatus_ASYNC_JOB_STATUS_PENDING:   common.TaskStatusPending,
+	pb.AsyncJobStatus_ASYNC_JOB_STATUS_RUNNING:   common.TaskStatusRunning,
+	pb.AsyncJobStatus_ASYNC_JOB_STATUS_SUCCEEDED: common.TaskStatusCompleted,
+	pb.AsyncJobStatus_ASYNC_JOB_STATUS_FAILED:    common.TaskStatusFailed,
+	pb.AsyncJobStatus_ASYNC_JOB_STATUS_CANCELLED: common.TaskStatusCancelled,
+}
+
+// asyncJobStore 异步作业的持久化，由 AsyncJobRepository 实现
+type asyncJobStore interface {
+	Create(job *models.AsyncJob) error
+	FindByJobID(jobID string) (*models.AsyncJob, error)
+	List(jobType string, status common.TaskStatus, offset, limit int) ([]models.AsyncJob, int64, error)
+	MarkRunning(jobID, worker string) (bool, error)
+	UpdateProgress(jobID, phase string, rows, bytes int64, percent float64, createdResources string) error
+	Finish(jobID string, status common.TaskStatus, result, errorMsg string) (bool, error)
+	FailStale(jobID string, before time.Time, errorMsg string) (bool, error)
+	RequestCancel(jobID string) (bool, error)
+	FindStale(before time.Time) ([]models.AsyncJob, error)
+	WorkerAlive(worker string, since time.Time) (bool, error)
+}
+
+// asyncJobHandler 一种作业的执行与取消后的清理
+type asyncJobHandler struct {
+	run func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error)
+	// cleanup 作业取消或执行节点失联后清理已写入的临时数据，只清理 created 中记录的资源，之前执行留下的同名表和文件不受影响
+	cleanup func(request *pb.SubmitAsyncJobRequest, created JobResources) error
+}
+
+// JobTable 作业执行期间创建的表
+type JobTable struct {
+	DbName    string `json:"db_name"`
+	TableName string `json:"table_name"`
+}
+
+// JobResources 作业执行期间创建的表与写入的对象
+type JobResources struct {
+	Tables []JobTable `json:"tables,omitempty"`
+	// ObjectPrefixes 批量数据桶中作业写入的对象前缀
+	ObjectPrefixes []string `json:"object_prefixes,omitempty"`
+}
+
+// runningJob 本节点上正在执行的作业
+type runningJob struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// JobProgress 作业的执行进度，由执行过程更新，心跳时写入元数据库
+// 方法对 nil 接收者是安全的，同步调用时上下文中没有进度，更新被忽略。
+type JobProgress struct {
+	mu      sync.Mutex
+	phase   string
+	rows    int64
+	bytes   int64
+	percent float64
+	created JobResources
+}
+
+// SetPhase 设置当前阶段，超过 asyncJobPhaseMaxLength 字节时截断
+func (p *JobProgress) SetPhase(phase string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.phase = truncatePhase(phase)
+}
+
+// AddCreatedTable 记录作业创建的表，作业取消或执行节点失联后只清理记录过的表
+func (p *JobProgress) AddCreatedTable(dbName, tableName string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, table := range p.created.Tables {
+		if table.DbName == dbName && table.TableName == tableName {
+			return
+		}
+	}
+	p.created.Tables = append(p.created.Tables, JobTable{DbName: dbName, TableName: tableName})
+}
+
+// AddCreatedObjects 记录作业写入的对象前缀，在写入前调用，作业取消或执行节点失联后只删除这些前缀下的对象
+func (p *JobProgress) AddCreatedObjects(prefix string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, existing := range p.created.ObjectPrefixes {
+		if existing == prefix {
+			return
+		}
+	}
+	p.created.ObjectPrefixes = append(p.created.ObjectPrefixes, prefix)
+}
+
+// AddRows 累加已处理的行数
+func (p *JobProgress) AddRows(n int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rows += n
+}
+
+// AddBytes 累加已处理的字节数
+func (p *JobProgress) AddBytes(n int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.bytes += n
+}
+
+// SetPercent 设置完成百分比，超出 0-100 时取边界值
+func (p *JobProgress) SetPercent(percent float64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.percent = min(max(percent, 0), 100)
+}
+
+// snapshot 当前进度
+func (p *JobProgress) snapshot() (string, int64, int64, float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.phase, p.rows, p.bytes, p.percent
+}
+
+// createdResources 作业已创建的表与写入的对象
+func (p *JobProgress) createdResources() JobResources {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return JobResources{
+		Tables:         append([]JobTable(nil), p.created.Tables...),
+		ObjectPrefixes: append([]string(nil), p.created.ObjectPrefixes...),
+	}
+}
+
+// truncatePhase 按字节截断阶段描述，不截断多字节字符
+func truncatePhase(phase string) string {
+	if len(phase) <= asyncJobPhaseMaxLength {
+		return phase
+	}
+	end := asyncJobPhaseMaxLength
+	for end > 0 && !utf8.RuneStart(phase[end]) {
+		end--
+	}
+	return phase[:end]
+}
+
+type jobProgressKey struct{}
+
+// WithJobProgress 在上下文中附加作业进度
+func WithJobProgress(ctx context.Context, progress *JobProgress) context.Context {
+	return context.WithValue(ctx, jobProgressKey{}, progress)
+}
+
+// JobProgressFromContext 上下文中的作业进度，不在异步作业中执行时返回 nil
+func JobProgressFromContext(ctx context.Context) *JobProgress {
+	progress, _ := ctx.Value(jobProgressKey{}).(*JobProgress)
+	return progress
+}
+
+// AsyncJobService 异步作业服务
+// 作业保存在元数据库中，由提交作业的节点在后台执行，不受客户端断开影响；
+// 执行节点定期写入进度与心跳，并检查其他节点写入的取消请求。
+type AsyncJobService struct {
+	store             asyncJobStore
+	handlers          map[string]asyncJobHandler
+	worker            string
+	heartbeatInterval time.Duration
+	pollInterval      time.Duration
+
+	mu      sync.Mutex
+	running map[string]*runningJob
+}
+
+var (
+	asyncJobService     *AsyncJobService
+	asyncJobServiceOnce sync.Once
+	asyncJobJanitorOnce sync.Once
+)
+
+// GetAsyncJobService 获取全局异步作业服务，需在 gorm 初始化后调用
+func GetAsyncJobService() *AsyncJobService {
+	asyncJobServiceOnce.Do(func() {
+		asyncJobService = newAsyncJobService(repositories.NewAsyncJobRepository(gorm.GetGormDB()), asyncJobHandlers())
+	})
+	return asyncJobService
+}
+
+func newAsyncJobService(store asyncJobStore, handlers map[string]asyncJobHandler) *AsyncJobService {
+	hostname, _ := os.Hostname()
+	return &AsyncJobService{
+		store:             store,
+		handlers:          handlers,
+		worker:            fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		heartbeatInterval: asyncJobHeartbeatInterval,
+		pollInterval:      asyncJobPollInterval,
+		running:           make(map[string]*runningJob),
+	}
+}
+
+// StartAsyncJobJanitor 启动失联作业检查任务，只会启动一次
+func StartAsyncJobJanitor() {
+	asyncJobJanitorOnce.Do(func() {
+		go func() {
+			log.Logger.Info("Starting async job janitor")
+			ticker := time.NewTicker(asyncJobJanitorInterval)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				GetAsyncJobService().FailStaleJobs(time.Now().Add(-asyncJobStaleTimeout))
+			}
+		}()
+	})
+}
+
+// Submit 保存作业并在后台开始执行
+func (s *AsyncJobService) Submit(request *pb.SubmitAsyncJobRequest) (*pb.AsyncJob, error) {
+	jobType, err := asyncJobTypeOf(request)
+	if err != nil {
+		return nil, err
+	}
+	handler, ok := s.handlers[jobType]
+	if !ok {
+		return nil, fmt.Errorf("%w: job type %s is not supported", ErrInvalidAsyncJob, jobType)
+	}
+	payload, err := protojson.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal async job request: %v", err)
+	}
+
+	job := &models.AsyncJob{
+		JobID:   uuid.NewString(),
+		JobType: jobType,
+		Status:  common.TaskStatusPending,
+		Request: string(payload),
+	}
+	if err := s.store.Create(job); err != nil {
+		return nil, fmt.Errorf("failed to create async job: %v", err)
+	}
+	log.Logger.Infof("Submitted async job %s of type %s", job.JobID, jobType)
+
+	s.start(job.JobID, request, handler)
+	return asyncJobToProto(job), nil
+}
+
+// Get 查询作业
+func (s *AsyncJobService) Get(jobID string) (*pb.AsyncJob, error) {
+	job, err := s.find(jobID)
+	if err != nil {
+		return nil, err
+	}
+	return asyncJobToProto(job), nil
+}
+
+// List 分页列出作业，page 从 1 开始，返回作业、总数与实际使用的分页参数
+func (s *AsyncJobService) List(jobType pb.AsyncJobType, status pb.AsyncJobStatus, page, pageSize int) ([]*pb.AsyncJob, int64, int, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAsyncJobPageSize
+	}
+	pageSize = min(pageSize, maxAsyncJobPageSize)
+
+	jobs, totalCount, err := s.store.List(asyncJobTypes[jobType], asyncJobStatuses[status], (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, 0, 0, fmt.Errorf("failed to list async jobs: %v", err)
+	}
+	result := make([]*pb.AsyncJob, 0, len(jobs))
+	for i := range jobs {
+		result = append(result, asyncJobToProto(&jobs[i]))
+	}
+	return result, totalCount, page, pageSize, nil
+}
+
+// Cancel 请求取消作业
+// 本节点上的作业立即中止，其他节点上的作业在下一次心跳时中止；已结束的作业不受影响。
+func (s *AsyncJobService) Cancel(jobID string) (*pb.AsyncJob, error) {
+	job, err := s.find(jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status.IsFinished() {
+		return asyncJobToProto(job), nil
+	}
+
+	if _, err := s.store.RequestCancel(jobID); err != nil {
+		return nil, fmt.Errorf("failed to cancel async job: %v", err)
+	}
+	if running := s.runningJob(jobID); running != nil {
+		running.cancel()
+	}
+	log.Logger.Infof("Cancel requested for async job %s", jobID)
+	return s.Get(jobID)
+}
+
+// Wait 等待作业结束，timeout 内未结束时返回作业的当前状态
+func (s *AsyncJobService) Wait(ctx context.Context, jobID string, timeout time.Duration) (*pb.AsyncJob, error) {
+	if timeout <= 0 {
+		timeout = defaultAsyncJobWait
+	}
+	timeout = min(timeout, maxAsyncJobWait)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		job, err := s.find(jobID)
+		if err != nil {
+			return nil, err
+		}
+		if job.Status.IsFinished() {
+			return asyncJobToProto(job), nil
+		}
+
+		// 本节点上的作业等待其结束，其他节点上的作业定期查询
+		var done <-chan struct{}
+		if running := s.runningJob(jobID); running != nil {
+			done = running.done
+		}
+		poll := time.NewTimer(s.pollInterval)
+		select {
+		case <-ctx.Done():
+			poll.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+			poll.Stop()
+			return s.Get(jobID)
+		case <-done:
+		case <-poll.C:
+		}
+		poll.Stop()
+	}
+}
+
+// FailStaleJobs 将执行节点失联的作业标记为失败并清理临时数据，返回处理的作业数
+// 只有心跳仍早于 before 时才标记失败；执行节点仍在为其他作业写入心跳时不清理，
+// 由执行节点在下一次心跳发现作业已结束后中止并自行清理，避免删除仍在写入的表。
+func (s *AsyncJobService) FailStaleJobs(before time.Time) int {
+	jobs, err := s.store.FindStale(before)
+	if err != nil {
+		log.Logger.Warnf("Failed to find stale async jobs: %v", err)
+		return 0
+	}
+
+	failed := 0
+	for i := range jobs {
+		job := &jobs[i]
+		if s.runningJob(job.JobID) != nil {
+			continue
+		}
+		ok, err := s.store.FailStale(job.JobID, before, "worker lost: no heartbeat since "+before.Format(time.RFC3339))
+		if err != nil {
+			log.Logger.Warnf("Failed to mark stale async job %s as failed: %v", job.JobID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		failed++
+		log.Logger.Warnf("Async job %s on worker %s lost its heartbeat, marked as failed", job.JobID, job.Worker)
+		if job.Worker != "" {
+			alive, err := s.store.WorkerAlive(job.Worker, before)
+			if err != nil {
+				log.Logger.Warnf("Failed to check worker %s of async job %s, cleanup skipped: %v", job.Worker, job.JobID, err)
+				continue
+			}
+			if alive {
+				log.Logger.Warnf("Worker %s of async job %s is still alive, leaving cleanup to it", job.Worker, job.JobID)
+				continue
+			}
+		}
+		s.cleanupJob(job.JobID, job.JobType, job.Request, job.Resources)
+	}
+	return failed
+}
+
+// start 在后台执行作业
+func (s *AsyncJobService) start(jobID string, request *pb.SubmitAsyncJobRequest, handler asyncJobHandler) {
+	// 作业不绑定提交请求的上下文，客户端断开后继续执行；取消时中止 Doris 上正在执行的语句
+	ctx, cancel := context.WithCancel(database.WithKillQueryOnCancel(context.Background()))
+	progress := &JobProgress{}
+	ctx = WithJobProgress(ctx, progress)
+
+	running := &runningJob{cancel: cancel, done: make(chan struct{})}
+	s.mu.Lock()
+	s.running[jobID] = running
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			cancel()
+			s.mu.Lock()
+			delete(s.running, jobID)
+			s.mu.Unlock()
+			close(running.done)
+		}()
+		s.execute(ctx, jobID, request, handler, progress, cancel)
+	}()
+}
+
+// execute 执行作业并保存结果
+func (s *AsyncJobService) execute(ctx context.Context, jobID string, request *pb.SubmitAsyncJobRequest, handler asyncJobHandler,
+	progress *JobProgress, cancel context.CancelFunc) {
+	ok, err := s.store.MarkRunning(jobID, s.worker)
+	if err != nil {
+		log.Logger.Errorf("Failed to start async job %s: %v", jobID, err)
+		s.finish(jobID, common.TaskStatusFailed, "", fmt.Sprintf("failed to start job: %v", err))
+		return
+	}
+	if !ok {
+		log.Logger.Warnf("Async job %s is no longer pending, skipped", jobID)
+		return
+	}
+	// 提交后、开始执行前已被取消
+	if ctx.Err() != nil {
+		s.finishCancelled(jobID, request, handler, progress)
+		return
+	}
+
+	stop := make(chan struct{})
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		s.heartbeat(jobID, progress, cancel, stop)
+	}()
+
+	result, runErr := runAsyncJobHandler(ctx, handler, request)
+	close(stop)
+	<-heartbeatDone
+
+	switch {
+	case runErr != nil && ctx.Err() != nil:
+		log.Logger.Infof("Async job %s cancelled: %v", jobID, runErr)
+		s.finishCancelled(jobID, request, handler, progress)
+	case runErr != nil:
+		log.Logger.Errorf("Async job %s failed: %v", jobID, runErr)
+		s.saveProgress(jobID, progress)
+		s.finish(jobID, common.TaskStatusFailed, "", runErr.Error())
+	default:
+		payload, err := protojson.Marshal(result)
+		if err != nil {
+			s.finish(jobID, common.TaskStatusFailed, "", fmt.Sprintf("failed to marshal job result: %v", err))
+			return
+		}
+		progress.SetPercent(100)
+		s.saveProgress(jobID, progress)
+		s.finish(jobID, common.TaskStatusCompleted, string(payload), "")
+		log.Logger.Infof("Async job %s completed", jobID)
+	}
+}
+
+// heartbeat 定期写入进度与心跳，发现取消请求或作业已被其他节点结束（判定失联）时中止作业
+func (s *AsyncJobService) heartbeat(jobID string, progress *JobProgress, cancel context.CancelFunc, stop <-chan struct{}) {
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.saveProgress(jobID, progress)
+			job, err := s.store.FindByJobID(jobID)
+			if err != nil {
+				log.Logger.Warnf("Failed to check cancel request of async job %s: %v", jobID, err)
+				continue
+			}
+			if job.CancelRequested || job.Status != common.TaskStatusRunning {
+				cancel()
+			}
+		}
+	}
+}
+
+// finishCancelled 清理取消的作业已写入的临时数据，并将作业标记为已取消
+func (s *AsyncJobService) finishCancelled(jobID string, request *pb.SubmitAsyncJobRequest, handler asyncJobHandler,
+	progress *JobProgress) {
+	message := "job cancelled"
+	if handler.cleanup != nil {
+		if err := handler.cleanup(request, progress.createdResources()); err != nil {
+			log.Logger.Warnf("Failed to clean up cancelled async job %s: %v", jobID, err)
+			message = fmt.Sprintf("job cancelled, cleanup failed: %v", err)
+		}
+	}
+	s.finish(jobID, common.TaskStatusCancelled, "", message)
+}
+
+// cleanupJob 清理执行节点失联的作业已写入的临时数据，createdResources 为心跳时保存的已创建的资源
+func (s *AsyncJobService) cleanupJob(jobID, jobType, payload, createdResources string) {
+	handler, ok := s.handlers[jobType]
+	if !ok || handler.cleanup == nil {
+		return
+	}
+	request := &pb.SubmitAsyncJobRequest{}
+	if err := protojson.Unmarshal([]byte(payload), request); err != nil {
+		log.Logger.Warnf("Failed to unmarshal request of async job %s: %v", jobID, err)
+		return
+	}
+	var created JobResources
+	if createdResources != "" {
+		if err := json.Unmarshal([]byte(createdResources), &created); err != nil {
+			log.Logger.Warnf("Failed to unmarshal created resources of async job %s: %v", jobID, err)
+			return
+		}
+	}
+	if err := handler.cleanup(request, created); err != nil {
+		log.Logger.Warnf("Failed to clean up async job %s: %v", jobID, err)
+	}
+}
+
+func (s *AsyncJobService) saveProgress(jobID string, progress *JobProgress) {
+	phase, rows, bytes, percent := progress.snapshot()
+	var createdResources string
+	if created := progress.createdResources(); len(created.Tables) > 0 || len(created.ObjectPrefixes) > 0 {
+		payload, _ := json.Marshal(created)
+		createdResources = string(payload)
+	}
+	if err := s.store.UpdateProgress(jobID, phase, rows, bytes, percent, createdResources); err != nil {
+		log.Logger.Warnf("Failed to save progress of async job %s: %v", jobID, err)
+	}
+}
+
+func (s *AsyncJobService) finish(jobID string, status common.TaskStatus, result, errorMsg string) {
+	if _, err := s.store.Finish(jobID, status, result, errorMsg); err != nil {
+		log.Logger.Errorf("Failed to mark async job %s as %s: %v", jobID, status, err)
+	}
+}
+
+func (s *AsyncJobService) find(jobID string) (*models.AsyncJob, error) {
+	job, err := s.store.FindByJobID(jobID)
+	if errors.Is(err, gormlib.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrAsyncJobNotFound, jobID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get async job: %v", err)
+	}
+	return job, nil
+}
+
+func (s *AsyncJobService) runningJob(jobID string) *runningJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running[jobID]
+}
+
+// runAsyncJobHandler 执行作业，作业 panic 时作为失败返回，避免影响服务进程
+func runAsyncJobHandler(ctx context.Context, handler asyncJobHandler, request *pb.SubmitAsyncJobRequest) (result *pb.AsyncJobResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler.run(ctx, request)
+}
+
+// asyncJobTypeOf 请求对应的作业类型
+func asyncJobTypeOf(request *pb.SubmitAsyncJobRequest) (string, error) {
+	switch request.GetRequest().(type) {
+	case *pb.SubmitAsyncJobRequest_ImportData:
+		if request.GetImportData() == nil {
+			break
+		}
+		return AsyncJobTypeImportData, nil
+	case *pb.SubmitAsyncJobRequest_ExportCsvFileFromDoris:
+		if request.GetExportCsvFileFromDoris() == nil {
+			break
+		}
+		return AsyncJobTypeExportCsvFileFromDoris, nil
+	case *pb.SubmitAsyncJobRequest_ImportMiraDBDataToDoris:
+		if request.GetImportMiraDBDataToDoris() == nil {
+			break
+		}
+		return AsyncJobTypeImportMiraDBDataToDoris, nil
+	case *pb.SubmitAsyncJobRequest_CreateExternalAndInternalTableAndImportData:
+		if request.GetCreateExternalAndInternalTableAndImportData() == nil {
+			break
+		}
+		return AsyncJobTypeCreateExternalAndInternalTableAndImportData, nil
+	}
+	return "", fmt.Errorf("%w: request is empty", ErrInvalidAsyncJob)
+}
+
+// asyncJobToProto 转换为 proto 消息
+func asyncJobToProto(job *models.AsyncJob) *pb.AsyncJob {
+	result := &pb.AsyncJob{
+		JobId:           job.JobID,
+		JobType:         pb.AsyncJobType_ASYNC_JOB_TYPE_UNSPECIFIED,
+		Status:          pb.AsyncJobStatus_ASYNC_JOB_STATUS_UNSPECIFIED,
+		ErrorMessage:    job.ErrorMessage,
+		CancelRequested: job.CancelRequested,
+		CreatedAt:       job.CreatedAt.Unix(),
+		Progress: &pb.AsyncJobProgress{
+			Phase:   job.Phase,
+			Rows:    job.RowsProcessed,
+			Bytes:   job.BytesProcessed,
+			Percent: job.Percent,
+		},
+	}
+	for jobType, name := range asyncJobTypes {
+		if name == job.JobType {
+			result.JobType = jobType
+		}
+	}
+	for status, taskStatus := range asyncJobStatuses {
+		if taskStatus == job.Status {
+			result.Status = status
+		}
+	}
+	if job.StartedAt != nil {
+		result.StartedAt = job.StartedAt.Unix()
+	}
+	if job.CompletedAt != nil {
+		result.FinishedAt = job.CompletedAt.Unix()
+	}
+	if job.Result != "" {
+		jobResult := &pb.AsyncJobResult{}
+		if err := protojson.Unmarshal([]byte(job.Result), jobResult); err != nil {
+			log.Logger.Warnf("Failed to unmarshal result of async job %s: %v", job.JobID, err)
+		} else {
+			result.Result = jobResult
+		}
+	}
+	return result
+}
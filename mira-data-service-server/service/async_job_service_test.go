@@ -0,0 +1,503 @@
+package service
+
+import (
+	"context"
+	"data-service/common"
+	"data-service/database/gorm/models"
+	pb "data-service/generated/datasource"
+	"data-service/mocks"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormlib "gorm.io/gorm"
+)
+
+// fakeAsyncJobStore 内存中的作业存储，行为与 AsyncJobRepository 相同
+type fakeAsyncJobStore struct {
+	mu   sync.Mutex
+	jobs map[string]*models.AsyncJob
+}
+
+func newFakeAsyncJobStore() *fakeAsyncJobStore {
+	return &fakeAsyncJobStore{jobs: make(map[string]*models.AsyncJob)}
+}
+
+func (f *fakeAsyncJobStore) Create(job *models.AsyncJob) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job.CreatedAt = time.Now()
+	stored := *job
+	f.jobs[job.JobID] = &stored
+	return nil
+}
+
+func (f *fakeAsyncJobStore) FindByJobID(jobID string) (*models.AsyncJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job, ok := f.jobs[jobID]
+	if !ok {
+		return nil, gormlib.ErrRecordNotFound
+	}
+	found := *job
+	return &found, nil
+}
+
+func (f *fakeAsyncJobStore) List(jobType string, status common.TaskStatus, offset, limit int) ([]models.AsyncJob, int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var jobs []models.AsyncJob
+	for _, job := range f.jobs {
+		if (jobType == "" || job.JobType == jobType) && (status == "" || job.Status == status) {
+			jobs = append(jobs, *job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.After(jobs[j].CreatedAt) })
+	total := int64(len(jobs))
+	if offset >= len(jobs) {
+		return nil, total, nil
+	}
+	return jobs[offset:min(offset+limit, len(jobs))], total, nil
+}
+
+func (f *fakeAsyncJobStore) MarkRunning(jobID, worker string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[jobID]
+	if job == nil || job.Status != common.TaskStatusPending {
+		return false, nil
+	}
+	now := time.Now()
+	job.Status, job.Worker, job.StartedAt, job.HeartbeatAt = common.TaskStatusRunning, worker, &now, &now
+	return true, nil
+}
+
+func (f *fakeAsyncJobStore) UpdateProgress(jobID, phase string, rows, bytes int64, percent float64, createdResources string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[jobID]
+	if job == nil || job.Status != common.TaskStatusRunning {
+		return nil
+	}
+	now := time.Now()
+	job.Phase, job.RowsProcessed, job.BytesProcessed, job.Percent, job.HeartbeatAt = phase, rows, bytes, percent, &now
+	job.Resources = createdResources
+	return nil
+}
+
+func (f *fakeAsyncJobStore) Finish(jobID string, status common.TaskStatus, result, errorMsg string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[jobID]
+	if job == nil || job.Status.IsFinished() {
+		return false, nil
+	}
+	now := time.Now()
+	job.Status, job.Result, job.ErrorMessage, job.CompletedAt = status, result, errorMsg, &now
+	return true, nil
+}
+
+func (f *fakeAsyncJobStore) RequestCancel(jobID string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[jobID]
+	if job == nil || job.Status.IsFinished() {
+		return false, nil
+	}
+	job.CancelRequested = true
+	return true, nil
+}
+
+func (f *fakeAsyncJobStore) FindStale(before time.Time) ([]models.AsyncJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var jobs []models.AsyncJob
+	for _, job := range f.jobs {
+		if (job.Status == common.TaskStatusRunning && job.HeartbeatAt.Before(before)) ||
+			(job.Status == common.TaskStatusPending && job.CreatedAt.Before(before)) {
+			jobs = append(jobs, *job)
+		}
+	}
+	return jobs, nil
+}
+
+func (f *fakeAsyncJobStore) FailStale(jobID string, before time.Time, errorMsg string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[jobID]
+	if job == nil || !((job.Status == common.TaskStatusRunning && job.HeartbeatAt.Before(before)) ||
+		(job.Status == common.TaskStatusPending && job.CreatedAt.Before(before))) {
+		return false, nil
+	}
+	now := time.Now()
+	job.Status, job.ErrorMessage, job.CompletedAt = common.TaskStatusFailed, errorMsg, &now
+	return true, nil
+}
+
+func (f *fakeAsyncJobStore) WorkerAlive(worker string, since time.Time) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, job := range f.jobs {
+		if job.Worker == worker && job.Status == common.TaskStatusRunning && !job.HeartbeatAt.Before(since) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newAsyncJobServiceForTest(store asyncJobStore, handler asyncJobHandler) *AsyncJobService {
+	service := newAsyncJobService(store, map[string]asyncJobHandler{AsyncJobTypeExportCsvFileFromDoris: handler})
+	service.heartbeatInterval = 10 * time.Millisecond
+	service.pollInterval = 10 * time.Millisecond
+	return service
+}
+
+func exportJobRequest(jobInstanceId string) *pb.SubmitAsyncJobRequest {
+	return &pb.SubmitAsyncJobRequest{Request: &pb.SubmitAsyncJobRequest_ExportCsvFileFromDoris{
+		ExportCsvFileFromDoris: &pb.ExportCsvFileFromDorisRequest{JobInstanceId: jobInstanceId, DbName: "db", TableName: "t"},
+	}}
+}
+
+func TestAsyncJobServiceSucceeds(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+			progress := JobProgressFromContext(ctx)
+			progress.SetPhase("exporting")
+			progress.AddRows(42)
+			progress.AddBytes(1024)
+			return &pb.AsyncJobResult{Result: &pb.AsyncJobResult_ExportCsvFileFromDoris{ExportCsvFileFromDoris: &pb.ExportCsvFileFromDorisResponse{
+				BucketName:    common.BATCH_DATA_BUCKET_NAME,
+				JobInstanceId: request.GetExportCsvFileFromDoris().JobInstanceId,
+			}}}, nil
+		},
+	})
+
+	submitted, err := service.Submit(exportJobRequest("job_1"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, submitted.JobId)
+	assert.Equal(t, pb.AsyncJobType_ASYNC_JOB_TYPE_EXPORT_CSV_FILE_FROM_DORIS, submitted.JobType)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_PENDING, submitted.Status)
+
+	job, err := service.Wait(context.Background(), submitted.JobId, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_SUCCEEDED, job.Status)
+	assert.Equal(t, "job_1", job.Result.GetExportCsvFileFromDoris().JobInstanceId)
+	assert.Equal(t, &pb.AsyncJobProgress{Phase: "exporting", Rows: 42, Bytes: 1024, Percent: 100}, job.Progress)
+	assert.NotZero(t, job.StartedAt)
+	assert.NotZero(t, job.FinishedAt)
+
+	// 请求同样保存在元数据库中
+	stored, err := store.FindByJobID(submitted.JobId)
+	require.NoError(t, err)
+	assert.Contains(t, stored.Request, "job_1")
+}
+
+func TestAsyncJobServiceFails(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+			return nil, fmt.Errorf("table t does not exist")
+		},
+	})
+	submitted, err := service.Submit(exportJobRequest("job_1"))
+	require.NoError(t, err)
+	job, err := service.Wait(context.Background(), submitted.JobId, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_FAILED, job.Status)
+	assert.Equal(t, "table t does not exist", job.ErrorMessage)
+	assert.Nil(t, job.Result)
+
+	// panic 作为失败处理
+	service.handlers[AsyncJobTypeExportCsvFileFromDoris] = asyncJobHandler{
+		run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+			panic("nil pointer")
+		},
+	}
+	submitted, err = service.Submit(exportJobRequest("job_2"))
+	require.NoError(t, err)
+	job, err = service.Wait(context.Background(), submitted.JobId, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_FAILED, job.Status)
+	assert.Contains(t, job.ErrorMessage, "job panicked: nil pointer")
+}
+
+func TestAsyncJobServiceCancel(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	started := make(chan struct{})
+	var cleaned []string
+	var created JobResources
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+			JobProgressFromContext(ctx).AddCreatedTable("db", "t")
+			JobProgressFromContext(ctx).AddCreatedObjects("job_1/export_0a1b2c3d_")
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		cleanup: func(request *pb.SubmitAsyncJobRequest, resources JobResources) error {
+			cleaned = append(cleaned, request.GetExportCsvFileFromDoris().JobInstanceId)
+			created = resources
+			return nil
+		},
+	})
+
+	submitted, err := service.Submit(exportJobRequest("job_1"))
+	require.NoError(t, err)
+	<-started
+
+	// 超时后返回作业的当前状态
+	job, err := service.Wait(context.Background(), submitted.JobId, 30*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_RUNNING, job.Status)
+
+	job, err = service.Cancel(submitted.JobId)
+	require.NoError(t, err)
+	assert.True(t, job.CancelRequested)
+
+	job, err = service.Wait(context.Background(), submitted.JobId, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_CANCELLED, job.Status)
+	assert.Equal(t, "job cancelled", job.ErrorMessage)
+	assert.Equal(t, []string{"job_1"}, cleaned)
+	// 只清理作业创建的表
+	assert.Equal(t, JobResources{Tables: []JobTable{{DbName: "db", TableName: "t"}},
+		ObjectPrefixes: []string{"job_1/export_0a1b2c3d_"}}, created)
+
+	// 已结束的作业不受取消影响
+	job, err = service.Cancel(submitted.JobId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_CANCELLED, job.Status)
+}
+
+func TestAsyncJobServiceCancelFromOtherWorker(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	started := make(chan struct{})
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+	submitted, err := service.Submit(exportJobRequest("job_1"))
+	require.NoError(t, err)
+	<-started
+
+	// 其他节点只能写入取消请求，执行节点在心跳时中止作业
+	ok, err := store.RequestCancel(submitted.JobId)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	job, err := service.Wait(context.Background(), submitted.JobId, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_CANCELLED, job.Status)
+}
+
+func TestAsyncJobServiceStopsWhenFailedByOtherWorker(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		run: func(ctx context.Context, request *pb.SubmitAsyncJobRequest) (*pb.AsyncJobResult, error) {
+			close(started)
+			<-ctx.Done()
+			close(stopped)
+			return nil, ctx.Err()
+		},
+	})
+	submitted, err := service.Submit(exportJobRequest("job_1"))
+	require.NoError(t, err)
+	<-started
+
+	// 其他节点误判失联将作业标记为失败，执行节点在心跳时中止作业，不覆盖失败状态
+	_, err = store.Finish(submitted.JobId, common.TaskStatusFailed, "", "worker lost")
+	require.NoError(t, err)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("job was not stopped")
+	}
+	job, err := service.Get(submitted.JobId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_FAILED, job.Status)
+}
+
+func TestAsyncJobServiceInvalidRequests(t *testing.T) {
+	service := newAsyncJobServiceForTest(newFakeAsyncJobStore(), asyncJobHandler{})
+
+	_, err := service.Submit(&pb.SubmitAsyncJobRequest{})
+	assert.ErrorIs(t, err, ErrInvalidAsyncJob)
+	_, err = service.Submit(&pb.SubmitAsyncJobRequest{Request: &pb.SubmitAsyncJobRequest_ImportData{}})
+	assert.ErrorIs(t, err, ErrInvalidAsyncJob)
+	// 未注册的作业类型
+	_, err = service.Submit(&pb.SubmitAsyncJobRequest{Request: &pb.SubmitAsyncJobRequest_ImportData{ImportData: &pb.ImportDataRequest{}}})
+	assert.ErrorIs(t, err, ErrInvalidAsyncJob)
+
+	_, err = service.Get("missing")
+	assert.ErrorIs(t, err, ErrAsyncJobNotFound)
+	_, err = service.Cancel("missing")
+	assert.ErrorIs(t, err, ErrAsyncJobNotFound)
+	_, err = service.Wait(context.Background(), "missing", time.Second)
+	assert.ErrorIs(t, err, ErrAsyncJobNotFound)
+}
+
+func TestAsyncJobServiceList(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{})
+	for i, status := range []common.TaskStatus{common.TaskStatusCompleted, common.TaskStatusFailed, common.TaskStatusCompleted} {
+		require.NoError(t, store.Create(&models.AsyncJob{JobID: fmt.Sprintf("job_%d", i), JobType: AsyncJobTypeImportData, Status: status}))
+		store.jobs[fmt.Sprintf("job_%d", i)].CreatedAt = time.Now().Add(time.Duration(i) * time.Second)
+	}
+	require.NoError(t, store.Create(&models.AsyncJob{JobID: "export", JobType: AsyncJobTypeExportCsvFileFromDoris, Status: common.TaskStatusCompleted}))
+
+	jobs, total, page, pageSize, err := service.List(pb.AsyncJobType_ASYNC_JOB_TYPE_IMPORT_DATA, pb.AsyncJobStatus_ASYNC_JOB_STATUS_SUCCEEDED, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, 1, page)
+	assert.Equal(t, defaultAsyncJobPageSize, pageSize)
+	require.Len(t, jobs, 2)
+	// 按提交时间倒序
+	assert.Equal(t, "job_2", jobs[0].JobId)
+	assert.Equal(t, "job_0", jobs[1].JobId)
+
+	jobs, total, _, pageSize, err = service.List(pb.AsyncJobType_ASYNC_JOB_TYPE_UNSPECIFIED, pb.AsyncJobStatus_ASYNC_JOB_STATUS_UNSPECIFIED, 2, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, maxAsyncJobPageSize, pageSize)
+	assert.Empty(t, jobs)
+}
+
+func TestAsyncJobServiceFailStaleJobs(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	var cleaned []string
+	var created JobResources
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		cleanup: func(request *pb.SubmitAsyncJobRequest, resources JobResources) error {
+			cleaned = append(cleaned, request.GetExportCsvFileFromDoris().JobInstanceId)
+			created = resources
+			return nil
+		},
+	})
+
+	lost := time.Now().Add(-time.Hour)
+	alive := time.Now()
+	request := `{"exportCsvFileFromDoris":{"jobInstanceId":"job_lost"}}`
+	require.NoError(t, store.Create(&models.AsyncJob{JobID: "lost", JobType: AsyncJobTypeExportCsvFileFromDoris,
+		Status: common.TaskStatusRunning, Worker: "node_1", HeartbeatAt: &lost, Request: request,
+		Resources: `{"tables":[{"db_name":"db","table_name":"t"}]}`}))
+	require.NoError(t, store.Create(&models.AsyncJob{JobID: "alive", JobType: AsyncJobTypeExportCsvFileFromDoris,
+		Status: common.TaskStatusRunning, Worker: "node_2", HeartbeatAt: &alive}))
+	require.NoError(t, store.Create(&models.AsyncJob{JobID: "done", JobType: AsyncJobTypeExportCsvFileFromDoris,
+		Status: common.TaskStatusCompleted}))
+
+	assert.Equal(t, 1, service.FailStaleJobs(time.Now().Add(-asyncJobStaleTimeout)))
+	job, err := service.Get("lost")
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_FAILED, job.Status)
+	assert.Contains(t, job.ErrorMessage, "worker lost")
+	assert.Equal(t, []string{"job_lost"}, cleaned)
+	assert.Equal(t, JobResources{Tables: []JobTable{{DbName: "db", TableName: "t"}}}, created)
+
+	job, err = service.Get("alive")
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_RUNNING, job.Status)
+}
+
+func TestAsyncJobServiceFailStaleJobsWorkerAlive(t *testing.T) {
+	store := newFakeAsyncJobStore()
+	var cleaned []string
+	service := newAsyncJobServiceForTest(store, asyncJobHandler{
+		cleanup: func(request *pb.SubmitAsyncJobRequest, _ JobResources) error {
+			cleaned = append(cleaned, request.GetExportCsvFileFromDoris().JobInstanceId)
+			return nil
+		},
+	})
+
+	// 执行节点仍在为其他作业写入心跳：作业标记为失败，清理留给执行节点
+	lost := time.Now().Add(-time.Hour)
+	alive := time.Now()
+	require.NoError(t, store.Create(&models.AsyncJob{JobID: "lost", JobType: AsyncJobTypeExportCsvFileFromDoris,
+		Status: common.TaskStatusRunning, Worker: "node_1", HeartbeatAt: &lost,
+		Request: `{"exportCsvFileFromDoris":{"jobInstanceId":"job_lost"}}`}))
+	require.NoError(t, store.Create(&models.AsyncJob{JobID: "other", JobType: AsyncJobTypeExportCsvFileFromDoris,
+		Status: common.TaskStatusRunning, Worker: "node_1", HeartbeatAt: &alive}))
+
+	assert.Equal(t, 1, service.FailStaleJobs(time.Now().Add(-asyncJobStaleTimeout)))
+	job, err := service.Get("lost")
+	require.NoError(t, err)
+	assert.Equal(t, pb.AsyncJobStatus_ASYNC_JOB_STATUS_FAILED, job.Status)
+	assert.Empty(t, cleaned)
+
+	// 查出失联后又刷新了心跳的作业不会被标记为失败
+	ok, err := store.FailStale("other", time.Now().Add(-asyncJobStaleTimeout), "worker lost")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestJobProgress(t *testing.T) {
+	// 不在异步作业中执行时更新被忽略
+	progress := JobProgressFromContext(context.Background())
+	assert.Nil(t, progress)
+	progress.SetPhase("importing")
+	progress.AddRows(1)
+
+	progress = &JobProgress{}
+	progress.SetPercent(150)
+	_, _, _, percent := progress.snapshot()
+	assert.Equal(t, float64(100), percent)
+	progress.SetPercent(-1)
+	_, _, _, percent = progress.snapshot()
+	assert.Equal(t, float64(0), percent)
+
+	// 阶段说明按字符截断到列宽之内
+	progress.SetPhase("importing " + strings.Repeat("表", 100))
+	phase, _, _, _ := progress.snapshot()
+	assert.LessOrEqual(t, len(phase), asyncJobPhaseMaxLength)
+	assert.True(t, utf8.ValidString(phase))
+
+	// 创建的表与写入的对象前缀去重记录
+	progress.AddCreatedTable("db", "t")
+	progress.AddCreatedTable("db", "t")
+	progress.AddCreatedTable("db", "t2")
+	progress.AddCreatedObjects("job_1/export_0a1b2c3d_")
+	progress.AddCreatedObjects("job_1/export_0a1b2c3d_")
+	assert.Equal(t, JobResources{
+		Tables:         []JobTable{{DbName: "db", TableName: "t"}, {DbName: "db", TableName: "t2"}},
+		ObjectPrefixes: []string{"job_1/export_0a1b2c3d_"},
+	}, progress.createdResources())
+}
+
+func TestImportTablesToClean(t *testing.T) {
+	request := &pb.ImportDataRequest{Targets: []*pb.ImportTarget{
+		{DbName: "db", TargetTableName: "full"},
+		{DbName: "db", TargetTableName: "pending"},
+		{DbName: "db", TargetTableName: "incr", Incremental: &pb.IncrementalImport{WatermarkColumn: "updated_at"}},
+	}}
+	created := []JobTable{{DbName: "db", TableName: "full"}, {DbName: "db", TableName: "incr"}, {DbName: "other", TableName: "full"}}
+
+	// 只删除本作业创建的非增量目标表，尚未导入的目标与增量目标保留
+	assert.Equal(t, []JobTable{{DbName: "db", TableName: "full"}}, importTablesToClean(request, created))
+	assert.Empty(t, importTablesToClean(request, nil))
+}
+
+func TestDeleteObjectsByPrefixes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 只删除本次导出写入的对象，同一任务之前导出的文件不受影响
+	client := mocks.NewMockClientInterface(ctrl)
+	client.EXPECT().ListObjects(gomock.Any(), "bucket", "job_1/export_0a1b2c3d_", true).
+		Return([]string{"job_1/export_0a1b2c3d_f1_0.parquet", "job_1/export_0a1b2c3d_f1_1.parquet"}, nil)
+	client.EXPECT().DeleteObject(gomock.Any(), "bucket", "job_1/export_0a1b2c3d_f1_0.parquet").Return(nil)
+	client.EXPECT().DeleteObject(gomock.Any(), "bucket", "job_1/export_0a1b2c3d_f1_1.parquet").Return(fmt.Errorf("denied"))
+	err := deleteObjectsByPrefixes(context.Background(), client, "bucket", []string{"job_1/export_0a1b2c3d_"})
+	assert.ErrorContains(t, err, "failed to delete 1 objects")
+}
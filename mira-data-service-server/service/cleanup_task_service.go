@@ -10,6 +10,7 @@ import (
 	"data-service/oss"
 	"errors"
 	"fmt"
+	"time"
 
 	gormlib "gorm.io/gorm"
 
@@ -66,6 +67,43 @@ func (s *CleanupTaskService) GetOrCreateCleanupTask(jobInstanceID, taskType stri
 	return s.CreateCleanupTask(jobInstanceID, taskType)
 }
 
+// ScheduleCleanupTask 创建到期后才执行的清理任务，任务已存在时重置为待处理并顺延过期时间
+func (s *CleanupTaskService) ScheduleCleanupTask(jobInstanceID, taskType string, expiresAt time.Time) error {
+	task, err := s.taskRepo.FindByJobInstanceID(jobInstanceID)
+	if err == nil {
+		return s.taskRepo.ResetPending(task.ID, &expiresAt)
+	}
+	if !errors.Is(err, gormlib.ErrRecordNotFound) {
+		return fmt.Errorf("failed to find cleanup task for job %s: %v", jobInstanceID, err)
+	}
+
+	task = &models.CleanupTask{
+		JobInstanceID: jobInstanceID,
+		TaskType:      taskType,
+		Status:        common.TaskStatusPending,
+		MaxRetries:    3,
+		ExpiresAt:     &expiresAt,
+	}
+	if err := s.taskRepo.Create(task); err != nil {
+		return fmt.Errorf("failed to create cleanup task: %v", err)
+	}
+
+	log.Logger.Infof("Scheduled cleanup task for job %s at %s, task ID: %d", jobInstanceID, expiresAt.Format(time.RFC3339), task.ID)
+	return nil
+}
+
+// CompleteCleanupTask 标记清理任务已完成，用于所有者已自行清理的情况，任务不存在时忽略
+func (s *CleanupTaskService) CompleteCleanupTask(jobInstanceID string) error {
+	task, err := s.taskRepo.FindByJobInstanceID(jobInstanceID)
+	if errors.Is(err, gormlib.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find cleanup task for job %s: %v", jobInstanceID, err)
+	}
+	return s.taskRepo.UpdateStatus(task.ID, common.TaskStatusCompleted, "")
+}
+
 // ExecuteCleanupTask 执行清理任务
 func (s *CleanupTaskService) ExecuteCleanupTask(jobInstanceId string) error {
 	task, err := s.GetCleanupTaskByJobInstanceId(jobInstanceId)
@@ -73,6 +111,11 @@ func (s *CleanupTaskService) ExecuteCleanupTask(jobInstanceId string) error {
 		return fmt.Errorf("failed to get cleanup task: %v", err)
 	}
 
+	// Catalog 租约未到期说明创建它的进程仍在使用
+	if task.TaskType == jdbcCatalogCleanupTaskType && task.ExpiresAt != nil && time.Now().Before(*task.ExpiresAt) {
+		return fmt.Errorf("jdbc catalog %s is still leased until %s", jobInstanceId, task.ExpiresAt.Format(time.RFC3339))
+	}
+
 	// 更新状态为运行中
 	err = s.taskRepo.UpdateStatus(task.ID, common.TaskStatusRunning, "")
 	if err != nil {
@@ -87,8 +130,18 @@ func (s *CleanupTaskService) ExecuteCleanupTask(jobInstanceId string) error {
 
 	// 根据任务类型执行不同的清理逻辑
 	switch task.TaskType {
+	case "export_files":
+		// 只需清理导出文件
+		if err != nil {
+			s.taskRepo.UpdateStatus(task.ID, common.TaskStatusFailed, err.Error())
+			return err
+		}
+		s.taskRepo.UpdateStatus(task.ID, common.TaskStatusCompleted, "")
+		return nil
 	case "doris_table":
 		return s.executeDorisTableCleanup(task)
+	case jdbcCatalogCleanupTaskType:
+		return s.executeJdbcCatalogCleanup(task)
 	case "mira_table":
 		return s.executeMiraTableCleanup(task)
 	default:
@@ -147,6 +200,21 @@ func (s *CleanupTaskService) executeDorisTableCleanup(task *models.CleanupTask)
 	return nil
 }
 
+// executeJdbcCatalogCleanup 删除租约到期的 JDBC Catalog
+func (s *CleanupTaskService) executeJdbcCatalogCleanup(task *models.CleanupTask) error {
+	dorisService, err := dorisCatalogDDL{}.connect()
+	if err == nil {
+		defer dorisService.Close()
+		err = dorisService.dropOrphanJdbcCatalog(task.JobInstanceID)
+	}
+	if err != nil {
+		s.taskRepo.UpdateStatus(task.ID, common.TaskStatusFailed, err.Error())
+		return fmt.Errorf("failed to drop jdbc catalog %s: %v", task.JobInstanceID, err)
+	}
+	s.taskRepo.UpdateStatus(task.ID, common.TaskStatusCompleted, "")
+	return nil
+}
+
 // executeMiraTableCleanup 执行Mira表清理（类似实现）
 func (s *CleanupTaskService) executeMiraTableCleanup(task *models.CleanupTask) error {
 	// 实现Mira表清理逻辑
@@ -189,6 +257,41 @@ func (s *CleanupTaskService) GetCleanupTaskByJobInstanceId(jobInstanceID string)
 	return task, nil
 }
 
+// cleanupExportObjects 删除批量数据桶中指定前缀下的对象，只清理一次导出写入的文件
+func (s *CleanupTaskService) cleanupExportObjects(prefixes []string) error {
+	if len(prefixes) == 0 {
+		return nil
+	}
+	ossFactory := oss.NewOSSFactory(config.GetConfigMap())
+	ossClient, err := ossFactory.NewOSSClient()
+	if err != nil {
+		return fmt.Errorf("failed to create OSS client: %v", err)
+	}
+	return deleteObjectsByPrefixes(context.Background(), ossClient, common.BATCH_DATA_BUCKET_NAME, prefixes)
+}
+
+// deleteObjectsByPrefixes 删除桶中各前缀下的全部对象，删除失败的对象汇总后返回
+func deleteObjectsByPrefixes(ctx context.Context, client oss.ClientInterface, bucketName string, prefixes []string) error {
+	var failed []string
+	for _, prefix := range prefixes {
+		keys, err := client.ListObjects(ctx, bucketName, prefix, true)
+		if err != nil {
+			return fmt.Errorf("failed to list objects with prefix %s: %v", prefix, err)
+		}
+		for _, key := range keys {
+			if err := client.DeleteObject(ctx, bucketName, key); err != nil {
+				log.Logger.Errorf("Failed to delete object %s: %v", key, err)
+				failed = append(failed, key)
+			}
+		}
+		log.Logger.Infof("Deleted %d objects with prefix %s from bucket %s", len(keys), prefix, bucketName)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete %d objects: %v", len(failed), failed)
+	}
+	return nil
+}
+
 // cleanupExportFiles 清理导出的数据文件
 func (s *CleanupTaskService) cleanupExportFiles(jobInstanceID string) error {
 	// 创建OSS客户端
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"data-service/database"
 	pb "data-service/generated/datasource"
 	"fmt"
@@ -11,7 +12,7 @@ import (
 // CrudService 定义数据库表操作接口
 type CrudService interface {
 	// TruncateTable 清空指定表的所有数据
-	TruncateTable(tableName string) (*pb.TruncateTableResponse, error)
+	TruncateTable(ctx context.Context, tableName string) (*pb.TruncateTableResponse, error)
 }
 
 // DefaultCrudService 是 CrudService 接口的默认实现
@@ -29,9 +30,9 @@ func NewCrudService(logger *zap.SugaredLogger, dbStrategy database.DatabaseStrat
 }
 
 // TruncateTable 实现表清空功能
-func (s *DefaultCrudService) TruncateTable(tableName string) (*pb.TruncateTableResponse, error) {
+func (s *DefaultCrudService) TruncateTable(ctx context.Context, tableName string) (*pb.TruncateTableResponse, error) {
 	// 检查表是否存在，如果表存在，清空表，不存在则正常返回
-	exists, err := s.dbStrategy.CheckTableExists(tableName)
+	exists, err := s.dbStrategy.CheckTableExists(ctx, tableName)
 	if err != nil {
 		s.logger.Errorf("Failed to check table exists: %v", err)
 		return &pb.TruncateTableResponse{
@@ -67,7 +68,7 @@ func (s *DefaultCrudService) TruncateTable(tableName string) (*pb.TruncateTableR
 
 	// 执行 TRUNCATE 语句
 	db := database.GetDB(s.dbStrategy)
-	_, err = db.Exec(sqlQuery)
+	_, err = db.ExecContext(ctx, sqlQuery)
 	if err != nil {
 		s.logger.Errorf("Failed to truncate table %s: %v", tableName, err)
 		return &pb.TruncateTableResponse{
@@ -0,0 +1,125 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/12/04
+	@note: 导入导出后的对账：导入时比对数据资产与 Doris 表，导出时比对 Doris 表与部署库中的表
+
+*
+*/
+package service
+
+import (
+	"context"
+	"data-service/database"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// watermarkWindow 增量导入本次导入的水位区间 (from, to]
+type watermarkWindow struct {
+	column string
+	from   string
+	to     string
+}
+
+// reconcileImport 比对数据资产与导入的 Doris 表，window 不为空时只比对本次导入的水位区间
+func reconcileImport(ctx context.Context, target *ds.ImportTarget, tableName string, window *watermarkWindow) (*ds.ReconcileReport, error) {
+	mode := database.ResolveReconcileMode(target.ReconcileMode)
+	if mode == ds.ReconcileMode_RECONCILE_MODE_NONE {
+		return nil, nil
+	}
+	// 增量导入没有新数据时无需对账
+	if window != nil && window.from != "" && window.to == window.from {
+		return nil, nil
+	}
+
+	connInfo, err := utils.GetDatasourceByAssetName(uuid.New().String(), target.External.AssetName,
+		target.External.ChainInfoId, target.External.Alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datasource info: %v", err)
+	}
+	sourceType := utils.ConvertDataSourceType(connInfo.Dbtype)
+	sourceStrategy, err := database.DatabaseFactory(sourceType, connInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := sourceStrategy.ConnectToDBWithPass(connInfo); err != nil {
+		return nil, fmt.Errorf("failed to connect to source database: %v", err)
+	}
+	dorisStrategy, err := connectDoris(target.DbName)
+	if err != nil {
+		return nil, err
+	}
+
+	source := database.ReconcileSource{Strategy: sourceStrategy, DbType: sourceType, TableName: connInfo.TableName}
+	dest := database.ReconcileSource{Strategy: dorisStrategy, DbType: ds.DataSourceType_DATA_SOURCE_TYPE_DORIS,
+		TableName: target.DbName + "." + tableName}
+	if window != nil && window.to != "" {
+		source.Condition = database.WatermarkCondition(sourceType, window.column, window.from, window.to)
+		dest.Condition = database.WatermarkCondition(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, window.column, window.from, window.to)
+	}
+
+	columns := target.Columns
+	if len(columns) == 0 {
+		for _, column := range connInfo.Columns {
+			columns = append(columns, column.Name)
+		}
+	}
+	report, err := database.Reconcile(ctx, source, dest, reconcileColumns(columns), mode)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger.Infof("Reconciled asset %s with %s.%s by %v: source %d rows, target %d rows, matched: %v",
+		target.External.AssetName, target.DbName, tableName, mode, report.SourceRows, report.TargetRows, report.Matched)
+	return report, nil
+}
+
+// reconcileExport 比对导出的 Doris 表与部署库中的目标表
+func (s *DorisService) reconcileExport(request *ds.ExportDorisDataToMiraDBRequest, connInfo *ds.ConnectionInfo,
+	dbType ds.DataSourceType) (*ds.ReconcileReport, error) {
+	mode := database.ResolveReconcileMode(request.ReconcileMode)
+	if mode == ds.ReconcileMode_RECONCILE_MODE_NONE {
+		return nil, nil
+	}
+	targetStrategy, err := database.DatabaseFactory(dbType, connInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := targetStrategy.ConnectToDBWithPass(connInfo); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %v", err)
+	}
+
+	// 源表与导出时的 INSERT 一样在当前 Doris 库中解析
+	sourceTable := request.SourceTableName
+	columns := make([]string, 0, len(request.Columns))
+	for _, column := range request.Columns {
+		columns = append(columns, column.Name)
+	}
+	report, err := database.Reconcile(context.Background(),
+		database.ReconcileSource{Strategy: s.dbStrategy, DbType: ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, TableName: sourceTable},
+		database.ReconcileSource{Strategy: targetStrategy, DbType: dbType, TableName: request.TableName},
+		reconcileColumns(columns), mode)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger.Infof("Reconciled %s with %s by %v: source %d rows, target %d rows, matched: %v",
+		sourceTable, request.TableName, mode, report.SourceRows, report.TargetRows, report.Matched)
+	return report, nil
+}
+
+// reconcileColumns 参与对账的列，去掉 rowid 伪列
+func reconcileColumns(columns []string) []string {
+	result := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if !strings.EqualFold(column, "rowid") {
+			result = append(result, column)
+		}
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"io"
+	"testing"
+
+	"data-service/clients"
+	"data-service/mocks"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFinishedOverwriteProcessor 构造覆盖模式下 Stream Load 已结束的处理器，导入结果为 result
+func newFinishedOverwriteProcessor(dorisService IDorisService, result *clients.StreamLoadResult) *StreamProcessor {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	pr, pw := io.Pipe()
+	go io.Copy(io.Discard, pr)
+	loadDone := make(chan struct{})
+	close(loadDone)
+	return &StreamProcessor{
+		schema:       schema,
+		dbName:       "mall",
+		tableName:    "orders",
+		label:        "label_1",
+		dorisService: dorisService,
+		loadTable:    "orders_stg_0a1b2c3d",
+		pipeWriter:   pw,
+		arrowWriter:  ipc.NewWriter(pw, ipc.WithSchema(schema)),
+		loadDone:     loadDone,
+		loadResult:   result,
+	}
+}
+
+func TestImportToDoris_OverwriteReplacesTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDoris := mocks.NewMockIDorisService(ctrl)
+	mockDoris.EXPECT().
+		ExecuteUpdate("ALTER TABLE `mall`.`orders` REPLACE WITH TABLE `orders_stg_0a1b2c3d` PROPERTIES('swap' = 'false')").
+		Return(int64(0), nil)
+
+	processor := newFinishedOverwriteProcessor(mockDoris, &clients.StreamLoadResult{Status: "Success", NumberLoadedRows: 3})
+	service := &DataWriteService{}
+	assert.NoError(t, service.importToDoris(processor))
+
+	// 暂存表已改名为目标表，cleanup 不再删除
+	processor.cleanup()
+}
+
+func TestImportToDoris_OverwriteAlreadyLoadedLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 标签已被之前成功的导入使用，暂存表为空，只能删除，不能替换目标表
+	mockDoris := mocks.NewMockIDorisService(ctrl)
+	mockDoris.EXPECT().
+		ExecuteUpdate("DROP TABLE IF EXISTS `mall`.`orders_stg_0a1b2c3d`").
+		Return(int64(0), nil)
+
+	processor := newFinishedOverwriteProcessor(mockDoris,
+		&clients.StreamLoadResult{Status: "Label Already Exists", ExistingJobStatus: "FINISHED"})
+	service := &DataWriteService{}
+	assert.NoError(t, service.importToDoris(processor))
+	processor.cleanup()
+}
@@ -2,29 +2,24 @@ package service
 
 import (
 	"bytes"
+	"context"
+	"data-service/clients"
 	"data-service/common"
-	"data-service/config"
+	"data-service/database"
 	"data-service/generated/datasource"
+	"errors"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 	"strings"
 	"sync"
-	"time"
 
 	log "data-service/log"
 
-	"os/exec"
-
 	"github.com/google/uuid"
 
 	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/apache/arrow/go/v15/arrow/array"
 	"github.com/apache/arrow/go/v15/arrow/ipc"
-	"github.com/apache/arrow/go/v15/parquet"
-	"github.com/apache/arrow/go/v15/parquet/compress"
-	"github.com/apache/arrow/go/v15/parquet/pqarrow"
 	"google.golang.org/grpc"
 )
 
@@ -47,7 +42,7 @@ func (s *DataWriteService) ProcessWriteStream(stream grpc.ClientStreamingServer[
 	if err != nil {
 		return fmt.Errorf("failed to create stream processor: %v", err)
 	}
-	defer processor.cleanupIfNotDebug()
+	defer processor.cleanup()
 
 	// 处理流数据
 	if err := s.processStreamData(stream, processor); err != nil {
@@ -67,64 +62,46 @@ func (s *DataWriteService) ProcessWriteStream(stream grpc.ClientStreamingServer[
 }
 
 // 2. 流处理器结构体
+// 收到第一个请求后即开始 Stream Load，之后的 Arrow 批次经管道直接写入导入请求，不落盘
 type StreamProcessor struct {
-	tempFile      *os.File
-	filePath      string
-	parquetWriter *pqarrow.FileWriter
-	schema        *arrow.Schema
-	tableName     string
-	dbName        string
-	dorisService  IDorisService
+	schema       *arrow.Schema
+	tableName    string
+	dbName       string
+	label        string
+	dorisService IDorisService
+	writeOptions database.WriteOptions
+	// loadTable 实际导入的表，覆盖模式下为暂存表，导入成功后替换目标表
+	loadTable   string
+	pipeWriter  *io.PipeWriter
+	arrowWriter *ipc.Writer
+	loadDone    chan struct{}
+	loadResult  *clients.StreamLoadResult
+	loadErr     error
 }
 
 // 3. 创建流处理器
 func (s *DataWriteService) createStreamProcessor() (*StreamProcessor, error) {
-	fileName := fmt.Sprintf("arrow_stream_%s.parquet", uuid.New().String())
-	filePath := filepath.Join(common.DATA_DIR, fileName)
-
-	tempFile, err := os.Create(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp file: %v", err)
-	}
-	return &StreamProcessor{
-		tempFile: tempFile,
-		filePath: filePath,
-	}, nil
+	return &StreamProcessor{}, nil
 }
 
-// 4. 清理资源
+// 4. 清理资源：中止未完成的导入，删除未替换目标表的暂存表
 func (p *StreamProcessor) cleanup() {
-	if p.parquetWriter != nil {
-		_ = p.parquetWriter.Close()
-	}
-	if p.tempFile != nil {
-		p.tempFile.Close()
-	}
-	if p.filePath != "" {
-		if err := os.Remove(p.filePath); err != nil {
-			log.Logger.Warnf("Failed to remove file %s: %v", p.filePath, err)
+	if p.pipeWriter != nil {
+		// 导入已完成时不影响结果
+		p.pipeWriter.CloseWithError(errWriteStreamAborted)
+		<-p.loadDone
+	}
+	if p.loadTable != "" && p.loadTable != p.tableName {
+		dorisDialect := database.DialectFor(datasource.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+		dropSQL := fmt.Sprintf("DROP TABLE IF EXISTS %s", dorisDialect.QualifyTableName(p.dbName, p.loadTable))
+		if _, err := p.dorisService.ExecuteUpdate(dropSQL); err != nil {
+			log.Logger.Warnf("Failed to drop staging table %s.%s: %v", p.dbName, p.loadTable, err)
 		}
 	}
 }
 
-func (p *StreamProcessor) cleanupIfNotDebug() {
-	// 获取配置中的日志级别
-	config := config.GetConfigMap()
-	logLevel := config.LoggerConfig.Level
-	if logLevel == "" {
-		logLevel = "info" // 默认info级别
-	}
-
-	// 如果是debug级别，跳过cleanup
-	if logLevel == "debug" {
-		log.Logger.Debugf("Skipping cleanup due to debug log level")
-		return
-	}
-
-	// 否则执行cleanup
-	log.Logger.Debugf("Executing cleanup (log level: %s)", logLevel)
-	p.cleanup()
-}
+// errWriteStreamAborted 写入流未正常结束，中止进行中的 Stream Load
+var errWriteStreamAborted = errors.New("write stream aborted")
 
 // 5. 处理流数据
 func (s *DataWriteService) processStreamData(stream grpc.ClientStreamingServer[datasource.WriteRequest, datasource.WriteResponse], processor *StreamProcessor) error {
@@ -158,6 +135,8 @@ func (s *DataWriteService) processStreamData(stream grpc.ClientStreamingServer[d
 func (s *DataWriteService) initializeFirstRequest(req *datasource.WriteRequest, processor *StreamProcessor) error {
 	processor.tableName = req.TableName
 	processor.dbName = req.DbName
+	processor.writeOptions = database.NewWriteOptions(req.WriteMode, req.KeyColumns)
+	processor.label = req.Label
 
 	if processor.tableName == "" {
 		return fmt.Errorf("table name is required")
@@ -198,25 +177,64 @@ func (s *DataWriteService) initializeFirstRequest(req *datasource.WriteRequest,
 	}
 	processor.schema = schema
 
-	// 创建表
-	if err := s.createTableInDoris(processor.dorisService, processor.dbName, processor.tableName, schema); err != nil {
-		return fmt.Errorf("failed to create table in Doris: %v", err)
+	columns := make([]string, len(schema.Fields()))
+	for i, field := range schema.Fields() {
+		columns[i] = field.Name
+	}
+	if err := processor.writeOptions.Validate(datasource.DataSourceType_DATA_SOURCE_TYPE_DORIS, columns); err != nil {
+		return err
 	}
 
-	// 初始化 Parquet 写入器
-	parquetProps := parquet.NewWriterProperties(
-		parquet.WithCompression(compress.Codecs.Snappy),
-	)
-	arrowProps := pqarrow.DefaultWriterProps()
+	// 创建表，更新模式以键列作为 Unique Key，导入时按键覆盖旧行
+	var keyColumns []string
+	if processor.writeOptions.Mode == datasource.WriteMode_WRITE_MODE_UPSERT {
+		keyColumns = processor.writeOptions.KeyColumns
+	}
+	if err := s.createTableInDoris(processor.dorisService, processor.dbName, processor.tableName, schema, keyColumns...); err != nil {
+		return fmt.Errorf("failed to create table in Doris: %v", err)
+	}
 
-	pw, err := pqarrow.NewFileWriter(processor.schema, processor.tempFile, parquetProps, arrowProps)
-	if err != nil {
-		return fmt.Errorf("failed to create parquet writer: %v", err)
+	// 覆盖模式先导入暂存表，数据全部导入后再替换目标表，导入期间目标表仍保留旧数据
+	processor.loadTable = processor.tableName
+	if processor.writeOptions.Mode == datasource.WriteMode_WRITE_MODE_OVERWRITE {
+		stagingTable := fmt.Sprintf("%s_stg_%s", processor.tableName, strings.ReplaceAll(uuid.New().String(), "-", "")[:8])
+		dorisDialect := database.DialectFor(datasource.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+		createSQL := fmt.Sprintf("CREATE TABLE %s LIKE %s", dorisDialect.QualifyTableName(processor.dbName, stagingTable),
+			dorisDialect.QualifyTableName(processor.dbName, processor.tableName))
+		if _, err := processor.dorisService.ExecuteUpdate(createSQL); err != nil {
+			return fmt.Errorf("failed to create staging table for %s.%s: %v", processor.dbName, processor.tableName, err)
+		}
+		processor.loadTable = stagingTable
 	}
-	processor.parquetWriter = pw
+
+	s.startStreamLoad(processor)
 	return nil
 }
 
+// startStreamLoad 开始 Stream Load，之后写入 processor.arrowWriter 的批次以 Arrow IPC 流的形式导入
+func (s *DataWriteService) startStreamLoad(processor *StreamProcessor) {
+	pr, pw := io.Pipe()
+	processor.pipeWriter = pw
+	processor.arrowWriter = ipc.NewWriter(pw, ipc.WithSchema(processor.schema))
+	processor.loadDone = make(chan struct{})
+	req := clients.StreamLoadRequest{
+		DbName:    processor.dbName,
+		TableName: processor.loadTable,
+		Label:     processor.label,
+		Format:    clients.StreamLoadFormatArrow,
+	}
+	go func() {
+		defer close(processor.loadDone)
+		processor.loadResult, processor.loadErr = clients.NewStreamLoadClientFromConfig().Load(context.Background(), req, pr)
+		// 导入提前结束时让写入端立即失败
+		loadErr := processor.loadErr
+		if loadErr == nil {
+			loadErr = fmt.Errorf("stream load finished before the write stream ended")
+		}
+		pr.CloseWithError(loadErr)
+	}()
+}
+
 // 7. 解析Arrow Schema
 func (s *DataWriteService) parseArrowSchema(arrowBatch []byte) (*arrow.Schema, error) {
 	reader := bytes.NewReader(arrowBatch)
@@ -242,25 +260,46 @@ func (s *DataWriteService) processArrowBatch(arrowBatch []byte, processor *Strea
 	defer ipcReader.Release()
 
 	for ipcReader.Next() {
-		record := ipcReader.Record()
-		if err := processor.parquetWriter.Write(record); err != nil {
-			record.Release()
-			return fmt.Errorf("failed to write parquet record: %v", err)
+		if err := processor.arrowWriter.Write(ipcReader.Record()); err != nil {
+			return fmt.Errorf("failed to write arrow record to stream load: %v", err)
 		}
-		record.Release()
 	}
-	return nil
+	return ipcReader.Err()
 }
 
-// 9. 导入到Doris
+// 9. 结束 Stream Load，覆盖模式下用暂存表原子替换目标表
 func (s *DataWriteService) importToDoris(processor *StreamProcessor) error {
-	if processor.parquetWriter != nil {
-		_ = processor.parquetWriter.Close()
+	if processor.pipeWriter == nil {
+		return fmt.Errorf("send arrow batch schema cannot be nil")
 	}
-	if processor.tempFile != nil {
-		_ = processor.tempFile.Close()
+	if err := processor.arrowWriter.Close(); err != nil {
+		return fmt.Errorf("failed to finish arrow stream: %v", err)
 	}
-	return s.importLocalFileToDoris(processor.dbName, processor.tableName, processor.filePath, processor.schema)
+	processor.pipeWriter.Close()
+	<-processor.loadDone
+	if processor.loadErr != nil {
+		return processor.loadErr
+	}
+
+	// 重试已成功的标签时暂存表中没有数据，目标表已是之前导入的结果，不能再替换；暂存表由 cleanup 删除
+	if processor.loadTable != processor.tableName && processor.loadResult.AlreadyLoaded() {
+		log.Logger.Infof("Label %s was already loaded into %s.%s, skip replacing the target table",
+			processor.label, processor.dbName, processor.tableName)
+		return nil
+	}
+
+	if processor.loadTable != processor.tableName {
+		dorisDialect := database.DialectFor(datasource.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+		replaceSQL := fmt.Sprintf("ALTER TABLE %s REPLACE WITH TABLE %s PROPERTIES('swap' = 'false')",
+			dorisDialect.QualifyTableName(processor.dbName, processor.tableName), dorisDialect.QuoteIdentifier(processor.loadTable))
+		if _, err := processor.dorisService.ExecuteUpdate(replaceSQL); err != nil {
+			return fmt.Errorf("failed to replace table %s.%s: %v", processor.dbName, processor.tableName, err)
+		}
+		// 暂存表已改名为目标表
+		processor.loadTable = processor.tableName
+	}
+	log.Logger.Infof("Stream loaded %d rows into %s.%s", processor.loadResult.NumberLoadedRows, processor.dbName, processor.tableName)
+	return nil
 }
 
 // getArrowValueAsString 将Arrow值转换为字符串
@@ -320,23 +359,45 @@ func (s *DataWriteService) getArrowValueAsString(col arrow.Array, index int64) s
 }
 
 // createTableInDoris 在Doris中创建表
-func (s *DataWriteService) createTableInDoris(dorisService IDorisService, dbName, tableName string, schema *arrow.Schema) error {
+//
+// 未指定键列时以自增列作为 Unique Key；指定键列时以键列作为 Unique Key 和分桶列，
+// Doris 要求 Key 列位于其他列之前，因此键列会被移到列定义的最前面。
+func (s *DataWriteService) createTableInDoris(dorisService IDorisService, dbName, tableName string, schema *arrow.Schema, keyColumns ...string) error {
 	// 构建列定义
 	var columnDefs []string
 
-	// 使用UUID生成5位随机字符串作为主键字段名的一部分
-	uuidStr := uuid.New().String()
-	// 取UUID的前5位字符（去掉连字符）
-	randomSuffix := strings.ReplaceAll(uuidStr[:8], "-", "")[:5]
-	primaryKeyFieldName := fmt.Sprintf("pk_%s", randomSuffix)
-	primaryKeyField := fmt.Sprintf("`%s` BIGINT NOT NULL AUTO_INCREMENT", primaryKeyFieldName)
-	columnDefs = append(columnDefs, primaryKeyField)
+	isKey := make(map[string]bool, len(keyColumns))
+	for _, key := range keyColumns {
+		isKey[key] = true
+	}
+	if len(keyColumns) == 0 {
+		// 使用UUID生成5位随机字符串作为主键字段名的一部分
+		uuidStr := uuid.New().String()
+		// 取UUID的前5位字符（去掉连字符）
+		randomSuffix := strings.ReplaceAll(uuidStr[:8], "-", "")[:5]
+		primaryKeyFieldName := fmt.Sprintf("pk_%s", randomSuffix)
+		primaryKeyField := fmt.Sprintf("`%s` BIGINT NOT NULL AUTO_INCREMENT", primaryKeyFieldName)
+		columnDefs = append(columnDefs, primaryKeyField)
+		keyColumns = []string{primaryKeyFieldName}
+	} else {
+		for _, key := range keyColumns {
+			field, ok := schema.FieldsByName(key)
+			if !ok || len(field) == 0 {
+				return fmt.Errorf("key column %s not found in schema", key)
+			}
+			columnDefs = append(columnDefs, fmt.Sprintf("`%s` %s NOT NULL", key, database.DorisKeyColumnType(field[0].Type)))
+		}
+	}
 
 	for _, field := range schema.Fields() {
-		dorisType := common.ConvertArrowTypeToDorisType(field.Type)
+		if isKey[field.Name] {
+			continue
+		}
+		dorisType := database.DialectFor(datasource.DataSourceType_DATA_SOURCE_TYPE_DORIS).ColumnType(field.Type)
 		columnDef := fmt.Sprintf("`%s` %s", field.Name, dorisType)
 		columnDefs = append(columnDefs, columnDef)
 	}
+	keyList := "`" + strings.Join(keyColumns, "`, `") + "`"
 
 	// 构建CREATE TABLE SQL
 	createTableSQL := fmt.Sprintf(`
@@ -344,12 +405,12 @@ func (s *DataWriteService) createTableInDoris(dorisService IDorisService, dbName
 			%s
 		)
 		ENGINE=OLAP
-		UNIQUE KEY (`+"`%s`"+`)
-		DISTRIBUTED BY HASH(`+"`%s`"+`) BUCKETS AUTO
+		UNIQUE KEY (%s)
+		DISTRIBUTED BY HASH(%s) BUCKETS AUTO
 		PROPERTIES (
 			"replication_num" = "1"
 		)
-	`, dbName, tableName, strings.Join(columnDefs, ",\n\t\t"), primaryKeyFieldName, primaryKeyFieldName)
+	`, dbName, tableName, strings.Join(columnDefs, ",\n\t\t"), keyList, keyList)
 
 	// 执行CREATE TABLE
 	_, err := dorisService.ExecuteUpdate(createTableSQL)
@@ -359,62 +420,3 @@ func (s *DataWriteService) createTableInDoris(dorisService IDorisService, dbName
 
 	return nil
 }
-
-// importLocalFileToDoris 使用doris-streamloader导入本地文件
-func (s *DataWriteService) importLocalFileToDoris(dbName, tableName, filePath string, schema *arrow.Schema) error {
-	// 添加空指针检查
-	if schema == nil {
-		return fmt.Errorf("send arrow batch schema cannot be nil")
-	}
-
-	conf := config.GetConfigMap()
-	if conf == nil {
-		return fmt.Errorf("config is nil")
-	}
-
-	feURL := fmt.Sprintf("http://%s:%d/api/%s/%s/_stream_load",
-		conf.DorisConfig.Address, conf.DorisConfig.Port, dbName, tableName)
-
-	// label 用于幂等；使用随机函数生成
-	randomStr, err := common.GenerateRandomString(10)
-	if err != nil {
-		return fmt.Errorf("failed to generate random string for label: %v", err)
-	}
-	label := fmt.Sprintf("import_%d_%s", time.Now().UnixNano(), randomStr)
-
-	// 认证信息；若无密码则会是 "root:"
-	auth := fmt.Sprintf("%s:%s", conf.DorisConfig.User, conf.DorisConfig.Password)
-
-	// 构建 curl 命令
-	args := []string{
-		"--location-trusted",
-		"-u", auth,
-		"-H", "Expect: 100-continue",
-		"-H", "format:parquet", // 某些版本也支持 file_type:parquet
-		"-H", fmt.Sprintf("label:%s", label),
-		"-T", filePath,
-		feURL,
-	}
-
-	cmd := exec.Command("curl", args...)
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	log.Logger.Infof("Executing curl stream load: curl %s", strings.Join(args, " "))
-
-	if err := cmd.Run(); err != nil {
-		log.Logger.Errorf("curl stderr: %s", stderr.String())
-		log.Logger.Errorf("curl stdout: %s", stdout.String())
-		return fmt.Errorf("curl stream load failed: %v, stderr: %s", err, stderr.String())
-	}
-
-	log.Logger.Infof("curl stream load output: %s", stdout.String())
-
-	// Doris FE 返回 JSON，沿用现有解析与校验
-	if err := checkStreamloaderOutput(stdout.String()); err != nil {
-		return err
-	}
-	return nil
-}
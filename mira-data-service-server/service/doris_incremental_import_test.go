@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildIncrementalInsert(t *testing.T) {
+	// 增量导入只取上次水位之后、本次上界之内的行
+	assert.Equal(t, "INSERT INTO `db`.`orders` (`id`, `updated_at`) SELECT `id`, `updated_at` FROM `db`.`job_external` "+
+		"WHERE `updated_at` > '2025-01-01 00:00:00' AND `updated_at` <= '2025-01-02 08:30:00.5'",
+		buildIncrementalInsert("`db`.`orders`", "`db`.`job_external`", []string{"id", "updated_at"},
+			"updated_at", "2025-01-01 00:00:00", "2025-01-02 08:30:00.5"))
+
+	// 全量导入同时导入水位列为 NULL 的行
+	assert.Equal(t, "INSERT INTO `orders` (`id`) SELECT `id` FROM `job_external` WHERE (`id` <= 100 OR `id` IS NULL)",
+		buildIncrementalInsert("`orders`", "`job_external`", []string{"id"}, "id", "", "100"))
+}
+
+func TestBuildWatermarkQuery(t *testing.T) {
+	assert.Equal(t, "SELECT MAX(`id`) FROM `job_external`", buildWatermarkQuery("`job_external`", "id", ""))
+	assert.Equal(t, "SELECT MAX(`id`) FROM `job_external` WHERE `id` > 42", buildWatermarkQuery("`job_external`", "id", "42"))
+	assert.Equal(t, "SELECT MAX(`ts`) FROM `t` WHERE `ts` > 'it''s'", buildWatermarkQuery("`t`", "ts", "it's"))
+}
+
+func TestFormatWatermark(t *testing.T) {
+	assert.Equal(t, "", formatWatermark(nil))
+	assert.Equal(t, "42", formatWatermark([]byte("42")))
+	assert.Equal(t, "42", formatWatermark(int64(42)))
+	assert.Equal(t, "2025-01-02 08:30:00.123456",
+		formatWatermark(time.Date(2025, 1, 2, 8, 30, 0, 123456000, time.UTC)))
+	assert.Equal(t, "2025-01-02 08:30:00", formatWatermark(time.Date(2025, 1, 2, 8, 30, 0, 0, time.UTC)))
+}
+
+func TestAppendMissingColumns(t *testing.T) {
+	columns := []string{"name", "id"}
+	assert.Equal(t, []string{"name", "id", "updated_at"}, appendMissingColumns(columns, "updated_at", "id", ""))
+	// 不修改传入的切片
+	assert.Equal(t, []string{"name", "id"}, columns)
+}
+
+func TestDorisKeyColumnType(t *testing.T) {
+	assert.Equal(t, "VARCHAR(65533)", dorisKeyColumnType("STRING"))
+	assert.Equal(t, "VARCHAR(64)", dorisKeyColumnType("VARCHAR(64)"))
+	assert.Equal(t, "BIGINT", dorisKeyColumnType("BIGINT"))
+}
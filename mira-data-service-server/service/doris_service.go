@@ -11,6 +11,7 @@
 package service
 
 import (
+	"data-service/clients"
 	"data-service/common"
 	"data-service/config"
 	"data-service/database"
@@ -21,16 +22,13 @@ import (
 	"database/sql"
 	"encoding/base64"
 	"fmt"
-	"os/exec"
 	"strings"
 	"time"
 
 	"bytes"
 
 	"context"
-	"io"
 	"os"
-	"path/filepath"
 
 	"sync"
 
@@ -52,14 +50,15 @@ type IDorisService interface {
 	ExecuteUpdate(sql string, args ...interface{}) (int64, error)
 	Close() error
 	EnsureDorisDatabaseExists(dbName string) error
-	CreateExternalAndInternalTableAndImportData(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, columns ...string) (string, error)
-	CreateExternalAndInternalTableAndImportDataBatched(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, pkColumn string, batchSize int, columns ...string) (string, error)
+	CreateExternalAndInternalTableAndImportData(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, layout *common.DorisTableLayout, columns ...string) (string, error)
+	CreateExternalAndInternalTableAndImportDataBatched(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, layout *common.DorisTableLayout, pkColumn string, batchSize int, columns ...string) (string, error)
+	ImportAssetIncrementally(req common.IncrementalImportRequest) (*common.IncrementalImportResult, error)
 	ImportArrowFileToDoris(bucketName string, objectName string, tableName string, dbName string) error
 	ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequest) error
 	ExportParquetFileFromDoris(request *ds.ExportCsvFileFromDorisRequest) error
-	ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMiraDBRequest) error
+	ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMiraDBRequest) (*ds.ReconcileReport, error)
 	ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDorisRequest) (string, error)
-	ProcessDataSourceAndExport(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, error)
+	PrepareDataSourceInDoris(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, string, error)
 	CreateExternalTableFromAsset(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, columnList ...string) ([]string, string, error)
 	GetDorisTableSchema(dbName, tableName string) ([]*ds.ColumnItem, error)
 	ConvertRequestToArrowSchema(request *ds.ExportDorisDataToMiraDBRequest) (*arrow.Schema, error)
@@ -75,6 +74,8 @@ type IDorisService interface {
 // DorisService 提供Doris数据库操作的服务
 type DorisService struct {
 	dbStrategy database.DatabaseStrategy
+	// ctx 执行 SQL 的上下文，取消后正在执行的语句在 Doris 上被中止
+	ctx context.Context
 }
 
 // FileInfo 文件信息结构体
@@ -85,8 +86,6 @@ type FileInfo struct {
 
 // NewDorisService 创建新的Doris服务实例
 func NewDorisService(dbName ...string) (IDorisService, error) {
-	config := config.GetConfigMap()
-
 	// 如果提供了数据库名，使用它；否则使用配置中的默认数据库
 	var targetDbName string
 	if len(dbName) > 0 && dbName[0] != "" {
@@ -95,6 +94,38 @@ func NewDorisService(dbName ...string) (IDorisService, error) {
 		targetDbName = ""
 	}
 
+	strategy, err := connectDoris(targetDbName)
+	if err != nil {
+		return nil, err
+	}
+	return &DorisService{
+		dbStrategy: strategy,
+	}, nil
+}
+
+// NewDorisServiceWithContext 创建绑定上下文的 Doris 服务实例，ctx 取消后正在执行的 SQL 随之中止
+func NewDorisServiceWithContext(ctx context.Context, dbName string) (IDorisService, error) {
+	strategy, err := connectDoris(dbName)
+	if err != nil {
+		return nil, err
+	}
+	return &DorisService{
+		dbStrategy: strategy,
+		ctx:        ctx,
+	}, nil
+}
+
+// queryContext 执行 SQL 使用的上下文
+func (s *DorisService) queryContext() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+// connectDoris 连接 Doris，targetDbName 为空时使用默认数据库
+func connectDoris(targetDbName string) (database.DatabaseStrategy, error) {
+	config := config.GetConfigMap()
 	connInfo := &ds.ConnectionInfo{
 		Host:     config.DorisConfig.Address,
 		Port:     9030,
@@ -119,10 +150,7 @@ func NewDorisService(dbName ...string) (IDorisService, error) {
 	} else {
 		log.Logger.Infof("Connected to Doris with default database")
 	}
-
-	return &DorisService{
-		dbStrategy: strategy,
-	}, nil
+	return strategy, nil
 }
 
 // ExecuteSQL 执行SQL语句并返回结果
@@ -147,7 +175,7 @@ func (s *DorisService) ExecuteSQL(sql string, args ...interface{}) (*sql.Rows, f
 
 		// 使用 DorisStrategy 的专用连接查询，避免串库
 		if dsStrategy, ok := s.dbStrategy.(*database.DorisStrategy); ok && targetDB != "" {
-			rows, done, err := dsStrategy.QueryInDB(context.Background(), targetDB, sql, args...)
+			rows, done, err := dsStrategy.QueryInDB(s.queryContext(), targetDB, sql, args...)
 			if err != nil {
 				log.Logger.Errorf("Failed to execute SQL on Doris: %v", err)
 				return nil, nil, fmt.Errorf("failed to execute SQL: %v", err)
@@ -155,7 +183,7 @@ func (s *DorisService) ExecuteSQL(sql string, args ...interface{}) (*sql.Rows, f
 			return rows, done, nil
 		}
 		// SELECT查询，返回结果集
-		rows, err := s.dbStrategy.Query(sql, args...)
+		rows, err := s.dbStrategy.Query(s.queryContext(), sql, args...)
 		if err != nil {
 			log.Logger.Errorf("Failed to execute SELECT SQL on Doris: %v", err)
 			return nil, nil, fmt.Errorf("failed to execute SELECT SQL: %v", err)
@@ -175,8 +203,16 @@ func (s *DorisService) ExecuteSQL(sql string, args ...interface{}) (*sql.Rows, f
 		log.Logger.Infof("SQL executed successfully, affected rows: %d", affectedRows)
 		return nil, nil, nil
 	} else {
-		// 其他SQL语句，尝试作为查询执行
-		rows, err := s.dbStrategy.Query(sql, args...)
+		// 其他SQL语句（SHOW 等），尝试作为查询执行，指定了库时同样使用专用连接
+		if dsStrategy, ok := s.dbStrategy.(*database.DorisStrategy); ok && targetDB != "" {
+			rows, done, err := dsStrategy.QueryInDB(s.queryContext(), targetDB, sql, args...)
+			if err != nil {
+				log.Logger.Errorf("Failed to execute SQL on Doris: %v", err)
+				return nil, nil, fmt.Errorf("failed to execute SQL: %v", err)
+			}
+			return rows, done, nil
+		}
+		rows, err := s.dbStrategy.Query(s.queryContext(), sql, args...)
 		if err != nil {
 			log.Logger.Errorf("Failed to execute SQL on Doris: %v", err)
 			return nil, nil, fmt.Errorf("failed to execute SQL: %v", err)
@@ -197,7 +233,7 @@ func (s *DorisService) ExecuteUpdate(sql string, args ...interface{}) (int64, er
 	if dsStrategy, ok := s.dbStrategy.(*database.DorisStrategy); ok {
 		targetDB := s.GetDBName()
 		if targetDB != "" {
-			result, err := dsStrategy.ExecInDB(context.Background(), targetDB, sql, args...)
+			result, err := dsStrategy.ExecInDB(s.queryContext(), targetDB, sql, args...)
 			if err != nil {
 				log.Logger.Errorf("Failed to execute update SQL on Doris: %v", err)
 				return 0, fmt.Errorf("failed to execute update SQL: %v", err)
@@ -217,7 +253,7 @@ func (s *DorisService) ExecuteUpdate(sql string, args ...interface{}) (int64, er
 	}
 
 	// 执行更新操作
-	result, err := db.Exec(sql, args...)
+	result, err := db.ExecContext(s.queryContext(), sql, args...)
 	if err != nil {
 		log.Logger.Errorf("Failed to execute update SQL on Doris: %v", err)
 		return 0, fmt.Errorf("failed to execute update SQL: %v", err)
@@ -242,36 +278,18 @@ func (s *DorisService) Close() error {
 
 // ExternalTableConfig 外部表配置
 type ExternalTableConfig struct {
-	ResourceName    string              // 资源名称
-	TableName       string              // 表名
-	Columns         []common.ColumnInfo // 列信息
-	JdbcURL         string              // JDBC连接URL
-	DriverURL       string              // 驱动JAR包URL
-	DriverClass     string              // 驱动类名
-	Username        string              // 用户名
-	Password        string              // 密码
-	SourceTableName string              // 源表名
-	TableType       string              // 表类型（mysql, postgresql等）
-	Properties      map[string]string   // 额外属性
-}
-
-// CreateExternalResourceAndTables 创建外部资源、外部表、内部表
-func (s *DorisService) CreateExternalResourceAndTables(config ExternalTableConfig) error {
-	// 1. 创建外部资源
-	if err := s.createExternalResource(config); err != nil {
-		return fmt.Errorf("failed to create external resource: %v", err)
-	}
-
-	// 2. 创建外部表
-	if err := s.createExternalTable(config); err != nil {
-		// 如果创建外部表失败，尝试清理已创建的资源
-		s.dropExternalResource(config.ResourceName)
-		return fmt.Errorf("failed to create external table: %v", err)
-	}
-
-	log.Logger.Infof("Successfully created external resource '%s' and table '%s'",
-		config.ResourceName, config.TableName)
-	return nil
+	ResourceName    string                   // 资源名称
+	TableName       string                   // 表名
+	Columns         []common.ColumnInfo      // 列信息
+	JdbcURL         string                   // JDBC连接URL
+	DriverURL       string                   // 驱动JAR包URL
+	DriverClass     string                   // 驱动类名
+	Username        string                   // 用户名
+	Password        string                   // 密码
+	SourceTableName string                   // 源表名
+	TableType       string                   // 表类型（mysql, postgresql等）
+	Properties      map[string]string        // 额外属性
+	Layout          *common.DorisTableLayout // 内部表的建表方式，为空时使用配置的默认值
 }
 
 // createExternalResource 创建外部资源
@@ -364,29 +382,8 @@ func (s *DorisService) dropExternalResource(resourceName string) error {
 	return nil
 }
 
-// DropExternalTableAndResource 删除外部表和资源
-func (s *DorisService) DropExternalTableAndResource(tableName, resourceName string) error {
-	// 1. 删除外部表
-	dropTableSQL := fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName)
-	_, err := s.ExecuteUpdate(dropTableSQL)
-	if err != nil {
-		log.Logger.Warnf("Failed to drop external table '%s': %v", tableName, err)
-		// 继续尝试删除资源
-	} else {
-		log.Logger.Infof("Dropped external table: %s", tableName)
-	}
-
-	// 2. 删除外部资源
-	if err := s.dropExternalResource(resourceName); err != nil {
-		return fmt.Errorf("failed to drop external resource: %v", err)
-	}
-
-	log.Logger.Infof("Successfully dropped external table '%s' and resource '%s'", tableName, resourceName)
-	return nil
-}
-
-// getAssetInfo 获取数据资产信息，使用现有的GetDatasourceByAssetName函数
-func (s *DorisService) getAssetInfo(assetName string, chainInfoId string, alias string, targetDbName string) (*AssetInfo, error) {
+// getAssetInfo 获取数据资产信息，并取得数据源的 JDBC Catalog，用完后必须调用 release 释放 Catalog
+func (s *DorisService) getAssetInfo(assetName string, chainInfoId string, alias string) (*AssetInfo, error) {
 	// 使用现有的GetDatasourceByAssetName函数获取数据源信息
 	requestId := uuid.New().String()
 	connInfo, err := utils.GetDatasourceByAssetName(requestId, assetName, chainInfoId, alias)
@@ -396,61 +393,50 @@ func (s *DorisService) getAssetInfo(assetName string, chainInfoId string, alias
 
 	log.Logger.Infof("Datasource connInfo: %v", connInfo)
 
-	// 失败清理哨兵
-	ok := false
-	defer func() {
-		if !ok {
-			db := targetDbName
-			if db != "" && s.isTlsUsed(db, requestId) {
-				s.cleanupTlsFiles(db, requestId, connInfo.Dbtype)
-			}
-		}
-	}()
-
-	// 如果有TLS配置，上传证书到doris
-	if connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 {
-		if err := s.processTLSCertificates(connInfo.TlsConfig, requestId, connInfo.Dbtype); err != nil {
-			log.Logger.Errorf("failed to process TLS certificates: %v", err)
-			return nil, fmt.Errorf("failed to process TLS certificates: %v", err)
-		}
-	}
-
-	// 根据数据库类型确定表类型和驱动信息
-	tableType, driverURL, driverClass := s.getDatabaseTypeInfo(connInfo.Dbtype)
-
-	// 如果 Kingbase 且启用 TLS，则追加自定义 SSL 工厂 fat jar
-	if (tableType == "kingbase8") && connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 {
-		driverURL = "file:///opt/apache-doris/driver/kingbase-ssl-factory-fat-1.0-SNAPSHOT.jar"
-	}
-
-	// todo vastbase是不是要特殊处理
-	if tableType == "vastbase" {
-		driverURL = "file:///opt/apache-doris/driver/vastbase-ssl-factory-fat-1.0-SNAPSHOT.jar"
-	}
-
-	// 构建JDBC URL
-	jdbcURL := s.buildJdbcURL(connInfo, tableType, requestId, targetDbName)
-
 	// 获取表结构信息
 	columns, err := s.getTableColumns(connInfo, assetName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get table columns: %v", err)
 	}
-	ok = true
 
+	// 同一数据源共用 Catalog，TLS 证书在创建 Catalog 时上传
+	catalog, err := GetJdbcCatalogManager().Acquire(connInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	tableType, driverURL, driverClass := s.jdbcDriverInfo(connInfo)
+	sourceDbName, sourceTableName := catalogSourceTable(connInfo, tableType)
 	return &AssetInfo{
-		JdbcURL:         jdbcURL,
+		Catalog:         catalog,
 		DriverURL:       driverURL,
 		DriverClass:     driverClass,
 		Username:        connInfo.User,
-		Password:        connInfo.Password,
-		SourceTableName: connInfo.TableName,
+		SourceDbName:    sourceDbName,
+		SourceTableName: sourceTableName,
 		TableType:       tableType,
 		Columns:         columns,
 		RequestId:       requestId,
 	}, nil
 }
 
+// jdbcDriverInfo 数据源的表类型和驱动信息，启用 TLS 的 Kingbase 以及 Vastbase 使用自定义 SSL 工厂 fat jar
+func (s *DorisService) jdbcDriverInfo(connInfo *ds.ConnectionInfo) (tableType, driverURL, driverClass string) {
+	// 根据数据库类型确定表类型和驱动信息
+	tableType, driverURL, driverClass = s.getDatabaseTypeInfo(connInfo.Dbtype)
+
+	// 如果 Kingbase 且启用 TLS，则追加自定义 SSL 工厂 fat jar
+	if (tableType == "kingbase8") && connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 {
+		driverURL = "file:///opt/apache-doris/driver/kingbase-ssl-factory-fat-1.0-SNAPSHOT.jar"
+	}
+
+	// todo vastbase是不是要特殊处理
+	if tableType == "vastbase" {
+		driverURL = "file:///opt/apache-doris/driver/vastbase-ssl-factory-fat-1.0-SNAPSHOT.jar"
+	}
+	return tableType, driverURL, driverClass
+}
+
 // getDatabaseTypeInfo 根据数据库类型获取表类型和驱动信息
 func (s *DorisService) getDatabaseTypeInfo(dbType int32) (tableType, driverURL, driverClass string) {
 	switch dbType {
@@ -478,6 +464,24 @@ func (s *DorisService) getDatabaseTypeInfo(dbType int32) (tableType, driverURL,
 		return "gbase",
 			"file:///opt/apache-doris/driver/gbase-connector-java-9.5.0.8-build1-bin.jar",
 			"com.gbase.jdbc.Driver"
+	case int32(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE):
+		return "hive",
+			"file:///opt/apache-doris/driver/hive-jdbc-3.1.3-standalone.jar",
+			"org.apache.hive.jdbc.HiveDriver"
+	case int32(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL):
+		return "postgresql",
+			"file:///opt/apache-doris/driver/postgresql-42.7.3.jar",
+			"org.postgresql.Driver"
+	case int32(ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS):
+		// openGauss 与 PostgreSQL 共用 postgresql 表类型，仅驱动不同
+		return "postgresql",
+			"file:///opt/apache-doris/driver/opengauss-jdbc-5.0.0.jar",
+			"org.opengauss.Driver"
+	case int32(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG):
+		// 达梦兼容 Oracle 语法，使用 oracle 表类型让 Doris 按 Oracle 方言生成下推 SQL
+		return "oracle",
+			"file:///opt/apache-doris/driver/DmJdbcDriver18.jar",
+			"dm.jdbc.driver.DmDriver"
 	default:
 		return "mysql",
 			"file:///opt/apache-doris/driver/mysql-connector-j-8.0.33.jar",
@@ -487,8 +491,20 @@ func (s *DorisService) getDatabaseTypeInfo(dbType int32) (tableType, driverURL,
 
 // buildJdbcURL 构建JDBC连接URL
 func (s *DorisService) buildJdbcURL(connInfo *ds.ConnectionInfo, tableType string, requestId string, targetDbName string) string {
-	// 基础 JDBC URL
-	jdbcURL := fmt.Sprintf("jdbc:%s://%s:%d/%s", tableType, connInfo.Host, connInfo.Port, connInfo.DbName)
+	// 基础 JDBC URL，Hive 使用 HiveServer2 协议 jdbc:hive2
+	scheme := tableType
+	if tableType == "hive" {
+		scheme = "hive2"
+	}
+	// openGauss 驱动使用 jdbc:opengauss 协议
+	if connInfo.Dbtype == int32(ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS) {
+		scheme = "opengauss"
+	}
+	jdbcURL := fmt.Sprintf("jdbc:%s://%s:%d/%s", scheme, connInfo.Host, connInfo.Port, connInfo.DbName)
+	// 达梦驱动使用 jdbc:dm 协议，DbName 对应模式，通过 schema 参数指定
+	if connInfo.Dbtype == int32(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG) {
+		jdbcURL = fmt.Sprintf("jdbc:dm://%s:%d?schema=%s", connInfo.Host, connInfo.Port, connInfo.DbName)
+	}
 
 	// 检查TLS配置
 	if connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 {
@@ -498,6 +514,7 @@ func (s *DorisService) buildJdbcURL(connInfo *ds.ConnectionInfo, tableType strin
 			curDB = common.MIRA_TMP_TASK_DB
 		}
 
+		// Hive 不支持 TLS，启用 TLS 的 Hive 数据源在创建 Catalog 前已被拒绝
 		switch tableType {
 		case "kingbase8":
 			return s.buildKingbaseSSLJdbcURL(jdbcURL, connInfo.TlsConfig, curDB, requestId)
@@ -505,6 +522,10 @@ func (s *DorisService) buildJdbcURL(connInfo *ds.ConnectionInfo, tableType strin
 			return s.buildVastbaseSSLJdbcURL(jdbcURL, connInfo.TlsConfig, curDB, requestId)
 		case "gbase":
 			return s.buildGbasebaseSSLJdbcURL(jdbcURL, connInfo.TlsConfig, curDB, requestId)
+		case "postgresql":
+			return s.buildPostgresSSLJdbcURL(jdbcURL, connInfo.TlsConfig, connInfo.Dbtype)
+		case "oracle":
+			return s.buildDamengSSLJdbcURL(jdbcURL, connInfo.TlsConfig)
 		default:
 			return s.buildMySQLSSLJdbcURL(jdbcURL, connInfo.TlsConfig, curDB, requestId)
 		}
@@ -512,10 +533,16 @@ func (s *DorisService) buildJdbcURL(connInfo *ds.ConnectionInfo, tableType strin
 
 	// 非SSL情况
 	switch tableType {
-	case "kingbase8", "vastbase":
+	case "kingbase8", "vastbase", "postgresql":
 		return jdbcURL + "?sslmode=disable"
 	case "gbase":
 		return jdbcURL + "?useSSL=false&useDynamicCharsetInfo=false&defaultFetchSize=-2147483648"
+	case "hive":
+		// HiveServer2 的会话参数以分号分隔，非SSL时无需额外参数
+		return jdbcURL
+	case "oracle":
+		// 达梦默认不加密，schema 参数已包含在 URL 中
+		return jdbcURL
 	default:
 		return jdbcURL + "?useSSL=false"
 	}
@@ -800,18 +827,83 @@ func (s *DorisService) buildGbasebaseSSLJdbcURL(baseURL string, tlsConfig *ds.Da
 	return finalURL
 }
 
-// getTableColumns 获取表结构信息
+// buildDamengSSLJdbcURL 构建达梦 SSL JDBC URL
+// 达梦 JDBC 只能从本地目录加载客户端证书，需预先在 Doris BE 上部署到 DAMENG_SSL_FILES_PATH
+func (s *DorisService) buildDamengSSLJdbcURL(baseURL string, tlsConfig *ds.DatasourceTlsConfig) string {
+	if tlsConfig.Mode >= 2 {
+		log.Logger.Warnf("Dameng JDBC driver does not verify the server certificate, TLS mode %d is downgraded to encryption only", tlsConfig.Mode)
+	}
+
+	finalURL := fmt.Sprintf("%s&sslFilesPath=%s", baseURL, common.DAMENG_SSL_FILES_PATH)
+	log.Logger.Infof("Generated Dameng SSL JDBC URL: %s", finalURL)
+	return finalURL
+}
+
+// buildPostgresSSLJdbcURL 构建PostgreSQL/openGauss SSL JDBC URL
+// 官方驱动没有类似 KbHttpSslFactory 的 HTTP 证书工厂，verify-ca/verify-full 依赖 BE JVM 信任库中的 CA
+func (s *DorisService) buildPostgresSSLJdbcURL(baseURL string, tlsConfig *ds.DatasourceTlsConfig, dbType int32) string {
+	var params []string
+
+	// 启用 SSL
+	params = append(params, "ssl=true")
+
+	// sslmode
+	var sslmode string
+	switch tlsConfig.Mode {
+	case 1:
+		sslmode = "require"
+	case 2:
+		sslmode = "verify-ca"
+	case 3:
+		sslmode = "verify-full"
+	default:
+		sslmode = "require"
+	}
+	params = append(params, fmt.Sprintf("sslmode=%s", sslmode))
+
+	if tlsConfig.Mode == 2 || tlsConfig.Mode == 3 {
+		// 使用 JVM 默认信任库校验服务端证书，而不是 ~/.postgresql/root.crt
+		factory := "org.postgresql.ssl.DefaultJavaSSLFactory"
+		if dbType == int32(ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS) {
+			factory = "org.opengauss.ssl.DefaultJavaSSLFactory"
+		}
+		params = append(params, fmt.Sprintf("sslfactory=%s", factory))
+		log.Logger.Warnf("PostgreSQL JDBC SSL verification relies on the Doris BE JVM truststore, make sure it trusts the server certificate")
+	}
+
+	if tlsConfig.ClientCert != "" && tlsConfig.ClientKey != "" {
+		log.Logger.Warnf("Client certificate is not supported for PostgreSQL JDBC external table, ignored")
+	}
+
+	finalURL := baseURL + "?" + strings.Join(params, "&")
+	log.Logger.Infof("Generated PostgreSQL SSL JDBC URL: %s", finalURL)
+	return finalURL
+}
+
+// getTableColumns 获取表结构信息，列以数据资产登记的为准，可空性、精度与注释从源表读取
+// 源表读取失败时列按可空处理；源库的默认值表达式不一定能在 Doris 中执行，且导入时每列都会写入，不复制默认值
 func (s *DorisService) getTableColumns(connInfo *ds.ConnectionInfo, tableName string) ([]common.ColumnInfo, error) {
 	// 如果ConnectionInfo中已经包含了列信息，直接使用
 	if len(connInfo.Columns) > 0 {
+		described, _, err := describeSourceTable(connInfo)
+		if err != nil {
+			log.Logger.Warnf("Failed to describe source table of %s, columns default to nullable: %v", tableName, err)
+		}
 		var columns []common.ColumnInfo
 		for _, col := range connInfo.Columns {
-			columns = append(columns, common.ColumnInfo{
+			column := common.ColumnInfo{
 				Name:     col.Name,
-				DataType: s.convertDatabaseTypeToDorisType(col.DataType, connInfo.Dbtype),
-				Nullable: true, // 默认设为可空，实际应该从数据库查询获取
+				DataType: s.convertDatabaseTypeToDorisType(database.ParseNativeType(col.DataType), connInfo.Dbtype),
+				Nullable: true,
 				Default:  "",
-			})
+			}
+			if meta := findColumnItem(described, col.Name); meta != nil {
+				column.DataType = s.convertDatabaseTypeToDorisType(database.NativeTypeOfItem(&ds.ColumnItem{
+					DataType: col.DataType, Precision: meta.Precision, Scale: meta.Scale}), connInfo.Dbtype)
+				column.Nullable = !meta.NotNull
+				column.Comment = meta.Comment
+			}
+			columns = append(columns, column)
 		}
 		return columns, nil
 	}
@@ -819,228 +911,227 @@ func (s *DorisService) getTableColumns(connInfo *ds.ConnectionInfo, tableName st
 	return nil, fmt.Errorf("failed to get table columns")
 }
 
-// CreateExternalTableFromAsset 根据数据资产信息创建外部表和同名内部表
+// CreateExternalTableFromAsset 根据数据资产信息创建同名内部表，返回导入的列和源表在 Catalog 中的全限定名
+// 源表通过数据源的 JDBC Catalog 访问，Catalog 由管理器缓存，返回后仍可在空闲删除前查询源表。
 func (s *DorisService) CreateExternalTableFromAsset(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, columnList ...string) ([]string, string, error) {
+	columns, assetInfo, err := s.createAssetTables(assetName, chainInfoId, alias, targetTableName, targetDbName, assetTableOptions{}, columnList...)
+	if err != nil {
+		return nil, "", err
+	}
+	assetInfo.release()
+	return columns, assetInfo.SourceTable(), nil
+}
+
+// assetTableOptions 根据数据资产建表时的选项
+type assetTableOptions struct {
+	// keepInternal 保留已存在的内部表
+	keepInternal bool
+	// layout 内部表的建表方式，为空时使用配置的默认值
+	layout *common.DorisTableLayout
+}
+
+// createAssetTables 取得数据资产所在数据源的 Catalog，并按选项创建或保留同名内部表
+// 返回导入的列和持有 Catalog 引用的资产信息，调用方用完后调用 release。
+func (s *DorisService) createAssetTables(assetName string, chainInfoId string, alias string, targetTableName string, targetDbName string, opts assetTableOptions, columnList ...string) ([]string, *AssetInfo, error) {
+	// 指定了导入的列时，键列也必须被导入
+	if len(columnList) > 0 && opts.layout != nil {
+		columnList = appendMissingColumns(columnList, opts.layout.KeyColumns...)
+	}
+
 	// 获取数据资产信息
-	assetInfo, err := s.getAssetInfo(assetName, chainInfoId, alias, targetDbName)
+	assetInfo, err := s.getAssetInfo(assetName, chainInfoId, alias)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to get asset info: %v", err)
+		return nil, nil, fmt.Errorf("failed to get asset info: %v", err)
 	}
 
 	filteredColumns, err := common.FilterColumnsWithRowidHandling(assetInfo.Columns, columnList)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to filter columns with rowid handling: %v", err)
+		assetInfo.release()
+		return nil, nil, fmt.Errorf("failed to filter columns with rowid handling: %v", err)
 	}
 
 	// 使用过滤后的列信息继续处理
 	assetInfo.Columns = filteredColumns
 
-	// 构建外部表配置，表名加external_前缀
-	externalTableName := fmt.Sprintf("%s_external", jobInstanceId)
-	internalTableName := targetTableName
-	resourceName := fmt.Sprintf("%s_resource", jobInstanceId)
-
-	config := ExternalTableConfig{
-		ResourceName:    resourceName,
-		TableName:       externalTableName,
-		JdbcURL:         assetInfo.JdbcURL,
-		DriverURL:       assetInfo.DriverURL,
-		DriverClass:     assetInfo.DriverClass,
-		Username:        assetInfo.Username,
-		Password:        assetInfo.Password,
-		SourceTableName: assetInfo.SourceTableName,
-		TableType:       assetInfo.TableType,
-		Columns:         assetInfo.Columns,
-	}
-
-	log.Logger.Debugf("config: %+v", config)
-	// 导入前清理可能存在的外部表和内部表（重试的情况下）
-	currentDb := targetDbName
-	if currentDb != "" {
-		_ = s.DropTable(currentDb, internalTableName)
-		_ = s.DropTable(currentDb, externalTableName)
-	} else {
-		log.Logger.Warnf("Cannot determine current database, skipping internal and external table cleanup")
+	var columns []string
+	for _, col := range assetInfo.Columns {
+		columns = append(columns, col.Name)
 	}
-
-	// 创建外部资源和表
-	if err := s.CreateExternalResourceAndTables(config); err != nil {
-		return nil, "", err
+	if opts.keepInternal {
+		return columns, assetInfo, nil
 	}
 
-	// 创建内部表
-	internalConfig := config
-	internalConfig.TableName = internalTableName
-	if err := s.createInternalTable(internalConfig); err != nil {
-		// 回滚外部表与资源
-		_ = s.DropExternalTableAndResource(externalTableName, resourceName)
-
-		// 回滚TLS FILE（根据 tableType 推断 dbType）
-		db := targetDbName
-		if db != "" && s.isTlsUsed(db, assetInfo.RequestId) {
-			var dbType int32
-			if assetInfo.TableType == "kingbase8" {
-				dbType = int32(ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE)
-			} else {
-				dbType = int32(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL)
-			}
-			s.cleanupTlsFiles(db, assetInfo.RequestId, dbType)
-		}
+	// 删除旧表前先检查建表方式，不相容时不改动已有的表
+	tableSQL, err := internalTableSQL(ExternalTableConfig{
+		TableName: targetTableName,
+		Columns:   assetInfo.Columns,
+		Layout:    opts.layout,
+	})
+	if err != nil {
+		assetInfo.release()
+		return nil, nil, fmt.Errorf("invalid layout of internal table '%s': %v", targetTableName, err)
+	}
 
-		return nil, "", fmt.Errorf("failed to create internal table: %v", err)
+	// 导入前清理可能存在的内部表（重试的情况下）
+	if targetDbName != "" {
+		_ = s.DropTable(targetDbName, targetTableName)
+	} else {
+		log.Logger.Warnf("Cannot determine current database, skipping internal table cleanup")
 	}
 
-	var columns []string
-	for _, col := range config.Columns {
-		columns = append(columns, col.Name)
+	// 创建内部表
+	if _, err := s.ExecuteUpdate(tableSQL); err != nil {
+		assetInfo.release()
+		return nil, nil, fmt.Errorf("failed to create internal table '%s': %v", targetTableName, err)
 	}
+	JobProgressFromContext(s.queryContext()).AddCreatedTable(targetDbName, targetTableName)
+	log.Logger.Infof("Created internal table: %s", targetTableName)
 
-	return columns, assetInfo.RequestId, nil
+	return columns, assetInfo, nil
 }
 
 // AssetInfo 数据资产信息
 type AssetInfo struct {
-	JdbcURL         string
+	Catalog         string // 数据源对应的 JDBC Catalog
 	DriverURL       string
 	DriverClass     string
 	Username        string
-	Password        string
+	SourceDbName    string // 源表在 Catalog 中所属的库
 	SourceTableName string
 	TableType       string
 	Columns         []common.ColumnInfo
 	RequestId       string
 }
 
-// createInternalTable 创建内部表，结构与外部表一致
-func (s *DorisService) createInternalTable(config ExternalTableConfig) error {
-	// 构建列定义，添加一个独立的主键字段
-	var columnDefs []string
-
-	// 使用UUID生成5位随机字符串作为主键字段名的一部分
-	uuidStr := uuid.New().String()
-	// 取UUID的前5位字符（去掉连字符）
-	randomSuffix := strings.ReplaceAll(uuidStr[:8], "-", "")[:5]
-	primaryKeyFieldName := fmt.Sprintf("pk_%s", randomSuffix)
-	primaryKeyField := fmt.Sprintf("`%s` BIGINT NOT NULL AUTO_INCREMENT", primaryKeyFieldName)
-	columnDefs = append(columnDefs, primaryKeyField)
-
-	// 然后添加原始表的列
-	for _, col := range config.Columns {
-		columnDef := fmt.Sprintf("`%s` %s", col.Name, col.DataType)
-		if !col.Nullable {
-			columnDef += " NOT NULL"
-		}
-		if col.Default != "" {
-			columnDef += fmt.Sprintf(" DEFAULT %s", col.Default)
-		}
-		columnDefs = append(columnDefs, columnDef)
-	}
+// SourceTable 源表在 Doris 中的全限定名 `catalog`.`db`.`table`
+func (a *AssetInfo) SourceTable() string {
+	dorisDialect := database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+	return strings.Join([]string{
+		dorisDialect.QuoteIdentifier(a.Catalog),
+		dorisDialect.QuoteIdentifier(a.SourceDbName),
+		dorisDialect.QuoteIdentifier(a.SourceTableName),
+	}, ".")
+}
 
-	// 构建创建内部表的SQL，使用主键语法
-	tableSQL := fmt.Sprintf(`
-		CREATE TABLE IF NOT EXISTS %s (
-			%s
-		)
-		ENGINE=OLAP
-		UNIQUE KEY (`+"`%s`"+`)
-		DISTRIBUTED BY HASH(`+"`%s`"+`) BUCKETS AUTO
-		PROPERTIES (
-			"replication_num" = "1"
-		)
-	`, config.TableName, strings.Join(columnDefs, ",\n\t\t"), primaryKeyFieldName, primaryKeyFieldName)
+// release 释放数据源 Catalog 的引用
+func (a *AssetInfo) release() {
+	GetJdbcCatalogManager().Release(a.Catalog)
+}
 
-	_, err := s.ExecuteUpdate(tableSQL)
+// createInternalTable 按建表方式创建内部表，结构与外部表一致
+func (s *DorisService) createInternalTable(config ExternalTableConfig) error {
+	tableSQL, err := internalTableSQL(config)
 	if err != nil {
+		return fmt.Errorf("invalid layout of internal table '%s': %v", config.TableName, err)
+	}
+	if _, err := s.ExecuteUpdate(tableSQL); err != nil {
 		return fmt.Errorf("failed to create internal table '%s': %v", config.TableName, err)
 	}
-	log.Logger.Infof("Created internal table: %s with auto-increment primary key %s", config.TableName, primaryKeyFieldName)
+	log.Logger.Infof("Created internal table: %s", config.TableName)
 	return nil
 }
 
-// CreateExternalAndInternalTableAndImportData 根据数据资产创建external/internal表并导入数据
-func (s *DorisService) CreateExternalAndInternalTableAndImportData(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, columns ...string) (string, error) {
+// internalTableSQL 生成内部表的建表语句，UNIQUE 模型未指定键列时添加一个独立的自增主键作为键
+func internalTableSQL(config ExternalTableConfig) (string, error) {
+	var layout common.DorisTableLayout
+	if config.Layout != nil {
+		layout = *config.Layout
+	} else {
+		layout = dorisTableLayoutDefaults()
+	}
 
-	columns, reqId, err := s.CreateExternalTableFromAsset(assetName, chainInfoId, alias, jobInstanceId, targetTableName, targetDbName, columns...)
-	if err != nil {
-		return "", fmt.Errorf("failed to create external/internal table: %v", err)
+	columns := config.Columns
+	if layout.Model == common.DorisTableModelUnique && len(layout.KeyColumns) == 0 {
+		// 使用UUID生成5位随机字符串作为主键字段名的一部分
+		uuidStr := uuid.New().String()
+		// 取UUID的前5位字符（去掉连字符）
+		randomSuffix := strings.ReplaceAll(uuidStr[:8], "-", "")[:5]
+		primaryKeyFieldName := fmt.Sprintf("pk_%s", randomSuffix)
+		columns = append([]common.ColumnInfo{{Name: primaryKeyFieldName, DataType: "BIGINT"}}, columns...)
+		layout.KeyColumns = []string{primaryKeyFieldName}
+		layout.AutoIncrementColumn = primaryKeyFieldName
 	}
+	return buildDorisCreateTableSQL(config.TableName, columns, &layout)
+}
 
-	// 构建资源名称
-	resourceName := fmt.Sprintf("%s_resource", jobInstanceId)
+// internalColumnDef 内部表的列定义
+func internalColumnDef(col common.ColumnInfo, dataType string) string {
+	columnDef := fmt.Sprintf("`%s` %s", col.Name, dataType)
+	if !col.Nullable {
+		columnDef += " NOT NULL"
+	}
+	if col.Default != "" {
+		columnDef += fmt.Sprintf(" DEFAULT %s", col.Default)
+	}
+	if col.Comment != "" {
+		comment, _ := database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS).QuoteLiteral(col.Comment)
+		columnDef += " COMMENT " + comment
+	}
+	return columnDef
+}
 
-	// 使用defer确保函数结束时删除resource
-	defer func() {
-		if err := s.dropExternalResource(resourceName); err != nil {
-			log.Logger.Warnf("Failed to cleanup resource '%s': %v", resourceName, err)
-		} else {
-			log.Logger.Infof("Successfully cleaned up resource: %s", resourceName)
-		}
+// dorisKeyColumnType 键列的类型，STRING 不能作为键列时改用最长的 VARCHAR
+func dorisKeyColumnType(dataType string) string {
+	if database.ParseNativeType(dataType).Name == "STRING" {
+		return database.DorisKeyColumnType(arrow.BinaryTypes.String)
+	}
+	return dataType
+}
 
-		// 再删 TLS 证书 FILE（关键收尾）
-		db := targetDbName
-		if db == "" {
-			log.Logger.Warn("Unable to determine current database for TLS file cleanup")
-			return
-		}
+// importFromCatalog 执行从 Catalog 读取源表的导入语句
+// 源表新建或结构变化后 Catalog 缓存的元数据可能过期，此时刷新 Catalog 后重试一次。
+func (s *DorisService) importFromCatalog(assetInfo *AssetInfo, insertSQL string) (int64, error) {
+	rows, err := s.ExecuteUpdate(insertSQL)
+	if err == nil || !isStaleCatalogError(err) {
+		return rows, err
+	}
+	log.Logger.Warnf("Import from catalog %s failed, refreshing catalog and retrying: %v", assetInfo.Catalog, err)
+	if refreshErr := GetJdbcCatalogManager().Refresh(assetInfo.Catalog); refreshErr != nil {
+		return 0, fmt.Errorf("%v (refresh catalog: %v)", err, refreshErr)
+	}
+	return s.ExecuteUpdate(insertSQL)
+}
 
-		// 获取数据库类型（需要从 connInfo 获取）
-		connInfo, err := utils.GetDatasourceByAssetName(reqId, assetName, chainInfoId, alias)
-		if err != nil {
-			log.Logger.Warnf("Failed to get datasource info for cleanup: %v", err)
-			return
-		}
-		// 根据数据库类型判断是否使用 TLS 并清理对应文件
-		// 检查是否使用 TLS 并清理
-		if connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 && s.isTlsUsed(db, reqId) {
-			s.cleanupTlsFiles(db, reqId, connInfo.Dbtype)
-		}
-	}()
+// CreateExternalAndInternalTableAndImportData 根据数据资产创建内部表，并从数据源的 JDBC Catalog 导入数据
+func (s *DorisService) CreateExternalAndInternalTableAndImportData(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, layout *common.DorisTableLayout, columns ...string) (string, error) {
 
-	// 2. 构造表名
+	columns, assetInfo, err := s.createAssetTables(assetName, chainInfoId, alias, targetTableName, targetDbName, assetTableOptions{layout: layout}, columns...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create internal table: %v", err)
+	}
+	defer assetInfo.release()
 
-	// 构建外部表配置，表名加external_前缀和随机后缀
-	externalTableName := fmt.Sprintf("%s_external", jobInstanceId)
 	internalTableName := targetTableName
-
-	var qualifiedInternalTable, qualifiedExternalTable string
+	qualifiedInternalTable := internalTableName
 	if targetDbName != "" {
 		qualifiedInternalTable = fmt.Sprintf("`%s`.`%s`", targetDbName, internalTableName)
-		qualifiedExternalTable = fmt.Sprintf("`%s`.`%s`", targetDbName, externalTableName)
-	} else {
-		qualifiedInternalTable = internalTableName
-		qualifiedExternalTable = externalTableName
 	}
 
-	// 3. 执行数据导入
+	// 执行数据导入
 	columnList := strings.Join(columns, ", ")
 	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s",
-		qualifiedInternalTable, columnList, columnList, qualifiedExternalTable)
-	_, err = s.ExecuteUpdate(insertSQL)
-	if err != nil {
-		assetInfo, assetErr := s.getAssetInfo(assetName, chainInfoId, alias, targetDbName)
-		if assetErr == nil {
-			isConnError := LogSourceConnectionError(err, SourceConnLogCtx{
-				TableType:   assetInfo.TableType,
-				JdbcURL:     assetInfo.JdbcURL,
-				DriverURL:   assetInfo.DriverURL,
-				DriverClass: assetInfo.DriverClass,
-				Username:    assetInfo.Username,
-			})
-
-			if isConnError {
-				return "", fmt.Errorf("failed to import data from external to internal table (connection error): %v", err)
-			}
+		qualifiedInternalTable, columnList, columnList, assetInfo.SourceTable())
+	if _, err := s.importFromCatalog(assetInfo, insertSQL); err != nil {
+		isConnError := LogSourceConnectionError(err, SourceConnLogCtx{
+			TableType:   assetInfo.TableType,
+			DriverURL:   assetInfo.DriverURL,
+			DriverClass: assetInfo.DriverClass,
+			Username:    assetInfo.Username,
+		})
+		if isConnError {
+			return "", fmt.Errorf("failed to import data from catalog %s (connection error): %v", assetInfo.Catalog, err)
 		}
-		return "", fmt.Errorf("failed to import data from external to internal table: %v", err)
+		return "", fmt.Errorf("failed to import data from catalog %s: %v", assetInfo.Catalog, err)
 	}
 
+	log.Logger.Infof("Imported asset %s into %s for jobInstanceId: %s", assetName, qualifiedInternalTable, jobInstanceId)
 	return internalTableName, nil
 }
 
 // CreateExternalAndInternalTableAndImportDataBatched
-// 1) 创建外部/内部表
-// 2) 在 Doris 上对外部表查询 MIN/MAX(pk)
-// 3) 以 pk 的数值区间按 batchSize 批量 INSERT INTO ... SELECT ... WHERE pk BETWEEN ? AND ?
+// 1) 创建内部表并取得数据源的 JDBC Catalog
+// 2) 直接连接源数据库查询 MIN/MAX(pk)
+// 3) 以 pk 的数值区间按 batchSize 批量 INSERT INTO ... SELECT ... FROM catalog.db.table WHERE pk BETWEEN ? AND ?
 // 4) 每个批次失败自动重试（默认 3 次）
 // 返回内部表表名
 func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
@@ -1050,6 +1141,7 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 	jobInstanceId string,
 	targetTableName string,
 	targetDbName string,
+	layout *common.DorisTableLayout,
 	pkColumn string,
 	batchSize int,
 	columns ...string,
@@ -1065,40 +1157,17 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 		}
 	}
 
-	columns, reqId, err := s.CreateExternalTableFromAsset(assetName, chainInfoId, alias, jobInstanceId, targetTableName, targetDbName, columns...)
+	columns, assetInfo, err := s.createAssetTables(assetName, chainInfoId, alias, targetTableName, targetDbName, assetTableOptions{layout: layout}, columns...)
 	if err != nil {
-		return "", fmt.Errorf("failed to create external/internal table: %v", err)
+		return "", fmt.Errorf("failed to create internal table: %v", err)
 	}
+	defer assetInfo.release()
 
-	// 与非分批方法相同的资源清理逻辑
-	resourceName := fmt.Sprintf("%s_resource", jobInstanceId)
-	defer func() {
-		if err := s.dropExternalResource(resourceName); err != nil {
-			log.Logger.Warnf("Failed to cleanup resource '%s': %v", resourceName, err)
-		} else {
-			log.Logger.Infof("Successfully cleaned up resource: %s", resourceName)
-		}
-
-		db := targetDbName
-		if db == "" {
-			log.Logger.Warn("Unable to determine current database for TLS file cleanup")
-			return
-		}
-		connInfo, err := utils.GetDatasourceByAssetName(reqId, assetName, chainInfoId, alias)
-		if err != nil {
-			log.Logger.Warnf("Failed to get datasource info for cleanup: %v", err)
-			return
-		}
-		if connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 && s.isTlsUsed(db, reqId) {
-			s.cleanupTlsFiles(db, reqId, connInfo.Dbtype)
-		}
-	}()
-
-	externalTableName := fmt.Sprintf("%s_external", jobInstanceId)
+	sourceTable := assetInfo.SourceTable()
 	internalTableName := targetTableName
 
 	// 计算 MIN/MAX(pk) - 直接连接源数据库查询
-	connInfo, err := utils.GetDatasourceByAssetName(reqId, assetName, chainInfoId, alias)
+	connInfo, err := utils.GetDatasourceByAssetName(assetInfo.RequestId, assetName, chainInfoId, alias)
 	if err != nil {
 		return "", fmt.Errorf("failed to get datasource info: %v", err)
 	}
@@ -1120,9 +1189,14 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 	// 根据数据库类型选择正确的标识符引用方式
 	var quotedPk string
 	if dbType == ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE ||
-		dbType == ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE {
-		// Vastbase 和 Kingbase 不加引号
+		dbType == ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE ||
+		dbType == ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL ||
+		dbType == ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS {
+		// Vastbase、Kingbase 和 PostgreSQL/openGauss 不加引号
 		quotedPk = pkColumn
+	} else if dbType == ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG {
+		// 达梦使用双引号，列名需与数据字典大小写一致
+		quotedPk = fmt.Sprintf("\"%s\"", pkColumn)
 	} else {
 		// MySQL 等其他数据库使用反引号
 		quotedPk = fmt.Sprintf("`%s`", pkColumn)
@@ -1157,8 +1231,8 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 	if !minID.Valid || !maxID.Valid {
 		// 值无效，退化为一次性导入
 		columnList := strings.Join(columns, ", ")
-		insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", internalTableName, columnList, columnList, externalTableName)
-		if _, err := s.ExecuteUpdate(insertSQL); err != nil {
+		insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", internalTableName, columnList, columnList, sourceTable)
+		if _, err := s.importFromCatalog(assetInfo, insertSQL); err != nil {
 			return "", fmt.Errorf("failed to import (fallback single insert): %v", err)
 		}
 		return internalTableName, nil
@@ -1171,16 +1245,20 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 		maxRetry = 3
 	}
 	for start := minID.Int64; start <= maxID.Int64; start += int64(batchSize) {
+		// 作业被取消后不再导入剩余的批次
+		if err := s.queryContext().Err(); err != nil {
+			return "", fmt.Errorf("batched import cancelled before range [%d, %d]: %w", start, maxID.Int64, err)
+		}
 		end := start + int64(batchSize) - 1
 		insertSQL := fmt.Sprintf(
 			"INSERT INTO %s (%s) SELECT %s FROM %s WHERE %s BETWEEN %d AND %d",
-			internalTableName, columnList, columnList, externalTableName, quotedPk, start, end,
+			internalTableName, columnList, columnList, sourceTable, quotedPk, start, end,
 		)
 
 		var lastErr error
 		for attempt := 1; attempt <= maxRetry; attempt++ {
-			_, lastErr = s.ExecuteUpdate(insertSQL)
-			if lastErr == nil {
+			_, lastErr = s.importFromCatalog(assetInfo, insertSQL)
+			if lastErr == nil || s.queryContext().Err() != nil {
 				break
 			}
 			log.Logger.Warnf("Batch import failed (attempt %d/%d) for range [%d, %d]: %v", attempt, maxRetry, start, end, lastErr)
@@ -1194,27 +1272,8 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 }
 
 // ImportArrowFileToDoris 导入Arrow格式文件到Doris
+// 直接从对象存储按需读取 Arrow 文件，按配置的并发数切分批次后通过 Stream Load 导入，不落盘
 func (s *DorisService) ImportArrowFileToDoris(bucketName string, objectName string, tableName string, dbName string) error {
-	// 使用项目中定义的DATA_DIR常量
-	dataDir := common.DATA_DIR
-
-	// 生成唯一的文件名
-	fileName := fmt.Sprintf("arrow_import_%s.arrow", uuid.New().String())
-	filePath := filepath.Join(dataDir, fileName)
-
-	// 创建文件
-	file, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %v", err)
-	}
-	defer func() {
-		file.Close()
-		// 使用完后删除文件
-		if err := os.Remove(filePath); err != nil {
-			log.Logger.Warnf("Failed to remove file %s: %v", filePath, err)
-		}
-	}()
-
 	// 获取OSS客户端
 	ossFactory := oss.NewOSSFactory(config.GetConfigMap())
 	ossClient, err := ossFactory.NewOSSClient()
@@ -1222,87 +1281,54 @@ func (s *DorisService) ImportArrowFileToDoris(bucketName string, objectName stri
 		return fmt.Errorf("failed to create OSS client: %v", err)
 	}
 
-	// 从Minio下载文件
+	// 从Minio读取文件，Arrow 文件的 schema 与批次索引在文件末尾，需要随机读取
 	object, err := ossClient.GetObject(context.Background(), bucketName, objectName, &oss.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get object from MinIO: %v", err)
 	}
 	defer object.Close()
-
-	// 将文件内容写入data目录下的文件
-	if _, err := io.Copy(file, object); err != nil {
-		return fmt.Errorf("failed to write object to file: %v", err)
-	}
-
-	// 关闭文件以确保内容写入磁盘
-	if err := file.Close(); err != nil {
-		return fmt.Errorf("failed to close file: %v", err)
+	file, ok := object.(ipc.ReadAtSeeker)
+	if !ok {
+		return fmt.Errorf("object %s/%s does not support random access", bucketName, objectName)
 	}
-
-	// 读取Arrow文件并获取schema
-	schema, err := s.readArrowFileSchema(filePath)
+	reader, err := ipc.NewFileReader(file)
 	if err != nil {
-		return fmt.Errorf("failed to read Arrow file schema: %v", err)
+		return fmt.Errorf("failed to create Arrow file reader: %v", err)
 	}
+	defer reader.Close()
+
+	schema := reader.Schema()
+	log.Logger.Infof("Read Arrow schema with %d fields", len(schema.Fields()))
 
 	// 创建Doris表（如果不存在）
 	if err := s.createDorisTableFromArrowSchema(dbName, tableName, schema); err != nil {
 		return fmt.Errorf("failed to create Doris table: %v", err)
 	}
 
-	// 使用doris-streamloader导入Arrow文件
-	if err := s.importArrowFileWithStreamloader(filePath, dbName, tableName); err != nil {
+	client := clients.NewStreamLoadClientFromConfig()
+	result, err := client.LoadParallel(context.Background(), clients.StreamLoadRequest{
+		DbName:    dbName,
+		TableName: tableName,
+		Format:    clients.StreamLoadFormatArrow,
+	}, clients.SplitRecords(reader, client.Parallelism))
+	if err != nil {
 		return fmt.Errorf("failed to import Arrow file: %v", err)
 	}
 
-	log.Logger.Infof("Successfully imported Arrow file to Doris table %s.%s", dbName, tableName)
+	log.Logger.Infof("Successfully imported Arrow file to Doris table %s.%s, loaded rows: %d",
+		dbName, tableName, result.NumberLoadedRows)
 	return nil
 }
 
-// readArrowFileSchema 读取Arrow文件的schema
-func (s *DorisService) readArrowFileSchema(filePath string) (*arrow.Schema, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open Arrow file: %v", err)
-	}
-	defer file.Close()
-
-	// 使用Arrow的FileReader读取文件
-	reader, err := ipc.NewFileReader(file, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Arrow file reader: %v", err)
-	}
-
-	schema := reader.Schema()
-	log.Logger.Infof("Read Arrow schema with %d fields", len(schema.Fields()))
-	return schema, nil
-}
-
-// createDorisTableFromArrowSchema 根据Arrow schema创建Doris表
+// createDorisTableFromArrowSchema 根据Arrow schema创建Doris明细表，分桶数与副本数使用配置的默认值
 func (s *DorisService) createDorisTableFromArrowSchema(dbName, tableName string, schema *arrow.Schema) error {
-	// 构建列定义
-	var columnDefs []string
-	for _, field := range schema.Fields() {
-		dorisType := s.convertArrowTypeToDorisType(field.Type)
-		columnDef := fmt.Sprintf("`%s` %s", field.Name, dorisType)
-		columnDefs = append(columnDefs, columnDef)
+	createTableSQL, err := arrowTableSQL(dbName, tableName, schema, dorisTableLayoutDefaults())
+	if err != nil {
+		return fmt.Errorf("failed to build Doris table for arrow schema: %v", err)
 	}
 
-	// 构建CREATE TABLE SQL
-	createTableSQL := fmt.Sprintf(`
-		CREATE TABLE IF NOT EXISTS %s.%s (
-			%s
-		)
-		ENGINE=OLAP
-		DISTRIBUTED BY HASH(`+"`%s`"+`) BUCKETS AUTO
-		PROPERTIES (
-			"replication_num" = "1"
-		)
-	`, dbName, tableName, strings.Join(columnDefs, ",\n\t\t"), schema.Field(0).Name)
-
 	// 执行CREATE TABLE
-	_, err := s.ExecuteUpdate(createTableSQL)
-	if err != nil {
+	if _, err := s.ExecuteUpdate(createTableSQL); err != nil {
 		return fmt.Errorf("failed to create Doris table: %v", err)
 	}
 
@@ -1310,111 +1336,30 @@ func (s *DorisService) createDorisTableFromArrowSchema(dbName, tableName string,
 	return nil
 }
 
-// convertArrowTypeToDorisType 将Arrow数据类型转换为Doris数据类型
-func (s *DorisService) convertArrowTypeToDorisType(arrowType arrow.DataType) string {
-	switch arrowType.ID() {
-	case arrow.INT8:
-		return "TINYINT"
-	case arrow.INT16:
-		return "SMALLINT"
-	case arrow.INT32:
-		return "INT"
-	case arrow.INT64:
-		return "BIGINT"
-	case arrow.UINT8:
-		return "TINYINT UNSIGNED"
-	case arrow.UINT16:
-		return "SMALLINT UNSIGNED"
-	case arrow.UINT32:
-		return "INT UNSIGNED"
-	case arrow.UINT64:
-		return "BIGINT UNSIGNED"
-	case arrow.FLOAT32:
-		return "FLOAT"
-	case arrow.FLOAT64:
-		return "DOUBLE"
-	case arrow.STRING:
-		return "VARCHAR(65533)"
-	case arrow.LARGE_STRING:
-		return "VARCHAR(65533)"
-	case arrow.BOOL:
-		return "BOOLEAN"
-	case arrow.DATE32:
-		return "DATE"
-	case arrow.DATE64:
-		return "DATETIME"
-	case arrow.TIMESTAMP:
-		return "DATETIME"
-	case arrow.DECIMAL128:
-		decimalType := arrowType.(*arrow.Decimal128Type)
-		return fmt.Sprintf("DECIMAL(%d,%d)", decimalType.Precision, decimalType.Scale)
-	case arrow.DECIMAL256:
-		decimalType := arrowType.(*arrow.Decimal256Type)
-		return fmt.Sprintf("DECIMAL(%d,%d)", decimalType.Precision, decimalType.Scale)
-	default:
-		return "VARCHAR(65533)" // 默认使用VARCHAR
+// arrowTableSQL 生成 Arrow 文件导入目标表的建表语句
+// 文件中没有键的信息，总是建为以首列排序、随机分桶的明细表，避免按首列分桶时数据倾斜。
+func arrowTableSQL(dbName, tableName string, schema *arrow.Schema, defaults common.DorisTableLayout) (string, error) {
+	dorisDialect := database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+	columns := make([]common.ColumnInfo, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		dorisType := dorisDialect.ColumnType(field.Type)
+		if len(columns) == 0 {
+			// 首列作为排序列，不能使用 STRING
+			dorisType = database.DorisKeyColumnType(field.Type)
+		}
+		columns = append(columns, common.ColumnInfo{Name: field.Name, DataType: dorisType, Nullable: true})
 	}
-}
-
-// importArrowFileWithStreamloader 使用doris-streamloader导入Arrow文件
-func (s *DorisService) importArrowFileWithStreamloader(filePath, dbName, tableName string) error {
-	// 构建doris-streamloader命令参数
-	config := config.GetConfigMap()
-	args := []string{
-		"--source_file", filePath,
-		"--u", config.DorisConfig.User,
-		"--p", config.DorisConfig.Password,
-		"--url", fmt.Sprintf("http://%s:%d", config.DorisConfig.Address, config.DorisConfig.Port),
-		"--db", dbName,
-		"--table", tableName,
-		"--workers", "0", // 0表示自动选择最佳线程数
-		"--format", "arrow", // 指定格式为Arrow
+	layout := common.DorisTableLayout{
+		Model:          common.DorisTableModelDuplicate,
+		Buckets:        defaults.Buckets,
+		ReplicationNum: defaults.ReplicationNum,
 	}
-
-	// 执行doris-streamloader命令
-	cmd := exec.Command("/home/workspace/bin/doris-streamloader", args...)
-
-	log.Logger.Infof("Executing doris-streamloader command for Arrow file: %s %s",
-		"/home/workspace/bin/doris-streamloader",
-		strings.Join(args, " "))
-
-	// 捕获输出
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	// 执行命令
-	err := cmd.Run()
-	if err != nil {
-		return fmt.Errorf("doris-streamloader failed: %v, stderr: %s", err, stderr.String())
-	}
-
-	log.Logger.Infof("doris-streamloader output: %s", stdout.String())
-	return nil
+	return buildDorisCreateTableSQL(dorisDialect.QualifyTableName(dbName, tableName), columns, &layout)
 }
 
-// ImportCsvFileToDoris 使用doris-streamloader导入CSV文件到Doris
+// ImportCsvFileToDoris 通过 Stream Load 导入CSV文件到Doris
+// 直接从对象存储流式读取，按配置的并发数按行切分后并发导入，请求带标签时相同标签的导入只会成功一次
 func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequest) error {
-	// 使用项目中定义的DATA_DIR常量
-	dataDir := common.DATA_DIR
-
-	// 生成唯一的文件名
-	fileName := fmt.Sprintf("csv_import_%s.csv", uuid.New().String())
-	filePath := filepath.Join(dataDir, fileName)
-
-	// 创建文件
-	file, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %v", err)
-	}
-	defer func() {
-		file.Close()
-		// 使用完后删除文件
-		if err := os.Remove(filePath); err != nil {
-			log.Logger.Warnf("Failed to remove file %s: %v", filePath, err)
-		}
-	}()
-
 	// 获取OSS客户端
 	ossFactory := oss.NewOSSFactory(config.GetConfigMap())
 	ossClient, err := ossFactory.NewOSSClient()
@@ -1422,70 +1367,27 @@ func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequ
 		return fmt.Errorf("failed to create OSS client: %v", err)
 	}
 
-	// 从Minio下载文件
+	// 从Minio读取文件
 	object, err := ossClient.GetObject(context.Background(), request.BucketName, request.ObjectName, &oss.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get object from MinIO: %v", err)
 	}
 	defer object.Close()
 
-	// 将文件内容写入data目录下的文件
-	if _, err := io.Copy(file, object); err != nil {
-		return fmt.Errorf("failed to write object to file: %v", err)
-	}
-
-	// 关闭文件以确保内容写入磁盘
-	if err := file.Close(); err != nil {
-		return fmt.Errorf("failed to close file: %v", err)
-	}
-
-	// 构建doris-streamloader命令参数
-	config := config.GetConfigMap()
-	args := []string{
-		"--source_file", filePath,
-		"--u", config.DorisConfig.User,
-		"--p", config.DorisConfig.Password,
-		"--url", fmt.Sprintf("http://%s:%d", config.DorisConfig.Address, config.DorisConfig.Port),
-		"--db", request.DbName,
-		"--table", request.TableName,
-		"--workers", "0", // 0表示自动选择最佳线程数
-	}
-
-	// 构建header参数
-	headerParts := []string{}
-	if request.ColumnSeparator != "" {
-		headerParts = append(headerParts, fmt.Sprintf("column_separator:%s", request.ColumnSeparator))
-	}
-	if len(request.Columns) > 0 {
-		headerParts = append(headerParts, fmt.Sprintf("columns:%s", strings.Join(request.Columns, ",")))
-	}
-	if len(headerParts) > 0 {
-		args = append(args, "--header", strings.Join(headerParts, "?"))
-	}
-
-	// 根据操作系统架构选择正确的doris-streamloader路径
-	streamloaderPath := common.GetDorisStreamloaderPath()
-	cmd := exec.Command(streamloaderPath, args...)
-
-	log.Logger.Infof("Executing doris-streamloader command: %s %s",
-		streamloaderPath,
-		strings.Join(args, " "))
-
-	// 捕获输出
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	// 执行命令
-	err = cmd.Run()
+	client := clients.NewStreamLoadClientFromConfig()
+	result, err := client.LoadParallel(context.Background(), clients.StreamLoadRequest{
+		DbName:          request.DbName,
+		TableName:       request.TableName,
+		Label:           request.Label,
+		Format:          clients.StreamLoadFormatCSV,
+		Columns:         request.Columns,
+		ColumnSeparator: request.ColumnSeparator,
+	}, clients.SplitLines(object, client.Parallelism, 0))
 	if err != nil {
-		return fmt.Errorf("doris-streamloader failed: %v, stderr: %s", err, stderr.String())
-	}
-
-	log.Logger.Infof("doris-streamloader output: %s", stdout.String())
-	if err := checkStreamloaderOutput(stdout.String()); err != nil {
 		return err
 	}
+	log.Logger.Infof("Imported CSV file %s/%s into %s.%s, loaded rows: %d",
+		request.BucketName, request.ObjectName, request.DbName, request.TableName, result.NumberLoadedRows)
 	return nil
 }
 
@@ -1547,14 +1449,19 @@ func (s *DorisService) ExportParquetFileFromDoris(request *ds.ExportCsvFileFromD
 	conf := config.GetConfigMap()
 	sqlGenerator := &database.SQLGenerator{}
 
-	// 统一使用 SELECT ... INTO OUTFILE
-	exportSQL := sqlGenerator.BuildSelectIntoOutfileSQL(request, conf)
+	// 统一使用 SELECT ... INTO OUTFILE，每次导出的文件名带随机标记，取消时只删除本次导出的文件
+	objectPrefix := database.ExportObjectPrefix(request.JobInstanceId, strings.ReplaceAll(uuid.New().String(), "-", "")[:8])
+	exportSQL, err := sqlGenerator.BuildSelectIntoOutfileSQLWithPrefix(request, conf, objectPrefix)
+	if err != nil {
+		return fmt.Errorf("failed to build export SQL: %v", err)
+	}
+	JobProgressFromContext(s.queryContext()).AddCreatedObjects(objectPrefix)
 	log.Logger.Infof("Executing export SQL: %s", exportSQL)
 
 	// Execute with retry mechanism
 	maxRetries := 5
 	baseDelay := 1 * time.Second
-	err := utils.WithRetry(maxRetries, baseDelay, func() error {
+	err = utils.WithRetry(maxRetries, baseDelay, func() error {
 		_, _, err := s.ExecuteSQL(exportSQL)
 		return err
 	}, utils.IsRetryableNetErr)
@@ -1567,32 +1474,32 @@ func (s *DorisService) ExportParquetFileFromDoris(request *ds.ExportCsvFileFromD
 	return nil
 }
 
-// ExportDorisDataToMiraDB 导出Doris数据到Mira数据库
-func (s *DorisService) ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMiraDBRequest) error {
+// ExportDorisDataToMiraDB 导出Doris数据到Mira数据库，导出后与源表对账，对账不一致时同时返回对账结果和错误
+func (s *DorisService) ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMiraDBRequest) (*ds.ReconcileReport, error) {
 	// 1. 在mira_tmp_task数据库中创建中间表
 	if request == nil {
-		return fmt.Errorf("request cannot be nil")
+		return nil, fmt.Errorf("request cannot be nil")
 	}
 
 	schema, err := s.ConvertRequestToArrowSchema(request)
 	if err != nil {
-		return fmt.Errorf("failed to convert request to Arrow schema: %v", err)
+		return nil, fmt.Errorf("failed to convert request to Arrow schema: %v", err)
 	}
 
 	log.Logger.Infof("Successfully converted request to Arrow schema for table: %s", request.TableName)
 
 	connInfo, dbType, err := s.buildDeployedDBConnectionInfo(common.MIRA_TMP_TASK_DB)
 	if err != nil {
-		return fmt.Errorf("failed to build connection info: %v", err)
+		return nil, fmt.Errorf("failed to build connection info: %v", err)
 	}
 
 	if err := s.createTableByDeployedDBType(request.TableName, schema, connInfo, dbType); err != nil {
-		return fmt.Errorf("failed to create table: %v", err)
+		return nil, fmt.Errorf("failed to create table: %v", err)
 	}
 
 	resourceName, err := GetGlobalResourceName()
 	if err != nil {
-		return fmt.Errorf("failed to get global resource name: %v", err)
+		return nil, fmt.Errorf("failed to get global resource name: %v", err)
 	}
 
 	columnInfos := s.convertColumnsToColumnInfo(request.Columns)
@@ -1609,7 +1516,7 @@ func (s *DorisService) ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMira
 
 	// 创建外表
 	if err := s.createExternalTable(config); err != nil {
-		return fmt.Errorf("failed to create external table: %v", err)
+		return nil, fmt.Errorf("failed to create external table: %v", err)
 	}
 
 	columnList := s.buildColumnList(request.Columns)
@@ -1619,10 +1526,14 @@ func (s *DorisService) ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMira
 		request.TableName+"_"+uuidStr+"_external", columnList, columnList, request.SourceTableName)
 	_, err = s.ExecuteUpdate(insertSQL)
 	if err != nil {
-		return fmt.Errorf("failed to insert data from external to internal table: %v", err)
+		return nil, fmt.Errorf("failed to insert data from external to internal table: %v", err)
 	}
 
-	return nil
+	report, err := s.reconcileExport(request, connInfo, dbType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile exported data: %v", err)
+	}
+	return report, database.ReconcileError(report)
 }
 
 func (s *DorisService) ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDorisRequest) (string, error) {
@@ -1659,14 +1570,16 @@ func (s *DorisService) ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDor
 		request.JobInstanceId+"_"+request.MiraTableName,
 	)
 
-	if err := s.createExternalTable(config); err != nil {
+	err = s.createTmpTableForJob(externalTableName, func() error { return s.createExternalTable(config) })
+	if err != nil {
 		return "", fmt.Errorf("failed to create external table: %v", err)
 	}
 
 	// 创建内部表
 	internalConfig := config
 	internalConfig.TableName = internalTableName
-	if err := s.createInternalTable(internalConfig); err != nil {
+	err = s.createTmpTableForJob(internalTableName, func() error { return s.createInternalTable(internalConfig) })
+	if err != nil {
 		return "", fmt.Errorf("failed to create internal table: %v", err)
 	}
 
@@ -1683,6 +1596,26 @@ func (s *DorisService) ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDor
 	return internalTableName, nil
 }
 
+// createTmpTableForJob 执行 create 在临时库中建表，异步作业中表原本不存在时记录为作业创建的表
+// 建表语句带 IF NOT EXISTS，之前执行留下的同名表不记录，作业取消时不会被删除；同步调用时不额外检查。
+func (s *DorisService) createTmpTableForJob(tableName string, create func() error) error {
+	progress := JobProgressFromContext(s.queryContext())
+	exists := true
+	if progress != nil {
+		var err error
+		if exists, err = s.dbStrategy.CheckTableExists(s.queryContext(), tableName); err != nil {
+			return fmt.Errorf("failed to check table '%s': %v", tableName, err)
+		}
+	}
+	if err := create(); err != nil {
+		return err
+	}
+	if !exists {
+		progress.AddCreatedTable(common.MIRA_TMP_TASK_DB, tableName)
+	}
+	return nil
+}
+
 // createTableByDeployedDBType 根据部署的数据库类型创建表
 func (s *DorisService) createTableByDeployedDBType(tableName string, schema *arrow.Schema, connInfo *ds.ConnectionInfo, dbType ds.DataSourceType) error {
 
@@ -1698,7 +1631,7 @@ func (s *DorisService) createTableByDeployedDBType(tableName string, schema *arr
 	}
 
 	// 使用CreateTemporaryTableIfNotExists创建表
-	if err := dbStrategy.CreateTemporaryTableIfNotExists(tableName, schema); err != nil {
+	if err := dbStrategy.CreateTemporaryTableIfNotExists(context.Background(), tableName, schema); err != nil {
 		return fmt.Errorf("failed to create table '%s': %v", tableName, err)
 	}
 
@@ -1733,14 +1666,9 @@ func (s *DorisService) ConvertRequestToArrowSchema(request *ds.ExportDorisDataTo
 		}
 
 		// 将数据库数据类型转换为Arrow数据类型
-		arrowType, err := s.convertDataTypeToArrowType(column.DataType)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert data type for column '%s': %v", column.Name, err)
-		}
-
 		field := arrow.Field{
 			Name: column.Name,
-			Type: arrowType,
+			Type: s.convertDataTypeToArrowType(column.DataType),
 		}
 
 		fields = append(fields, field)
@@ -1752,98 +1680,10 @@ func (s *DorisService) ConvertRequestToArrowSchema(request *ds.ExportDorisDataTo
 	return schema, nil
 }
 
-func (s *DorisService) convertDataTypeToArrowType(dataType string) (arrow.DataType, error) {
-	// 获取系统配置以确定数据库类型
-	conf := config.GetConfigMap()
-	dbTypeName := conf.Dbms.Type
-	dbType := utils.ConvertDBType(dbTypeName)
-
-	// 根据数据库类型调用相应的转换函数
-	switch dbType {
-	case ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
-		ds.DataSourceType_DATA_SOURCE_TYPE_TDSQL,
-		ds.DataSourceType_DATA_SOURCE_TYPE_TIDB:
-		return common.ConvertDorisTypeToArrowType(dataType)
-	case ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE:
-		return s.convertKingbaseTypeToArrowType(dataType)
-	default:
-		// 默认使用MySQL的转换逻辑
-		return common.ConvertDorisTypeToArrowType(dataType)
-	}
-}
-
-// convertKingbaseTypeToArrowType 复用Kingbase的类型转换逻辑
-func (s *DorisService) convertKingbaseTypeToArrowType(dataType string) (arrow.DataType, error) {
-	// 转换为大写以匹配kingbaseTypeToArrowType的逻辑
-	dbTypeName := strings.ToUpper(strings.TrimSpace(dataType))
-
-	// 处理浮点数类型
-	if strings.Contains(dbTypeName, "FLOAT") || strings.Contains(dbTypeName, "DOUBLE") {
-		return arrow.PrimitiveTypes.Float64, nil
-	}
-
-	if dbTypeName == "REAL" {
-		return arrow.PrimitiveTypes.Float32, nil
-	}
-
-	if strings.Contains(dbTypeName, "DECIMAL") || strings.Contains(dbTypeName, "NUMERIC") {
-		// 使用默认的精度和标度
-		return &arrow.Decimal128Type{Precision: 38, Scale: 10}, nil
-	}
-
-	// 处理 INT 和无符号类型
-	switch {
-	case strings.Contains(dbTypeName, "TINYINT"):
-		return arrow.PrimitiveTypes.Int8, nil
-	case strings.Contains(dbTypeName, "SMALLINT"):
-		return arrow.PrimitiveTypes.Int16, nil
-	case strings.Contains(dbTypeName, "MEDIUMINT"):
-		return arrow.PrimitiveTypes.Int32, nil
-	case strings.Contains(dbTypeName, "BIGINT"):
-		return arrow.PrimitiveTypes.Int64, nil
-	case strings.Contains(dbTypeName, "INT"), dbTypeName == "INTEGER":
-		return arrow.PrimitiveTypes.Int32, nil
-	case strings.Contains(dbTypeName, "UNSIGNED TINYINT"):
-		return arrow.PrimitiveTypes.Uint8, nil
-	case strings.Contains(dbTypeName, "UNSIGNED SMALLINT"):
-		return arrow.PrimitiveTypes.Uint16, nil
-	case strings.Contains(dbTypeName, "UNSIGNED MEDIUMINT"), strings.Contains(dbTypeName, "UNSIGNED INT"):
-		return arrow.PrimitiveTypes.Uint32, nil
-	case strings.Contains(dbTypeName, "UNSIGNED BIGINT"):
-		return arrow.PrimitiveTypes.Uint64, nil
-	}
-
-	// 处理 CHAR 或 TEXT 类型
-	if strings.Contains(dbTypeName, "CHAR") {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	if strings.Contains(dbTypeName, "TEXT") {
-		return arrow.BinaryTypes.LargeString, nil
-	}
-
-	// 处理 TIME 类型
-	if dbTypeName == "TIME" {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	// 处理 YEAR 类型
-	if dbTypeName == "YEAR" {
-		return arrow.PrimitiveTypes.Int64, nil
-	}
-
-	// 处理 DATE 类型
-	if dbTypeName == "DATE" {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	// 处理 TIMESTAMP 类型
-	if dbTypeName == "TIMESTAMP" {
-		return arrow.BinaryTypes.String, nil
-	}
-
-	// 处理其他类型
-	return arrow.BinaryTypes.String, nil
+// convertDataTypeToArrowType 按系统配置的数据库类型将字段类型转换为Arrow数据类型
+func (s *DorisService) convertDataTypeToArrowType(dataType string) arrow.DataType {
+	dbType := utils.ConvertDBType(config.GetConfigMap().Dbms.Type)
+	return database.ArrowType(dbType, database.ParseNativeType(dataType))
 }
 
 func GetGlobalResourceName() (string, error) {
@@ -1902,7 +1742,12 @@ func (s *DorisService) InitGlobalResource() error {
 
 // CheckResourceExists 检查资源是否存在
 func (s *DorisService) CheckResourceExists(resourceName string) (bool, error) {
-	query := fmt.Sprintf("SHOW RESOURCES WHERE NAME = '%s'", resourceName)
+	// SHOW 语句不支持占位符，资源名按字符串字面量转义
+	literal, err := database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS).QuoteLiteral(resourceName)
+	if err != nil {
+		return false, err
+	}
+	query := "SHOW RESOURCES WHERE NAME = " + literal
 	rows, done, err := s.ExecuteSQL(query)
 	if err != nil {
 		return false, fmt.Errorf("failed to query resource: %v", err)
@@ -2014,9 +1859,9 @@ func (s *DorisService) getMiraTableSchema(tableName string) ([]*ds.ColumnItem, e
 	case ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 		ds.DataSourceType_DATA_SOURCE_TYPE_TDSQL,
 		ds.DataSourceType_DATA_SOURCE_TYPE_TIDB:
-		tableInfo, err = dbStrategy.GetTableInfo(common.MIRA_TMP_TASK_DB, tableName, false)
+		tableInfo, err = dbStrategy.GetTableInfo(context.Background(), common.MIRA_TMP_TASK_DB, tableName, false)
 	case ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE:
-		tableInfo, err = dbStrategy.GetTableInfo("public", tableName, false)
+		tableInfo, err = dbStrategy.GetTableInfo(context.Background(), "public", tableName, false)
 	default:
 		return nil, fmt.Errorf("unsupported database type: %v", dbType)
 	}
@@ -2036,12 +1881,12 @@ func (s *DorisService) getMiraTableSchema(tableName string) ([]*ds.ColumnItem, e
 func (s *DorisService) CleanDorisTableWithPrefix(prefix string) error {
 
 	// 使用您提供的SQL模式生成DROP语句
-	query := fmt.Sprintf(`
+	query := `
 		SELECT CONCAT('DROP TABLE IF EXISTS ', table_name, ';') AS drop_command
 		FROM information_schema.tables
-		WHERE table_schema = '%s' AND table_name LIKE '%s%%'`, common.MIRA_TMP_TASK_DB, prefix)
+		WHERE table_schema = ? AND table_name LIKE ?`
 
-	rows, done, err := s.ExecuteSQL(query)
+	rows, done, err := s.ExecuteSQL(query, common.MIRA_TMP_TASK_DB, prefix+"%")
 	if err != nil {
 		return fmt.Errorf("failed to query drop commands: %v", err)
 	}
@@ -2171,14 +2016,14 @@ func (s *DorisService) GetDorisTableSchema(dbName, tableName string) ([]*ds.Colu
 	}
 
 	// 方法2: 如果DESCRIBE失败，使用information_schema
-	infoSchemaSQL := fmt.Sprintf(`
+	infoSchemaSQL := `
 		SELECT COLUMN_NAME, DATA_TYPE, IS_NULLABLE, COLUMN_DEFAULT, EXTRA
 		FROM information_schema.COLUMNS 
-		WHERE TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s'
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
 		ORDER BY ORDINAL_POSITION
-	`, dbName, tableName)
+	`
 
-	rows, done, err = s.ExecuteSQL(infoSchemaSQL)
+	rows, done, err = s.ExecuteSQL(infoSchemaSQL, dbName, tableName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query information_schema for table %s.%s: %v", dbName, tableName, err)
 	}
@@ -2212,165 +2057,9 @@ func (s *DorisService) GetDorisTableSchema(dbName, tableName string) ([]*ds.Colu
 	return columns, nil
 }
 
-func (s *DorisService) convertDatabaseTypeToDorisType(dataType string, dbType int32) string {
-	// 转换为大写以匹配类型
-	dbTypeName := strings.ToUpper(strings.TrimSpace(dataType))
-
-	// 根据数据库类型进行不同的处理
-	switch dbType {
-	case int32(ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE),
-		int32(ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE):
-		return s.convertKingbaseTypeToDorisType(dbTypeName)
-	case int32(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL),
-		int32(ds.DataSourceType_DATA_SOURCE_TYPE_TIDB),
-		int32(ds.DataSourceType_DATA_SOURCE_TYPE_TDSQL):
-		return s.convertMySQLTypeToDorisType(dbTypeName)
-	default:
-		// 默认使用MySQL的转换逻辑
-		return s.convertMySQLTypeToDorisType(dbTypeName)
-	}
-}
-
-// convertKingbaseTypeToDorisType 将Kingbase数据类型转换为Doris数据类型
-func (s *DorisService) convertKingbaseTypeToDorisType(dbTypeName string) string {
-	// 处理整数类型
-	switch {
-	case strings.Contains(dbTypeName, "INT8") || strings.Contains(dbTypeName, "BIGINT"):
-		return "BIGINT"
-	case strings.Contains(dbTypeName, "INT2") || strings.Contains(dbTypeName, "SMALLINT"):
-		return "SMALLINT"
-	case strings.Contains(dbTypeName, "INT4") || strings.Contains(dbTypeName, "INT") || dbTypeName == "INTEGER":
-		return "INT"
-	case strings.Contains(dbTypeName, "TINYINT"):
-		return "TINYINT"
-	}
-
-	// 处理数值类型
-	if strings.Contains(dbTypeName, "NUMERIC") || strings.Contains(dbTypeName, "DECIMAL") {
-		// 提取精度和标度信息
-		if strings.Contains(dbTypeName, "(") && strings.Contains(dbTypeName, ")") {
-			// 保持原有的精度和标度
-			return dbTypeName
-		}
-		// 默认精度和标度
-		return "DECIMAL(38,10)"
-	}
-
-	// 处理浮点数类型
-	// KingBase 的 float4 对应 Doris 的单精度浮点 FLOAT
-	if strings.Contains(dbTypeName, "FLOAT4") || dbTypeName == "FLOAT4" {
-		return "FLOAT"
-	}
-	// KingBase 的 float8 对应 Doris 的双精度浮点 DOUBLE
-	if strings.Contains(dbTypeName, "FLOAT8") || dbTypeName == "FLOAT8" {
-		return "DOUBLE"
-	}
-
-	if strings.Contains(dbTypeName, "FLOAT") || strings.Contains(dbTypeName, "DOUBLE") {
-		return "DOUBLE"
-	}
-
-	if dbTypeName == "REAL" {
-		return "FLOAT"
-	}
-
-	// 处理字符串类型
-	if strings.Contains(dbTypeName, "VARCHAR") || strings.Contains(dbTypeName, "CHAR") {
-		// 保持原有的长度信息
-		if strings.Contains(dbTypeName, "(") && strings.Contains(dbTypeName, ")") {
-			return dbTypeName
-		}
-		return "VARCHAR"
-	}
-
-	if strings.Contains(dbTypeName, "TEXT") {
-		return "VARCHAR"
-	}
-
-	// 处理时间类型
-
-	if dbTypeName == "DATE" {
-		return "DATE"
-	}
-
-	if dbTypeName == "TIMESTAMP" {
-		return "DATETIME"
-	}
-
-	// 处理布尔类型
-	if dbTypeName == "BOOLEAN" || dbTypeName == "BOOL" {
-		return "BOOLEAN"
-	}
-
-	// 默认返回字符串类型
-	return "VARCHAR"
-}
-
-// convertMySQLTypeToDorisType 将MySQL数据类型转换为Doris数据类型
-func (s *DorisService) convertMySQLTypeToDorisType(dbTypeName string) string {
-	// 处理整数类型
-	switch {
-	case strings.Contains(dbTypeName, "TINYINT"):
-		return "TINYINT"
-	case strings.Contains(dbTypeName, "SMALLINT"):
-		return "SMALLINT"
-	case strings.Contains(dbTypeName, "BIGINT"):
-		return "BIGINT"
-	case strings.Contains(dbTypeName, "MEDIUMINT") || strings.Contains(dbTypeName, "INT") || dbTypeName == "INTEGER":
-		return "INT"
-	}
-
-	// 处理数值类型
-	if strings.Contains(dbTypeName, "DECIMAL") || strings.Contains(dbTypeName, "NUMERIC") {
-		// 提取精度和标度信息
-		if strings.Contains(dbTypeName, "(") && strings.Contains(dbTypeName, ")") {
-			// 保持原有的精度和标度
-			return dbTypeName
-		}
-		// 默认精度和标度
-		return "DECIMAL(38,10)"
-	}
-
-	// 处理浮点数类型
-	if dbTypeName == "FLOAT" {
-		return "FLOAT"
-	}
-
-	if dbTypeName == "DOUBLE" {
-		return "DOUBLE"
-	}
-
-	// 处理字符串类型
-	if strings.Contains(dbTypeName, "VARCHAR") || strings.Contains(dbTypeName, "CHAR") {
-		// 保持原有的长度信息
-		if strings.Contains(dbTypeName, "(") && strings.Contains(dbTypeName, ")") {
-			return dbTypeName
-		}
-		return "VARCHAR"
-	}
-
-	if strings.Contains(dbTypeName, "TEXT") || strings.Contains(dbTypeName, "LONGTEXT") ||
-		strings.Contains(dbTypeName, "MEDIUMTEXT") || strings.Contains(dbTypeName, "TINYTEXT") {
-		return "VARCHAR"
-	}
-
-	// 处理时间类型
-
-	if dbTypeName == "DATE" {
-		return "DATE"
-	}
-
-	if dbTypeName == "TIMESTAMP" || dbTypeName == "DATETIME" {
-		return "DATETIME"
-	}
-
-	// 处理布尔类型
-	if dbTypeName == "BOOLEAN" || dbTypeName == "BOOL" {
-		return "BOOLEAN"
-	}
-
-	// 默认返回字符串类型
-	return "VARCHAR"
+// convertDatabaseTypeToDorisType 将数据源的字段类型转换为Doris建表类型，定点数保留精度与标度
+func (s *DorisService) convertDatabaseTypeToDorisType(native database.NativeType, dbType int32) string {
+	return database.ConvertNativeType(ds.DataSourceType(dbType), ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, native)
 }
 
 // trackExportTaskStatusByLabel 使用SHOW EXPORT命令根据Label追踪导出任务状态直到完成
@@ -2477,84 +2166,56 @@ func (s *DorisService) GetExportTaskByLabel(dbName string, label string) (*commo
 	return nil, nil
 }
 
-// ProcessDataSourceAndExport 处理数据源并导出到 MinIO
-func (s *DorisService) ProcessDataSourceAndExport(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, error) {
-	var tableName string
-	var err error
-
-	// 1.从数据源拉取数据到doris
+// PrepareDataSourceInDoris 把读取请求的数据源拉取到 Doris，返回可以直接查询的库名和表名
+// Doris 数据源不需要拉取，直接返回请求中的表
+func (s *DorisService) PrepareDataSourceInDoris(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, string, error) {
 	switch src := request.DataSource.(type) {
 	case *ds.ReadDataSourceStreamingRequest_External:
-		// 1.1 从外部数据源拉取数据到doris
-		tableName, err = s.CreateExternalAndInternalTableAndImportData(src.External.AssetName, src.External.ChainInfoId, src.External.Alias, enhancedJobInstanceId, enhancedJobInstanceId+"_"+"internal", enhancedJobInstanceId)
+		// 从外部数据源拉取数据到doris
+		tableName, err := s.CreateExternalAndInternalTableAndImportData(src.External.AssetName, src.External.ChainInfoId, src.External.Alias, enhancedJobInstanceId, enhancedJobInstanceId+"_"+"internal", enhancedJobInstanceId, nil)
 		if err != nil {
 			log.Logger.Errorf("failed to create external table from asset: %v", err)
-			return "", fmt.Errorf("failed to create external table from asset: %v", err)
+			return "", "", fmt.Errorf("failed to create external table from asset: %v", err)
 		}
 		if len(tableName) == 0 {
 			log.Logger.Errorf("tableName is empty after import, import failed")
-			return "", fmt.Errorf("import failed: tableName is empty")
-		}
-
-		// 1.2 执行导出csv文件到minio
-		err = s.ExportParquetFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
-			DbName:           common.MIRA_TMP_TASK_DB,
-			TableName:        tableName,
-			JobInstanceId:    enhancedJobInstanceId,
-			Columns:          request.Columns,
-			SortRules:        request.SortRules,
-			FilterConditions: request.FilterConditions,
-		})
-		if err != nil {
-			log.Logger.Errorf("failed to export csv file from doris: %v", err)
-			return "", fmt.Errorf("failed to export csv file from doris: %v", err)
+			return "", "", fmt.Errorf("import failed: tableName is empty")
 		}
+		return common.MIRA_TMP_TASK_DB, tableName, nil
 
 	case *ds.ReadDataSourceStreamingRequest_Internal:
-		// 1.1 从内部数据源拉取数据到doris
-		tableName, err = s.ImportMiraDBDataToDoris(&ds.ImportMiraDBDataToDorisRequest{
+		// 从内部数据源拉取数据到doris
+		tableName, err := s.ImportMiraDBDataToDoris(&ds.ImportMiraDBDataToDorisRequest{
 			MiraTableName: src.Internal.TableName,
 			JobInstanceId: enhancedJobInstanceId,
 		})
 		if err != nil {
 			log.Logger.Errorf("failed to import mira db data to doris: %v", err)
-			return "", fmt.Errorf("failed to import mira db data to doris: %v", err)
-		}
-		// 1.2 执行导出arrow文件到minio
-		err = s.ExportParquetFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
-			DbName:           common.MIRA_TMP_TASK_DB,
-			TableName:        tableName,
-			JobInstanceId:    enhancedJobInstanceId,
-			Columns:          request.Columns,
-			SortRules:        request.SortRules,
-			FilterConditions: request.FilterConditions,
-		})
-		if err != nil {
-			log.Logger.Errorf("failed to export parquet file from doris: %v", err)
-			return "", fmt.Errorf("failed to export parquet file from doris: %v", err)
+			return "", "", fmt.Errorf("failed to import mira db data to doris: %v", err)
 		}
+		return common.MIRA_TMP_TASK_DB, tableName, nil
 
 	case *ds.ReadDataSourceStreamingRequest_Doris:
-		// 1.2 执行导出arrow文件到minio
-		err = s.ExportParquetFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
-			DbName:           src.Doris.DbName,
-			TableName:        src.Doris.TableName,
-			JobInstanceId:    enhancedJobInstanceId,
-			Columns:          request.Columns,
-			SortRules:        request.SortRules,
-			FilterConditions: request.FilterConditions,
-		})
-		if err != nil {
-			log.Logger.Errorf("failed to export parquet file from doris: %v", err)
-			return "", fmt.Errorf("failed to export parquet file from doris: %v", err)
-		}
-		tableName = src.Doris.TableName
+		return src.Doris.DbName, src.Doris.TableName, nil
 
 	default:
-		return "", fmt.Errorf("unknown data source type")
+		return "", "", fmt.Errorf("unknown data source type")
 	}
+}
 
-	return tableName, nil
+// NewDorisExportRequest 由读取请求构造读取 Doris 表 dbName.tableName 的导出请求
+func NewDorisExportRequest(request *ds.ReadDataSourceStreamingRequest, dbName, tableName, enhancedJobInstanceId string) *ds.ExportCsvFileFromDorisRequest {
+	return &ds.ExportCsvFileFromDorisRequest{
+		DbName:           dbName,
+		TableName:        tableName,
+		JobInstanceId:    enhancedJobInstanceId,
+		Columns:          request.Columns,
+		SortRules:        request.SortRules,
+		FilterConditions: request.FilterConditions,
+		FilterExpression: request.FilterExpression,
+		Sampling:         request.Sampling,
+		Keys:             request.Keys,
+	}
 }
 
 // DropDatabase 删除指定的数据库
@@ -2582,6 +2243,19 @@ func (s *DorisService) DropTable(dbName, tableName string) error {
 func (s *DorisService) processTLSCertificates(tlsConfig *ds.DatasourceTlsConfig, requestId string, dbType int32) error {
 	log.Logger.Infof("TLS configuration detected, processing certificates for database type: %d", dbType)
 
+	// PostgreSQL/openGauss 驱动没有从 FE 拉取证书的 SSL 工厂，证书由 BE JVM 信任库提供，无需上传
+	if dbType == int32(ds.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL) ||
+		dbType == int32(ds.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS) {
+		log.Logger.Infof("Skipping certificate upload for PostgreSQL/openGauss, relying on BE JVM truststore")
+		return nil
+	}
+
+	// 达梦 JDBC 从 BE 本地的 sslFilesPath 目录加载证书，无需上传
+	if dbType == int32(ds.DataSourceType_DATA_SOURCE_TYPE_DAMENG) {
+		log.Logger.Infof("Skipping certificate upload for Dameng, using certificates under %s", common.DAMENG_SSL_FILES_PATH)
+		return nil
+	}
+
 	if dbType == int32(ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE) ||
 		dbType == int32(ds.DataSourceType_DATA_SOURCE_TYPE_VASTBASE) {
 		// 1) CA 用 PEM（catalog=kingbase）
@@ -3008,7 +2682,7 @@ func (s *DorisService) getCurrentDatabaseId(dbName string) (int, error) {
 // getCurrentDatabase 获取当前数据库名
 func (s *DorisService) getCurrentDatabase() string {
 	query := "SELECT DATABASE()"
-	rows, err := s.dbStrategy.Query(query) // 关键修复：避免调用 ExecuteSQL 造成递归
+	rows, err := s.dbStrategy.Query(context.Background(), query) // 关键修复：避免调用 ExecuteSQL 造成递归
 	if err != nil {
 		log.Logger.Errorf("Failed to get current database: %v", err)
 		return ""
@@ -0,0 +1,470 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database"
+	ds "data-service/generated/datasource"
+)
+
+// 表键 attributes 中的建表属性
+const (
+	// layoutAttrModel 数据模型：unique、aggregate、duplicate，所在表键的列作为键列
+	layoutAttrModel = "model"
+	// layoutAttrDistributedBy 分桶列，逗号分隔；random 表示随机分桶
+	layoutAttrDistributedBy = "distributed_by"
+	// layoutAttrBuckets 分桶数：auto 或正整数
+	layoutAttrBuckets = "buckets"
+	// layoutAttrPartitionBy 分区方式与分区列，如 range(dt)、list(region)
+	layoutAttrPartitionBy = "partition_by"
+	// layoutAttrPartitions 分区定义，分号分隔的 名称:取值；RANGE 分区的取值为上界，LIST 分区的取值逗号分隔
+	layoutAttrPartitions = "partitions"
+	// layoutAttrReplicationNum 副本数
+	layoutAttrReplicationNum = "replication_num"
+	// layoutAttrAggregatePrefix aggregate.<列名> 指定 AGGREGATE 模型值列的聚合方式
+	layoutAttrAggregatePrefix = "aggregate."
+)
+
+var (
+	partitionByPattern   = regexp.MustCompile(`(?i)^(range|list)\s*\(\s*([^()]*?)\s*\)$`)
+	partitionNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+	// dorisAggregations AGGREGATE 模型支持的聚合方式
+	dorisAggregations = map[string]bool{
+		"SUM": true, "MAX": true, "MIN": true, "REPLACE": true, "REPLACE_IF_NOT_NULL": true,
+		"HLL_UNION": true, "BITMAP_UNION": true,
+	}
+	// dorisNonKeyTypes 不能作为键列、分桶列或分区列的类型
+	dorisNonKeyTypes = map[string]bool{
+		"FLOAT": true, "DOUBLE": true, "JSON": true, "JSONB": true, "VARIANT": true,
+		"ARRAY": true, "MAP": true, "STRUCT": true, "HLL": true, "BITMAP": true, "QUANTILE_STATE": true,
+	}
+	// dorisRangePartitionTypes RANGE 分区列支持的类型
+	dorisRangePartitionTypes = map[string]bool{
+		"TINYINT": true, "SMALLINT": true, "INT": true, "BIGINT": true, "LARGEINT": true,
+		"DATE": true, "DATEV2": true, "DATETIME": true, "DATETIMEV2": true,
+	}
+)
+
+// dorisTableLayoutDefaults 读取 doris 配置中的建表默认值
+func dorisTableLayoutDefaults() common.DorisTableLayout {
+	layout := common.DorisTableLayout{Model: common.DorisTableModelUnique, ReplicationNum: 1}
+	conf := config.GetConfigMap()
+	if conf == nil {
+		return layout
+	}
+	if strings.EqualFold(strings.TrimSpace(conf.DorisConfig.TableModel), string(common.DorisTableModelDuplicate)) {
+		layout.Model = common.DorisTableModelDuplicate
+	}
+	if conf.DorisConfig.TableBuckets > 0 {
+		layout.Buckets = conf.DorisConfig.TableBuckets
+	}
+	if conf.DorisConfig.ReplicationNum > 0 {
+		layout.ReplicationNum = conf.DorisConfig.ReplicationNum
+	}
+	return layout
+}
+
+// parseDorisTableLayout 根据表键及其 attributes 确定内部表的建表方式，未指定的部分使用 defaults
+// 键列取第一个指定了 model 的表键；都未指定时取第一个主键或自增主键，其次取第一个唯一键，按 UNIQUE 模型建表。
+// 其余属性可以写在任意表键上，同一属性在不同表键上的取值必须一致。
+func parseDorisTableLayout(keys []*ds.TableKey, defaults common.DorisTableLayout) (*common.DorisTableLayout, error) {
+	layout := defaults
+
+	var modelKey *ds.TableKey
+	for _, priority := range []func(*ds.TableKey) bool{
+		func(k *ds.TableKey) bool { return keyModel(k) != "" },
+		func(k *ds.TableKey) bool {
+			return (k.KeyType == ds.KeyType_KEY_TYPE_PRIMARY || k.KeyType == ds.KeyType_KEY_TYPE_AUTO_INCREMENT) && len(trimmedNames(k.ColumnNames)) > 0
+		},
+		func(k *ds.TableKey) bool {
+			return k.KeyType == ds.KeyType_KEY_TYPE_UNIQUE && len(trimmedNames(k.ColumnNames)) > 0
+		},
+	} {
+		for _, k := range keys {
+			if k != nil && priority(k) {
+				modelKey = k
+				break
+			}
+		}
+		if modelKey != nil {
+			break
+		}
+	}
+	if modelKey != nil {
+		layout.Model = common.DorisTableModelUnique
+		if model := keyModel(modelKey); model != "" {
+			switch m := common.DorisTableModel(strings.ToUpper(model)); m {
+			case common.DorisTableModelUnique, common.DorisTableModelAggregate, common.DorisTableModelDuplicate:
+				layout.Model = m
+			default:
+				return nil, fmt.Errorf("unsupported table model '%s', expected unique, aggregate or duplicate", model)
+			}
+		}
+		layout.KeyColumns = trimmedNames(modelKey.ColumnNames)
+	}
+
+	attrs := make(map[string]string)
+	for _, k := range keys {
+		if k == nil {
+			continue
+		}
+		for name, value := range k.Attributes {
+			name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+			if strings.EqualFold(name, layoutAttrModel) {
+				continue
+			}
+			if previous, ok := attrs[name]; ok && previous != value {
+				return nil, fmt.Errorf("table key attribute '%s' has conflicting values '%s' and '%s'", name, previous, value)
+			}
+			attrs[name] = value
+		}
+	}
+
+	// 属性名不区分大小写，aggregate.<列名> 中的列名保持原样
+	for key, value := range attrs {
+		name := strings.ToLower(key)
+		switch {
+		case name == layoutAttrDistributedBy:
+			if strings.EqualFold(value, "random") {
+				layout.RandomDistribution = true
+			} else if layout.DistributionColumns = trimmedNames(strings.Split(value, ",")); len(layout.DistributionColumns) == 0 {
+				return nil, fmt.Errorf("distributed_by must be 'random' or a list of columns")
+			}
+		case name == layoutAttrBuckets:
+			if strings.EqualFold(value, "auto") {
+				layout.Buckets = 0
+			} else if buckets, err := strconv.Atoi(value); err != nil || buckets <= 0 {
+				return nil, fmt.Errorf("buckets must be 'auto' or a positive integer, got '%s'", value)
+			} else {
+				layout.Buckets = buckets
+			}
+		case name == layoutAttrReplicationNum:
+			replicas, err := strconv.Atoi(value)
+			if err != nil || replicas <= 0 {
+				return nil, fmt.Errorf("replication_num must be a positive integer, got '%s'", value)
+			}
+			layout.ReplicationNum = replicas
+		case name == layoutAttrPartitionBy:
+			match := partitionByPattern.FindStringSubmatch(value)
+			if match == nil || match[2] == "" {
+				return nil, fmt.Errorf("partition_by must look like range(column) or list(column), got '%s'", value)
+			}
+			if strings.Contains(match[2], ",") {
+				return nil, fmt.Errorf("partition_by supports a single partition column, got '%s'", match[2])
+			}
+			layout.PartitionType = common.DorisPartitionType(strings.ToUpper(match[1]))
+			layout.PartitionColumn = strings.Trim(match[2], "`")
+		case name == layoutAttrPartitions:
+			partitions, err := parseDorisPartitions(value)
+			if err != nil {
+				return nil, err
+			}
+			layout.Partitions = partitions
+		case strings.HasPrefix(name, layoutAttrAggregatePrefix):
+			column := strings.TrimSpace(key[len(layoutAttrAggregatePrefix):])
+			aggregation := strings.ToUpper(value)
+			if !dorisAggregations[aggregation] {
+				return nil, fmt.Errorf("unsupported aggregation '%s' for column '%s'", value, column)
+			}
+			if layout.Aggregations == nil {
+				layout.Aggregations = make(map[string]string)
+			}
+			layout.Aggregations[column] = aggregation
+		}
+	}
+
+	if layout.PartitionType == "" && len(layout.Partitions) > 0 {
+		return nil, fmt.Errorf("partitions are given without partition_by")
+	}
+	if layout.PartitionType != "" && len(layout.Partitions) == 0 {
+		return nil, fmt.Errorf("partition_by %s(%s) requires at least one partition", layout.PartitionType, layout.PartitionColumn)
+	}
+	return &layout, nil
+}
+
+// keyModel 表键上指定的数据模型
+func keyModel(k *ds.TableKey) string {
+	for name, value := range k.Attributes {
+		if strings.EqualFold(strings.TrimSpace(name), layoutAttrModel) {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// parseDorisPartitions 解析 名称:取值;名称:取值 形式的分区定义
+func parseDorisPartitions(value string) ([]common.DorisPartition, error) {
+	var partitions []common.DorisPartition
+	for _, definition := range strings.Split(value, ";") {
+		if strings.TrimSpace(definition) == "" {
+			continue
+		}
+		name, values, ok := strings.Cut(definition, ":")
+		name = strings.TrimSpace(name)
+		if !ok || !partitionNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid partition definition '%s', expected name:values", strings.TrimSpace(definition))
+		}
+		partition := common.DorisPartition{Name: name, Values: trimmedNames(strings.Split(values, ","))}
+		if len(partition.Values) == 0 {
+			return nil, fmt.Errorf("partition '%s' has no values", name)
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, nil
+}
+
+// validateDorisTableLayout 检查建表方式与列是否相容，在执行任何 DDL 之前调用
+func validateDorisTableLayout(columns []common.ColumnInfo, layout *common.DorisTableLayout) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("table has no columns")
+	}
+	types := make(map[string]string, len(columns))
+	for _, col := range columns {
+		types[col.Name] = database.ParseNativeType(col.DataType).Name
+	}
+
+	keys := make(map[string]bool, len(layout.KeyColumns))
+	for _, key := range layout.KeyColumns {
+		typeName, ok := types[key]
+		if !ok {
+			return fmt.Errorf("key column '%s' is not in the imported columns", key)
+		}
+		if keys[key] {
+			return fmt.Errorf("key column '%s' is listed more than once", key)
+		}
+		if dorisNonKeyTypes[typeName] {
+			return fmt.Errorf("column '%s' of type %s cannot be a key column", key, typeName)
+		}
+		keys[key] = true
+	}
+
+	switch layout.Model {
+	case common.DorisTableModelDuplicate:
+	case common.DorisTableModelUnique, common.DorisTableModelAggregate:
+		if len(layout.KeyColumns) == 0 {
+			return fmt.Errorf("%s model requires key columns", layout.Model)
+		}
+	default:
+		return fmt.Errorf("unsupported table model '%s'", layout.Model)
+	}
+	if len(keys) == len(columns) && layout.Model == common.DorisTableModelAggregate {
+		return fmt.Errorf("AGGREGATE model requires at least one value column")
+	}
+
+	if len(layout.Aggregations) > 0 && layout.Model != common.DorisTableModelAggregate {
+		return fmt.Errorf("aggregations are only allowed with the AGGREGATE model, got %s", layout.Model)
+	}
+	for column := range layout.Aggregations {
+		if _, ok := types[column]; !ok {
+			return fmt.Errorf("aggregated column '%s' is not in the imported columns", column)
+		}
+		if keys[column] {
+			return fmt.Errorf("key column '%s' cannot be aggregated", column)
+		}
+	}
+
+	// UNIQUE、AGGREGATE 模型的分桶列与分区列必须是键列
+	keyedModel := layout.Model != common.DorisTableModelDuplicate
+	if layout.RandomDistribution {
+		if len(layout.DistributionColumns) > 0 {
+			return fmt.Errorf("random distribution cannot have distribution columns")
+		}
+		if layout.Model == common.DorisTableModelUnique {
+			return fmt.Errorf("UNIQUE model does not support random distribution")
+		}
+		if layout.Model == common.DorisTableModelAggregate {
+			for _, col := range columns {
+				if aggregation := aggregationOf(layout, col.Name); !keys[col.Name] && strings.HasPrefix(aggregation, "REPLACE") {
+					return fmt.Errorf("AGGREGATE model with %s column '%s' does not support random distribution", aggregation, col.Name)
+				}
+			}
+		}
+	}
+	for _, column := range layout.DistributionColumns {
+		typeName, ok := types[column]
+		if !ok {
+			return fmt.Errorf("distribution column '%s' is not in the imported columns", column)
+		}
+		if keyedModel && !keys[column] {
+			return fmt.Errorf("distribution column '%s' must be a key column of the %s model", column, layout.Model)
+		}
+		if dorisNonKeyTypes[typeName] {
+			return fmt.Errorf("column '%s' of type %s cannot be a distribution column", column, typeName)
+		}
+	}
+	if layout.Buckets < 0 {
+		return fmt.Errorf("buckets must not be negative")
+	}
+	if layout.ReplicationNum <= 0 {
+		return fmt.Errorf("replication_num must be positive")
+	}
+
+	if layout.PartitionType != "" {
+		typeName, ok := types[layout.PartitionColumn]
+		if !ok {
+			return fmt.Errorf("partition column '%s' is not in the imported columns", layout.PartitionColumn)
+		}
+		if keyedModel && !keys[layout.PartitionColumn] {
+			return fmt.Errorf("partition column '%s' must be a key column of the %s model", layout.PartitionColumn, layout.Model)
+		}
+		if dorisNonKeyTypes[typeName] {
+			return fmt.Errorf("column '%s' of type %s cannot be a partition column", layout.PartitionColumn, typeName)
+		}
+		if layout.PartitionType == common.DorisPartitionRange && !dorisRangePartitionTypes[typeName] {
+			return fmt.Errorf("RANGE partition column '%s' must be an integer, date or datetime column, got %s", layout.PartitionColumn, typeName)
+		}
+		names := make(map[string]bool, len(layout.Partitions))
+		for i, partition := range layout.Partitions {
+			if names[partition.Name] {
+				return fmt.Errorf("partition '%s' is defined more than once", partition.Name)
+			}
+			names[partition.Name] = true
+			if layout.PartitionType == common.DorisPartitionRange {
+				if len(partition.Values) != 1 {
+					return fmt.Errorf("RANGE partition '%s' must have exactly one upper bound", partition.Name)
+				}
+				if strings.EqualFold(partition.Values[0], "MAXVALUE") && i != len(layout.Partitions)-1 {
+					return fmt.Errorf("only the last RANGE partition can be unbounded, got '%s'", partition.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// buildDorisCreateTableSQL 按建表方式生成 CREATE TABLE 语句，键列按键的顺序排在其他列之前
+func buildDorisCreateTableSQL(tableName string, columns []common.ColumnInfo, layout *common.DorisTableLayout) (string, error) {
+	if err := validateDorisTableLayout(columns, layout); err != nil {
+		return "", err
+	}
+	dorisDialect := database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+	quoteAll := func(names []string) string {
+		quoted := make([]string, len(names))
+		for i, name := range names {
+			quoted[i] = dorisDialect.QuoteIdentifier(name)
+		}
+		return strings.Join(quoted, ", ")
+	}
+
+	keyColumns := layout.KeyColumns
+	keySet := make(map[string]bool, len(keyColumns))
+	for _, key := range keyColumns {
+		keySet[key] = true
+	}
+	// 分区列与分桶列同样不能使用 STRING
+	restricted := make(map[string]bool)
+	for _, column := range append(append([]string{layout.PartitionColumn}, keyColumns...), layout.DistributionColumns...) {
+		restricted[column] = true
+	}
+	columnDef := func(col common.ColumnInfo) string {
+		dataType := col.DataType
+		if restricted[col.Name] {
+			dataType = dorisKeyColumnType(dataType)
+		}
+		if layout.Model == common.DorisTableModelAggregate && !keySet[col.Name] {
+			dataType += " " + aggregationOf(layout, col.Name)
+		}
+		def := internalColumnDef(col, dataType)
+		if col.Name == layout.AutoIncrementColumn {
+			def += " AUTO_INCREMENT"
+		}
+		return def
+	}
+
+	byName := make(map[string]common.ColumnInfo, len(columns))
+	for _, col := range columns {
+		byName[col.Name] = col
+	}
+	var columnDefs []string
+	for _, key := range keyColumns {
+		columnDefs = append(columnDefs, columnDef(byName[key]))
+	}
+	for _, col := range columns {
+		if !keySet[col.Name] {
+			columnDefs = append(columnDefs, columnDef(col))
+		}
+	}
+
+	// 明细模型未指定排序列时由 Doris 选择
+	var clauses []string
+	if len(keyColumns) > 0 {
+		clauses = append(clauses, fmt.Sprintf("%s KEY (%s)", layout.Model, quoteAll(keyColumns)))
+	}
+
+	if layout.PartitionType != "" {
+		var partitions []string
+		for _, partition := range layout.Partitions {
+			values := make([]string, len(partition.Values))
+			for i, value := range partition.Values {
+				if layout.PartitionType == common.DorisPartitionRange && strings.EqualFold(value, "MAXVALUE") {
+					values[i] = "MAXVALUE"
+					continue
+				}
+				literal, err := dorisDialect.QuoteLiteral(value)
+				if err != nil {
+					return "", fmt.Errorf("invalid value '%s' of partition '%s': %v", value, partition.Name, err)
+				}
+				values[i] = literal
+			}
+			if layout.PartitionType == common.DorisPartitionRange {
+				partitions = append(partitions, fmt.Sprintf("PARTITION %s VALUES LESS THAN (%s)",
+					dorisDialect.QuoteIdentifier(partition.Name), values[0]))
+			} else {
+				partitions = append(partitions, fmt.Sprintf("PARTITION %s VALUES IN (%s)",
+					dorisDialect.QuoteIdentifier(partition.Name), strings.Join(values, ", ")))
+			}
+		}
+		clauses = append(clauses, fmt.Sprintf("PARTITION BY %s(%s) (\n\t\t\t%s\n\t\t)", layout.PartitionType,
+			dorisDialect.QuoteIdentifier(layout.PartitionColumn), strings.Join(partitions, ",\n\t\t\t")))
+	}
+
+	buckets := "AUTO"
+	if layout.Buckets > 0 {
+		buckets = strconv.Itoa(layout.Buckets)
+	}
+	distribution := layout.DistributionColumns
+	if len(distribution) == 0 && layout.Model != common.DorisTableModelDuplicate {
+		distribution = keyColumns
+	}
+	if layout.RandomDistribution || len(distribution) == 0 {
+		clauses = append(clauses, "DISTRIBUTED BY RANDOM BUCKETS "+buckets)
+	} else {
+		clauses = append(clauses, fmt.Sprintf("DISTRIBUTED BY HASH(%s) BUCKETS %s", quoteAll(distribution), buckets))
+	}
+
+	return fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			%s
+		)
+		ENGINE=OLAP
+		%s
+		PROPERTIES (
+			"replication_num" = "%d"
+		)
+	`, tableName, strings.Join(columnDefs, ",\n\t\t\t"), strings.Join(clauses, "\n\t\t"), layout.ReplicationNum), nil
+}
+
+// aggregationOf AGGREGATE 模型中值列的聚合方式，未指定时为 REPLACE
+func aggregationOf(layout *common.DorisTableLayout, column string) string {
+	if aggregation, ok := layout.Aggregations[column]; ok {
+		return aggregation
+	}
+	return "REPLACE"
+}
+
+// trimmedNames 去掉名称两端的空白并跳过空名称
+func trimmedNames(names []string) []string {
+	var result []string
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
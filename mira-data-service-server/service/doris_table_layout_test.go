@@ -0,0 +1,264 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"data-service/common"
+	pb "data-service/generated/datasource"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var layoutDefaults = common.DorisTableLayout{Model: common.DorisTableModelUnique, ReplicationNum: 1}
+
+// squashSpaces 合并空白，便于比较生成的 DDL
+func squashSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func TestParseDorisTableLayoutKeyColumns(t *testing.T) {
+	// 没有表键时使用默认值
+	layout, err := parseDorisTableLayout(nil, layoutDefaults)
+	require.NoError(t, err)
+	assert.Equal(t, layoutDefaults, *layout)
+
+	// 索引键不作为键列
+	layout, err = parseDorisTableLayout([]*pb.TableKey{
+		{KeyType: pb.KeyType_KEY_TYPE_INDEX, ColumnNames: []string{"name"}},
+	}, layoutDefaults)
+	require.NoError(t, err)
+	assert.Nil(t, layout.KeyColumns)
+
+	// 主键优先于唯一键
+	layout, err = parseDorisTableLayout([]*pb.TableKey{
+		{KeyType: pb.KeyType_KEY_TYPE_UNIQUE, ColumnNames: []string{"email"}},
+		{KeyType: pb.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{" tenant ", "id"}},
+	}, common.DorisTableLayout{Model: common.DorisTableModelDuplicate, ReplicationNum: 1})
+	require.NoError(t, err)
+	assert.Equal(t, common.DorisTableModelUnique, layout.Model)
+	assert.Equal(t, []string{"tenant", "id"}, layout.KeyColumns)
+
+	// 指定了 model 的表键优先
+	layout, err = parseDorisTableLayout([]*pb.TableKey{
+		{KeyType: pb.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id"}},
+		{KeyType: pb.KeyType_KEY_TYPE_INDEX, ColumnNames: []string{"dt", "region"}, Attributes: map[string]string{"Model": "Duplicate"}},
+	}, layoutDefaults)
+	require.NoError(t, err)
+	assert.Equal(t, common.DorisTableModelDuplicate, layout.Model)
+	assert.Equal(t, []string{"dt", "region"}, layout.KeyColumns)
+}
+
+func TestParseDorisTableLayoutAttributes(t *testing.T) {
+	layout, err := parseDorisTableLayout([]*pb.TableKey{
+		{KeyType: pb.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"dt", "region"}, Attributes: map[string]string{
+			"model":           "aggregate",
+			"distributed_by":  "region",
+			"buckets":         "16",
+			"partition_by":    "RANGE(dt)",
+			"partitions":      "p2024:2025-01-01; pmax:MAXVALUE",
+			"aggregate.Sales": "sum",
+			"comment":         "ignored",
+		}},
+		{KeyType: pb.KeyType_KEY_TYPE_INDEX, ColumnNames: []string{"region"}, Attributes: map[string]string{
+			"replication_num": "3",
+			"buckets":         "16",
+		}},
+	}, layoutDefaults)
+	require.NoError(t, err)
+	assert.Equal(t, &common.DorisTableLayout{
+		Model:               common.DorisTableModelAggregate,
+		KeyColumns:          []string{"dt", "region"},
+		Aggregations:        map[string]string{"Sales": "SUM"},
+		DistributionColumns: []string{"region"},
+		Buckets:             16,
+		PartitionType:       common.DorisPartitionRange,
+		PartitionColumn:     "dt",
+		Partitions: []common.DorisPartition{
+			{Name: "p2024", Values: []string{"2025-01-01"}},
+			{Name: "pmax", Values: []string{"MAXVALUE"}},
+		},
+		ReplicationNum: 3,
+	}, layout)
+
+	layout, err = parseDorisTableLayout([]*pb.TableKey{
+		{KeyType: pb.KeyType_KEY_TYPE_INDEX, Attributes: map[string]string{
+			"distributed_by": "random",
+			"buckets":        "auto",
+			"partition_by":   "list(region)",
+			"partitions":     "p_north:beijing,tianjin;p_south:guangzhou",
+		}},
+	}, common.DorisTableLayout{Model: common.DorisTableModelDuplicate, Buckets: 8, ReplicationNum: 1})
+	require.NoError(t, err)
+	assert.True(t, layout.RandomDistribution)
+	assert.Equal(t, 0, layout.Buckets)
+	assert.Equal(t, common.DorisPartitionList, layout.PartitionType)
+	assert.Equal(t, []common.DorisPartition{
+		{Name: "p_north", Values: []string{"beijing", "tianjin"}},
+		{Name: "p_south", Values: []string{"guangzhou"}},
+	}, layout.Partitions)
+}
+
+func TestParseDorisTableLayoutErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs []map[string]string
+		err   string
+	}{
+		{"unknown model", []map[string]string{{"model": "primary"}}, "unsupported table model"},
+		{"invalid buckets", []map[string]string{{"buckets": "0"}}, "buckets must be 'auto' or a positive integer"},
+		{"invalid replication", []map[string]string{{"replication_num": "two"}}, "replication_num must be a positive integer"},
+		{"invalid partition_by", []map[string]string{{"partition_by": "hash(dt)", "partitions": "p1:1"}}, "partition_by must look like"},
+		{"multiple partition columns", []map[string]string{{"partition_by": "range(a, b)", "partitions": "p1:1"}}, "single partition column"},
+		{"partitions without partition_by", []map[string]string{{"partitions": "p1:1"}}, "without partition_by"},
+		{"partition_by without partitions", []map[string]string{{"partition_by": "range(dt)"}}, "requires at least one partition"},
+		{"invalid partition", []map[string]string{{"partition_by": "range(dt)", "partitions": "2024:2025-01-01"}}, "invalid partition definition"},
+		{"unknown aggregation", []map[string]string{{"aggregate.amount": "avg"}}, "unsupported aggregation 'avg'"},
+		{"conflicting attributes", []map[string]string{{"buckets": "8"}, {"buckets": "16"}}, "conflicting values '8' and '16'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var keys []*pb.TableKey
+			for _, attrs := range tt.attrs {
+				keys = append(keys, &pb.TableKey{KeyType: pb.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id"}, Attributes: attrs})
+			}
+			_, err := parseDorisTableLayout(keys, layoutDefaults)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+var layoutColumns = []common.ColumnInfo{
+	{Name: "id", DataType: "BIGINT"},
+	{Name: "name", DataType: "STRING", Nullable: true},
+	{Name: "dt", DataType: "DATE"},
+	{Name: "score", DataType: "DOUBLE", Nullable: true},
+	{Name: "tags", DataType: "ARRAY<STRING>", Nullable: true},
+}
+
+func TestValidateDorisTableLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout common.DorisTableLayout
+		err    string
+	}{
+		{"missing key column", common.DorisTableLayout{Model: common.DorisTableModelUnique, KeyColumns: []string{"uid"}}, "key column 'uid' is not in the imported columns"},
+		{"duplicate key column", common.DorisTableLayout{Model: common.DorisTableModelUnique, KeyColumns: []string{"id", "id"}}, "listed more than once"},
+		{"double key", common.DorisTableLayout{Model: common.DorisTableModelDuplicate, KeyColumns: []string{"score"}}, "type DOUBLE cannot be a key column"},
+		{"unique without keys", common.DorisTableLayout{Model: common.DorisTableModelUnique}, "UNIQUE model requires key columns"},
+		{"aggregations outside aggregate model", common.DorisTableLayout{Model: common.DorisTableModelUnique, KeyColumns: []string{"id"},
+			Aggregations: map[string]string{"score": "SUM"}}, "only allowed with the AGGREGATE model"},
+		{"aggregated key", common.DorisTableLayout{Model: common.DorisTableModelAggregate, KeyColumns: []string{"id"},
+			Aggregations: map[string]string{"id": "MAX"}}, "key column 'id' cannot be aggregated"},
+		{"distribution column not a key", common.DorisTableLayout{Model: common.DorisTableModelUnique, KeyColumns: []string{"id"},
+			DistributionColumns: []string{"dt"}}, "must be a key column of the UNIQUE model"},
+		{"random unique", common.DorisTableLayout{Model: common.DorisTableModelUnique, KeyColumns: []string{"id"},
+			RandomDistribution: true}, "UNIQUE model does not support random distribution"},
+		{"random aggregate with replace", common.DorisTableLayout{Model: common.DorisTableModelAggregate, KeyColumns: []string{"id"},
+			RandomDistribution: true, Aggregations: map[string]string{"score": "SUM"}}, "REPLACE column 'name' does not support random distribution"},
+		{"partition column not a key", common.DorisTableLayout{Model: common.DorisTableModelUnique, KeyColumns: []string{"id"},
+			PartitionType: common.DorisPartitionList, PartitionColumn: "name", Partitions: []common.DorisPartition{{Name: "p1", Values: []string{"a"}}}},
+			"partition column 'name' must be a key column"},
+		{"range partition on string", common.DorisTableLayout{Model: common.DorisTableModelDuplicate,
+			PartitionType: common.DorisPartitionRange, PartitionColumn: "name", Partitions: []common.DorisPartition{{Name: "p1", Values: []string{"m"}}}},
+			"must be an integer, date or datetime column, got STRING"},
+		{"duplicate partition", common.DorisTableLayout{Model: common.DorisTableModelDuplicate, PartitionType: common.DorisPartitionRange,
+			PartitionColumn: "dt", Partitions: []common.DorisPartition{{Name: "p1", Values: []string{"2024-01-01"}}, {Name: "p1", Values: []string{"2025-01-01"}}}},
+			"partition 'p1' is defined more than once"},
+		{"maxvalue not last", common.DorisTableLayout{Model: common.DorisTableModelDuplicate, PartitionType: common.DorisPartitionRange,
+			PartitionColumn: "dt", Partitions: []common.DorisPartition{{Name: "pmax", Values: []string{"MAXVALUE"}}, {Name: "p1", Values: []string{"2025-01-01"}}}},
+			"only the last RANGE partition can be unbounded"},
+		{"zero replicas", common.DorisTableLayout{Model: common.DorisTableModelDuplicate}, "replication_num must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := tt.layout
+			if layout.ReplicationNum == 0 && tt.name != "zero replicas" {
+				layout.ReplicationNum = 1
+			}
+			assert.ErrorContains(t, validateDorisTableLayout(layoutColumns, &layout), tt.err)
+		})
+	}
+}
+
+func TestBuildDorisCreateTableSQL(t *testing.T) {
+	query, err := buildDorisCreateTableSQL("`db`.`orders`", layoutColumns, &common.DorisTableLayout{
+		Model:           common.DorisTableModelUnique,
+		KeyColumns:      []string{"dt", "name"},
+		Buckets:         16,
+		PartitionType:   common.DorisPartitionRange,
+		PartitionColumn: "dt",
+		Partitions: []common.DorisPartition{
+			{Name: "p2024", Values: []string{"2025-01-01"}},
+			{Name: "pmax", Values: []string{"maxvalue"}},
+		},
+		ReplicationNum: 3,
+	})
+	require.NoError(t, err)
+	// 键列按键的顺序排在最前，STRING 键列改用 VARCHAR
+	assert.Equal(t, "CREATE TABLE IF NOT EXISTS `db`.`orders` ( `dt` DATE NOT NULL, `name` VARCHAR(65533), `id` BIGINT NOT NULL, "+
+		"`score` DOUBLE, `tags` ARRAY<STRING> ) ENGINE=OLAP UNIQUE KEY (`dt`, `name`) "+
+		"PARTITION BY RANGE(`dt`) ( PARTITION `p2024` VALUES LESS THAN ('2025-01-01'), PARTITION `pmax` VALUES LESS THAN (MAXVALUE) ) "+
+		"DISTRIBUTED BY HASH(`dt`, `name`) BUCKETS 16 PROPERTIES ( \"replication_num\" = \"3\" )", squashSpaces(query))
+
+	query, err = buildDorisCreateTableSQL("`t`", layoutColumns, &common.DorisTableLayout{
+		Model:           common.DorisTableModelAggregate,
+		KeyColumns:      []string{"id", "dt"},
+		Aggregations:    map[string]string{"score": "MAX", "name": "REPLACE_IF_NOT_NULL"},
+		PartitionType:   common.DorisPartitionList,
+		PartitionColumn: "dt",
+		Partitions:      []common.DorisPartition{{Name: "p1", Values: []string{"2025-01-01", "2025-01-02"}}},
+		ReplicationNum:  1,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, squashSpaces(query), "`id` BIGINT NOT NULL, `dt` DATE NOT NULL, `name` STRING REPLACE_IF_NOT_NULL, "+
+		"`score` DOUBLE MAX, `tags` ARRAY<STRING> REPLACE ) ENGINE=OLAP AGGREGATE KEY (`id`, `dt`) "+
+		"PARTITION BY LIST(`dt`) ( PARTITION `p1` VALUES IN ('2025-01-01', '2025-01-02') ) DISTRIBUTED BY HASH(`id`, `dt`) BUCKETS AUTO")
+
+	// 明细模型默认随机分桶，未指定排序列时由 Doris 选择
+	query, err = buildDorisCreateTableSQL("`t`", layoutColumns, &common.DorisTableLayout{Model: common.DorisTableModelDuplicate, ReplicationNum: 1})
+	require.NoError(t, err)
+	assert.NotContains(t, query, "KEY (")
+	assert.Contains(t, squashSpaces(query), "ENGINE=OLAP DISTRIBUTED BY RANDOM BUCKETS AUTO")
+
+	query, err = buildDorisCreateTableSQL("`t`", layoutColumns, &common.DorisTableLayout{Model: common.DorisTableModelDuplicate,
+		KeyColumns: []string{"id"}, DistributionColumns: []string{"name"}, Buckets: 4, ReplicationNum: 1})
+	require.NoError(t, err)
+	assert.Contains(t, squashSpaces(query), "`id` BIGINT NOT NULL, `name` VARCHAR(65533), `dt` DATE NOT NULL")
+	assert.Contains(t, squashSpaces(query), "DUPLICATE KEY (`id`) DISTRIBUTED BY HASH(`name`) BUCKETS 4")
+
+	// 不相容的组合不生成 DDL
+	_, err = buildDorisCreateTableSQL("`t`", layoutColumns, &common.DorisTableLayout{Model: common.DorisTableModelUnique, ReplicationNum: 1})
+	assert.ErrorContains(t, err, "UNIQUE model requires key columns")
+}
+
+func TestInternalTableSQL(t *testing.T) {
+	columns := []common.ColumnInfo{{Name: "name", DataType: "STRING", Nullable: true}}
+
+	// UNIQUE 模型没有键列时添加自增主键
+	query, err := internalTableSQL(ExternalTableConfig{TableName: "`t`", Columns: columns,
+		Layout: &common.DorisTableLayout{Model: common.DorisTableModelUnique, Buckets: 8, ReplicationNum: 3}})
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile("^CREATE TABLE IF NOT EXISTS `t` \\( `(pk_[0-9a-f]{5})` BIGINT NOT NULL AUTO_INCREMENT, `name` STRING \\) "+
+		"ENGINE=OLAP UNIQUE KEY \\(`pk_[0-9a-f]{5}`\\) DISTRIBUTED BY HASH\\(`pk_[0-9a-f]{5}`\\) BUCKETS 8 PROPERTIES \\( \"replication_num\" = \"3\" \\)$"),
+		squashSpaces(query))
+
+	query, err = internalTableSQL(ExternalTableConfig{TableName: "`t`", Columns: columns,
+		Layout: &common.DorisTableLayout{Model: common.DorisTableModelDuplicate, ReplicationNum: 1}})
+	require.NoError(t, err)
+	assert.NotContains(t, query, "AUTO_INCREMENT")
+}
+
+func TestArrowTableSQL(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "amount", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+	query, err := arrowTableSQL("db", "t", schema, common.DorisTableLayout{Model: common.DorisTableModelUnique, Buckets: 10, ReplicationNum: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE IF NOT EXISTS `db`.`t` ( `name` VARCHAR(65533), `amount` DOUBLE ) ENGINE=OLAP "+
+		"DISTRIBUTED BY RANDOM BUCKETS 10 PROPERTIES ( \"replication_num\" = \"3\" )", squashSpaces(query))
+}
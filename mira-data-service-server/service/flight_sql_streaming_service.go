@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"data-service/clients"
+	"data-service/common"
+	"data-service/config"
+	"data-service/database"
+	"data-service/generated/datasource"
+	"data-service/log"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// DorisReadMode 读取 Doris 数据的方式
+type DorisReadMode string
+
+const (
+	// DorisReadModeOSS SELECT ... INTO OUTFILE 导出 parquet 到对象存储后逐个分片读取，支持续传
+	DorisReadModeOSS DorisReadMode = "oss"
+	// DorisReadModeFlightSQL 通过 Arrow Flight SQL 查询，Doris 返回的批次直接转发给客户端，不支持续传
+	DorisReadModeFlightSQL DorisReadMode = "flight_sql"
+)
+
+// DorisReadModeFromConfig 读取 doris.read_mode，未配置或无法识别时按 oss 处理
+func DorisReadModeFromConfig() DorisReadMode {
+	conf := config.GetConfigMap()
+	if conf == nil {
+		return DorisReadModeOSS
+	}
+	switch mode := DorisReadMode(strings.ToLower(strings.TrimSpace(conf.DorisConfig.ReadMode))); mode {
+	case DorisReadModeOSS, DorisReadModeFlightSQL:
+		return mode
+	case "":
+		return DorisReadModeOSS
+	default:
+		log.Logger.Warnf("Unknown doris read_mode %q, falling back to %s", mode, DorisReadModeOSS)
+		return DorisReadModeOSS
+	}
+}
+
+// FlightSQLStreamingService 通过 Doris 的 Arrow Flight SQL 接口执行查询，并把结果批次直接转发到 gRPC 流
+type FlightSQLStreamingService struct {
+	newClient func(ctx context.Context) (*clients.DorisFlightSQLClient, error)
+	// batchSize 单条消息的最多行数，不大于 0 时按 Doris 返回的批次发送
+	batchSize int64
+}
+
+func NewFlightSQLStreamingService() *FlightSQLStreamingService {
+	return &FlightSQLStreamingService{
+		newClient: clients.NewDorisFlightSQLClientFromConfig,
+		batchSize: int64(config.GetConfigMap().StreamConfig.ParquetBatchSize),
+	}
+}
+
+// StreamQuery 执行查询并逐批发送，返回已发送的行数
+// 超过 parquet_batch_size 行的批次切成多个发送，避免单条消息过大
+func (s *FlightSQLStreamingService) StreamQuery(ctx context.Context, query string,
+	stream datasource.DataSourceService_ReadDataSourceStreamingServer) (int64, error) {
+	client, err := s.newClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	var sent, chunkID int64
+	send := func(rec arrow.Record) error {
+		if err := sendArrowRecord(stream, rec, chunkID, ""); err != nil {
+			return err
+		}
+		sent += rec.NumRows()
+		chunkID++
+		return nil
+	}
+
+	log.Logger.Infof("Executing flight sql query: %s", query)
+	_, err = client.Query(ctx, query, func(rec arrow.Record) error {
+		if s.batchSize <= 0 || rec.NumRows() <= s.batchSize {
+			return send(rec)
+		}
+		for offset := int64(0); offset < rec.NumRows(); offset += s.batchSize {
+			slice := rec.NewSlice(offset, min(offset+s.batchSize, rec.NumRows()))
+			err := send(slice)
+			slice.Release()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return sent, err
+}
+
+// DorisDataReader 把读取请求的数据源准备到 Doris 后按 read_mode 流式返回
+// flight_sql 在发送任何批次之前失败时退回 oss 方式
+type DorisDataReader struct {
+	dorisService  IDorisService
+	parquetStream *ParquetStreamingService
+	flightStream  *FlightSQLStreamingService
+	mode          DorisReadMode
+}
+
+func NewDorisDataReader(dorisService IDorisService, parquetStream *ParquetStreamingService) *DorisDataReader {
+	return &DorisDataReader{
+		dorisService:  dorisService,
+		parquetStream: parquetStream,
+		flightStream:  NewFlightSQLStreamingService(),
+		mode:          DorisReadModeFromConfig(),
+	}
+}
+
+// Stream 准备数据源并流式返回，返回数据在 Doris 中的表名
+// position 不为空时 oss 方式的批次附带续传令牌并随发送前移；flight_sql 方式的批次不带续传令牌。
+func (r *DorisDataReader) Stream(request *datasource.ReadDataSourceStreamingRequest, enhancedJobInstanceId string,
+	position *common.ResumeToken, stream datasource.DataSourceService_ReadDataSourceStreamingServer) (string, error) {
+	dbName, tableName, err := r.dorisService.PrepareDataSourceInDoris(request, enhancedJobInstanceId)
+	if err != nil {
+		return "", err
+	}
+	exportRequest := NewDorisExportRequest(request, dbName, tableName, enhancedJobInstanceId)
+
+	if r.mode == DorisReadModeFlightSQL {
+		query, err := (&database.SQLGenerator{}).BuildExportSelectSQL(exportRequest)
+		if err != nil {
+			return tableName, fmt.Errorf("failed to build flight sql query: %v", err)
+		}
+		sent, err := r.flightStream.StreamQuery(stream.Context(), query, stream)
+		if err == nil {
+			log.Logger.Infof("Successfully streamed %d rows of %s.%s through flight sql for jobInstanceId: %s",
+				sent, dbName, tableName, enhancedJobInstanceId)
+			return tableName, nil
+		}
+		// 已经发送过数据时无法换一种方式重新读取
+		if sent > 0 || stream.Context().Err() != nil {
+			return tableName, fmt.Errorf("failed to stream %s.%s through flight sql after %d rows: %v", dbName, tableName, sent, err)
+		}
+		log.Logger.Warnf("Flight sql read of %s.%s failed, falling back to oss export: %v", dbName, tableName, err)
+	}
+
+	if err := r.dorisService.ExportParquetFileFromDoris(exportRequest); err != nil {
+		log.Logger.Errorf("failed to export parquet file from doris: %v", err)
+		return tableName, fmt.Errorf("failed to export parquet file from doris: %v", err)
+	}
+	log.Logger.Infof("Successfully exported table %s to MinIO for jobInstanceId: %s", tableName, enhancedJobInstanceId)
+
+	if err := r.parquetStream.StreamParquetFileFromOSS(enhancedJobInstanceId, request.Columns, position, stream); err != nil {
+		return tableName, fmt.Errorf("failed to stream parquet file from OSS: %v", err)
+	}
+	return tableName, nil
+}
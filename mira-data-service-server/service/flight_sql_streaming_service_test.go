@@ -0,0 +1,169 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"data-service/clients"
+	"data-service/generated/datasource"
+	"data-service/mocks"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/flight"
+	"github.com/apache/arrow/go/v15/arrow/flight/flightsql"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeArrowStream 记录发送给客户端的 Arrow 批次
+type fakeArrowStream struct {
+	grpc.ServerStream
+	responses []*datasource.ArrowResponse
+}
+
+func (s *fakeArrowStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeArrowStream) Send(response *datasource.ArrowResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func (s *fakeArrowStream) ids(t *testing.T) [][]int64 {
+	var batches [][]int64
+	for _, response := range s.responses {
+		reader, err := ipc.NewReader(bytes.NewReader(response.ArrowBatch))
+		require.NoError(t, err)
+		for reader.Next() {
+			batches = append(batches, append([]int64(nil), reader.Record().Column(0).(*array.Int64).Int64Values()...))
+		}
+		reader.Release()
+	}
+	return batches
+}
+
+type flightAuth struct{}
+
+func (flightAuth) Validate(string, string) (string, error) { return "token", nil }
+func (flightAuth) IsValid(string) (interface{}, error)     { return "", nil }
+
+// fakeDorisFlightSQL 本地模拟的 Doris Flight SQL 服务，只返回一个由 rows 个 id 组成的批次
+type fakeDorisFlightSQL struct {
+	flightsql.BaseServer
+	rows  int64
+	query string
+}
+
+func (f *fakeDorisFlightSQL) GetFlightInfoStatement(_ context.Context, cmd flightsql.StatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	f.query = cmd.GetQuery()
+	ticket, err := flightsql.CreateStatementQueryTicket([]byte("0"))
+	if err != nil {
+		return nil, err
+	}
+	return &flight.FlightInfo{FlightDescriptor: desc, Endpoint: []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: ticket}}}}, nil
+}
+
+func (f *fakeDorisFlightSQL) DoGetStatement(context.Context, flightsql.StatementQueryTicket) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for i := int64(0); i < f.rows; i++ {
+		builder.Field(0).(*array.Int64Builder).Append(i)
+	}
+	chunks := make(chan flight.StreamChunk, 1)
+	chunks <- flight.StreamChunk{Data: builder.NewRecord()}
+	close(chunks)
+	return schema, chunks, nil
+}
+
+func newFlightSQLStreamingServiceForTest(t *testing.T, fake *fakeDorisFlightSQL, batchSize int64) *FlightSQLStreamingService {
+	server := flight.NewServerWithMiddleware([]flight.ServerMiddleware{flight.CreateServerBasicAuthMiddleware(flightAuth{})})
+	server.RegisterFlightService(flightsql.NewFlightServer(fake))
+	require.NoError(t, server.Init("localhost:0"))
+	go server.Serve()
+	t.Cleanup(server.Shutdown)
+
+	return &FlightSQLStreamingService{
+		newClient: func(ctx context.Context) (*clients.DorisFlightSQLClient, error) {
+			return clients.NewDorisFlightSQLClient(ctx, server.Addr().String(), "root", "")
+		},
+		batchSize: batchSize,
+	}
+}
+
+func TestFlightSQLStreamingServiceSplitsLargeBatches(t *testing.T) {
+	fake := &fakeDorisFlightSQL{rows: 5}
+	service := newFlightSQLStreamingServiceForTest(t, fake, 2)
+
+	stream := &fakeArrowStream{}
+	sent, err := service.StreamQuery(context.Background(), "SELECT `id` FROM `db`.`t`", stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), sent)
+	assert.Equal(t, "SELECT `id` FROM `db`.`t`", fake.query)
+	assert.Equal(t, [][]int64{{0, 1}, {2, 3}, {4}}, stream.ids(t))
+	// Flight SQL 读取不支持续传
+	for _, response := range stream.responses {
+		assert.Empty(t, response.ResumeToken)
+	}
+}
+
+func TestDorisDataReaderStreamsThroughFlightSQL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &datasource.ReadDataSourceStreamingRequest{
+		Columns:    []string{"id"},
+		DataSource: &datasource.ReadDataSourceStreamingRequest_Doris{Doris: &datasource.DorisDataSource{DbName: "db", TableName: "t"}},
+	}
+	dorisService := mocks.NewMockIDorisService(ctrl)
+	dorisService.EXPECT().PrepareDataSourceInDoris(request, "job_1").Return("db", "t", nil)
+
+	fake := &fakeDorisFlightSQL{rows: 3}
+	reader := &DorisDataReader{
+		dorisService: dorisService,
+		flightStream: newFlightSQLStreamingServiceForTest(t, fake, 0),
+		mode:         DorisReadModeFlightSQL,
+	}
+	stream := &fakeArrowStream{}
+	tableName, err := reader.Stream(request, "job_1", nil, stream)
+	require.NoError(t, err)
+	assert.Equal(t, "t", tableName)
+	assert.Equal(t, "SELECT `id` FROM `db`.`t`", fake.query)
+	assert.Equal(t, [][]int64{{0, 1, 2}}, stream.ids(t))
+}
+
+func TestDorisDataReaderFallsBackToOSS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &datasource.ReadDataSourceStreamingRequest{
+		DataSource: &datasource.ReadDataSourceStreamingRequest_Doris{Doris: &datasource.DorisDataSource{DbName: "db", TableName: "t"}},
+	}
+	dorisService := mocks.NewMockIDorisService(ctrl)
+	dorisService.EXPECT().PrepareDataSourceInDoris(request, "job_1").Return("db", "t", nil)
+	// Flight SQL 连接失败且未发送数据，改为导出到对象存储
+	dorisService.EXPECT().ExportParquetFileFromDoris(gomock.Any()).DoAndReturn(func(export *datasource.ExportCsvFileFromDorisRequest) error {
+		assert.Equal(t, "db", export.DbName)
+		assert.Equal(t, "t", export.TableName)
+		assert.Equal(t, "job_1", export.JobInstanceId)
+		return fmt.Errorf("s3 unavailable")
+	})
+
+	reader := &DorisDataReader{
+		dorisService: dorisService,
+		flightStream: &FlightSQLStreamingService{newClient: func(context.Context) (*clients.DorisFlightSQLClient, error) {
+			return nil, fmt.Errorf("connection refused")
+		}},
+		mode: DorisReadModeFlightSQL,
+	}
+	_, err := reader.Stream(request, "job_1", nil, &fakeArrowStream{})
+	assert.ErrorContains(t, err, "failed to export parquet file from doris: s3 unavailable")
+}
@@ -4,11 +4,18 @@ import (
 	"context"
 	"data-service/common"
 	"data-service/config"
+	"data-service/database"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
 	pb "data-service/generated/datasource"
 	"data-service/log"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+
+	gormlib "gorm.io/gorm"
 )
 
 // ImportService 数据导入服务
@@ -30,7 +37,9 @@ func (s *ImportService) ImportData(ctx context.Context, request *pb.ImportDataRe
 		Results: make([]*pb.ImportResult, 0, len(request.Targets)),
 	}
 
-	for _, target := range request.Targets {
+	progress := JobProgressFromContext(ctx)
+	for i, target := range request.Targets {
+		progress.SetPhase(fmt.Sprintf("importing %s.%s", target.DbName, target.TargetTableName))
 		result := s.processImportTarget(ctx, target)
 		response.Results = append(response.Results, result)
 
@@ -38,6 +47,13 @@ func (s *ImportService) ImportData(ctx context.Context, request *pb.ImportDataRe
 		if !result.Success {
 			response.Success = false
 		}
+		progress.AddRows(result.AffectedRows)
+		progress.SetPercent(float64(i+1) * 100 / float64(len(request.Targets)))
+
+		// 作业被取消后不再导入剩余的目标
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("import cancelled: %w", err)
+		}
 	}
 
 	// 设置最终消息
@@ -61,7 +77,7 @@ func (s *ImportService) processImportTarget(ctx context.Context, target *pb.Impo
 	}
 
 	// 2. 导入数据
-	tableName, affectedRows, err := s.importDataToDoris(target)
+	tableName, affectedRows, err := s.importDataToDoris(ctx, target, result)
 	if err != nil {
 		result.ErrorMessage = err.Error()
 		return result
@@ -94,8 +110,8 @@ func (s *ImportService) ensureDatabase(dbName string) error {
 	return nil
 }
 
-// importDataToDoris 将数据导入到 Doris
-func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int64, error) {
+// importDataToDoris 将数据导入到 Doris 并与源表对账，增量导入后的水位与对账结果写入 result
+func (s *ImportService) importDataToDoris(ctx context.Context, target *pb.ImportTarget, result *pb.ImportResult) (string, int64, error) {
 	log.Logger.Infof("importDataToDoris params - DbName: %s, TargetTableName: %s, Columns: %v, External: %+v, Keys: %+v",
 		target.DbName, target.TargetTableName, target.Columns, target.External, target.Keys)
 
@@ -103,7 +119,7 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 	unlock := lockImport(fmt.Sprintf("%s.%s", target.DbName, target.TargetTableName))
 	defer unlock()
 
-	dorisService, err := NewDorisService(target.DbName)
+	dorisService, err := NewDorisServiceWithContext(ctx, target.DbName)
 	if err != nil {
 		return "", 0, fmt.Errorf("failed to create doris service: %v", err)
 	}
@@ -114,6 +130,24 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 	}
 	jobInstanceId := target.DbName + "_" + randomSuffix
 
+	// 未指定表键时从源表读取单列自增主键
+	if len(target.Keys) == 0 {
+		target.Keys = detectAssetAutoIncrementKeys(target.External, target.Columns)
+		if len(target.Keys) > 0 {
+			log.Logger.Infof("Detected auto-increment primary key %v of asset %s", target.Keys[0].ColumnNames, target.External.AssetName)
+		}
+	}
+
+	// 按表键及其属性确定内部表的建表方式，不相容的组合在建表前报错
+	layout, err := parseDorisTableLayout(target.Keys, dorisTableLayoutDefaults())
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid table layout of %s.%s: %v", target.DbName, target.TargetTableName, err)
+	}
+
+	if target.Incremental != nil && target.Incremental.WatermarkColumn != "" {
+		return s.importIncrementally(ctx, dorisService, target, layout, jobInstanceId, result)
+	}
+
 	// 优先使用 ImportTarget.keys 中的“自增主键”定义
 	if pk, ok := getAutoIncrementPrimaryKey(target); ok {
 		// 从配置文件读取分批大小，如果未配置则使用默认值 5000
@@ -128,6 +162,7 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 			jobInstanceId,
 			target.TargetTableName,
 			target.DbName,
+			layout,
 			pk,
 			batchSize,
 			target.Columns...,
@@ -140,6 +175,9 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 			log.Logger.Warnf("Failed to get row count for table %s: %v", tableName, err)
 			affectedRows = 0
 		}
+		if err := s.reconcile(ctx, target, tableName, nil, result); err != nil {
+			return "", 0, err
+		}
 		return tableName, affectedRows, nil
 	}
 
@@ -150,6 +188,7 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 		jobInstanceId,
 		target.TargetTableName,
 		target.DbName,
+		layout,
 		target.Columns...,
 	)
 	if err != nil {
@@ -163,10 +202,91 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 		// 即使无法获取行数，也不影响主流程
 		affectedRows = 0
 	}
+	if err := s.reconcile(ctx, target, tableName, nil, result); err != nil {
+		return "", 0, err
+	}
 
 	return tableName, affectedRows, nil
 }
 
+// importIncrementally 按水位列增量导入，水位按目标库表保存在元数据库中
+// 没有保存的水位、水位列变化或要求全量刷新时重建目标表并全量导入
+func (s *ImportService) importIncrementally(ctx context.Context, dorisService IDorisService, target *pb.ImportTarget, layout *common.DorisTableLayout, jobInstanceId string, result *pb.ImportResult) (string, int64, error) {
+	db := gorm.GetGormDB()
+	if db == nil {
+		return "", 0, fmt.Errorf("metadata database is not initialized, cannot load import watermark")
+	}
+	watermarkRepo := repositories.NewImportWatermarkRepository(db)
+	watermarkColumn := target.Incremental.WatermarkColumn
+
+	fromWatermark := ""
+	stored, err := watermarkRepo.FindByTarget(target.DbName, target.TargetTableName)
+	switch {
+	case errors.Is(err, gormlib.ErrRecordNotFound):
+		log.Logger.Infof("No watermark stored for %s.%s, running full import", target.DbName, target.TargetTableName)
+	case err != nil:
+		return "", 0, fmt.Errorf("failed to load import watermark: %v", err)
+	case target.Incremental.FullRefresh:
+		log.Logger.Infof("Full refresh requested for %s.%s, ignoring watermark '%s'", target.DbName, target.TargetTableName, stored.Watermark)
+	case stored.WatermarkColumn != watermarkColumn:
+		log.Logger.Infof("Watermark column of %s.%s changed from %s to %s, running full import",
+			target.DbName, target.TargetTableName, stored.WatermarkColumn, watermarkColumn)
+	default:
+		fromWatermark = stored.Watermark
+	}
+
+	imported, err := dorisService.ImportAssetIncrementally(common.IncrementalImportRequest{
+		AssetName:       target.External.AssetName,
+		ChainInfoId:     target.External.ChainInfoId,
+		Alias:           target.External.Alias,
+		JobInstanceId:   jobInstanceId,
+		TargetTableName: target.TargetTableName,
+		TargetDbName:    target.DbName,
+		Columns:         target.Columns,
+		WatermarkColumn: watermarkColumn,
+		FromWatermark:   fromWatermark,
+		Layout:          layout,
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to import asset incrementally: %v", err)
+	}
+
+	// 对账不一致时不保存水位，下次从旧水位重新导入
+	window := &watermarkWindow{column: watermarkColumn, to: imported.Watermark}
+	if imported.Incremental {
+		window.from = fromWatermark
+	}
+	if err := s.reconcile(ctx, target, imported.TableName, window, result); err != nil {
+		return "", 0, err
+	}
+
+	// 数据已导入但水位保存失败时，下次从旧水位重新导入，有键的目标表按键覆盖，不会重复
+	err = watermarkRepo.Save(&models.ImportWatermark{
+		DbName:          target.DbName,
+		TargetTable:     target.TargetTableName,
+		AssetName:       target.External.AssetName,
+		WatermarkColumn: watermarkColumn,
+		Watermark:       imported.Watermark,
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to save import watermark: %v", err)
+	}
+
+	result.Watermark = imported.Watermark
+	result.Incremental = imported.Incremental
+	return imported.TableName, imported.Rows, nil
+}
+
+// reconcile 导入后与源表对账，结果写入 result，两端不一致或无法对账时返回错误
+func (s *ImportService) reconcile(ctx context.Context, target *pb.ImportTarget, tableName string, window *watermarkWindow, result *pb.ImportResult) error {
+	report, err := reconcileImport(ctx, target, tableName, window)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile import: %v", err)
+	}
+	result.Reconciliation = report
+	return database.ReconcileError(report)
+}
+
 // getTableRowCount 获取表的行数
 func (s *ImportService) getTableRowCount(dorisService IDorisService, tableName string) (int64, error) {
 	countSQL := fmt.Sprintf("SELECT COUNT(*) FROM %s", tableName)
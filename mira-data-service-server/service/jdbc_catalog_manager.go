@@ -0,0 +1,417 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+)
+
+const (
+	// 未配置 catalog_idle_timeout 时 Catalog 的默认空闲删除时间
+	defaultCatalogIdleTimeout = 30 * time.Minute
+	// 未配置 catalog_refresh_interval 时复用 Catalog 前刷新元数据的默认间隔
+	defaultCatalogRefreshInterval = 5 * time.Minute
+	// 空闲 Catalog 巡检间隔
+	catalogJanitorInterval = 5 * time.Minute
+	// 数据源 Catalog 的名称前缀
+	jdbcCatalogPrefix = "mira_jdbc_"
+	// Catalog 租约的有效期，巡检时续期，进程退出后租约到期，由清理任务删除遗留的 Catalog
+	catalogLeaseTTL = 3 * catalogJanitorInterval
+	// Catalog 租约对应的清理任务类型
+	jdbcCatalogCleanupTaskType = "jdbc_catalog"
+)
+
+// jdbcCatalogInstance 本进程创建的 Catalog 名称中的实例标识
+// 引用计数只在进程内有效，各副本、各进程使用各自的 Catalog，不会删除其他进程正在使用的 Catalog。
+var jdbcCatalogInstance = newCatalogInstanceTag()
+
+func newCatalogInstanceTag() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate jdbc catalog instance tag: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
+
+// jdbcCatalogDDL 在 Doris 中创建、刷新、删除 JDBC Catalog
+type jdbcCatalogDDL interface {
+	createCatalog(name string, source *ds.ConnectionInfo) error
+	refreshCatalog(name string) error
+	dropCatalog(name string, source *ds.ConnectionInfo) error
+}
+
+// jdbcCatalogLeases 在元数据库中记录 Catalog 租约，进程异常退出后到期的租约由清理任务删除对应的 Catalog
+type jdbcCatalogLeases interface {
+	renew(name string, expiresAt time.Time) error
+	release(name string) error
+}
+
+// jdbcCatalog 管理器中的一个 Catalog
+type jdbcCatalog struct {
+	name   string
+	source *ds.ConnectionInfo
+
+	// mu 串行化同一 Catalog 的创建与刷新
+	mu          sync.Mutex
+	created     bool
+	refreshedAt time.Time
+
+	// refs、lastUsed 由 JdbcCatalogManager.mu 保护
+	refs     int
+	lastUsed time.Time
+}
+
+// JdbcCatalogManager 管理导入数据资产所用的 Doris JDBC Catalog
+// 同一数据源（按连接指纹）共用一个 Catalog，导入期间持有引用，引用全部释放且空闲超过 catalog_idle_timeout 后删除。
+type JdbcCatalogManager struct {
+	ddl    jdbcCatalogDDL
+	leases jdbcCatalogLeases
+	// refreshInterval 复用 Catalog 前刷新元数据的间隔
+	refreshInterval func() time.Duration
+
+	mu       sync.Mutex
+	catalogs map[string]*jdbcCatalog
+}
+
+var (
+	jdbcCatalogManager = &JdbcCatalogManager{
+		ddl:             dorisCatalogDDL{},
+		leases:          cleanupTaskCatalogLeases{},
+		refreshInterval: catalogRefreshInterval,
+		catalogs:        make(map[string]*jdbcCatalog),
+	}
+	catalogJanitorOnce sync.Once
+)
+
+// GetJdbcCatalogManager 获取全局 Catalog 管理器
+func GetJdbcCatalogManager() *JdbcCatalogManager {
+	return jdbcCatalogManager
+}
+
+// JdbcCatalogName 数据源对应的 Catalog 名称，由进程实例标识和连接指纹的摘要生成，密码或 TLS 配置变化后使用新的 Catalog
+func JdbcCatalogName(source *ds.ConnectionInfo) string {
+	sum := sha256.Sum256([]byte(database.PoolFingerprint(fmt.Sprint(source.Dbtype), source)))
+	return jdbcCatalogPrefix + jdbcCatalogInstance + "_" + hex.EncodeToString(sum[:8])
+}
+
+// Acquire 获取数据源的 Catalog 并增加引用计数，返回 Catalog 名称，用完后必须调用 Release
+// Catalog 不存在时创建；复用超过 catalog_refresh_interval 未刷新的 Catalog 前先刷新元数据。
+func (m *JdbcCatalogManager) Acquire(source *ds.ConnectionInfo) (string, error) {
+	name := JdbcCatalogName(source)
+
+	m.mu.Lock()
+	c, ok := m.catalogs[name]
+	if !ok {
+		c = &jdbcCatalog{name: name, source: source}
+		m.catalogs[name] = c
+	}
+	c.refs++
+	c.lastUsed = time.Now()
+	m.mu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.created {
+		if err := m.ddl.createCatalog(name, source); err != nil {
+			m.Release(name)
+			return "", fmt.Errorf("failed to create catalog %s: %v", name, err)
+		}
+		c.created = true
+		c.refreshedAt = time.Now()
+		m.renewLease(name)
+		log.Logger.Infof("Created jdbc catalog %s for %s:%d/%s", name, source.Host, source.Port, source.DbName)
+		return name, nil
+	}
+	if time.Since(c.refreshedAt) >= m.refreshInterval() {
+		if err := m.refreshLocked(c); err != nil {
+			m.Release(name)
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// Release 释放一次 Acquire 得到的引用
+func (m *JdbcCatalogManager) Release(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.catalogs[name]
+	if !ok || c.refs == 0 {
+		log.Logger.Warnf("Releasing jdbc catalog %s that is not acquired", name)
+		return
+	}
+	c.refs--
+	c.lastUsed = time.Now()
+}
+
+// Refresh 立即刷新 Catalog 的元数据，用于源表结构变化或新建表后 Doris 缓存的元数据过期的情况
+func (m *JdbcCatalogManager) Refresh(name string) error {
+	m.mu.Lock()
+	c, ok := m.catalogs[name]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("jdbc catalog %s is not managed", name)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.created {
+		return fmt.Errorf("jdbc catalog %s is not created", name)
+	}
+	return m.refreshLocked(c)
+}
+
+// refreshLocked 刷新元数据，调用方持有 c.mu
+func (m *JdbcCatalogManager) refreshLocked(c *jdbcCatalog) error {
+	if err := m.ddl.refreshCatalog(c.name); err != nil {
+		return fmt.Errorf("failed to refresh catalog %s: %v", c.name, err)
+	}
+	c.refreshedAt = time.Now()
+	log.Logger.Infof("Refreshed jdbc catalog %s", c.name)
+	return nil
+}
+
+// renewLease 续期 Catalog 租约，失败时只记录日志，Catalog 仍可使用
+func (m *JdbcCatalogManager) renewLease(name string) {
+	if err := m.leases.renew(name, time.Now().Add(catalogLeaseTTL)); err != nil {
+		log.Logger.Warnf("Failed to renew lease of jdbc catalog %s: %v", name, err)
+	}
+}
+
+// EvictIdle 删除没有引用且空闲超过 idleTimeout 的 Catalog，返回删除数量，保留的 Catalog 续期租约
+// 删除期间持有锁，避免同一数据源的新导入在 DROP CATALOG 之前拿到即将删除的 Catalog。
+func (m *JdbcCatalogManager) EvictIdle(idleTimeout time.Duration) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	evicted := 0
+	for name, c := range m.catalogs {
+		if c.refs > 0 || time.Since(c.lastUsed) < idleTimeout {
+			if c.created {
+				m.renewLease(name)
+			}
+			continue
+		}
+		delete(m.catalogs, name)
+		evicted++
+		if !c.created {
+			continue
+		}
+		if err := m.ddl.dropCatalog(name, c.source); err != nil {
+			// 租约保留，到期后由清理任务重试删除
+			log.Logger.Warnf("Failed to drop idle jdbc catalog %s: %v", name, err)
+			continue
+		}
+		if err := m.leases.release(name); err != nil {
+			log.Logger.Warnf("Failed to release lease of jdbc catalog %s: %v", name, err)
+		}
+	}
+	if evicted > 0 {
+		log.Logger.Infof("Evicted %d idle jdbc catalogs, remaining: %d", evicted, len(m.catalogs))
+	}
+	return evicted
+}
+
+// cleanupTaskCatalogLeases 以到期后才执行的清理任务记录 Catalog 租约
+type cleanupTaskCatalogLeases struct{}
+
+func (cleanupTaskCatalogLeases) renew(name string, expiresAt time.Time) error {
+	return NewCleanupTaskService().ScheduleCleanupTask(name, jdbcCatalogCleanupTaskType, expiresAt)
+}
+
+func (cleanupTaskCatalogLeases) release(name string) error {
+	return NewCleanupTaskService().CompleteCleanupTask(name)
+}
+
+// catalogIdleTimeout 读取 Catalog 空闲删除时间（分钟），未配置时使用默认值
+func catalogIdleTimeout() time.Duration {
+	conf := config.GetConfigMap()
+	if conf == nil || conf.DorisConfig.CatalogIdleTimeout <= 0 {
+		return defaultCatalogIdleTimeout
+	}
+	return time.Duration(conf.DorisConfig.CatalogIdleTimeout) * time.Minute
+}
+
+// catalogRefreshInterval 读取复用 Catalog 前刷新元数据的间隔（秒），未配置时使用默认值
+func catalogRefreshInterval() time.Duration {
+	conf := config.GetConfigMap()
+	if conf == nil || conf.DorisConfig.CatalogRefreshInterval <= 0 {
+		return defaultCatalogRefreshInterval
+	}
+	return time.Duration(conf.DorisConfig.CatalogRefreshInterval) * time.Second
+}
+
+// StartJdbcCatalogJanitor 启动空闲 Catalog 回收任务，只会启动一次
+func StartJdbcCatalogJanitor() {
+	catalogJanitorOnce.Do(func() {
+		go func() {
+			log.Logger.Info("Starting jdbc catalog janitor")
+			ticker := time.NewTicker(catalogJanitorInterval)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				jdbcCatalogManager.EvictIdle(catalogIdleTimeout())
+			}
+		}()
+	})
+}
+
+// catalogSourceTable 源表在 Catalog 中所属的库和表名
+// Catalog 的库对应 MySQL 类数据源的库，或 PostgreSQL、Oracle 类数据源的模式；表名带模式前缀时以该模式为准。
+func catalogSourceTable(source *ds.ConnectionInfo, tableType string) (string, string) {
+	if i := strings.LastIndex(source.TableName, "."); i > 0 {
+		return source.TableName[:i], source.TableName[i+1:]
+	}
+	switch tableType {
+	case "postgresql", "kingbase8", "vastbase":
+		return "public", source.TableName
+	default:
+		return source.DbName, source.TableName
+	}
+}
+
+// isStaleCatalogError 错误是否由 Catalog 缓存的元数据过期导致（找不到源库、源表或列）
+func isStaleCatalogError(err error) bool {
+	low := strings.ToLower(err.Error())
+	return strings.Contains(low, "unknown database") ||
+		strings.Contains(low, "unknown table") ||
+		strings.Contains(low, "unknown column") ||
+		strings.Contains(low, "does not exist")
+}
+
+// buildCreateJdbcCatalogSQL 创建 JDBC Catalog 的 SQL，只同步 jdbc_url 中指定的库
+func buildCreateJdbcCatalogSQL(name string, props ExternalTableConfig) string {
+	dorisDialect := database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS)
+	quote := func(s string) string {
+		literal, _ := dorisDialect.QuoteLiteral(s)
+		return literal
+	}
+	return fmt.Sprintf(`CREATE CATALOG %s PROPERTIES (
+	"type" = "jdbc",
+	"user" = %s,
+	"password" = %s,
+	"jdbc_url" = %s,
+	"driver_url" = %s,
+	"driver_class" = %s,
+	"only_specified_database" = "true"
+)`, dorisDialect.QuoteIdentifier(name), quote(props.Username), quote(props.Password),
+		quote(props.JdbcURL), quote(props.DriverURL), quote(props.DriverClass))
+}
+
+// dorisCatalogDDL 连接 mira_task_tmp 执行 Catalog DDL，Catalog 引用的 TLS 证书 FILE 保存在该库中，不随导入的目标库删除
+type dorisCatalogDDL struct{}
+
+func (dorisCatalogDDL) connect() (*DorisService, error) {
+	strategy, err := connectDoris(common.MIRA_TMP_TASK_DB)
+	if err != nil {
+		return nil, err
+	}
+	return &DorisService{dbStrategy: strategy}, nil
+}
+
+func (d dorisCatalogDDL) createCatalog(name string, source *ds.ConnectionInfo) error {
+	s, err := d.connect()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	return s.createJdbcCatalog(name, source)
+}
+
+func (d dorisCatalogDDL) refreshCatalog(name string) error {
+	s, err := d.connect()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	_, err = s.ExecuteUpdate("REFRESH CATALOG " + database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS).QuoteIdentifier(name))
+	return err
+}
+
+func (d dorisCatalogDDL) dropCatalog(name string, source *ds.ConnectionInfo) error {
+	s, err := d.connect()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	return s.dropJdbcCatalog(name, source)
+}
+
+// createJdbcCatalog 上传 TLS 证书并创建数据源的 JDBC Catalog，证书 FILE 以 Catalog 名称命名
+// 同名 Catalog 可能是本进程之前删除失败遗留的，其引用的证书 FILE 已不可用，先删除再按本次的属性重建。
+func (s *DorisService) createJdbcCatalog(name string, source *ds.ConnectionInfo) error {
+	tlsEnabled := source.TlsConfig != nil && source.TlsConfig.UseTls == 2
+	// Hive JDBC 驱动的 sslTrustStore 只接受 BE 本地路径，无法引用上传的证书，与直连一样拒绝 TLS，避免不校验证书的连接
+	if tlsEnabled && source.Dbtype == int32(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE) {
+		return database.ErrHiveTLSUnsupported
+	}
+	if err := s.dropJdbcCatalog(name, source); err != nil {
+		return err
+	}
+	if tlsEnabled {
+		if err := s.processTLSCertificates(source.TlsConfig, name, source.Dbtype); err != nil {
+			s.cleanupTlsFiles(common.MIRA_TMP_TASK_DB, name, source.Dbtype)
+			return fmt.Errorf("failed to process TLS certificates: %v", err)
+		}
+	}
+
+	tableType, driverURL, driverClass := s.jdbcDriverInfo(source)
+	props := ExternalTableConfig{
+		JdbcURL:     s.buildJdbcURL(source, tableType, name, common.MIRA_TMP_TASK_DB),
+		DriverURL:   driverURL,
+		DriverClass: driverClass,
+		Username:    source.User,
+		Password:    source.Password,
+	}
+	if _, err := s.ExecuteUpdate(buildCreateJdbcCatalogSQL(name, props)); err != nil {
+		LogSourceConnectionError(err, SourceConnLogCtx{
+			TableType:   tableType,
+			JdbcURL:     props.JdbcURL,
+			DriverURL:   props.DriverURL,
+			DriverClass: props.DriverClass,
+			Username:    props.Username,
+		})
+		if tlsEnabled {
+			s.cleanupTlsFiles(common.MIRA_TMP_TASK_DB, name, source.Dbtype)
+		}
+		return err
+	}
+	return nil
+}
+
+// dropJdbcCatalog 删除 Catalog 及其 TLS 证书
+func (s *DorisService) dropJdbcCatalog(name string, source *ds.ConnectionInfo) error {
+	dropSQL := "DROP CATALOG IF EXISTS " + database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS).QuoteIdentifier(name)
+	if _, err := s.ExecuteUpdate(dropSQL); err != nil {
+		return err
+	}
+	if source.TlsConfig != nil && source.TlsConfig.UseTls == 2 {
+		s.cleanupTlsFiles(common.MIRA_TMP_TASK_DB, name, source.Dbtype)
+	}
+	log.Logger.Infof("Dropped jdbc catalog %s", name)
+	return nil
+}
+
+// dropOrphanJdbcCatalog 删除租约到期的 Catalog，创建它的进程已退出，不知道数据源类型，按各类证书的文件名清理
+func (s *DorisService) dropOrphanJdbcCatalog(name string) error {
+	dropSQL := "DROP CATALOG IF EXISTS " + database.DialectFor(ds.DataSourceType_DATA_SOURCE_TYPE_DORIS).QuoteIdentifier(name)
+	if _, err := s.ExecuteUpdate(dropSQL); err != nil {
+		return err
+	}
+	for _, dbType := range []ds.DataSourceType{ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE} {
+		s.cleanupTlsFiles(common.MIRA_TMP_TASK_DB, name, int32(dbType))
+	}
+	log.Logger.Infof("Dropped orphan jdbc catalog %s", name)
+	return nil
+}
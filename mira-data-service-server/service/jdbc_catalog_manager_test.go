@@ -0,0 +1,219 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"data-service/database"
+	ds "data-service/generated/datasource"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCatalogDDL 记录执行过的 Catalog DDL
+type fakeCatalogDDL struct {
+	calls     []string
+	createErr error
+}
+
+func (f *fakeCatalogDDL) createCatalog(name string, _ *ds.ConnectionInfo) error {
+	f.calls = append(f.calls, "create "+name)
+	return f.createErr
+}
+
+func (f *fakeCatalogDDL) refreshCatalog(name string) error {
+	f.calls = append(f.calls, "refresh "+name)
+	return nil
+}
+
+func (f *fakeCatalogDDL) dropCatalog(name string, _ *ds.ConnectionInfo) error {
+	f.calls = append(f.calls, "drop "+name)
+	return nil
+}
+
+// fakeCatalogLeases 记录 Catalog 租约的续期与释放
+type fakeCatalogLeases struct {
+	calls []string
+}
+
+func (f *fakeCatalogLeases) renew(name string, _ time.Time) error {
+	f.calls = append(f.calls, "renew "+name)
+	return nil
+}
+
+func (f *fakeCatalogLeases) release(name string) error {
+	f.calls = append(f.calls, "release "+name)
+	return nil
+}
+
+func newCatalogManagerForTest(ddl jdbcCatalogDDL, refreshInterval time.Duration) *JdbcCatalogManager {
+	return &JdbcCatalogManager{
+		ddl:             ddl,
+		leases:          &fakeCatalogLeases{},
+		refreshInterval: func() time.Duration { return refreshInterval },
+		catalogs:        make(map[string]*jdbcCatalog),
+	}
+}
+
+func TestJdbcCatalogName(t *testing.T) {
+	newSource := func(modify func(*ds.ConnectionInfo)) *ds.ConnectionInfo {
+		source := &ds.ConnectionInfo{Dbtype: int32(ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL), Host: "10.0.0.1", Port: 3306,
+			User: "root", Password: "secret", DbName: "shop", TableName: "orders"}
+		modify(source)
+		return source
+	}
+	name := JdbcCatalogName(newSource(func(*ds.ConnectionInfo) {}))
+	// 名称带本进程的实例标识，不同进程不共用 Catalog
+	assert.True(t, strings.HasPrefix(name, "mira_jdbc_"+jdbcCatalogInstance+"_"))
+	assert.Len(t, name, len("mira_jdbc_")+8+1+16)
+
+	// 同一数据源的不同表共用 Catalog
+	assert.Equal(t, name, JdbcCatalogName(newSource(func(s *ds.ConnectionInfo) { s.TableName = "customers" })))
+
+	// 密码、TLS 配置、数据库类型变化后使用新的 Catalog
+	assert.NotEqual(t, name, JdbcCatalogName(newSource(func(s *ds.ConnectionInfo) { s.Password = "changed" })))
+	assert.NotEqual(t, name, JdbcCatalogName(newSource(func(s *ds.ConnectionInfo) {
+		s.TlsConfig = &ds.DatasourceTlsConfig{UseTls: 2, Mode: 2, CaCert: "ca"}
+	})))
+	assert.NotEqual(t, name, JdbcCatalogName(newSource(func(s *ds.ConnectionInfo) {
+		s.Dbtype = int32(ds.DataSourceType_DATA_SOURCE_TYPE_TIDB)
+	})))
+}
+
+func TestJdbcCatalogManagerSharesAndExpires(t *testing.T) {
+	ddl := &fakeCatalogDDL{}
+	manager := newCatalogManagerForTest(ddl, time.Hour)
+	source := &ds.ConnectionInfo{Host: "10.0.0.1", Port: 3306, User: "root", DbName: "shop"}
+	name := JdbcCatalogName(source)
+
+	first, err := manager.Acquire(source)
+	require.NoError(t, err)
+	second, err := manager.Acquire(source)
+	require.NoError(t, err)
+	assert.Equal(t, name, first)
+	assert.Equal(t, name, second)
+	// 刷新间隔内复用时不刷新
+	assert.Equal(t, []string{"create " + name}, ddl.calls)
+
+	// 仍有引用时不删除
+	manager.Release(name)
+	manager.catalogs[name].lastUsed = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, 0, manager.EvictIdle(time.Hour))
+
+	// 引用全部释放后，未超过空闲时间不删除
+	manager.Release(name)
+	assert.Equal(t, 0, manager.EvictIdle(time.Hour))
+
+	manager.catalogs[name].lastUsed = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, 1, manager.EvictIdle(time.Hour))
+	assert.Equal(t, []string{"create " + name, "drop " + name}, ddl.calls)
+	// 创建时登记租约，保留期间巡检续期，删除后释放
+	leases := manager.leases.(*fakeCatalogLeases)
+	assert.Equal(t, []string{"renew " + name, "renew " + name, "renew " + name, "release " + name}, leases.calls)
+
+	// 删除后再次使用时重新创建
+	_, err = manager.Acquire(source)
+	require.NoError(t, err)
+	assert.Equal(t, "create "+name, ddl.calls[len(ddl.calls)-1])
+}
+
+func TestJdbcCatalogManagerRefresh(t *testing.T) {
+	ddl := &fakeCatalogDDL{}
+	manager := newCatalogManagerForTest(ddl, time.Minute)
+	source := &ds.ConnectionInfo{Host: "10.0.0.1", Port: 5432, User: "postgres", DbName: "shop"}
+	name := JdbcCatalogName(source)
+
+	_, err := manager.Acquire(source)
+	require.NoError(t, err)
+
+	// 超过刷新间隔后复用前刷新元数据
+	manager.catalogs[name].refreshedAt = time.Now().Add(-2 * time.Minute)
+	_, err = manager.Acquire(source)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create " + name, "refresh " + name}, ddl.calls)
+
+	// 按需刷新
+	require.NoError(t, manager.Refresh(name))
+	assert.Equal(t, "refresh "+name, ddl.calls[2])
+	assert.ErrorContains(t, manager.Refresh("mira_jdbc_unknown"), "is not managed")
+}
+
+func TestJdbcCatalogManagerCreateFailure(t *testing.T) {
+	ddl := &fakeCatalogDDL{createErr: fmt.Errorf("communications link failure")}
+	manager := newCatalogManagerForTest(ddl, time.Hour)
+	source := &ds.ConnectionInfo{Host: "10.0.0.1", Port: 3306, User: "root", DbName: "shop"}
+	name := JdbcCatalogName(source)
+
+	_, err := manager.Acquire(source)
+	assert.ErrorContains(t, err, "communications link failure")
+	// 创建失败时释放引用，下次使用时重新创建
+	assert.Equal(t, 0, manager.catalogs[name].refs)
+
+	ddl.createErr = nil
+	_, err = manager.Acquire(source)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create " + name, "create " + name}, ddl.calls)
+
+	// 未创建成功的 Catalog 过期时不执行 DROP
+	failed := &jdbcCatalog{name: "mira_jdbc_failed", lastUsed: time.Now().Add(-2 * time.Hour)}
+	manager.catalogs[failed.name] = failed
+	assert.Equal(t, 1, manager.EvictIdle(time.Hour))
+	assert.Len(t, ddl.calls, 2)
+}
+
+func TestCatalogSourceTable(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    *ds.ConnectionInfo
+		tableType string
+		db, table string
+	}{
+		{"mysql uses database", &ds.ConnectionInfo{DbName: "shop", TableName: "orders"}, "mysql", "shop", "orders"},
+		{"postgresql defaults to public", &ds.ConnectionInfo{DbName: "shop", TableName: "orders"}, "postgresql", "public", "orders"},
+		{"kingbase defaults to public", &ds.ConnectionInfo{DbName: "shop", TableName: "orders"}, "kingbase8", "public", "orders"},
+		{"schema prefix", &ds.ConnectionInfo{DbName: "shop", TableName: "sales.orders"}, "postgresql", "sales", "orders"},
+		{"oracle uses schema", &ds.ConnectionInfo{DbName: "SHOP", TableName: "ORDERS"}, "oracle", "SHOP", "ORDERS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, table := catalogSourceTable(tt.source, tt.tableType)
+			assert.Equal(t, tt.db, db)
+			assert.Equal(t, tt.table, table)
+		})
+	}
+
+	asset := &AssetInfo{Catalog: "mira_jdbc_0011", SourceDbName: "public", SourceTableName: "orders"}
+	assert.Equal(t, "`mira_jdbc_0011`.`public`.`orders`", asset.SourceTable())
+}
+
+func TestBuildCreateJdbcCatalogSQL(t *testing.T) {
+	query := buildCreateJdbcCatalogSQL("mira_jdbc_0011", ExternalTableConfig{
+		JdbcURL:     "jdbc:mysql://10.0.0.1:3306/shop?useSSL=false",
+		DriverURL:   "file:///opt/apache-doris/driver/mysql-connector-j-8.0.33.jar",
+		DriverClass: "com.mysql.cj.jdbc.Driver",
+		Username:    "root",
+		Password:    "it's",
+	})
+	assert.True(t, strings.HasPrefix(query, "CREATE CATALOG `mira_jdbc_0011` PROPERTIES ("))
+	assert.Contains(t, query, `"type" = "jdbc"`)
+	assert.Contains(t, query, `"jdbc_url" = 'jdbc:mysql://10.0.0.1:3306/shop?useSSL=false'`)
+	assert.Contains(t, query, `"password" = 'it''s'`)
+	assert.Contains(t, query, `"only_specified_database" = "true"`)
+}
+
+func TestIsStaleCatalogError(t *testing.T) {
+	assert.True(t, isStaleCatalogError(fmt.Errorf("Table [orders] does not exist in database [shop]")))
+	assert.True(t, isStaleCatalogError(fmt.Errorf("errCode = 2, detailMessage = Unknown column 'email' in 'table list'")))
+	assert.False(t, isStaleCatalogError(fmt.Errorf("communications link failure")))
+}
+
+func TestCreateJdbcCatalogRejectsHiveTLS(t *testing.T) {
+	source := &ds.ConnectionInfo{Dbtype: int32(ds.DataSourceType_DATA_SOURCE_TYPE_HIVE), Host: "10.0.0.1", Port: 10000,
+		DbName: "default", TlsConfig: &ds.DatasourceTlsConfig{UseTls: 2, Mode: 2, CaCert: "ca"}}
+	// 在执行任何 DDL 和上传证书之前拒绝，与直连 Hive 的行为一致
+	err := (&DorisService{}).createJdbcCatalog(JdbcCatalogName(source), source)
+	assert.ErrorIs(t, err, database.ErrHiveTLSUnsupported)
+}
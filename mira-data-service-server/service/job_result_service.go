@@ -126,7 +126,7 @@ func (s *DefaultJobResultService) PushJobResultToExternalDB(ctx context.Context,
 	}
 
 	// 计算结果存储类型 0:minio 1:mysql 2:TIDB, 3:TDSQL, 4:Kingbase 5:VastBase 6: GBase
-	err = syncResultToDB(storageInfo.GetResultStorageConfig().GetResultStorageType(), &types.CalculationResultStorage{
+	report, err := syncResultToDB(ctx, storageInfo.GetResultStorageConfig().GetResultStorageType(), &types.CalculationResultStorage{
 		Host:     storageInfo.GetResultStorageConfig().GetHost(),
 		Port:     int(storageInfo.GetResultStorageConfig().GetPort()),
 		User:     storageInfo.GetResultStorageConfig().GetUser(),
@@ -135,16 +135,26 @@ func (s *DefaultJobResultService) PushJobResultToExternalDB(ctx context.Context,
 	}, req, tlsConfig)
 	if err != nil {
 		log.Logger.Errorf("syncResultToDB Failed, err: %v", err)
+		// 对账不一致时返回对账结果，便于调用方查看差异
+		if report != nil {
+			return &pb.PushJobResultResponse{
+				Success:        false,
+				Message:        err.Error(),
+				Reconciliation: report,
+			}, nil
+		}
 		return nil, err
 	}
 
 	return &pb.PushJobResultResponse{
-		Success: true,
-		Message: "success",
+		Success:        true,
+		Message:        "success",
+		Reconciliation: report,
 	}, nil
 }
 
-func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, req *pb.PushJobResultRequest, tlsConfig *pb.DatasourceTlsConfig) error {
+// syncResultToDB 将计算结果写入外部数据库，写入后与结果文件对账，对账不一致时同时返回对账结果和错误
+func syncResultToDB(ctx context.Context, dbType int32, storageInfo *types.CalculationResultStorage, req *pb.PushJobResultRequest, tlsConfig *pb.DatasourceTlsConfig) (*pb.ReconcileReport, error) {
 	// 1.获取CSV文件流
 	dataId := strings.TrimPrefix(req.DataId, "tee_")
 	objectName := fmt.Sprintf("%s/%s/%s/%s", req.ChainInfoId, req.JobInstanceId, req.PartyId, dataId)
@@ -154,7 +164,7 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 	csvReader, err := getResultContentReader(req.IsEncrypted, req.PubKey, objectName)
 	if err != nil {
 		log.Logger.Errorf("getResultContentReader Failed, err: %v", err)
-		return err
+		return nil, err
 	}
 	// defer csvReader.Close()
 
@@ -172,20 +182,32 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 	dbStrategy, err := database.DatabaseFactory(DBType, connInfo)
 	if err != nil {
 		log.Logger.Errorf("DatabaseFactory Failed, err: %v", err)
-		return err
+		return nil, err
 	}
 
 	if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
 		log.Logger.Errorf("Failed to connect to database: %v", err)
-		return err
+		return nil, err
 	}
 
 	db := database.GetDB(dbStrategy)
 	tableName := req.JobInstanceId
+	writeOptions := database.NewWriteOptions(req.WriteMode, req.KeyColumns)
 
 	// 流式处理CSV数据
-	var headers []string
+	var columns []string
 	isFirstBatch := true
+	reconcileMode := database.ResolveReconcileMode(req.ReconcileMode)
+	var sourcePrint *database.FingerprintAccumulator
+	// 追加写入前表中已有的行数，对账时从目标端扣除
+	var existingRows int64
+	// 覆盖模式的清表与全部写入在同一事务内，任一批失败或请求取消时结果表保持原样
+	var tx *sql.Tx
+	defer func() {
+		if tx != nil {
+			tx.Rollback()
+		}
+	}()
 
 	err = processCSVStreaming(csvReader, func(records [][]string) error {
 		if len(records) == 0 {
@@ -198,7 +220,7 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 				return errors.New("records header is empty")
 			}
 
-			headers = records[0]
+			headers := records[0]
 			var originHeaders []string
 			err := copier.Copy(&originHeaders, &headers)
 			if err != nil {
@@ -206,37 +228,119 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 			}
 
 			// 创建表结构
-			columns, _ := generateStructModel(originHeaders, headers)
+			columns, _ = generateStructModel(originHeaders, headers)
+			if err := writeOptions.Validate(DBType, columns); err != nil {
+				return err
+			}
 			schema := convertColumnsToArrowSchema(columns)
 			err = utils.WithRetry(3, 200*time.Millisecond, func() error {
-				return dbStrategy.CreateTemporaryTableIfNotExists(tableName, schema)
+				callCtx, cancel := database.WithCallTimeout(ctx)
+				defer cancel()
+				return dbStrategy.CreateTemporaryTableIfNotExists(callCtx, tableName, schema)
 			}, utils.IsRetryableNetErr)
 			if err != nil {
 				return fmt.Errorf("CreateTemporaryTableIfNotExists Failed after retry, err: %v", err)
 			}
+			reconcileCtx, cancel := database.WithCallTimeout(ctx)
+			err = database.ReconcileTableSchema(reconcileCtx, db, DBType, tableName, schema, database.SchemaEvolutionPolicyFromConfig())
+			cancel()
+			if err != nil {
+				return err
+			}
+			if err := database.EnsureWriteKey(ctx, db, DBType, tableName, writeOptions); err != nil {
+				return err
+			}
+			// 覆盖模式在写入第一批数据前开启事务并清空结果表，全部写入后一起提交
+			if writeOptions.Mode == pb.WriteMode_WRITE_MODE_OVERWRITE {
+				if tx, err = db.BeginTx(ctx, nil); err != nil {
+					return fmt.Errorf("failed to begin transaction: %v", err)
+				}
+				if _, err := tx.ExecContext(ctx, database.DialectFor(DBType).ClearTableSQL(tableName)); err != nil {
+					return fmt.Errorf("failed to clear table %s: %v", tableName, err)
+				}
+			}
+
+			reconcileMode, existingRows, err = resultReconcileMode(ctx, dbStrategy, DBType, tableName, writeOptions, reconcileMode)
+			if err != nil {
+				return err
+			}
+			sourcePrint = database.NewFingerprintAccumulator(columns, reconcileMode)
 
 			isFirstBatch = false
 
 			// 跳过表头，处理数据行
 			if len(records) > 1 {
 				dataRows := records[1:]
-				return batchInsertData(db, tableName, headers, dataRows, dbType)
+				addResultRows(sourcePrint, dataRows)
+				return batchInsertData(ctx, db, tx, DBType, tableName, columns, dataRows, writeOptions)
 			}
 
 			return nil
 		}
 
 		// 批量插入数据
-		return batchInsertData(db, tableName, headers, records, dbType)
+		addResultRows(sourcePrint, records)
+		return batchInsertData(ctx, db, tx, DBType, tableName, columns, records, writeOptions)
 	})
 
 	if err != nil {
 		log.Logger.Errorf("processCSVStreaming Failed, err: %v", err)
-		return err
+		return nil, err
+	}
+	if tx != nil {
+		err = tx.Commit()
+		tx = nil
+		if err != nil {
+			return nil, fmt.Errorf("failed to commit overwrite of table %s: %v", tableName, err)
+		}
+	}
+
+	var report *pb.ReconcileReport
+	if sourcePrint != nil && reconcileMode != pb.ReconcileMode_RECONCILE_MODE_NONE {
+		targetPrint, err := database.ComputeTableFingerprint(ctx, database.ReconcileSource{
+			Strategy: dbStrategy, DbType: DBType, TableName: tableName}, columns, reconcileMode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fingerprint table %s: %v", tableName, err)
+		}
+		targetPrint.Rows -= existingRows
+		report = database.CompareFingerprints(reconcileMode, sourcePrint.Fingerprint(), targetPrint)
+		if err := database.ReconcileError(report); err != nil {
+			return report, err
+		}
 	}
 
 	log.Logger.Infof("syncResultToDB | success, instanceId: %s", req.JobInstanceId)
-	return nil
+	return report, nil
+}
+
+// resultReconcileMode 按写入方式确定结果表的对账强度：
+// 更新或忽略冲突的写入行数与结果文件不一致，不对账；追加到已有数据的表时只比对新增的行数，同时返回已有的行数
+func resultReconcileMode(ctx context.Context, dbStrategy database.DatabaseStrategy, dbType pb.DataSourceType, tableName string,
+	opts database.WriteOptions, mode pb.ReconcileMode) (pb.ReconcileMode, int64, error) {
+	if mode == pb.ReconcileMode_RECONCILE_MODE_NONE ||
+		opts.Mode == pb.WriteMode_WRITE_MODE_UPSERT || opts.Mode == pb.WriteMode_WRITE_MODE_INSERT_IGNORE {
+		return pb.ReconcileMode_RECONCILE_MODE_NONE, 0, nil
+	}
+	if opts.Mode == pb.WriteMode_WRITE_MODE_OVERWRITE {
+		return mode, 0, nil
+	}
+	existing, err := database.ComputeTableFingerprint(ctx, database.ReconcileSource{
+		Strategy: dbStrategy, DbType: dbType, TableName: tableName}, nil, pb.ReconcileMode_RECONCILE_MODE_COUNT)
+	if err != nil {
+		return mode, 0, fmt.Errorf("failed to count rows of table %s: %v", tableName, err)
+	}
+	if existing.Rows > 0 {
+		log.Logger.Warnf("Table %s already has %d rows, reconciling appended rows by count only", tableName, existing.Rows)
+		return pb.ReconcileMode_RECONCILE_MODE_COUNT, existing.Rows, nil
+	}
+	return mode, 0, nil
+}
+
+// addResultRows 将结果文件的数据行累加到对账指纹
+func addResultRows(acc *database.FingerprintAccumulator, rows [][]string) {
+	for _, row := range rows {
+		acc.AddStrings(row)
+	}
 }
 
 // TransformSoftwareFormat 将多个JSON字符串合并并转换格式
@@ -513,8 +617,28 @@ func convertColumnsToArrowSchema(columns []string) *arrow.Schema {
 	return arrow.NewSchema(fields, nil)
 }
 
-// 批量插入数据
-func batchInsertData(db *sql.DB, tableName string, headers []string, dataRows [][]string, dbType int32) error {
+// 批量插入数据，按写入模式生成 INSERT / UPSERT / MERGE 语句，支持时使用原生批量导入
+// tx 不为空时（覆盖模式）在该事务内逐批 INSERT，与清表一起提交，不使用原生批量导入
+func batchInsertData(ctx context.Context, db *sql.DB, tx *sql.Tx, dbType pb.DataSourceType, tableName string, columns []string,
+	dataRows [][]string, opts database.WriteOptions) error {
+	// 追加模式优先使用原生批量导入，服务端不允许时回退到 INSERT
+	if loader := database.BulkLoaderFor(dbType); loader != nil && tx == nil &&
+		opts.Mode != pb.WriteMode_WRITE_MODE_UPSERT && opts.Mode != pb.WriteMode_WRITE_MODE_INSERT_IGNORE {
+		loaded, err := loader.BulkLoad(ctx, db, database.BulkLoadRequest{
+			TableName: tableName,
+			Columns:   columns,
+			Rows:      database.NewStringRowSource(dataRows),
+		})
+		if err == nil {
+			log.Logger.Debugf("Bulk loaded %d records into %s", loaded, tableName)
+			return nil
+		}
+		if !errors.Is(err, database.ErrBulkLoadUnsupported) {
+			return fmt.Errorf("failed to bulk load %d records: %v", len(dataRows), err)
+		}
+		log.Logger.Warnf("Bulk load rejected for table %s, falling back to INSERT: %v", tableName, err)
+	}
+
 	batchSize := 1000
 
 	for i := 0; i < len(dataRows); i += batchSize {
@@ -524,12 +648,28 @@ func batchInsertData(db *sql.DB, tableName string, headers []string, dataRows []
 		}
 
 		batch := dataRows[i:end]
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, row := range batch {
+			if len(row) != len(columns) {
+				return fmt.Errorf("row has %d fields, expected %d", len(row), len(columns))
+			}
+			for _, value := range row {
+				args = append(args, value)
+			}
+		}
 
-		// 生成INSERT SQL
-		insertSQL, args := generateInsertSQL(tableName, headers, batch, dbType)
+		// 生成写入SQL
+		insertSQL, err := database.BuildWriteSQL(dbType, tableName, columns, len(batch), opts)
+		if err != nil {
+			return err
+		}
 
 		// 执行插入
-		_, err := db.Exec(insertSQL, args...)
+		if tx != nil {
+			_, err = tx.ExecContext(ctx, insertSQL, args...)
+		} else {
+			_, err = db.ExecContext(ctx, insertSQL, args...)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to insert batch %d-%d: %v", i, end-1, err)
 		}
@@ -540,59 +680,6 @@ func batchInsertData(db *sql.DB, tableName string, headers []string, dataRows []
 	return nil
 }
 
-// 生成INSERT SQL语句
-func generateInsertSQL(tableName string, headers []string, dataRows [][]string, dbType int32) (string, []interface{}) {
-	var placeholders []string
-	var args []interface{}
-	paramIndex := 1 // 用于KingBase的参数索引
-
-	for _, row := range dataRows {
-		var rowPlaceholders []string
-		for _, value := range row {
-			// 根据数据库类型使用不同的占位符
-			if dbType == 4 || dbType == 5 { // KingBase, VastBase
-				rowPlaceholders = append(rowPlaceholders, fmt.Sprintf("$%d", paramIndex))
-				paramIndex++
-			} else { // MySQL, TiDB, TDSQL等
-				rowPlaceholders = append(rowPlaceholders, "?")
-			}
-			args = append(args, value)
-		}
-		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(rowPlaceholders, ", ")))
-	}
-
-	// 为字段名添加反引号，如果字段名已经有反引号则不重复添加
-	quotedHeaders := make([]string, len(headers))
-	for i, header := range headers {
-		log.Logger.Debugf("generateInsertSQL | Original field name: %s", header)
-
-		if dbType == 4 || dbType == 5 { // KingBase - 使用双引号
-			if strings.HasPrefix(header, "\"") && strings.HasSuffix(header, "\"") {
-				// 字段名已经有双引号，直接使用
-				quotedHeaders[i] = header
-			} else {
-				// 字段名没有双引号，添加双引号
-				quotedHeaders[i] = fmt.Sprintf("\"%s\"", header)
-			}
-		} else { // MySQL, TiDB, TDSQL - 使用反引号
-			if strings.HasPrefix(header, "`") && strings.HasSuffix(header, "`") {
-				// 字段名已经有反引号，直接使用
-				quotedHeaders[i] = header
-			} else {
-				// 字段名没有反引号，添加反引号
-				quotedHeaders[i] = fmt.Sprintf("`%s`", header)
-			}
-		}
-	}
-
-	columns := strings.Join(quotedHeaders, ", ")
-	values := strings.Join(placeholders, ", ")
-
-	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", tableName, columns, values)
-
-	return insertSQL, args
-}
-
 func ProcessFileFormat(result string) (string, error) {
 	// 兼容空结果
 	if strings.TrimSpace(result) == "" {
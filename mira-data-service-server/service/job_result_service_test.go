@@ -1,10 +1,15 @@
 package service
 
 import (
+	"context"
+	"data-service/database"
+	pb "data-service/generated/datasource"
 	"encoding/json"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTransformHardwareFormat(t *testing.T) {
@@ -84,3 +89,23 @@ func TestTransformHardwareFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchInsertDataInTransaction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 覆盖模式的写入与清表在同一事务内，不走原生批量导入，失败时整体回滚
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `r` \\(`id`, `name`\\) VALUES \\(\\?, \\?\\), \\(\\?, \\?\\)").
+		WithArgs("1", "a", "2", "b").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectRollback()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	opts := database.NewWriteOptions(pb.WriteMode_WRITE_MODE_OVERWRITE, nil)
+	err = batchInsertData(context.Background(), db, tx, pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL, "r",
+		[]string{"id", "name"}, [][]string{{"1", "a"}, {"2", "b"}}, opts)
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
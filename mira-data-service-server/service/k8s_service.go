@@ -94,7 +94,7 @@ func (s *k8sService) SubmitBatchJob(ctx context.Context, request *pb.BatchReadRe
 			s.logger.Errorf("Failed to get connection info: %v, RequestId=%s", err, request.RequestId)
 			return nil, err
 		}
-		tableInfo, err = tableInfoService.GetTableInfo(request.GetRequestId(), ds.External.AssetName, ds.External.ChainInfoId, false, ds.External.Alias)
+		tableInfo, err = tableInfoService.GetTableInfo(ctx, request.GetRequestId(), ds.External.AssetName, ds.External.ChainInfoId, false, ds.External.Alias)
 		if err != nil {
 			s.logger.Errorf("Failed to get table info: %v, RequestId=%s", err, request.RequestId)
 			return nil, err
@@ -151,7 +151,7 @@ func (s *k8sService) SubmitBatchJob(ctx context.Context, request *pb.BatchReadRe
 			TableName: targetTable,
 			Columns:   []*pb.ColumnItem{},
 		}
-		tableInfo, err = tableInfoService.GetTableInfoByDataSource(connInfo, tempTable, false)
+		tableInfo, err = tableInfoService.GetTableInfoByDataSource(ctx, connInfo, tempTable, false)
 		if err != nil {
 			s.logger.Errorf("Failed to get table info: %v, RequestId=%s", err, request.RequestId)
 			return nil, err
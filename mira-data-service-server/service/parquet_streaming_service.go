@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
@@ -40,7 +39,9 @@ func NewParquetStreamingService(chunkService *ChunkService, ossClient oss.Client
 }
 
 // StreamParquetFileFromOSS 从OSS按顺序读取 parquet 分片并流式返回 Arrow 批次
-func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanceId string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+// position 不为空时每个批次都附带续传令牌，并从 position 记录的分片和行继续读取；发送成功后 position 随之前移
+func (s *ParquetStreamingService) StreamParquetFileFromOSS(jobInstanceId string, columns []string,
+	position *common.ResumeToken, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
 	bucketName := common.BATCH_DATA_BUCKET_NAME
 	keys, err := s.listAndSortParquetParts(bucketName, jobInstanceId)
 	if err != nil {
@@ -49,6 +50,17 @@ func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanc
 	if len(keys) == 0 {
 		return fmt.Errorf("no parquet parts found for jobInstanceId %s in bucket %s", jobInstanceId, bucketName)
 	}
+	// 续传时跳过已发送完的分片
+	startPart := 0
+	if position != nil {
+		startPart = position.Part
+		if startPart >= len(keys) {
+			return fmt.Errorf("resume part %d out of range, only %d parquet parts found for jobInstanceId %s",
+				startPart, len(keys), jobInstanceId)
+		}
+		keys = keys[startPart:]
+		log.Logger.Infof("Resuming jobInstanceId %s from part %d, row %d", jobInstanceId, startPart, position.PartRows)
+	}
 
 	// 第一阶段：下载所有文件
 	log.Logger.Infof("Starting to download %d Parquet parts", len(keys))
@@ -74,7 +86,7 @@ func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanc
 			10*time.Second,       // 最大延迟
 			func() error {
 				var downloadErr error
-				localFilePath, downloadErr = s.downloadSinglePart(stream.Context(), bucketName, key, tableName, jobInstanceId, i)
+				localFilePath, downloadErr = s.downloadSinglePart(stream.Context(), bucketName, key, startPart+i)
 				return downloadErr
 			},
 			utils.IsRetryableNetErr, // 只对网络错误重试
@@ -108,7 +120,7 @@ func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanc
 		}
 		log.Logger.Infof("Processing Parquet part %d/%d: %s", i+1, len(localFilePaths), localFilePath)
 
-		n, err := s.processParquetPart(localFilePath, columns, stream)
+		n, err := s.processParquetPart(localFilePath, columns, startPart+i, position, stream)
 		if err != nil {
 			// 清理所有临时文件
 			for _, path := range localFilePaths {
@@ -166,18 +178,15 @@ func (s *ParquetStreamingService) listAndSortParquetParts(bucketName, jobInstanc
 	return out, nil
 }
 
-func (s *ParquetStreamingService) downloadSinglePart(ctx context.Context, bucketName, objectName, tableName, jobInstanceId string, partIndex int) (string, error) {
-	tempDir := common.DATA_DIR
-	localFileName := fmt.Sprintf("parquet_part_%s_%s_%d_%d.parquet", tableName, jobInstanceId, partIndex, time.Now().Unix())
-	localFilePath := filepath.Join(tempDir, localFileName)
-
+// downloadSinglePart 下载单个分片到本地临时文件，文件名由 os.CreateTemp 生成，不包含请求中的表名和任务 ID
+func (s *ParquetStreamingService) downloadSinglePart(ctx context.Context, bucketName, objectName string, partIndex int) (string, error) {
 	reader, err := s.ossClient.GetObject(ctx, bucketName, objectName, &oss.GetOptions{})
 	if err != nil {
 		return "", fmt.Errorf("failed to get object from OSS: %v", err)
 	}
 	defer reader.Close()
 
-	file, err := os.Create(localFilePath)
+	file, err := os.CreateTemp(common.DATA_DIR, fmt.Sprintf("parquet_part_%d_*.parquet", partIndex))
 	if err != nil {
 		return "", fmt.Errorf("failed to create local file: %v", err)
 	}
@@ -186,10 +195,12 @@ func (s *ParquetStreamingService) downloadSinglePart(ctx context.Context, bucket
 	if _, err = io.Copy(file, reader); err != nil {
 		return "", fmt.Errorf("failed to copy object content to local file: %v", err)
 	}
-	return localFilePath, nil
+	return file.Name(), nil
 }
 
-func (s *ParquetStreamingService) processParquetPart(filePath string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) (int64, error) {
+// processParquetPart 读取单个 parquet 分片并逐批发送，position 指向本分片时跳过其中已发送的行
+func (s *ParquetStreamingService) processParquetPart(filePath string, columns []string, part int, position *common.ResumeToken,
+	stream datasource.DataSourceService_ReadDataSourceStreamingServer) (int64, error) {
 	mem := memory.NewGoAllocator()
 	pf, err := file.OpenParquetFile(filePath, false) // 返回 source.ParquetFile
 	if err != nil {
@@ -213,6 +224,15 @@ func (s *ParquetStreamingService) processParquetPart(filePath string, columns []
 	tr := array.NewTableReader(table, int64(batchSize))
 	defer tr.Release()
 
+	var skip int64
+	if position != nil {
+		if position.Part == part {
+			skip = position.PartRows
+		} else {
+			position.Part, position.PartRows = part, 0
+		}
+	}
+
 	var total int64
 	var chunkID int64
 	for tr.Next() {
@@ -220,6 +240,17 @@ func (s *ParquetStreamingService) processParquetPart(filePath string, columns []
 		if rec == nil {
 			continue
 		}
+		// 跳过续传前已发送的行
+		if skip > 0 {
+			if rec.NumRows() <= skip {
+				skip -= rec.NumRows()
+				continue
+			}
+			rec = rec.NewSlice(skip, rec.NumRows())
+			skip = 0
+		} else {
+			rec.Retain()
+		}
 		// 可选投影
 		sendRec := rec
 		if len(columns) > 0 {
@@ -230,16 +261,26 @@ func (s *ParquetStreamingService) processParquetPart(filePath string, columns []
 			}
 			rec.Release()
 			sendRec = proj
-		} else {
-			// 与后续统一的生命周期
-			rec.Retain()
-			rec.Release()
 		}
 
-		if err := sendArrowRecord(stream, sendRec, chunkID); err != nil {
+		var resumeToken string
+		var next common.ResumeToken
+		if position != nil {
+			next = *position
+			next.Rows += sendRec.NumRows()
+			next.PartRows += sendRec.NumRows()
+			if resumeToken, err = next.Encode(); err != nil {
+				sendRec.Release()
+				return total, err
+			}
+		}
+		if err := sendArrowRecord(stream, sendRec, chunkID, resumeToken); err != nil {
 			sendRec.Release()
 			return total, fmt.Errorf("failed to send record: %v", err)
 		}
+		if position != nil {
+			*position = next
+		}
 		total += int64(sendRec.NumRows())
 		sendRec.Release()
 		chunkID++
@@ -266,7 +307,7 @@ func projectRecordByNames(rec arrow.Record, cols []string) (arrow.Record, error)
 	return array.NewRecord(newSchema, arrays, rec.NumRows()), nil
 }
 
-func sendArrowRecord(stream datasource.DataSourceService_ReadDataSourceStreamingServer, rec arrow.Record, chunkID int64) error {
+func sendArrowRecord(stream datasource.DataSourceService_ReadDataSourceStreamingServer, rec arrow.Record, chunkID int64, resumeToken string) error {
 	var buf bytes.Buffer
 	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()))
 	if err := w.Write(rec); err != nil {
@@ -275,7 +316,7 @@ func sendArrowRecord(stream datasource.DataSourceService_ReadDataSourceStreaming
 	if err := w.Close(); err != nil {
 		return fmt.Errorf("failed to close arrow writer: %v", err)
 	}
-	if err := stream.Send(&datasource.ArrowResponse{ArrowBatch: buf.Bytes()}); err != nil {
+	if err := stream.Send(&datasource.ArrowResponse{ArrowBatch: buf.Bytes(), ResumeToken: resumeToken}); err != nil {
 		return fmt.Errorf("failed to send arrow response: %v", err)
 	}
 	log.Logger.Debugf("Successfully sent parquet batch %d with %d rows", chunkID, rec.NumRows())
@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	"data-service/common"
 	"data-service/log"
@@ -13,6 +16,9 @@ import (
 	"google.golang.org/grpc"
 )
 
+// resumePartsRetention 读取中断后保留导出分片供续传的时长，到期后由清理任务删除
+const resumePartsRetention = 24 * time.Hour
+
 // ReadService 处理数据读取相关的服务
 type ReadService struct {
 	ossClient     oss.ClientInterface
@@ -20,6 +26,7 @@ type ReadService struct {
 	chunkService  *ChunkService
 	streamService *CSVStreamingService
 	parquetStream *ParquetStreamingService
+	dorisReader   *DorisDataReader
 }
 
 // NewReadService 创建一个新的ReadService实例
@@ -39,12 +46,22 @@ func NewReadService(ossClient oss.ClientInterface) (*ReadService, error) {
 		chunkService:  chunkService,
 		streamService: streamService,
 		parquetStream: parquetStream,
+		dorisReader:   NewDorisDataReader(dorisService, parquetStream),
 	}, nil
 }
 
 // ProcessReadRequest 处理读取请求
+// 每个批次都附带续传令牌；读取中断时保留已导出的 parquet 分片，携带令牌重新请求时直接从分片续传
 func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
-	var tableName string
+	fingerprint := common.ResumeFingerprint(request)
+	resume, err := common.DecodeResumeToken(request.ResumeToken, fingerprint)
+	if err != nil {
+		return err
+	}
+	if resume != nil {
+		return s.resumeReadRequest(request, resume, g)
+	}
+
 	randomSuffix, err := common.GenerateRandomString(common.SUFFIX_RANDOM_LENGTH)
 	if err != nil {
 		return fmt.Errorf("failed to generate random suffix: %v", err)
@@ -56,12 +73,15 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 		Columns:          request.Columns,
 		SortRules:        request.SortRules,
 		FilterConditions: request.FilterConditions,
+		FilterExpression: request.FilterExpression,
+		Sampling:         request.Sampling,
+		Keys:             request.Keys,
 	}
 
 	// 根据数据源类型设置相应的字段和生成jobInstanceId
 	switch {
 	case request.GetExternal() != nil:
-		enhancedJobInstanceId = request.GetExternal().AssetName + "_" + randomSuffix
+		enhancedJobInstanceId = jobInstancePrefix(request) + randomSuffix
 
 		// 导入数据
 		importService := NewImportService()
@@ -82,22 +102,21 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 		}
 		log.Logger.Debugf("importResult: %v", importResult)
 
-		tableName = importResult.Results[0].TargetTableName
 		// 后续请求变成Doris数据源
 		streamingRequest.DataSource = &pb.ReadDataSourceStreamingRequest_Doris{
 			Doris: &pb.DorisDataSource{
 				DbName:    enhancedJobInstanceId,
-				TableName: tableName,
+				TableName: importResult.Results[0].TargetTableName,
 			},
 		}
 
 	case request.GetInternal() != nil:
-		enhancedJobInstanceId = request.GetInternal().TableName + "_" + randomSuffix
+		enhancedJobInstanceId = jobInstancePrefix(request) + randomSuffix
 		streamingRequest.DataSource = &pb.ReadDataSourceStreamingRequest_Internal{
 			Internal: request.GetInternal(),
 		}
 	case request.GetDoris() != nil:
-		enhancedJobInstanceId = request.GetDoris().DbName + "@" + randomSuffix
+		enhancedJobInstanceId = jobInstancePrefix(request) + randomSuffix
 		streamingRequest.DataSource = &pb.ReadDataSourceStreamingRequest_Doris{
 			Doris: request.GetDoris(),
 		}
@@ -107,8 +126,20 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 
 	streamingRequest.JobInstanceId = enhancedJobInstanceId
 
+	// 续传位置，随发送的批次前移
+	position := &common.ResumeToken{Kind: common.ResumeByPart, Fingerprint: fingerprint, JobInstanceId: enhancedJobInstanceId}
+	streamFailed := false
+
 	// 5.执行完成后清理资源
 	defer func() {
+		// 已向客户端发送过数据的读取中断时保留导出分片，供续传使用
+		keepParts := streamFailed && position.Rows > 0
+		if keepParts {
+			log.Logger.Infof("Keeping exported parts of job %s for resume after %d records", enhancedJobInstanceId, position.Rows)
+			if request.GetInternal() == nil {
+				s.scheduleResumeCleanup(enhancedJobInstanceId)
+			}
+		}
 		// 只在外部数据源时进行清理
 		if request.GetExternal() != nil {
 			// 删除Doris数据库（也可以放清理任务中）
@@ -117,15 +148,19 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 			}
 
 			// 删除Minio上的文件
-			if err := s.cleanupMinioFiles(enhancedJobInstanceId); err != nil {
-				log.Logger.Warnf("Failed to cleanup minio files for job %s: %v", enhancedJobInstanceId, err)
+			if !keepParts {
+				if err := s.cleanupMinioFiles(enhancedJobInstanceId); err != nil {
+					log.Logger.Warnf("Failed to cleanup minio files for job %s: %v", enhancedJobInstanceId, err)
+				}
 			}
 
 			log.Logger.Infof("Cleaned up external data source resources for job %s", enhancedJobInstanceId)
 		} else if request.GetDoris() != nil {
 			// Doris数据源：只删除Minio文件
-			if err := s.cleanupMinioFiles(enhancedJobInstanceId); err != nil {
-				log.Logger.Warnf("Failed to cleanup minio files for job %s: %v", enhancedJobInstanceId, err)
+			if !keepParts {
+				if err := s.cleanupMinioFiles(enhancedJobInstanceId); err != nil {
+					log.Logger.Warnf("Failed to cleanup minio files for job %s: %v", enhancedJobInstanceId, err)
+				}
 			}
 
 			log.Logger.Infof("Cleaned up Doris data source resources for job %s", enhancedJobInstanceId)
@@ -135,24 +170,78 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 		}
 	}()
 
-	// 1.从数据源拉取数据到doris并导出到minio
+	// 从数据源拉取数据到doris，按配置通过 Flight SQL 直接读取，或导出到minio后流式读取
 	// bug 如果相同的任务读同一张表，会出现多读数据，需要加子路径做隔离
-	tableName, err = s.dorisService.ProcessDataSourceAndExport(streamingRequest, enhancedJobInstanceId)
-	if err != nil {
-		return fmt.Errorf("failed to process data source and export: %v", err)
+	if _, err := s.dorisReader.Stream(streamingRequest, enhancedJobInstanceId, position, g); err != nil {
+		streamFailed = true
+		log.Logger.Errorf("failed to stream doris data: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// resumeReadRequest 按续传令牌从已导出的 parquet 分片继续读取，不再重新导入和导出
+func (s *ReadService) resumeReadRequest(request *pb.ReadRequest, resume *common.ResumeToken, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
+	// 任务 ID 必须由本请求的数据源生成，避免令牌指向其他任务的导出文件
+	if resume.Kind != common.ResumeByPart || !isJobInstanceOf(resume.JobInstanceId, jobInstancePrefix(request)) {
+		return fmt.Errorf("%w: token is not for this read request", common.ErrInvalidResumeToken)
 	}
-	log.Logger.Infof("Successfully exported table %s to MinIO for jobInstanceId: %s", tableName, enhancedJobInstanceId)
+	log.Logger.Infof("Resuming read of job %s from part %d after %d records", resume.JobInstanceId, resume.Part, resume.Rows)
 
-	// 2.执行导出parquet文件到minio
-	// 3.从minio流式读取arrow文件
-	if err := s.parquetStream.StreamParquetFileFromOSS(tableName, enhancedJobInstanceId, request.Columns, g); err != nil {
-		log.Logger.Errorf("failed to stream parquet file from OSS: %v", err)
+	if err := s.parquetStream.StreamParquetFileFromOSS(resume.JobInstanceId, request.Columns, resume, g); err != nil {
+		// 保留导出分片并顺延清理时间，客户端可使用最后收到的令牌再次续传
+		log.Logger.Errorf("failed to resume streaming parquet file from OSS: %v", err)
+		if request.GetInternal() == nil {
+			s.scheduleResumeCleanup(resume.JobInstanceId)
+		}
 		return fmt.Errorf("failed to stream parquet file from OSS: %v", err)
 	}
 
+	// 内部数据源的导出文件原本不清理，其余数据源读取完成后立即执行清理任务
+	if request.GetInternal() == nil {
+		if err := NewCleanupTaskService().ExecuteCleanupTask(resume.JobInstanceId); err != nil {
+			log.Logger.Warnf("Failed to execute cleanup task for job %s: %v", resume.JobInstanceId, err)
+			if err := s.cleanupMinioFiles(resume.JobInstanceId); err != nil {
+				log.Logger.Warnf("Failed to cleanup minio files for job %s: %v", resume.JobInstanceId, err)
+			}
+		}
+	}
 	return nil
 }
 
+// scheduleResumeCleanup 为保留的导出分片登记清理任务，超过 resumePartsRetention 仍未续传完成时由清理任务删除
+func (s *ReadService) scheduleResumeCleanup(jobInstanceId string) {
+	expiresAt := time.Now().Add(resumePartsRetention)
+	if err := NewCleanupTaskService().ScheduleCleanupTask(jobInstanceId, "export_files", expiresAt); err != nil {
+		log.Logger.Warnf("Failed to schedule cleanup of kept parts for job %s: %v", jobInstanceId, err)
+	}
+}
+
+// isJobInstanceOf 判断任务 ID 是否恰好为 prefix 加 GenerateRandomString 生成的随机后缀
+func isJobInstanceOf(jobInstanceId, prefix string) bool {
+	suffix, ok := strings.CutPrefix(jobInstanceId, prefix)
+	if prefix == "" || !ok || len(suffix) != common.SUFFIX_RANDOM_LENGTH {
+		return false
+	}
+	_, err := hex.DecodeString(suffix)
+	return err == nil
+}
+
+// jobInstancePrefix 按数据源生成任务 ID 的前缀，任务 ID 为前缀加随机后缀
+func jobInstancePrefix(request *pb.ReadRequest) string {
+	switch {
+	case request.GetExternal() != nil:
+		return request.GetExternal().AssetName + "_"
+	case request.GetInternal() != nil:
+		return request.GetInternal().TableName + "_"
+	case request.GetDoris() != nil:
+		return request.GetDoris().DbName + "@"
+	default:
+		return ""
+	}
+}
+
 // cleanupMinioFiles 清理Minio上的文件
 func (s *ReadService) cleanupMinioFiles(jobInstanceId string) error {
 	// 从批量数据桶中删除相关文件
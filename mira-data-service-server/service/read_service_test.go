@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	pb "data-service/generated/datasource"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsJobInstanceOf(t *testing.T) {
+	request := &pb.ReadRequest{DataSource: &pb.ReadRequest_Doris{Doris: &pb.DorisDataSource{DbName: "mall", TableName: "orders"}}}
+	prefix := jobInstancePrefix(request)
+	assert.Equal(t, "mall@", prefix)
+
+	assert.True(t, isJobInstanceOf("mall@0a1b2c3d", prefix))
+	for _, jobInstanceId := range []string{
+		"mall@",               // 缺少随机后缀
+		"mall@0a1b2c3d/../x",  // 后缀之后追加路径
+		"mall@0a1b2c3d0a",     // 后缀长度不符
+		"mall@../../etc",      // 后缀不是随机串
+		"other@0a1b2c3d",      // 其他数据源的任务
+		"mall_extra@0a1b2c3d", // 仅前缀相似
+	} {
+		assert.False(t, isJobInstanceOf(jobInstanceId, prefix), jobInstanceId)
+	}
+	assert.False(t, isJobInstanceOf("0a1b2c3d", ""))
+}
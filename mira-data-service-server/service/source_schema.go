@@ -0,0 +1,84 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/28
+	@note: 读取数据资产源表的字段元数据与表键，用于创建 doris 内部表和补全导入的表键信息
+
+*
+*/
+package service
+
+import (
+	"context"
+	"data-service/database"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// describeSourceTable 连接数据资产所在的数据库，读取源表的字段元数据与表键信息
+func describeSourceTable(connInfo *ds.ConnectionInfo) ([]*ds.ColumnItem, []*ds.TableKey, error) {
+	dbType := utils.ConvertDataSourceType(connInfo.Dbtype)
+	if dbType == ds.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN {
+		return nil, nil, fmt.Errorf("invalid database type: %v", connInfo.Dbtype)
+	}
+	strategy, err := database.DatabaseFactory(dbType, connInfo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := strategy.ConnectToDBWithPass(connInfo); err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to source database: %v", err)
+	}
+	ctx, cancel := database.WithCallTimeout(context.Background())
+	defer cancel()
+	return strategy.DescribeTable(ctx, connInfo.DbName, connInfo.TableName)
+}
+
+// findColumnItem 按列名查找字段元数据，忽略大小写
+func findColumnItem(columns []*ds.ColumnItem, name string) *ds.ColumnItem {
+	for _, column := range columns {
+		if strings.EqualFold(column.Name, name) {
+			return column
+		}
+	}
+	return nil
+}
+
+// sourceAutoIncrementKeys 源表的单列自增主键，导入列为空（全部列）或包含该列时返回，否则返回 nil
+// 分批导入会把主键列加入导入列，只有主键本就在导入列中时才自动使用，避免改变导入结果的列
+func sourceAutoIncrementKeys(keys []*ds.TableKey, columns []string) []*ds.TableKey {
+	for _, key := range keys {
+		if key.KeyType != ds.KeyType_KEY_TYPE_AUTO_INCREMENT || len(key.ColumnNames) != 1 {
+			continue
+		}
+		if len(columns) == 0 {
+			return []*ds.TableKey{key}
+		}
+		for _, column := range columns {
+			if column == key.ColumnNames[0] {
+				return []*ds.TableKey{key}
+			}
+		}
+	}
+	return nil
+}
+
+// detectAssetAutoIncrementKeys 读取数据资产源表的单列自增主键，读取失败时返回 nil，导入按不分批处理
+func detectAssetAutoIncrementKeys(external *ds.ExternalDataSource, columns []string) []*ds.TableKey {
+	connInfo, err := utils.GetDatasourceByAssetName(uuid.New().String(), external.AssetName, external.ChainInfoId, external.Alias)
+	if err != nil {
+		log.Logger.Warnf("Failed to get datasource of asset %s: %v", external.AssetName, err)
+		return nil
+	}
+	_, keys, err := describeSourceTable(connInfo)
+	if err != nil {
+		log.Logger.Warnf("Failed to describe source table of asset %s: %v", external.AssetName, err)
+		return nil
+	}
+	return sourceAutoIncrementKeys(keys, columns)
+}
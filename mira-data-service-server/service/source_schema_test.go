@@ -0,0 +1,41 @@
+package service
+
+import (
+	"data-service/database"
+	pb "data-service/generated/datasource"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertDatabaseTypeToDorisType(t *testing.T) {
+	dorisService := &DorisService{}
+	for _, dbType := range []pb.DataSourceType{pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb.DataSourceType_DATA_SOURCE_TYPE_DAMENG, pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL} {
+		// 源表元数据中的精度和标度
+		native := database.NativeTypeOfItem(&pb.ColumnItem{DataType: "numeric", Precision: 10, Scale: 2})
+		assert.Equal(t, "DECIMAL(10,2)", dorisService.convertDatabaseTypeToDorisType(native, int32(dbType)), dbType.String())
+		assert.Equal(t, "DECIMAL(12,4)", dorisService.convertDatabaseTypeToDorisType(
+			database.ParseNativeType("numeric(12,4)"), int32(dbType)), dbType.String())
+		// 超出 Doris 范围的精度按字符串存储，不截断
+		assert.Equal(t, "VARCHAR(65533)", dorisService.convertDatabaseTypeToDorisType(
+			database.ParseNativeType("numeric(65,30)"), int32(dbType)), dbType.String())
+	}
+	postgres := int32(pb.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL)
+	assert.Equal(t, "DATETIME(6)", dorisService.convertDatabaseTypeToDorisType(database.ParseNativeType("timestamp with time zone"), postgres))
+	assert.Equal(t, "JSON", dorisService.convertDatabaseTypeToDorisType(database.ParseNativeType("jsonb"), postgres))
+	assert.Equal(t, "BIGINT", dorisService.convertDatabaseTypeToDorisType(
+		database.ParseNativeType("int unsigned"), int32(pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL)))
+}
+
+func TestSourceAutoIncrementKeys(t *testing.T) {
+	keys := []*pb.TableKey{
+		{KeyName: "PRIMARY", KeyType: pb.KeyType_KEY_TYPE_PRIMARY, ColumnNames: []string{"id"}},
+		{KeyName: "PRIMARY", KeyType: pb.KeyType_KEY_TYPE_AUTO_INCREMENT, ColumnNames: []string{"id"}},
+	}
+	assert.Equal(t, keys[1:], sourceAutoIncrementKeys(keys, nil))
+	assert.Equal(t, keys[1:], sourceAutoIncrementKeys(keys, []string{"name", "id"}))
+	// 主键不在导入列中时不自动分批
+	assert.Nil(t, sourceAutoIncrementKeys(keys, []string{"name"}))
+	assert.Nil(t, sourceAutoIncrementKeys(keys[:1], nil))
+}
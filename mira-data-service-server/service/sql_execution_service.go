@@ -2,8 +2,8 @@ package service
 
 import (
 	"bytes"
-	"data-service/common"
 	"data-service/config"
+	"data-service/database"
 	pb "data-service/generated/datasource"
 	log "data-service/log"
 	"data-service/utils"
@@ -232,30 +232,15 @@ func (s *SqlExecutionService) buildArrowSchemaFromColumnTypes(columns []string,
 			continue
 		}
 
-		// 使用DorisService已有的类型转换逻辑
-		arrowType, err := s.convertDorisColumnTypeToArrowType(columnTypes[i])
-		if err != nil {
-			log.Logger.Warnf("Failed to convert column type for %s: %v, using string type", columnName, err)
-			arrowType = arrow.BinaryTypes.String
-		}
-
 		fields = append(fields, arrow.Field{
 			Name: columnName,
-			Type: arrowType,
+			Type: database.ColumnArrowType(pb.DataSourceType_DATA_SOURCE_TYPE_DORIS, columnTypes[i]),
 		})
 	}
 
 	return fields, nil
 }
 
-// convertDorisColumnTypeToArrowType 转换Doris列类型到Arrow类型
-func (s *SqlExecutionService) convertDorisColumnTypeToArrowType(colType *sql.ColumnType) (arrow.DataType, error) {
-	// 获取数据库类型名称
-	dbTypeName := strings.ToUpper(colType.DatabaseTypeName())
-
-	return common.ConvertDorisTypeToArrowType(dbTypeName)
-}
-
 // ExecuteSqlWithTableOutput 执行SQL并将结果写入目标表
 func (s *SqlExecutionService) ExecuteSqlWithTableOutput(sql, targetTableName string, stream grpc.ServerStreamingServer[pb.ExecuteSqlResponse]) error {
 	// 1. 先获取列信息：拼接 LIMIT 1
@@ -334,7 +319,8 @@ func (s *SqlExecutionService) createTargetTable(dbName string, tableName string,
 
 	for i, colName := range columns {
 		colType := columnTypes[i]
-		dorisType := common.ConvertSqlTypeToDorisType(colType)
+		dorisType := database.ConvertNativeType(pb.DataSourceType_DATA_SOURCE_TYPE_DORIS,
+			pb.DataSourceType_DATA_SOURCE_TYPE_DORIS, database.NativeTypeOfColumn(colType))
 		columnDef := fmt.Sprintf("`%s` %s", colName, dorisType)
 		columnDefs = append(columnDefs, columnDef)
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"data-service/config"
 	"data-service/database"
 	pb "data-service/generated/datasource"
@@ -11,23 +12,26 @@ import (
 )
 
 type TableInfoService interface {
-	GetTableInfo(requestId string, assetName string, chainId string, isExactQuery bool, alias string) (*pb.TableInfoResponse, error)
-	GetTableInfoByDataSource(connInfo *pb.ConnectionInfo, tableName string, isExactQuery bool) (*pb.TableInfoResponse, error)
-	GetInternalTableInfo(tableName string, dbName string, isExactQuery bool) (*pb.TableInfoResponse, error)
+	GetTableInfo(ctx context.Context, requestId string, assetName string, chainId string, isExactQuery bool, alias string) (*pb.TableInfoResponse, error)
+	GetTableInfoByDataSource(ctx context.Context, connInfo *pb.ConnectionInfo, tableName string, isExactQuery bool) (*pb.TableInfoResponse, error)
+	GetInternalTableInfo(ctx context.Context, tableName string, dbName string, isExactQuery bool) (*pb.TableInfoResponse, error)
+	ProfileTable(ctx context.Context, request *pb.ProfileTableRequest) (*pb.ProfileTableResponse, error)
 }
 
 type tableInfoService struct {
-	logger *zap.SugaredLogger
+	logger   *zap.SugaredLogger
+	profiles *profileCache
 }
 
 func NewTableInfoService(logger *zap.SugaredLogger) TableInfoService {
 	return &tableInfoService{
-		logger: logger,
+		logger:   logger,
+		profiles: newProfileCache(),
 	}
 }
 
 // 通过数据资产获取表信息
-func (s *tableInfoService) GetTableInfo(requestId string, assetName string, chainId string, isExactQuery bool, alias string) (*pb.TableInfoResponse, error) {
+func (s *tableInfoService) GetTableInfo(ctx context.Context, requestId string, assetName string, chainId string, isExactQuery bool, alias string) (*pb.TableInfoResponse, error) {
 	// 获取表大小信息
 	connInfo, err := utils.GetDatasourceByAssetName(requestId, assetName, chainId, alias)
 	if err != nil {
@@ -53,7 +57,9 @@ func (s *tableInfoService) GetTableInfo(requestId string, assetName string, chai
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
-	tableInfo, err := dbStrategy.GetTableInfo(connInfo.DbName, connInfo.TableName, isExactQuery)
+	callCtx, cancel := database.WithCallTimeout(ctx)
+	defer cancel()
+	tableInfo, err := dbStrategy.GetTableInfo(callCtx, connInfo.DbName, connInfo.TableName, isExactQuery)
 	if err != nil {
 		s.logger.Errorf("Failed to get table info: %v", err)
 		return nil, err
@@ -63,7 +69,7 @@ func (s *tableInfoService) GetTableInfo(requestId string, assetName string, chai
 }
 
 // 通过数据源信息获取表信息
-func (s *tableInfoService) GetTableInfoByDataSource(connInfo *pb.ConnectionInfo, tableName string, isExactQuery bool) (*pb.TableInfoResponse, error) {
+func (s *tableInfoService) GetTableInfoByDataSource(ctx context.Context, connInfo *pb.ConnectionInfo, tableName string, isExactQuery bool) (*pb.TableInfoResponse, error) {
 	dbType := utils.ConvertDataSourceType(connInfo.Dbtype)
 	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN {
 		s.logger.Errorf("Failed to convert data source type: %v", connInfo.Dbtype)
@@ -81,7 +87,9 @@ func (s *tableInfoService) GetTableInfoByDataSource(connInfo *pb.ConnectionInfo,
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
-	tableInfo, err := dbStrategy.GetTableInfo(connInfo.DbName, tableName, isExactQuery)
+	callCtx, cancel := database.WithCallTimeout(ctx)
+	defer cancel()
+	tableInfo, err := dbStrategy.GetTableInfo(callCtx, connInfo.DbName, tableName, isExactQuery)
 	if err != nil {
 		s.logger.Errorf("Failed to get table info: %v", err)
 		return nil, err
@@ -91,7 +99,7 @@ func (s *tableInfoService) GetTableInfoByDataSource(connInfo *pb.ConnectionInfo,
 
 }
 
-func (s *tableInfoService) GetInternalTableInfo(tableName string, dbName string, isExactQuery bool) (*pb.TableInfoResponse, error) {
+func (s *tableInfoService) GetInternalTableInfo(ctx context.Context, tableName string, dbName string, isExactQuery bool) (*pb.TableInfoResponse, error) {
 	conf := config.GetConfigMap()
 	connInfo := &pb.ConnectionInfo{
 		Host:      conf.DorisConfig.Address,
@@ -104,5 +112,5 @@ func (s *tableInfoService) GetInternalTableInfo(tableName string, dbName string,
 		TableName: tableName,
 	}
 
-	return s.GetTableInfoByDataSource(connInfo, tableName, isExactQuery)
+	return s.GetTableInfoByDataSource(ctx, connInfo, tableName, isExactQuery)
 }
@@ -0,0 +1,240 @@
+/*
+*
+
+	@author: shiliang
+	@date: 2025/11/26
+	@note: 数据资产与 doris 内部表的字段统计，结果按数据源和表结构版本缓存
+
+*
+*/
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"data-service/config"
+	"data-service/database"
+	pb "data-service/generated/datasource"
+	"data-service/utils"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultProfileSampleRows 抽样统计的默认行数
+	defaultProfileSampleRows = 100000
+	// maxProfileSampleRows 抽样统计的最大行数，样本在内存中统计
+	maxProfileSampleRows = 1000000
+	// defaultProfileTopK 默认返回的高频值个数
+	defaultProfileTopK = 10
+	// profileCacheTTL 统计结果的缓存时间，表结构变化时缓存立即失效
+	profileCacheTTL = 30 * time.Minute
+)
+
+// profileCacheEntry 缓存的统计结果
+type profileCacheEntry struct {
+	response  *pb.ProfileTableResponse
+	expiresAt time.Time
+}
+
+// profileCache 统计结果缓存，键包含数据源、表结构版本及统计参数
+type profileCache struct {
+	entries map[string]*profileCacheEntry
+	mutex   sync.Mutex
+}
+
+func newProfileCache() *profileCache {
+	return &profileCache{entries: make(map[string]*profileCacheEntry)}
+}
+
+// get 返回未过期的缓存结果，返回值为副本并标记为缓存结果
+func (c *profileCache) get(key string) *pb.ProfileTableResponse {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil
+	}
+	response := proto.Clone(entry.response).(*pb.ProfileTableResponse)
+	response.Cached = true
+	return response
+}
+
+// put 保存统计结果，同时清理已过期的条目
+func (c *profileCache) put(key string, response *pb.ProfileTableResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = &profileCacheEntry{
+		response:  proto.Clone(response).(*pb.ProfileTableResponse),
+		expiresAt: now.Add(profileCacheTTL),
+	}
+}
+
+// ProfileTable 统计数据资产或 doris 内部表各字段的空值率、不同值个数、最值与高频值
+// 表结构通过 GetTableInfo 获取，统计查询耗时与数据量相关，只跟随请求的 ctx，不使用单次调用超时
+func (s *tableInfoService) ProfileTable(ctx context.Context, request *pb.ProfileTableRequest) (*pb.ProfileTableResponse, error) {
+	var (
+		connInfo *pb.ConnectionInfo
+		// sourceKey 缓存键中标识数据源的部分
+		sourceKey string
+		// profileTableName 统计 SQL 中使用的表名
+		profileTableName string
+		err              error
+	)
+	switch {
+	case request.GetExternal() != nil:
+		external := request.GetExternal()
+		connInfo, err = utils.GetDatasourceByAssetName(request.RequestId, external.AssetName, external.ChainInfoId, external.Alias)
+		if err != nil {
+			s.logger.Errorf("Failed to get datasource by asset name: %v", err)
+			return nil, err
+		}
+		sourceKey = fmt.Sprintf("asset:%s/%s/%s", external.ChainInfoId, external.Alias, external.AssetName)
+		profileTableName = connInfo.TableName
+	case request.GetDoris() != nil:
+		doris := request.GetDoris()
+		conf := config.GetConfigMap()
+		// Doris 连接池共享，库名写在表名中而不是通过 USE 切换
+		connInfo = &pb.ConnectionInfo{
+			Host:      conf.DorisConfig.Address,
+			Port:      9030,
+			User:      conf.DorisConfig.User,
+			DbName:    doris.DbName,
+			Password:  conf.DorisConfig.Password,
+			Dbtype:    int32(pb.DataSourceType_DATA_SOURCE_TYPE_DORIS),
+			TableName: doris.TableName,
+		}
+		sourceKey = fmt.Sprintf("doris:%s.%s", doris.DbName, doris.TableName)
+		profileTableName = doris.TableName
+		if doris.DbName != "" {
+			profileTableName = doris.DbName + "." + doris.TableName
+		}
+	default:
+		return nil, fmt.Errorf("data source is required")
+	}
+
+	dbType := utils.ConvertDataSourceType(connInfo.Dbtype)
+	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN {
+		s.logger.Errorf("Failed to convert data source type: %v", connInfo.Dbtype)
+		return nil, fmt.Errorf("invalid database type")
+	}
+	dbStrategy, err := database.DatabaseFactory(dbType, connInfo)
+	if err != nil {
+		s.logger.Errorf("Failed to create database strategy: %v", err)
+		return nil, err
+	}
+	if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
+		s.logger.Errorf("Failed to connect to database: %v", err)
+		return nil, fmt.Errorf("failed to connect to database: %v", err)
+	}
+
+	callCtx, cancel := database.WithCallTimeout(ctx)
+	tableInfo, err := dbStrategy.GetTableInfo(callCtx, connInfo.DbName, connInfo.TableName, false)
+	cancel()
+	if err != nil {
+		s.logger.Errorf("Failed to get table info: %v", err)
+		return nil, err
+	}
+	columns, err := selectProfileColumns(tableInfo.Columns, request.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := database.ProfileOptions{TopK: int(request.TopK)}
+	if opts.TopK == 0 {
+		opts.TopK = defaultProfileTopK
+	}
+	if request.Mode == pb.ProfileMode_PROFILE_MODE_SAMPLED {
+		opts.SampleRows = request.SampleRows
+		if opts.SampleRows <= 0 {
+			opts.SampleRows = defaultProfileSampleRows
+		}
+		if opts.SampleRows > maxProfileSampleRows {
+			opts.SampleRows = maxProfileSampleRows
+		}
+	}
+
+	schemaVersion := profileSchemaVersion(tableInfo.Columns)
+	cacheKey := strings.Join([]string{sourceKey, schemaVersion, request.Mode.String(),
+		fmt.Sprint(opts.SampleRows), fmt.Sprint(opts.TopK), profileColumnKey(columns)}, "|")
+	if !request.Refresh {
+		if cached := s.profiles.get(cacheKey); cached != nil {
+			s.logger.Infof("Using cached profile of %s, schema version %s", sourceKey, schemaVersion)
+			return cached, nil
+		}
+	}
+
+	start := time.Now()
+	rowCount, profiles, err := database.ProfileTable(ctx, dbStrategy, dbType, profileTableName, columns, opts)
+	if err != nil {
+		s.logger.Errorf("Failed to profile table %s: %v", profileTableName, err)
+		return nil, err
+	}
+	s.logger.Infof("Profiled %d columns of %s over %d rows in %v", len(profiles), sourceKey, rowCount, time.Since(start))
+
+	response := &pb.ProfileTableResponse{
+		TableName:     connInfo.TableName,
+		RowCount:      rowCount,
+		Mode:          request.Mode,
+		SchemaVersion: schemaVersion,
+		ProfiledAt:    start.Unix(),
+		Columns:       profiles,
+	}
+	s.profiles.put(cacheKey, response)
+	return response, nil
+}
+
+// selectProfileColumns 按请求的字段名筛选需要统计的字段，names 为空时统计全部字段
+func selectProfileColumns(columns []*pb.ColumnItem, names []string) ([]*pb.ColumnItem, error) {
+	if len(names) == 0 {
+		return columns, nil
+	}
+	selected := make([]*pb.ColumnItem, 0, len(names))
+	for _, name := range names {
+		var found *pb.ColumnItem
+		for _, column := range columns {
+			if strings.EqualFold(column.Name, name) {
+				found = column
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("column %s not found", name)
+		}
+		selected = append(selected, found)
+	}
+	return selected, nil
+}
+
+// profileSchemaVersion 根据字段名和类型计算表结构版本
+func profileSchemaVersion(columns []*pb.ColumnItem) string {
+	h := sha256.New()
+	for _, column := range columns {
+		fmt.Fprintf(h, "%s:%s;", strings.ToLower(column.Name), strings.ToLower(column.DataType))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// profileColumnKey 缓存键中表示统计字段的部分
+func profileColumnKey(columns []*pb.ColumnItem) string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = strings.ToLower(column.Name)
+	}
+	return strings.Join(names, ",")
+}
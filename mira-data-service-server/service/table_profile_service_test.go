@@ -0,0 +1,43 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	ds "data-service/generated/datasource"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileCache(t *testing.T) {
+	cache := newProfileCache()
+	assert.Nil(t, cache.get("k"))
+
+	response := &ds.ProfileTableResponse{TableName: "t", RowCount: 3}
+	cache.put("k", response)
+	cached := cache.get("k")
+	assert.True(t, cached.Cached)
+	assert.Equal(t, int64(3), cached.RowCount)
+	// 返回副本，修改不影响缓存
+	cached.RowCount = 0
+	assert.Equal(t, int64(3), cache.get("k").RowCount)
+	assert.False(t, response.Cached)
+
+	cache.entries["k"].expiresAt = time.Now().Add(-time.Second)
+	assert.Nil(t, cache.get("k"))
+	assert.Empty(t, cache.entries)
+}
+
+func TestProfileSchemaVersion(t *testing.T) {
+	columns := []*ds.ColumnItem{{Name: "id", DataType: "BIGINT"}, {Name: "city", DataType: "VARCHAR"}}
+	version := profileSchemaVersion(columns)
+	assert.Len(t, version, 16)
+	assert.Equal(t, version, profileSchemaVersion([]*ds.ColumnItem{{Name: "ID", DataType: "bigint"}, {Name: "city", DataType: "varchar"}}))
+	assert.NotEqual(t, version, profileSchemaVersion([]*ds.ColumnItem{{Name: "id", DataType: "INT"}, {Name: "city", DataType: "VARCHAR"}}))
+
+	selected, err := selectProfileColumns(columns, []string{"CITY"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*ds.ColumnItem{columns[1]}, selected)
+	_, err = selectProfileColumns(columns, []string{"name"})
+	assert.EqualError(t, err, "column name not found")
+}
@@ -12,6 +12,7 @@ package utils
 import (
 	"data-service/log"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -96,10 +97,8 @@ func AppendUint8Value(b *array.Uint8Builder, val interface{}) {
 		b.Append(uint8(0)) // 默认值 0
 	case uint8:
 		b.Append(v)
-	case int64:
-		b.Append(uint8(v)) // 转换为 uint8
 	default:
-		b.Append(uint8(0)) // 默认值 0
+		b.Append(uint8(unsignedValue(v))) // 转换为 uint8
 	}
 }
 
@@ -109,10 +108,8 @@ func AppendUint16Value(b *array.Uint16Builder, val interface{}) {
 		b.Append(uint16(0)) // 默认值 0
 	case uint16:
 		b.Append(v)
-	case int64:
-		b.Append(uint16(v)) // 转换为 uint16
 	default:
-		b.Append(uint16(0)) // 默认值 0
+		b.Append(uint16(unsignedValue(v))) // 转换为 uint16
 	}
 }
 
@@ -122,23 +119,34 @@ func AppendUint32Value(b *array.Uint32Builder, val interface{}) {
 		b.Append(uint32(0)) // 默认值 0
 	case uint32:
 		b.Append(v)
-	case int64:
-		b.Append(uint32(v)) // 转换为 uint32
 	default:
-		b.Append(uint32(0)) // 默认值 0
+		b.Append(uint32(unsignedValue(v))) // 转换为 uint32
 	}
 }
 
 func AppendUint64Value(b *array.Uint64Builder, val interface{}) {
-	switch v := val.(type) {
-	case nil:
+	if val == nil {
 		b.Append(uint64(0)) // 默认值 0
+		return
+	}
+	b.Append(unsignedValue(val))
+}
+
+// unsignedValue 将驱动返回的整数或文本转换为 uint64，MySQL 驱动对 BIGINT UNSIGNED 返回 uint64，文本协议可能返回 []byte，无法转换时返回 0
+func unsignedValue(val interface{}) uint64 {
+	switch v := val.(type) {
 	case uint64:
-		b.Append(v)
+		return v
 	case int64:
-		b.Append(uint64(v)) // 转换为 uint64
+		return uint64(v)
+	case []byte:
+		n, _ := strconv.ParseUint(string(v), 10, 64)
+		return n
+	case string:
+		n, _ := strconv.ParseUint(v, 10, 64)
+		return n
 	default:
-		b.Append(uint64(0)) // 默认值 0
+		return 0
 	}
 }
 
@@ -287,6 +295,8 @@ func AppendTimestampValue(b *array.TimestampBuilder, val interface{}) {
 	case []byte:
 		// 如果是 []byte 类型，尝试解析为字符串的时间戳
 		appendTimestampFromBytes(b, v)
+	case string:
+		appendTimestampFromBytes(b, []byte(v))
 	default:
 		// 不支持的类型，使用默认值
 		b.Append(arrow.Timestamp(0))
@@ -314,18 +324,27 @@ func appendTimestampFromTime(b *array.TimestampBuilder, timeVal time.Time) {
 	}
 }
 
+// timestampLayouts 文本形式时间戳的格式，带时区偏移的按偏移换算，不带的按 UTC 解析
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
 func appendTimestampFromBytes(b *array.TimestampBuilder, byteVal []byte) {
-	strVal := string(byteVal)
-	// 解析字符串为时间戳，格式为 "2006-01-02 15:04:05"
-	parsedTime, err := time.Parse("2006-01-02 15:04:05", strVal)
-	if err == nil {
-		// 如果解析成功，将时间戳的纳秒数添加到 builder
-		b.Append(arrow.Timestamp(parsedTime.UnixNano()))
-	} else {
-		// 如果解析失败，记录警告日志，并添加默认值 0
-		log.Logger.Warnf("Failed to parse TIMESTAMP: %s", strVal)
-		b.Append(arrow.Timestamp(0))
+	strVal := strings.TrimSpace(string(byteVal))
+	for _, layout := range timestampLayouts {
+		if parsedTime, err := time.Parse(layout, strVal); err == nil {
+			// 解析成功后按时间戳单位换算
+			appendTimestampFromTime(b, parsedTime)
+			return
+		}
 	}
+	// 如果解析失败，记录警告日志，并添加默认值 0
+	log.Logger.Warnf("Failed to parse TIMESTAMP: %s", strVal)
+	b.Append(arrow.Timestamp(0))
 }
 
 func AppendDate32Value(b *array.Date32Builder, val interface{}) {
@@ -405,6 +424,52 @@ func appendTime32FromString(b *array.Time32Builder, strVal string) {
 	b.Append(arrow.Time32(secondsSinceMidnight))
 }
 
+// AppendBooleanValue 处理布尔类型的值，MySQL 的 BIT(1) 返回单字节 0/1，其余驱动返回 bool、整数或 t/true/1 等文本
+func AppendBooleanValue(b *array.BooleanBuilder, val interface{}) {
+	switch v := val.(type) {
+	case nil:
+		b.AppendNull()
+	case bool:
+		b.Append(v)
+	case int64:
+		b.Append(v != 0)
+	case []byte:
+		if len(v) == 1 && v[0] <= 1 {
+			b.Append(v[0] == 1)
+			return
+		}
+		appendBooleanFromString(b, string(v))
+	case string:
+		appendBooleanFromString(b, v)
+	default:
+		b.AppendNull()
+	}
+}
+
+func appendBooleanFromString(b *array.BooleanBuilder, strVal string) {
+	parsed, err := strconv.ParseBool(strings.TrimSpace(strVal))
+	if err != nil {
+		log.Logger.Warnf("Failed to parse BOOLEAN: %s", strVal)
+		b.AppendNull()
+		return
+	}
+	b.Append(parsed)
+}
+
+// AppendBinaryValue 处理二进制类型的值
+func AppendBinaryValue(b *array.BinaryBuilder, val interface{}) {
+	switch v := val.(type) {
+	case nil:
+		b.AppendNull()
+	case []byte:
+		b.Append(v)
+	case string:
+		b.AppendString(v)
+	default:
+		b.AppendString(fmt.Sprintf("%v", v))
+	}
+}
+
 func AppendValueToBuilder(b array.Builder, val interface{}) error {
 	switch b := b.(type) {
 	case *array.StringBuilder:
@@ -430,7 +495,11 @@ func AppendValueToBuilder(b array.Builder, val interface{}) error {
 	case *array.Time32Builder:
 		AppendStringValue(b, val)
 	case *array.TimestampBuilder:
-		AppendStringValue(b, val)
+		if val == nil {
+			b.AppendNull()
+		} else {
+			AppendTimestampValue(b, val)
+		}
 	case *array.Float32Builder:
 		AppendFloat32Value(b, val)
 	case *array.Float64Builder:
@@ -438,7 +507,15 @@ func AppendValueToBuilder(b array.Builder, val interface{}) error {
 	case *array.Decimal128Builder:
 		AppendDecimalValue(b, val)
 	case *array.Date32Builder:
-		AppendStringValue(b, val)
+		if val == nil {
+			b.AppendNull()
+		} else {
+			AppendDate32Value(b, val)
+		}
+	case *array.BooleanBuilder:
+		AppendBooleanValue(b, val)
+	case *array.BinaryBuilder:
+		AppendBinaryValue(b, val)
 	default:
 		return fmt.Errorf("unsupported builder type: %T", b)
 	}
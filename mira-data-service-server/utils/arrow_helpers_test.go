@@ -121,8 +121,9 @@ func TestAppendTimestampValue(t *testing.T) {
 		{"NilInput", nil, 0, arrow.Second, false},                                                       // nil 输入
 		{"ValidTimeInput", time.Unix(1672531200, 0), 1672531200, arrow.Second, false},                   // 正确的 time.Time 输入
 		{"ValidByteInput", []byte("2023-01-01 00:00:00"), 1672531200000000000, arrow.Nanosecond, false}, // 正确的 []byte 输入
-		{"InvalidByteInput", []byte("invalid time"), 0, arrow.Second, true},                             // 无法解析的时间字符串
-		{"UnsupportedType", 12345, 0, arrow.Second, false},                                              // 不支持的类型
+		{"OffsetStringInput", "2023-01-01 08:00:00.5+08", 1672531200500000, arrow.Microsecond, false},
+		{"InvalidByteInput", []byte("invalid time"), 0, arrow.Second, true}, // 无法解析的时间字符串
+		{"UnsupportedType", 12345, 0, arrow.Second, false},                  // 不支持的类型
 	}
 
 	for _, test := range tests {
@@ -358,9 +359,10 @@ func TestAppendUint64Value(t *testing.T) {
 		expected uint64
 	}{
 		{"NilInput", nil, uint64(0)}, // nil 输入，期望值为 0
-		{"ValidUint64Input", uint64(1234567890123456789), uint64(1234567890123456789)}, // uint64 输入，直接追加
-		{"Int64ToUint64", int64(9223372036854775807), uint64(9223372036854775807)},     // int64 转换为 uint64
-		{"InvalidType", "string", uint64(0)},                                           // 无效类型，默认值为 0
+		{"ValidUint64Input", uint64(1234567890123456789), uint64(1234567890123456789)},  // uint64 输入，直接追加
+		{"Int64ToUint64", int64(9223372036854775807), uint64(9223372036854775807)},      // int64 转换为 uint64
+		{"BytesToUint64", []byte("18446744073709551615"), uint64(18446744073709551615)}, // 驱动以文本返回的 BIGINT UNSIGNED
+		{"InvalidType", "string", uint64(0)},                                            // 无效类型，默认值为 0
 	}
 
 	for _, test := range tests {
@@ -570,3 +572,41 @@ func TestAppendFloat64Value(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendBooleanValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		valid    bool
+		expected bool
+	}{
+		{"NilInput", nil, false, false},         // nil 输入，追加 null
+		{"BoolInput", true, true, true},         // bool 输入，直接追加
+		{"Int64Input", int64(0), true, false},   // TINYINT(1) 按非零判断
+		{"BitInput", []byte{1}, true, true},     // BIT(1) 返回单字节
+		{"TextInput", []byte("t"), true, true},  // PostgreSQL 文本格式
+		{"StringInput", "false", true, false},   // 字符串形式
+		{"InvalidInput", "maybe", false, false}, // 无法解析，追加 null
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+			defer mem.AssertSize(t, 0)
+
+			b := array.NewBooleanBuilder(mem)
+			defer b.Release()
+
+			AppendBooleanValue(b, test.input)
+
+			arr := b.NewArray().(*array.Boolean)
+			defer arr.Release()
+
+			require.Equal(t, 1, arr.Len(), "Array length should be 1")
+			assert.Equal(t, test.valid, arr.IsValid(0), "Boolean validity mismatch")
+			if test.valid {
+				assert.Equal(t, test.expected, arr.Value(0), "Boolean value mismatch")
+			}
+		})
+	}
+}
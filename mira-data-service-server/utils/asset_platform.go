@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	pb "chainweaver.org.cn/chainweaver/mira/mira-ida-access-service/pb/mirapb"
 
@@ -107,6 +108,8 @@ func ConvertDataSourceType(dbType int32) pb2.DataSourceType {
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_MYSQL
 	case 2:
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_KINGBASE
+	case 3:
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_HIVE
 	case 4:
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_TIDB
 	case 5:
@@ -117,6 +120,12 @@ func ConvertDataSourceType(dbType int32) pb2.DataSourceType {
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_GBASE
 	case 8:
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_DORIS
+	case 9:
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL
+	case 10:
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS
+	case 11:
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_DAMENG
 	default:
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN
 	}
@@ -136,6 +145,14 @@ func ConvertDBType(dbType string) pb2.DataSourceType {
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_VASTBASE
 	case "gbase":
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_GBASE
+	case "hive":
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_HIVE
+	case "postgresql":
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL
+	case "opengauss":
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS
+	case "dameng":
+		return pb2.DataSourceType_DATA_SOURCE_TYPE_DAMENG
 	default:
 		return pb2.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN
 	}
@@ -147,6 +164,8 @@ func GetDbTypeName(dbType int32) string {
 		return "mysql"
 	case 2:
 		return "kingbase_pgsql"
+	case 3:
+		return "hive"
 	case 4:
 		return "tidb"
 	case 5:
@@ -157,6 +176,12 @@ func GetDbTypeName(dbType int32) string {
 		return "gbase"
 	case 8:
 		return "doris"
+	case 9:
+		return "postgresql"
+	case 10:
+		return "opengauss"
+	case 11:
+		return "dameng"
 	default:
 		return "unknown"
 	}
@@ -187,6 +212,8 @@ func GetDbTypeFromName(dbTypeName string) int32 {
 		return 1
 	case "kingbase_pgsql":
 		return 2
+	case "hive":
+		return 3
 	case "tidb":
 		return 4
 	case "tdsql":
@@ -197,6 +224,12 @@ func GetDbTypeFromName(dbTypeName string) int32 {
 		return 7
 	case "doris":
 		return 8
+	case "postgresql":
+		return 9
+	case "opengauss":
+		return 10
+	case "dameng":
+		return 11
 	default:
 		return 0
 	}
@@ -211,18 +244,18 @@ func BuildSelectQuery(query string, args []interface{}, dbType pb2.DataSourceTyp
 			if argIndex >= len(args) {
 				return "", fmt.Errorf("insufficient arguments for query")
 			}
-			arg, err := formatArgument(args[argIndex])
+			arg, err := formatArgument(args[argIndex], dbType)
 			if err != nil {
 				return "", err
 			}
 			sb.WriteString(arg)
 			argIndex++
-		} else if dbType == pb2.DataSourceType_DATA_SOURCE_TYPE_KINGBASE && query[i] == '$' && i+1 < len(query) && isDigit(query[i+1]) {
-			// 处理 Kingbase 的占位符 $1, $2
+		} else if usesDollarPlaceholder(dbType) && query[i] == '$' && i+1 < len(query) && isDigit(query[i+1]) {
+			// 处理 Kingbase/Vastbase/PostgreSQL 的占位符 $1, $2
 			if argIndex >= len(args) {
 				return "", fmt.Errorf("insufficient arguments for query")
 			}
-			arg, err := formatArgument(args[argIndex])
+			arg, err := formatArgument(args[argIndex], dbType)
 			if err != nil {
 				return "", err
 			}
@@ -246,11 +279,26 @@ func BuildSelectQuery(query string, args []interface{}, dbType pb2.DataSourceTyp
 	return sb.String(), nil
 }
 
-// 格式化参数并转义
-func formatArgument(arg interface{}) (string, error) {
+// usesDollarPlaceholder 判断数据源是否使用 $1,$2 形式的占位符
+func usesDollarPlaceholder(dbType pb2.DataSourceType) bool {
+	switch dbType {
+	case pb2.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, pb2.DataSourceType_DATA_SOURCE_TYPE_VASTBASE,
+		pb2.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL, pb2.DataSourceType_DATA_SOURCE_TYPE_OPENGAUSS:
+		return true
+	default:
+		return false
+	}
+}
+
+// 格式化参数并转义，字符串中的单引号写两次，MySQL 系与 Hive 额外转义反斜杠
+func formatArgument(arg interface{}, dbType pb2.DataSourceType) (string, error) {
 	switch v := arg.(type) {
+	case nil:
+		return "NULL", nil
 	case string:
-		return fmt.Sprintf("'%s'", v), nil
+		return quoteStringLiteral(v, dbType), nil
+	case time.Time:
+		return quoteStringLiteral(v.Format("2006-01-02 15:04:05.999999"), dbType), nil
 	case int, int8, int16, int32, int64:
 		return fmt.Sprintf("%d", v), nil
 	case uint, uint8, uint16, uint32, uint64:
@@ -266,6 +314,20 @@ func formatArgument(arg interface{}) (string, error) {
 	}
 }
 
+// quoteStringLiteral 为字符串加单引号并转义
+func quoteStringLiteral(s string, dbType pb2.DataSourceType) string {
+	switch dbType {
+	case pb2.DataSourceType_DATA_SOURCE_TYPE_HIVE:
+		// Hive 不支持两个单引号的写法，统一用反斜杠转义
+		s = strings.ReplaceAll(s, `\`, `\\`)
+		return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+	case pb2.DataSourceType_DATA_SOURCE_TYPE_MYSQL, pb2.DataSourceType_DATA_SOURCE_TYPE_GBASE,
+		pb2.DataSourceType_DATA_SOURCE_TYPE_DORIS:
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 // 判断字符是否为数字
 func isDigit(c byte) bool {
 	return c >= '0' && c <= '9'
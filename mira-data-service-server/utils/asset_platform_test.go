@@ -171,6 +171,24 @@ func TestBuildSelectQuery(t *testing.T) {
 			wantErr:    true,
 			errMessage: "too many arguments for query",
 		},
+		{
+			name:       "MySQL string argument with quotes is escaped",
+			query:      "SELECT * FROM users WHERE name = ?",
+			args:       []interface{}{`O'Brien\' OR 1=1 -- `},
+			dbType:     pb2.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
+			want:       `SELECT * FROM users WHERE name = 'O''Brien\\'' OR 1=1 -- '`,
+			wantErr:    false,
+			errMessage: "",
+		},
+		{
+			name:       "PostgreSQL placeholder with string argument",
+			query:      "SELECT * FROM users WHERE name = $1",
+			args:       []interface{}{"O'Brien"},
+			dbType:     pb2.DataSourceType_DATA_SOURCE_TYPE_POSTGRESQL,
+			want:       "SELECT * FROM users WHERE name = 'O''Brien'",
+			wantErr:    false,
+			errMessage: "",
+		},
 		// Add more test cases as necessary
 	}
 